@@ -1469,11 +1469,63 @@ func (x *_SchemaResponse_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_SchemaResponse_4_list)(nil)
+
+type _SchemaResponse_4_list struct {
+	list *[]*SchemaResponse_MigrationHandler
+}
+
+func (x *_SchemaResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SchemaResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SchemaResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SchemaResponse_MigrationHandler)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SchemaResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SchemaResponse_MigrationHandler)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SchemaResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(SchemaResponse_MigrationHandler)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SchemaResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SchemaResponse_4_list) NewElement() protoreflect.Value {
+	v := new(SchemaResponse_MigrationHandler)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SchemaResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SchemaResponse                  protoreflect.MessageDescriptor
-	fd_SchemaResponse_init_schema      protoreflect.FieldDescriptor
-	fd_SchemaResponse_execute_handlers protoreflect.FieldDescriptor
-	fd_SchemaResponse_query_handlers   protoreflect.FieldDescriptor
+	md_SchemaResponse                    protoreflect.MessageDescriptor
+	fd_SchemaResponse_init_schema        protoreflect.FieldDescriptor
+	fd_SchemaResponse_execute_handlers   protoreflect.FieldDescriptor
+	fd_SchemaResponse_query_handlers     protoreflect.FieldDescriptor
+	fd_SchemaResponse_migration_handlers protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1482,6 +1534,7 @@ func init() {
 	fd_SchemaResponse_init_schema = md_SchemaResponse.Fields().ByName("init_schema")
 	fd_SchemaResponse_execute_handlers = md_SchemaResponse.Fields().ByName("execute_handlers")
 	fd_SchemaResponse_query_handlers = md_SchemaResponse.Fields().ByName("query_handlers")
+	fd_SchemaResponse_migration_handlers = md_SchemaResponse.Fields().ByName("migration_handlers")
 }
 
 var _ protoreflect.Message = (*fastReflection_SchemaResponse)(nil)
@@ -1567,6 +1620,12 @@ func (x *fastReflection_SchemaResponse) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.MigrationHandlers) != 0 {
+		value := protoreflect.ValueOfList(&_SchemaResponse_4_list{list: &x.MigrationHandlers})
+		if !f(fd_SchemaResponse_migration_handlers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1588,6 +1647,8 @@ func (x *fastReflection_SchemaResponse) Has(fd protoreflect.FieldDescriptor) boo
 		return len(x.ExecuteHandlers) != 0
 	case "cosmos.accounts.v1.SchemaResponse.query_handlers":
 		return len(x.QueryHandlers) != 0
+	case "cosmos.accounts.v1.SchemaResponse.migration_handlers":
+		return len(x.MigrationHandlers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse"))
@@ -1610,6 +1671,8 @@ func (x *fastReflection_SchemaResponse) Clear(fd protoreflect.FieldDescriptor) {
 		x.ExecuteHandlers = nil
 	case "cosmos.accounts.v1.SchemaResponse.query_handlers":
 		x.QueryHandlers = nil
+	case "cosmos.accounts.v1.SchemaResponse.migration_handlers":
+		x.MigrationHandlers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse"))
@@ -1641,6 +1704,12 @@ func (x *fastReflection_SchemaResponse) Get(descriptor protoreflect.FieldDescrip
 		}
 		listValue := &_SchemaResponse_3_list{list: &x.QueryHandlers}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.accounts.v1.SchemaResponse.migration_handlers":
+		if len(x.MigrationHandlers) == 0 {
+			return protoreflect.ValueOfList(&_SchemaResponse_4_list{})
+		}
+		listValue := &_SchemaResponse_4_list{list: &x.MigrationHandlers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse"))
@@ -1671,6 +1740,10 @@ func (x *fastReflection_SchemaResponse) Set(fd protoreflect.FieldDescriptor, val
 		lv := value.List()
 		clv := lv.(*_SchemaResponse_3_list)
 		x.QueryHandlers = *clv.list
+	case "cosmos.accounts.v1.SchemaResponse.migration_handlers":
+		lv := value.List()
+		clv := lv.(*_SchemaResponse_4_list)
+		x.MigrationHandlers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse"))
@@ -1708,6 +1781,12 @@ func (x *fastReflection_SchemaResponse) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_SchemaResponse_3_list{list: &x.QueryHandlers}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.accounts.v1.SchemaResponse.migration_handlers":
+		if x.MigrationHandlers == nil {
+			x.MigrationHandlers = []*SchemaResponse_MigrationHandler{}
+		}
+		value := &_SchemaResponse_4_list{list: &x.MigrationHandlers}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse"))
@@ -1730,6 +1809,9 @@ func (x *fastReflection_SchemaResponse) NewField(fd protoreflect.FieldDescriptor
 	case "cosmos.accounts.v1.SchemaResponse.query_handlers":
 		list := []*SchemaResponse_Handler{}
 		return protoreflect.ValueOfList(&_SchemaResponse_3_list{list: &list})
+	case "cosmos.accounts.v1.SchemaResponse.migration_handlers":
+		list := []*SchemaResponse_MigrationHandler{}
+		return protoreflect.ValueOfList(&_SchemaResponse_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse"))
@@ -1815,6 +1897,12 @@ func (x *fastReflection_SchemaResponse) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.MigrationHandlers) > 0 {
+			for _, e := range x.MigrationHandlers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1844,6 +1932,22 @@ func (x *fastReflection_SchemaResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MigrationHandlers) > 0 {
+			for iNdEx := len(x.MigrationHandlers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MigrationHandlers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.QueryHandlers) > 0 {
 			for iNdEx := len(x.QueryHandlers) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.QueryHandlers[iNdEx])
@@ -1901,7 +2005,565 @@ func (x *fastReflection_SchemaResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SchemaResponse)
+		x := input.Message.Interface().(*SchemaResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitSchema", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InitSchema == nil {
+					x.InitSchema = &SchemaResponse_Handler{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitSchema); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteHandlers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecuteHandlers = append(x.ExecuteHandlers, &SchemaResponse_Handler{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecuteHandlers[len(x.ExecuteHandlers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueryHandlers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QueryHandlers = append(x.QueryHandlers, &SchemaResponse_Handler{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueryHandlers[len(x.QueryHandlers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MigrationHandlers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MigrationHandlers = append(x.MigrationHandlers, &SchemaResponse_MigrationHandler{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MigrationHandlers[len(x.MigrationHandlers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SchemaResponse_Handler          protoreflect.MessageDescriptor
+	fd_SchemaResponse_Handler_request  protoreflect.FieldDescriptor
+	fd_SchemaResponse_Handler_response protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_accounts_v1_query_proto_init()
+	md_SchemaResponse_Handler = File_cosmos_accounts_v1_query_proto.Messages().ByName("SchemaResponse").Messages().ByName("Handler")
+	fd_SchemaResponse_Handler_request = md_SchemaResponse_Handler.Fields().ByName("request")
+	fd_SchemaResponse_Handler_response = md_SchemaResponse_Handler.Fields().ByName("response")
+}
+
+var _ protoreflect.Message = (*fastReflection_SchemaResponse_Handler)(nil)
+
+type fastReflection_SchemaResponse_Handler SchemaResponse_Handler
+
+func (x *SchemaResponse_Handler) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SchemaResponse_Handler)(x)
+}
+
+func (x *SchemaResponse_Handler) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_query_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SchemaResponse_Handler_messageType fastReflection_SchemaResponse_Handler_messageType
+var _ protoreflect.MessageType = fastReflection_SchemaResponse_Handler_messageType{}
+
+type fastReflection_SchemaResponse_Handler_messageType struct{}
+
+func (x fastReflection_SchemaResponse_Handler_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SchemaResponse_Handler)(nil)
+}
+func (x fastReflection_SchemaResponse_Handler_messageType) New() protoreflect.Message {
+	return new(fastReflection_SchemaResponse_Handler)
+}
+func (x fastReflection_SchemaResponse_Handler_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchemaResponse_Handler
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SchemaResponse_Handler) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchemaResponse_Handler
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SchemaResponse_Handler) Type() protoreflect.MessageType {
+	return _fastReflection_SchemaResponse_Handler_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SchemaResponse_Handler) New() protoreflect.Message {
+	return new(fastReflection_SchemaResponse_Handler)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SchemaResponse_Handler) Interface() protoreflect.ProtoMessage {
+	return (*SchemaResponse_Handler)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SchemaResponse_Handler) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Request != "" {
+		value := protoreflect.ValueOfString(x.Request)
+		if !f(fd_SchemaResponse_Handler_request, value) {
+			return
+		}
+	}
+	if x.Response != "" {
+		value := protoreflect.ValueOfString(x.Response)
+		if !f(fd_SchemaResponse_Handler_response, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SchemaResponse_Handler) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
+		return x.Request != ""
+	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
+		return x.Response != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaResponse_Handler) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
+		x.Request = ""
+	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
+		x.Response = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SchemaResponse_Handler) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
+		value := x.Request
+		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
+		value := x.Response
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaResponse_Handler) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
+		x.Request = value.Interface().(string)
+	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
+		x.Response = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaResponse_Handler) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
+		panic(fmt.Errorf("field request of message cosmos.accounts.v1.SchemaResponse.Handler is not mutable"))
+	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
+		panic(fmt.Errorf("field response of message cosmos.accounts.v1.SchemaResponse.Handler is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SchemaResponse_Handler) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
+		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+		}
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SchemaResponse_Handler) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.SchemaResponse.Handler", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SchemaResponse_Handler) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SchemaResponse_Handler) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SchemaResponse_Handler) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SchemaResponse_Handler)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Request)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Response)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SchemaResponse_Handler)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Response) > 0 {
+			i -= len(x.Response)
+			copy(dAtA[i:], x.Response)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Response)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Request) > 0 {
+			i -= len(x.Request)
+			copy(dAtA[i:], x.Request)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Request)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SchemaResponse_Handler)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1933,17 +2595,17 @@ func (x *fastReflection_SchemaResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse_Handler: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse_Handler: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitSchema", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1953,67 +2615,29 @@ func (x *fastReflection_SchemaResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.InitSchema == nil {
-					x.InitSchema = &SchemaResponse_Handler{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitSchema); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Request = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteHandlers", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExecuteHandlers = append(x.ExecuteHandlers, &SchemaResponse_Handler{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecuteHandlers[len(x.ExecuteHandlers)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueryHandlers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2023,25 +2647,23 @@ func (x *fastReflection_SchemaResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.QueryHandlers = append(x.QueryHandlers, &SchemaResponse_Handler{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueryHandlers[len(x.QueryHandlers)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Response = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2079,28 +2701,28 @@ func (x *fastReflection_SchemaResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SchemaResponse_Handler          protoreflect.MessageDescriptor
-	fd_SchemaResponse_Handler_request  protoreflect.FieldDescriptor
-	fd_SchemaResponse_Handler_response protoreflect.FieldDescriptor
+	md_SchemaResponse_MigrationHandler                   protoreflect.MessageDescriptor
+	fd_SchemaResponse_MigrationHandler_from_account_type protoreflect.FieldDescriptor
+	fd_SchemaResponse_MigrationHandler_handler           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_accounts_v1_query_proto_init()
-	md_SchemaResponse_Handler = File_cosmos_accounts_v1_query_proto.Messages().ByName("SchemaResponse").Messages().ByName("Handler")
-	fd_SchemaResponse_Handler_request = md_SchemaResponse_Handler.Fields().ByName("request")
-	fd_SchemaResponse_Handler_response = md_SchemaResponse_Handler.Fields().ByName("response")
+	md_SchemaResponse_MigrationHandler = File_cosmos_accounts_v1_query_proto.Messages().ByName("SchemaResponse").Messages().ByName("MigrationHandler")
+	fd_SchemaResponse_MigrationHandler_from_account_type = md_SchemaResponse_MigrationHandler.Fields().ByName("from_account_type")
+	fd_SchemaResponse_MigrationHandler_handler = md_SchemaResponse_MigrationHandler.Fields().ByName("handler")
 }
 
-var _ protoreflect.Message = (*fastReflection_SchemaResponse_Handler)(nil)
+var _ protoreflect.Message = (*fastReflection_SchemaResponse_MigrationHandler)(nil)
 
-type fastReflection_SchemaResponse_Handler SchemaResponse_Handler
+type fastReflection_SchemaResponse_MigrationHandler SchemaResponse_MigrationHandler
 
-func (x *SchemaResponse_Handler) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SchemaResponse_Handler)(x)
+func (x *SchemaResponse_MigrationHandler) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SchemaResponse_MigrationHandler)(x)
 }
 
-func (x *SchemaResponse_Handler) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_accounts_v1_query_proto_msgTypes[8]
+func (x *SchemaResponse_MigrationHandler) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_accounts_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2111,43 +2733,43 @@ func (x *SchemaResponse_Handler) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SchemaResponse_Handler_messageType fastReflection_SchemaResponse_Handler_messageType
-var _ protoreflect.MessageType = fastReflection_SchemaResponse_Handler_messageType{}
+var _fastReflection_SchemaResponse_MigrationHandler_messageType fastReflection_SchemaResponse_MigrationHandler_messageType
+var _ protoreflect.MessageType = fastReflection_SchemaResponse_MigrationHandler_messageType{}
 
-type fastReflection_SchemaResponse_Handler_messageType struct{}
+type fastReflection_SchemaResponse_MigrationHandler_messageType struct{}
 
-func (x fastReflection_SchemaResponse_Handler_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SchemaResponse_Handler)(nil)
+func (x fastReflection_SchemaResponse_MigrationHandler_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SchemaResponse_MigrationHandler)(nil)
 }
-func (x fastReflection_SchemaResponse_Handler_messageType) New() protoreflect.Message {
-	return new(fastReflection_SchemaResponse_Handler)
+func (x fastReflection_SchemaResponse_MigrationHandler_messageType) New() protoreflect.Message {
+	return new(fastReflection_SchemaResponse_MigrationHandler)
 }
-func (x fastReflection_SchemaResponse_Handler_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SchemaResponse_Handler
+func (x fastReflection_SchemaResponse_MigrationHandler_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchemaResponse_MigrationHandler
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SchemaResponse_Handler) Descriptor() protoreflect.MessageDescriptor {
-	return md_SchemaResponse_Handler
+func (x *fastReflection_SchemaResponse_MigrationHandler) Descriptor() protoreflect.MessageDescriptor {
+	return md_SchemaResponse_MigrationHandler
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SchemaResponse_Handler) Type() protoreflect.MessageType {
-	return _fastReflection_SchemaResponse_Handler_messageType
+func (x *fastReflection_SchemaResponse_MigrationHandler) Type() protoreflect.MessageType {
+	return _fastReflection_SchemaResponse_MigrationHandler_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SchemaResponse_Handler) New() protoreflect.Message {
-	return new(fastReflection_SchemaResponse_Handler)
+func (x *fastReflection_SchemaResponse_MigrationHandler) New() protoreflect.Message {
+	return new(fastReflection_SchemaResponse_MigrationHandler)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SchemaResponse_Handler) Interface() protoreflect.ProtoMessage {
-	return (*SchemaResponse_Handler)(x)
+func (x *fastReflection_SchemaResponse_MigrationHandler) Interface() protoreflect.ProtoMessage {
+	return (*SchemaResponse_MigrationHandler)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2155,16 +2777,16 @@ func (x *fastReflection_SchemaResponse_Handler) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SchemaResponse_Handler) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Request != "" {
-		value := protoreflect.ValueOfString(x.Request)
-		if !f(fd_SchemaResponse_Handler_request, value) {
+func (x *fastReflection_SchemaResponse_MigrationHandler) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromAccountType != "" {
+		value := protoreflect.ValueOfString(x.FromAccountType)
+		if !f(fd_SchemaResponse_MigrationHandler_from_account_type, value) {
 			return
 		}
 	}
-	if x.Response != "" {
-		value := protoreflect.ValueOfString(x.Response)
-		if !f(fd_SchemaResponse_Handler_response, value) {
+	if x.Handler != nil {
+		value := protoreflect.ValueOfMessage(x.Handler.ProtoReflect())
+		if !f(fd_SchemaResponse_MigrationHandler_handler, value) {
 			return
 		}
 	}
@@ -2181,17 +2803,17 @@ func (x *fastReflection_SchemaResponse_Handler) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SchemaResponse_Handler) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SchemaResponse_MigrationHandler) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
-		return x.Request != ""
-	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
-		return x.Response != ""
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.from_account_type":
+		return x.FromAccountType != ""
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.handler":
+		return x.Handler != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.MigrationHandler"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.MigrationHandler does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2201,17 +2823,17 @@ func (x *fastReflection_SchemaResponse_Handler) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SchemaResponse_Handler) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SchemaResponse_MigrationHandler) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
-		x.Request = ""
-	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
-		x.Response = ""
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.from_account_type":
+		x.FromAccountType = ""
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.handler":
+		x.Handler = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.MigrationHandler"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.MigrationHandler does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2221,19 +2843,19 @@ func (x *fastReflection_SchemaResponse_Handler) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SchemaResponse_Handler) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SchemaResponse_MigrationHandler) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
-		value := x.Request
-		return protoreflect.ValueOfString(value)
-	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
-		value := x.Response
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.from_account_type":
+		value := x.FromAccountType
 		return protoreflect.ValueOfString(value)
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.handler":
+		value := x.Handler
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.MigrationHandler"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.MigrationHandler does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2247,17 +2869,17 @@ func (x *fastReflection_SchemaResponse_Handler) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SchemaResponse_Handler) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SchemaResponse_MigrationHandler) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
-		x.Request = value.Interface().(string)
-	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
-		x.Response = value.Interface().(string)
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.from_account_type":
+		x.FromAccountType = value.Interface().(string)
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.handler":
+		x.Handler = value.Message().Interface().(*SchemaResponse_Handler)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.MigrationHandler"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.MigrationHandler does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2271,44 +2893,48 @@ func (x *fastReflection_SchemaResponse_Handler) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SchemaResponse_Handler) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SchemaResponse_MigrationHandler) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
-		panic(fmt.Errorf("field request of message cosmos.accounts.v1.SchemaResponse.Handler is not mutable"))
-	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
-		panic(fmt.Errorf("field response of message cosmos.accounts.v1.SchemaResponse.Handler is not mutable"))
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.handler":
+		if x.Handler == nil {
+			x.Handler = new(SchemaResponse_Handler)
+		}
+		return protoreflect.ValueOfMessage(x.Handler.ProtoReflect())
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.from_account_type":
+		panic(fmt.Errorf("field from_account_type of message cosmos.accounts.v1.SchemaResponse.MigrationHandler is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.MigrationHandler"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.MigrationHandler does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SchemaResponse_Handler) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SchemaResponse_MigrationHandler) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.accounts.v1.SchemaResponse.Handler.request":
-		return protoreflect.ValueOfString("")
-	case "cosmos.accounts.v1.SchemaResponse.Handler.response":
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.from_account_type":
 		return protoreflect.ValueOfString("")
+	case "cosmos.accounts.v1.SchemaResponse.MigrationHandler.handler":
+		m := new(SchemaResponse_Handler)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.Handler"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.accounts.v1.SchemaResponse.MigrationHandler"))
 		}
-		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.Handler does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.accounts.v1.SchemaResponse.MigrationHandler does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SchemaResponse_Handler) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SchemaResponse_MigrationHandler) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.SchemaResponse.Handler", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.accounts.v1.SchemaResponse.MigrationHandler", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2316,7 +2942,7 @@ func (x *fastReflection_SchemaResponse_Handler) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SchemaResponse_Handler) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SchemaResponse_MigrationHandler) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2327,7 +2953,7 @@ func (x *fastReflection_SchemaResponse_Handler) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SchemaResponse_Handler) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SchemaResponse_MigrationHandler) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2339,7 +2965,7 @@ func (x *fastReflection_SchemaResponse_Handler) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SchemaResponse_Handler) IsValid() bool {
+func (x *fastReflection_SchemaResponse_MigrationHandler) IsValid() bool {
 	return x != nil
 }
 
@@ -2349,9 +2975,9 @@ func (x *fastReflection_SchemaResponse_Handler) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SchemaResponse_MigrationHandler) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SchemaResponse_Handler)
+		x := input.Message.Interface().(*SchemaResponse_MigrationHandler)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2363,12 +2989,12 @@ func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.Request)
+		l = len(x.FromAccountType)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Response)
-		if l > 0 {
+		if x.Handler != nil {
+			l = options.Size(x.Handler)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2381,7 +3007,7 @@ func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SchemaResponse_Handler)
+		x := input.Message.Interface().(*SchemaResponse_MigrationHandler)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2400,17 +3026,24 @@ func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Response) > 0 {
-			i -= len(x.Response)
-			copy(dAtA[i:], x.Response)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Response)))
+		if x.Handler != nil {
+			encoded, err := options.Marshal(x.Handler)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Request) > 0 {
-			i -= len(x.Request)
-			copy(dAtA[i:], x.Request)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Request)))
+		if len(x.FromAccountType) > 0 {
+			i -= len(x.FromAccountType)
+			copy(dAtA[i:], x.FromAccountType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAccountType)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2425,7 +3058,7 @@ func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SchemaResponse_Handler)
+		x := input.Message.Interface().(*SchemaResponse_MigrationHandler)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2457,15 +3090,15 @@ func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse_Handler: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse_MigrationHandler: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse_Handler: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SchemaResponse_MigrationHandler: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAccountType", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2493,13 +3126,13 @@ func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Request = string(dAtA[iNdEx:postIndex])
+				x.FromAccountType = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2509,23 +3142,27 @@ func (x *fastReflection_SchemaResponse_Handler) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Response = string(dAtA[iNdEx:postIndex])
+				if x.Handler == nil {
+					x.Handler = &SchemaResponse_Handler{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Handler); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4371,6 +5008,9 @@ type SchemaResponse struct {
 	ExecuteHandlers []*SchemaResponse_Handler `protobuf:"bytes,2,rep,name=execute_handlers,json=executeHandlers,proto3" json:"execute_handlers,omitempty"`
 	// query_handlers defines the schema descriptor for the Query account method.
 	QueryHandlers []*SchemaResponse_Handler `protobuf:"bytes,3,rep,name=query_handlers,json=queryHandlers,proto3" json:"query_handlers,omitempty"`
+	// migration_handlers defines the schema descriptor for the account types
+	// which can be migrated to the account type.
+	MigrationHandlers []*SchemaResponse_MigrationHandler `protobuf:"bytes,4,rep,name=migration_handlers,json=migrationHandlers,proto3" json:"migration_handlers,omitempty"`
 }
 
 func (x *SchemaResponse) Reset() {
@@ -4414,6 +5054,13 @@ func (x *SchemaResponse) GetQueryHandlers() []*SchemaResponse_Handler {
 	return nil
 }
 
+func (x *SchemaResponse) GetMigrationHandlers() []*SchemaResponse_MigrationHandler {
+	if x != nil {
+		return x.MigrationHandlers
+	}
+	return nil
+}
+
 // AccountTypeRequest is the request type for the Query/AccountType RPC method.
 type AccountTypeRequest struct {
 	state         protoimpl.MessageState
@@ -4611,6 +5258,52 @@ func (x *SchemaResponse_Handler) GetResponse() string {
 	return ""
 }
 
+// MigrationHandler defines a schema descriptor for a migration handler.
+type SchemaResponse_MigrationHandler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from_account_type is the account type migrated from.
+	FromAccountType string `protobuf:"bytes,1,opt,name=from_account_type,json=fromAccountType,proto3" json:"from_account_type,omitempty"`
+	// handler is the schema descriptor of the migration handler.
+	Handler *SchemaResponse_Handler `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"`
+}
+
+func (x *SchemaResponse_MigrationHandler) Reset() {
+	*x = SchemaResponse_MigrationHandler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_accounts_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaResponse_MigrationHandler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaResponse_MigrationHandler) ProtoMessage() {}
+
+// Deprecated: Use SchemaResponse_MigrationHandler.ProtoReflect.Descriptor instead.
+func (*SchemaResponse_MigrationHandler) Descriptor() ([]byte, []int) {
+	return file_cosmos_accounts_v1_query_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *SchemaResponse_MigrationHandler) GetFromAccountType() string {
+	if x != nil {
+		return x.FromAccountType
+	}
+	return ""
+}
+
+func (x *SchemaResponse_MigrationHandler) GetHandler() *SchemaResponse_Handler {
+	if x != nil {
+		return x.Handler
+	}
+	return nil
+}
+
 var File_cosmos_accounts_v1_query_proto protoreflect.FileDescriptor
 
 var file_cosmos_accounts_v1_query_proto_rawDesc = []byte{
@@ -4632,7 +5325,7 @@ var file_cosmos_accounts_v1_query_proto_rawDesc = []byte{
 	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65,
 	0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc8, 0x02, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb3, 0x04, 0x0a,
 	0x0e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x4b, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63,
@@ -4649,61 +5342,76 @@ var file_cosmos_accounts_v1_query_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
 	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
 	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x73, 0x1a, 0x3f, 0x0a, 0x07, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x38, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x30, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x32, 0x89, 0x03, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x63,
-	0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x27,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x21, 0x2e,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x62, 0x0a, 0x12, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x11, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x73, 0x1a, 0x3f, 0x0a, 0x07, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x84, 0x01, 0x0a, 0x10,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x6f,
+	0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x07,
+	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x22, 0x2e, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x38, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x30, 0x0a, 0x14,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2f,
+	0x0a, 0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x32,
+	0x89, 0x03, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x63, 0x0a, 0x0c, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x42, 0xbe, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa,
-	0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x60, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xbe, 0x01, 0x0a, 0x16,
+	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4718,38 +5426,41 @@ func file_cosmos_accounts_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_accounts_v1_query_proto_rawDescData
 }
 
-var file_cosmos_accounts_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_accounts_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_cosmos_accounts_v1_query_proto_goTypes = []interface{}{
-	(*AccountQueryRequest)(nil),    // 0: cosmos.accounts.v1.AccountQueryRequest
-	(*AccountQueryResponse)(nil),   // 1: cosmos.accounts.v1.AccountQueryResponse
-	(*SchemaRequest)(nil),          // 2: cosmos.accounts.v1.SchemaRequest
-	(*SchemaResponse)(nil),         // 3: cosmos.accounts.v1.SchemaResponse
-	(*AccountTypeRequest)(nil),     // 4: cosmos.accounts.v1.AccountTypeRequest
-	(*AccountTypeResponse)(nil),    // 5: cosmos.accounts.v1.AccountTypeResponse
-	(*AccountNumberRequest)(nil),   // 6: cosmos.accounts.v1.AccountNumberRequest
-	(*AccountNumberResponse)(nil),  // 7: cosmos.accounts.v1.AccountNumberResponse
-	(*SchemaResponse_Handler)(nil), // 8: cosmos.accounts.v1.SchemaResponse.Handler
-	(*anypb.Any)(nil),              // 9: google.protobuf.Any
+	(*AccountQueryRequest)(nil),             // 0: cosmos.accounts.v1.AccountQueryRequest
+	(*AccountQueryResponse)(nil),            // 1: cosmos.accounts.v1.AccountQueryResponse
+	(*SchemaRequest)(nil),                   // 2: cosmos.accounts.v1.SchemaRequest
+	(*SchemaResponse)(nil),                  // 3: cosmos.accounts.v1.SchemaResponse
+	(*AccountTypeRequest)(nil),              // 4: cosmos.accounts.v1.AccountTypeRequest
+	(*AccountTypeResponse)(nil),             // 5: cosmos.accounts.v1.AccountTypeResponse
+	(*AccountNumberRequest)(nil),            // 6: cosmos.accounts.v1.AccountNumberRequest
+	(*AccountNumberResponse)(nil),           // 7: cosmos.accounts.v1.AccountNumberResponse
+	(*SchemaResponse_Handler)(nil),          // 8: cosmos.accounts.v1.SchemaResponse.Handler
+	(*SchemaResponse_MigrationHandler)(nil), // 9: cosmos.accounts.v1.SchemaResponse.MigrationHandler
+	(*anypb.Any)(nil),                       // 10: google.protobuf.Any
 }
 var file_cosmos_accounts_v1_query_proto_depIdxs = []int32{
-	9, // 0: cosmos.accounts.v1.AccountQueryRequest.request:type_name -> google.protobuf.Any
-	9, // 1: cosmos.accounts.v1.AccountQueryResponse.response:type_name -> google.protobuf.Any
-	8, // 2: cosmos.accounts.v1.SchemaResponse.init_schema:type_name -> cosmos.accounts.v1.SchemaResponse.Handler
-	8, // 3: cosmos.accounts.v1.SchemaResponse.execute_handlers:type_name -> cosmos.accounts.v1.SchemaResponse.Handler
-	8, // 4: cosmos.accounts.v1.SchemaResponse.query_handlers:type_name -> cosmos.accounts.v1.SchemaResponse.Handler
-	0, // 5: cosmos.accounts.v1.Query.AccountQuery:input_type -> cosmos.accounts.v1.AccountQueryRequest
-	2, // 6: cosmos.accounts.v1.Query.Schema:input_type -> cosmos.accounts.v1.SchemaRequest
-	4, // 7: cosmos.accounts.v1.Query.AccountType:input_type -> cosmos.accounts.v1.AccountTypeRequest
-	6, // 8: cosmos.accounts.v1.Query.AccountNumber:input_type -> cosmos.accounts.v1.AccountNumberRequest
-	1, // 9: cosmos.accounts.v1.Query.AccountQuery:output_type -> cosmos.accounts.v1.AccountQueryResponse
-	3, // 10: cosmos.accounts.v1.Query.Schema:output_type -> cosmos.accounts.v1.SchemaResponse
-	5, // 11: cosmos.accounts.v1.Query.AccountType:output_type -> cosmos.accounts.v1.AccountTypeResponse
-	7, // 12: cosmos.accounts.v1.Query.AccountNumber:output_type -> cosmos.accounts.v1.AccountNumberResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	10, // 0: cosmos.accounts.v1.AccountQueryRequest.request:type_name -> google.protobuf.Any
+	10, // 1: cosmos.accounts.v1.AccountQueryResponse.response:type_name -> google.protobuf.Any
+	8,  // 2: cosmos.accounts.v1.SchemaResponse.init_schema:type_name -> cosmos.accounts.v1.SchemaResponse.Handler
+	8,  // 3: cosmos.accounts.v1.SchemaResponse.execute_handlers:type_name -> cosmos.accounts.v1.SchemaResponse.Handler
+	8,  // 4: cosmos.accounts.v1.SchemaResponse.query_handlers:type_name -> cosmos.accounts.v1.SchemaResponse.Handler
+	9,  // 5: cosmos.accounts.v1.SchemaResponse.migration_handlers:type_name -> cosmos.accounts.v1.SchemaResponse.MigrationHandler
+	8,  // 6: cosmos.accounts.v1.SchemaResponse.MigrationHandler.handler:type_name -> cosmos.accounts.v1.SchemaResponse.Handler
+	0,  // 7: cosmos.accounts.v1.Query.AccountQuery:input_type -> cosmos.accounts.v1.AccountQueryRequest
+	2,  // 8: cosmos.accounts.v1.Query.Schema:input_type -> cosmos.accounts.v1.SchemaRequest
+	4,  // 9: cosmos.accounts.v1.Query.AccountType:input_type -> cosmos.accounts.v1.AccountTypeRequest
+	6,  // 10: cosmos.accounts.v1.Query.AccountNumber:input_type -> cosmos.accounts.v1.AccountNumberRequest
+	1,  // 11: cosmos.accounts.v1.Query.AccountQuery:output_type -> cosmos.accounts.v1.AccountQueryResponse
+	3,  // 12: cosmos.accounts.v1.Query.Schema:output_type -> cosmos.accounts.v1.SchemaResponse
+	5,  // 13: cosmos.accounts.v1.Query.AccountType:output_type -> cosmos.accounts.v1.AccountTypeResponse
+	7,  // 14: cosmos.accounts.v1.Query.AccountNumber:output_type -> cosmos.accounts.v1.AccountNumberResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_accounts_v1_query_proto_init() }
@@ -4866,6 +5577,18 @@ func file_cosmos_accounts_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_accounts_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaResponse_MigrationHandler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4873,7 +5596,7 @@ func file_cosmos_accounts_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_accounts_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Msg_Init_FullMethodName          = "/cosmos.accounts.v1.Msg/Init"
 	Msg_Execute_FullMethodName       = "/cosmos.accounts.v1.Msg/Execute"
+	Msg_Migrate_FullMethodName       = "/cosmos.accounts.v1.Msg/Migrate"
+	Msg_ClaimPayments_FullMethodName = "/cosmos.accounts.v1.Msg/ClaimPayments"
 	Msg_ExecuteBundle_FullMethodName = "/cosmos.accounts.v1.Msg/ExecuteBundle"
 )
 
@@ -34,6 +36,12 @@ type MsgClient interface {
 	Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error)
 	// Execute executes a message to the target account.
 	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
+	// Migrate migrates the target account to another account type, keeping its
+	// address, account number and state.
+	Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error)
+	// ClaimPayments claims a payment authorized by the target account for the
+	// sender merchant.
+	ClaimPayments(ctx context.Context, in *MsgClaimPayments, opts ...grpc.CallOption) (*MsgClaimPaymentsResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error)
@@ -67,6 +75,26 @@ func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgMigrateResponse)
+	err := c.cc.Invoke(ctx, Msg_Migrate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimPayments(ctx context.Context, in *MsgClaimPayments, opts ...grpc.CallOption) (*MsgClaimPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimPaymentsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgExecuteBundleResponse)
@@ -87,6 +115,12 @@ type MsgServer interface {
 	Init(context.Context, *MsgInit) (*MsgInitResponse, error)
 	// Execute executes a message to the target account.
 	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
+	// Migrate migrates the target account to another account type, keeping its
+	// address, account number and state.
+	Migrate(context.Context, *MsgMigrate) (*MsgMigrateResponse, error)
+	// ClaimPayments claims a payment authorized by the target account for the
+	// sender merchant.
+	ClaimPayments(context.Context, *MsgClaimPayments) (*MsgClaimPaymentsResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error)
@@ -106,6 +140,12 @@ func (UnimplementedMsgServer) Init(context.Context, *MsgInit) (*MsgInitResponse,
 func (UnimplementedMsgServer) Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
+func (UnimplementedMsgServer) Migrate(context.Context, *MsgMigrate) (*MsgMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (UnimplementedMsgServer) ClaimPayments(context.Context, *MsgClaimPayments) (*MsgClaimPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPayments not implemented")
+}
 func (UnimplementedMsgServer) ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBundle not implemented")
 }
@@ -166,6 +206,42 @@ func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Migrate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Migrate(ctx, req.(*MsgMigrate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimPayments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimPayments(ctx, req.(*MsgClaimPayments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ExecuteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecuteBundle)
 	if err := dec(in); err != nil {
@@ -199,6 +275,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Execute",
 			Handler:    _Msg_Execute_Handler,
 		},
+		{
+			MethodName: "Migrate",
+			Handler:    _Msg_Migrate_Handler,
+		},
+		{
+			MethodName: "ClaimPayments",
+			Handler:    _Msg_ClaimPayments_Handler,
+		},
 		{
 			MethodName: "ExecuteBundle",
 			Handler:    _Msg_ExecuteBundle_Handler,
@@ -3636,7 +3636,7 @@ func (x *MsgNonAtomicExecResponse) GetResults() []*NonAtomicExecResult {
 }
 
 // MsgMigrateAccount defines a message which allows users to migrate from BaseAccount
-// to other x/accounts types.
+// or vesting accounts to other x/accounts types.
 type MsgMigrateAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1145,6 +1145,7 @@ var (
 	fd_SnapshotItem_iavl              protoreflect.FieldDescriptor
 	fd_SnapshotItem_extension         protoreflect.FieldDescriptor
 	fd_SnapshotItem_extension_payload protoreflect.FieldDescriptor
+	fd_SnapshotItem_store_checksum    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1154,6 +1155,7 @@ func init() {
 	fd_SnapshotItem_iavl = md_SnapshotItem.Fields().ByName("iavl")
 	fd_SnapshotItem_extension = md_SnapshotItem.Fields().ByName("extension")
 	fd_SnapshotItem_extension_payload = md_SnapshotItem.Fields().ByName("extension_payload")
+	fd_SnapshotItem_store_checksum = md_SnapshotItem.Fields().ByName("store_checksum")
 }
 
 var _ protoreflect.Message = (*fastReflection_SnapshotItem)(nil)
@@ -1247,6 +1249,12 @@ func (x *fastReflection_SnapshotItem) Range(f func(protoreflect.FieldDescriptor,
 			if !f(fd_SnapshotItem_extension_payload, value) {
 				return
 			}
+		case *SnapshotItem_StoreChecksum:
+			v := o.StoreChecksum
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SnapshotItem_store_checksum, value) {
+				return
+			}
 		}
 	}
 }
@@ -1296,6 +1304,14 @@ func (x *fastReflection_SnapshotItem) Has(fd protoreflect.FieldDescriptor) bool
 		} else {
 			return false
 		}
+	case "cosmos.store.snapshots.v2.SnapshotItem.store_checksum":
+		if x.Item == nil {
+			return false
+		} else if _, ok := x.Item.(*SnapshotItem_StoreChecksum); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotItem"))
@@ -1320,6 +1336,8 @@ func (x *fastReflection_SnapshotItem) Clear(fd protoreflect.FieldDescriptor) {
 		x.Item = nil
 	case "cosmos.store.snapshots.v2.SnapshotItem.extension_payload":
 		x.Item = nil
+	case "cosmos.store.snapshots.v2.SnapshotItem.store_checksum":
+		x.Item = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotItem"))
@@ -1368,6 +1386,14 @@ func (x *fastReflection_SnapshotItem) Get(descriptor protoreflect.FieldDescripto
 		} else {
 			return protoreflect.ValueOfMessage((*SnapshotExtensionPayload)(nil).ProtoReflect())
 		}
+	case "cosmos.store.snapshots.v2.SnapshotItem.store_checksum":
+		if x.Item == nil {
+			return protoreflect.ValueOfMessage((*SnapshotStoreChecksum)(nil).ProtoReflect())
+		} else if v, ok := x.Item.(*SnapshotItem_StoreChecksum); ok {
+			return protoreflect.ValueOfMessage(v.StoreChecksum.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SnapshotStoreChecksum)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotItem"))
@@ -1400,6 +1426,9 @@ func (x *fastReflection_SnapshotItem) Set(fd protoreflect.FieldDescriptor, value
 	case "cosmos.store.snapshots.v2.SnapshotItem.extension_payload":
 		cv := value.Message().Interface().(*SnapshotExtensionPayload)
 		x.Item = &SnapshotItem_ExtensionPayload{ExtensionPayload: cv}
+	case "cosmos.store.snapshots.v2.SnapshotItem.store_checksum":
+		cv := value.Message().Interface().(*SnapshotStoreChecksum)
+		x.Item = &SnapshotItem_StoreChecksum{StoreChecksum: cv}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotItem"))
@@ -1484,6 +1513,22 @@ func (x *fastReflection_SnapshotItem) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Item = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
+	case "cosmos.store.snapshots.v2.SnapshotItem.store_checksum":
+		if x.Item == nil {
+			value := &SnapshotStoreChecksum{}
+			oneofValue := &SnapshotItem_StoreChecksum{StoreChecksum: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Item.(type) {
+		case *SnapshotItem_StoreChecksum:
+			return protoreflect.ValueOfMessage(m.StoreChecksum.ProtoReflect())
+		default:
+			value := &SnapshotStoreChecksum{}
+			oneofValue := &SnapshotItem_StoreChecksum{StoreChecksum: value}
+			x.Item = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotItem"))
@@ -1509,6 +1554,9 @@ func (x *fastReflection_SnapshotItem) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.store.snapshots.v2.SnapshotItem.extension_payload":
 		value := &SnapshotExtensionPayload{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.store.snapshots.v2.SnapshotItem.store_checksum":
+		value := &SnapshotStoreChecksum{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotItem"))
@@ -1535,6 +1583,8 @@ func (x *fastReflection_SnapshotItem) WhichOneof(d protoreflect.OneofDescriptor)
 			return x.Descriptor().Fields().ByName("extension")
 		case *SnapshotItem_ExtensionPayload:
 			return x.Descriptor().Fields().ByName("extension_payload")
+		case *SnapshotItem_StoreChecksum:
+			return x.Descriptor().Fields().ByName("store_checksum")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v2.SnapshotItem", d.FullName()))
@@ -1617,6 +1667,12 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			}
 			l = options.Size(x.ExtensionPayload)
 			n += 1 + l + runtime.Sov(uint64(l))
+		case *SnapshotItem_StoreChecksum:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.StoreChecksum)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1700,6 +1756,19 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x22
+		case *SnapshotItem_StoreChecksum:
+			encoded, err := options.Marshal(x.StoreChecksum)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1846,20 +1915,478 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotExtensionMeta{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_Extension{v}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionPayload", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotExtensionPayload{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_ExtensionPayload{v}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoreChecksum", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SnapshotStoreChecksum{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Item = &SnapshotItem_StoreChecksum{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SnapshotStoreItem      protoreflect.MessageDescriptor
+	fd_SnapshotStoreItem_name protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_store_snapshots_v2_snapshot_proto_init()
+	md_SnapshotStoreItem = File_cosmos_store_snapshots_v2_snapshot_proto.Messages().ByName("SnapshotStoreItem")
+	fd_SnapshotStoreItem_name = md_SnapshotStoreItem.Fields().ByName("name")
+}
+
+var _ protoreflect.Message = (*fastReflection_SnapshotStoreItem)(nil)
+
+type fastReflection_SnapshotStoreItem SnapshotStoreItem
+
+func (x *SnapshotStoreItem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreItem)(x)
+}
+
+func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SnapshotStoreItem_messageType fastReflection_SnapshotStoreItem_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotStoreItem_messageType{}
+
+type fastReflection_SnapshotStoreItem_messageType struct{}
+
+func (x fastReflection_SnapshotStoreItem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreItem)(nil)
+}
+func (x fastReflection_SnapshotStoreItem_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreItem)
+}
+func (x fastReflection_SnapshotStoreItem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreItem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SnapshotStoreItem) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreItem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SnapshotStoreItem) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotStoreItem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SnapshotStoreItem) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreItem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotStoreItem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_SnapshotStoreItem_name, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+		return x.Name != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+		x.Name = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+		x.Name = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v2.SnapshotStoreItem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SnapshotStoreItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+		}
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v2.SnapshotStoreItem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SnapshotStoreItem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &SnapshotExtensionMeta{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.Item = &SnapshotItem_Extension{v}
-				iNdEx = postIndex
-			case 4:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtensionPayload", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1869,26 +2396,23 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v := &SnapshotExtensionPayload{}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				x.Item = &SnapshotItem_ExtensionPayload{v}
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1926,26 +2450,28 @@ func (x *fastReflection_SnapshotItem) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SnapshotStoreItem      protoreflect.MessageDescriptor
-	fd_SnapshotStoreItem_name protoreflect.FieldDescriptor
+	md_SnapshotStoreChecksum          protoreflect.MessageDescriptor
+	fd_SnapshotStoreChecksum_name     protoreflect.FieldDescriptor
+	fd_SnapshotStoreChecksum_checksum protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_store_snapshots_v2_snapshot_proto_init()
-	md_SnapshotStoreItem = File_cosmos_store_snapshots_v2_snapshot_proto.Messages().ByName("SnapshotStoreItem")
-	fd_SnapshotStoreItem_name = md_SnapshotStoreItem.Fields().ByName("name")
+	md_SnapshotStoreChecksum = File_cosmos_store_snapshots_v2_snapshot_proto.Messages().ByName("SnapshotStoreChecksum")
+	fd_SnapshotStoreChecksum_name = md_SnapshotStoreChecksum.Fields().ByName("name")
+	fd_SnapshotStoreChecksum_checksum = md_SnapshotStoreChecksum.Fields().ByName("checksum")
 }
 
-var _ protoreflect.Message = (*fastReflection_SnapshotStoreItem)(nil)
+var _ protoreflect.Message = (*fastReflection_SnapshotStoreChecksum)(nil)
 
-type fastReflection_SnapshotStoreItem SnapshotStoreItem
+type fastReflection_SnapshotStoreChecksum SnapshotStoreChecksum
 
-func (x *SnapshotStoreItem) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SnapshotStoreItem)(x)
+func (x *SnapshotStoreChecksum) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreChecksum)(x)
 }
 
-func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[3]
+func (x *SnapshotStoreChecksum) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,43 +2482,43 @@ func (x *SnapshotStoreItem) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SnapshotStoreItem_messageType fastReflection_SnapshotStoreItem_messageType
-var _ protoreflect.MessageType = fastReflection_SnapshotStoreItem_messageType{}
+var _fastReflection_SnapshotStoreChecksum_messageType fastReflection_SnapshotStoreChecksum_messageType
+var _ protoreflect.MessageType = fastReflection_SnapshotStoreChecksum_messageType{}
 
-type fastReflection_SnapshotStoreItem_messageType struct{}
+type fastReflection_SnapshotStoreChecksum_messageType struct{}
 
-func (x fastReflection_SnapshotStoreItem_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SnapshotStoreItem)(nil)
+func (x fastReflection_SnapshotStoreChecksum_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SnapshotStoreChecksum)(nil)
 }
-func (x fastReflection_SnapshotStoreItem_messageType) New() protoreflect.Message {
-	return new(fastReflection_SnapshotStoreItem)
+func (x fastReflection_SnapshotStoreChecksum_messageType) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreChecksum)
 }
-func (x fastReflection_SnapshotStoreItem_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotStoreItem
+func (x fastReflection_SnapshotStoreChecksum_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreChecksum
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SnapshotStoreItem) Descriptor() protoreflect.MessageDescriptor {
-	return md_SnapshotStoreItem
+func (x *fastReflection_SnapshotStoreChecksum) Descriptor() protoreflect.MessageDescriptor {
+	return md_SnapshotStoreChecksum
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SnapshotStoreItem) Type() protoreflect.MessageType {
-	return _fastReflection_SnapshotStoreItem_messageType
+func (x *fastReflection_SnapshotStoreChecksum) Type() protoreflect.MessageType {
+	return _fastReflection_SnapshotStoreChecksum_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SnapshotStoreItem) New() protoreflect.Message {
-	return new(fastReflection_SnapshotStoreItem)
+func (x *fastReflection_SnapshotStoreChecksum) New() protoreflect.Message {
+	return new(fastReflection_SnapshotStoreChecksum)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage {
-	return (*SnapshotStoreItem)(x)
+func (x *fastReflection_SnapshotStoreChecksum) Interface() protoreflect.ProtoMessage {
+	return (*SnapshotStoreChecksum)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2000,10 +2526,16 @@ func (x *fastReflection_SnapshotStoreItem) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_SnapshotStoreChecksum) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Name != "" {
 		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_SnapshotStoreItem_name, value) {
+		if !f(fd_SnapshotStoreChecksum_name, value) {
+			return
+		}
+	}
+	if len(x.Checksum) != 0 {
+		value := protoreflect.ValueOfBytes(x.Checksum)
+		if !f(fd_SnapshotStoreChecksum_checksum, value) {
 			return
 		}
 	}
@@ -2020,15 +2552,17 @@ func (x *fastReflection_SnapshotStoreItem) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SnapshotStoreChecksum) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.name":
 		return x.Name != ""
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.checksum":
+		return len(x.Checksum) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreChecksum"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreChecksum does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2038,15 +2572,17 @@ func (x *fastReflection_SnapshotStoreItem) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SnapshotStoreChecksum) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.name":
 		x.Name = ""
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.checksum":
+		x.Checksum = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreChecksum"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreChecksum does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2056,16 +2592,19 @@ func (x *fastReflection_SnapshotStoreItem) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotStoreChecksum) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.name":
 		value := x.Name
 		return protoreflect.ValueOfString(value)
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.checksum":
+		value := x.Checksum
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreChecksum"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreChecksum does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2079,15 +2618,17 @@ func (x *fastReflection_SnapshotStoreItem) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SnapshotStoreChecksum) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.name":
 		x.Name = value.Interface().(string)
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.checksum":
+		x.Checksum = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreChecksum"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreChecksum does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2101,40 +2642,44 @@ func (x *fastReflection_SnapshotStoreItem) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotStoreChecksum) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
-		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v2.SnapshotStoreItem is not mutable"))
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.name":
+		panic(fmt.Errorf("field name of message cosmos.store.snapshots.v2.SnapshotStoreChecksum is not mutable"))
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.checksum":
+		panic(fmt.Errorf("field checksum of message cosmos.store.snapshots.v2.SnapshotStoreChecksum is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreChecksum"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreChecksum does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SnapshotStoreItem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SnapshotStoreChecksum) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.store.snapshots.v2.SnapshotStoreItem.name":
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.name":
 		return protoreflect.ValueOfString("")
+	case "cosmos.store.snapshots.v2.SnapshotStoreChecksum.checksum":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreItem"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.store.snapshots.v2.SnapshotStoreChecksum"))
 		}
-		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreItem does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.store.snapshots.v2.SnapshotStoreChecksum does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SnapshotStoreChecksum) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v2.SnapshotStoreItem", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.store.snapshots.v2.SnapshotStoreChecksum", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2142,7 +2687,7 @@ func (x *fastReflection_SnapshotStoreItem) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SnapshotStoreChecksum) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2153,7 +2698,7 @@ func (x *fastReflection_SnapshotStoreItem) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SnapshotStoreChecksum) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2165,7 +2710,7 @@ func (x *fastReflection_SnapshotStoreItem) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
+func (x *fastReflection_SnapshotStoreChecksum) IsValid() bool {
 	return x != nil
 }
 
@@ -2175,9 +2720,9 @@ func (x *fastReflection_SnapshotStoreItem) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SnapshotStoreChecksum) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotStoreChecksum)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2193,6 +2738,10 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Checksum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2203,7 +2752,7 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotStoreChecksum)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2222,6 +2771,13 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Checksum) > 0 {
+			i -= len(x.Checksum)
+			copy(dAtA[i:], x.Checksum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Checksum)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Name) > 0 {
 			i -= len(x.Name)
 			copy(dAtA[i:], x.Name)
@@ -2240,7 +2796,7 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SnapshotStoreItem)
+		x := input.Message.Interface().(*SnapshotStoreChecksum)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2272,10 +2828,10 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreChecksum: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreItem: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SnapshotStoreChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2310,6 +2866,40 @@ func (x *fastReflection_SnapshotStoreItem) ProtoMethods() *protoiface.Methods {
 				}
 				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Checksum = append(x.Checksum[:0], dAtA[iNdEx:postIndex]...)
+				if x.Checksum == nil {
+					x.Checksum = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2371,7 +2961,7 @@ func (x *SnapshotIAVLItem) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotIAVLItem) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[4]
+	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2951,7 +3541,7 @@ func (x *SnapshotExtensionMeta) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionMeta) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[5]
+	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3417,7 +4007,7 @@ func (x *SnapshotExtensionPayload) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SnapshotExtensionPayload) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[6]
+	mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3945,11 +4535,11 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are assignable to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_Iavl
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_StoreChecksum
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -4008,6 +4598,13 @@ func (x *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (x *SnapshotItem) GetStoreChecksum() *SnapshotStoreChecksum {
+	if x, ok := x.GetItem().(*SnapshotItem_StoreChecksum); ok {
+		return x.StoreChecksum
+	}
+	return nil
+}
+
 type isSnapshotItem_Item interface {
 	isSnapshotItem_Item()
 }
@@ -4028,6 +4625,10 @@ type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof"`
 }
 
+type SnapshotItem_StoreChecksum struct {
+	StoreChecksum *SnapshotStoreChecksum `protobuf:"bytes,5,opt,name=store_checksum,json=storeChecksum,proto3,oneof"`
+}
+
 func (*SnapshotItem_Store) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_Iavl) isSnapshotItem_Item() {}
@@ -4036,6 +4637,8 @@ func (*SnapshotItem_Extension) isSnapshotItem_Item() {}
 
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
 
+func (*SnapshotItem_StoreChecksum) isSnapshotItem_Item() {}
+
 // SnapshotStoreItem contains metadata about a snapshotted store.
 type SnapshotStoreItem struct {
 	state         protoimpl.MessageState
@@ -4072,6 +4675,51 @@ func (x *SnapshotStoreItem) GetName() string {
 	return ""
 }
 
+// SnapshotStoreChecksum terminates the items of a snapshotted store.
+type SnapshotStoreChecksum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// checksum is the SHA-256 hash over the serialized IAVL items of the store.
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (x *SnapshotStoreChecksum) Reset() {
+	*x = SnapshotStoreChecksum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotStoreChecksum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotStoreChecksum) ProtoMessage() {}
+
+// Deprecated: Use SnapshotStoreChecksum.ProtoReflect.Descriptor instead.
+func (*SnapshotStoreChecksum) Descriptor() ([]byte, []int) {
+	return file_cosmos_store_snapshots_v2_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SnapshotStoreChecksum) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnapshotStoreChecksum) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
 // SnapshotIAVLItem is an exported IAVL node.
 type SnapshotIAVLItem struct {
 	state         protoimpl.MessageState
@@ -4089,7 +4737,7 @@ type SnapshotIAVLItem struct {
 func (x *SnapshotIAVLItem) Reset() {
 	*x = SnapshotIAVLItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[4]
+		mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4103,7 +4751,7 @@ func (*SnapshotIAVLItem) ProtoMessage() {}
 
 // Deprecated: Use SnapshotIAVLItem.ProtoReflect.Descriptor instead.
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v2_snapshot_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_store_snapshots_v2_snapshot_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SnapshotIAVLItem) GetKey() []byte {
@@ -4147,7 +4795,7 @@ type SnapshotExtensionMeta struct {
 func (x *SnapshotExtensionMeta) Reset() {
 	*x = SnapshotExtensionMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[5]
+		mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4161,7 +4809,7 @@ func (*SnapshotExtensionMeta) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionMeta.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v2_snapshot_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_store_snapshots_v2_snapshot_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SnapshotExtensionMeta) GetName() string {
@@ -4190,7 +4838,7 @@ type SnapshotExtensionPayload struct {
 func (x *SnapshotExtensionPayload) Reset() {
 	*x = SnapshotExtensionPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[6]
+		mi := &file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4204,7 +4852,7 @@ func (*SnapshotExtensionPayload) ProtoMessage() {}
 
 // Deprecated: Use SnapshotExtensionPayload.ProtoReflect.Descriptor instead.
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return file_cosmos_store_snapshots_v2_snapshot_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_store_snapshots_v2_snapshot_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SnapshotExtensionPayload) GetPayload() []byte {
@@ -4238,7 +4886,7 @@ var file_cosmos_store_snapshots_v2_snapshot_proto_rawDesc = []byte{
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
 	0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xcf, 0x03, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
 	0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73,
 	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76,
@@ -4259,47 +4907,57 @@ var file_cosmos_store_snapshots_v2_snapshot_proto_rawDesc = []byte{
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e,
 	0x76, 0x32, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
 	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a,
-	0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20,
-	0x30, 0x2e, 0x34, 0x36, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x11,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x58,
-	0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x49, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x13,
-	0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
-	0x2e, 0x34, 0x36, 0x42, 0xed, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x73, 0x2e, 0x76, 0x32, 0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f,
-	0x76, 0x32, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x76, 0x32, 0xa2, 0x02,
-	0x03, 0x43, 0x53, 0x53, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x56, 0x32,
-	0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x25, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x3a,
-	0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x59, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x42,
+	0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x11, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x47, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0x81,
+	0x01, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x41, 0x56, 0x4c, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x13, 0xd2,
+	0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e,
+	0x34, 0x36, 0x22, 0x58, 0x0a, 0x15, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x22, 0x49, 0x0a, 0x18,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x36, 0x42, 0xed, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2e, 0x76, 0x32, 0x42, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x2f, 0x76, 0x32, 0x3b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x76, 0x32, 0xa2, 0x02, 0x03, 0x43, 0x53, 0x53, 0xaa, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x5c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x32,
+	0xe2, 0x02, 0x25, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x5c,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x3a, 0x3a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4314,27 +4972,29 @@ func file_cosmos_store_snapshots_v2_snapshot_proto_rawDescGZIP() []byte {
 	return file_cosmos_store_snapshots_v2_snapshot_proto_rawDescData
 }
 
-var file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_cosmos_store_snapshots_v2_snapshot_proto_goTypes = []interface{}{
 	(*Snapshot)(nil),                 // 0: cosmos.store.snapshots.v2.Snapshot
 	(*Metadata)(nil),                 // 1: cosmos.store.snapshots.v2.Metadata
 	(*SnapshotItem)(nil),             // 2: cosmos.store.snapshots.v2.SnapshotItem
 	(*SnapshotStoreItem)(nil),        // 3: cosmos.store.snapshots.v2.SnapshotStoreItem
-	(*SnapshotIAVLItem)(nil),         // 4: cosmos.store.snapshots.v2.SnapshotIAVLItem
-	(*SnapshotExtensionMeta)(nil),    // 5: cosmos.store.snapshots.v2.SnapshotExtensionMeta
-	(*SnapshotExtensionPayload)(nil), // 6: cosmos.store.snapshots.v2.SnapshotExtensionPayload
+	(*SnapshotStoreChecksum)(nil),    // 4: cosmos.store.snapshots.v2.SnapshotStoreChecksum
+	(*SnapshotIAVLItem)(nil),         // 5: cosmos.store.snapshots.v2.SnapshotIAVLItem
+	(*SnapshotExtensionMeta)(nil),    // 6: cosmos.store.snapshots.v2.SnapshotExtensionMeta
+	(*SnapshotExtensionPayload)(nil), // 7: cosmos.store.snapshots.v2.SnapshotExtensionPayload
 }
 var file_cosmos_store_snapshots_v2_snapshot_proto_depIdxs = []int32{
 	1, // 0: cosmos.store.snapshots.v2.Snapshot.metadata:type_name -> cosmos.store.snapshots.v2.Metadata
 	3, // 1: cosmos.store.snapshots.v2.SnapshotItem.store:type_name -> cosmos.store.snapshots.v2.SnapshotStoreItem
-	4, // 2: cosmos.store.snapshots.v2.SnapshotItem.iavl:type_name -> cosmos.store.snapshots.v2.SnapshotIAVLItem
-	5, // 3: cosmos.store.snapshots.v2.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v2.SnapshotExtensionMeta
-	6, // 4: cosmos.store.snapshots.v2.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v2.SnapshotExtensionPayload
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 2: cosmos.store.snapshots.v2.SnapshotItem.iavl:type_name -> cosmos.store.snapshots.v2.SnapshotIAVLItem
+	6, // 3: cosmos.store.snapshots.v2.SnapshotItem.extension:type_name -> cosmos.store.snapshots.v2.SnapshotExtensionMeta
+	7, // 4: cosmos.store.snapshots.v2.SnapshotItem.extension_payload:type_name -> cosmos.store.snapshots.v2.SnapshotExtensionPayload
+	4, // 5: cosmos.store.snapshots.v2.SnapshotItem.store_checksum:type_name -> cosmos.store.snapshots.v2.SnapshotStoreChecksum
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_store_snapshots_v2_snapshot_proto_init() }
@@ -4392,7 +5052,7 @@ func file_cosmos_store_snapshots_v2_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotIAVLItem); i {
+			switch v := v.(*SnapshotStoreChecksum); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4404,7 +5064,7 @@ func file_cosmos_store_snapshots_v2_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotExtensionMeta); i {
+			switch v := v.(*SnapshotIAVLItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4416,6 +5076,18 @@ func file_cosmos_store_snapshots_v2_snapshot_proto_init() {
 			}
 		}
 		file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotExtensionMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_store_snapshots_v2_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnapshotExtensionPayload); i {
 			case 0:
 				return &v.state
@@ -4433,6 +5105,7 @@ func file_cosmos_store_snapshots_v2_snapshot_proto_init() {
 		(*SnapshotItem_Iavl)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_StoreChecksum)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4440,7 +5113,7 @@ func file_cosmos_store_snapshots_v2_snapshot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_store_snapshots_v2_snapshot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
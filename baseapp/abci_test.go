@@ -27,6 +27,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	corestore "cosmossdk.io/core/store"
 	coretesting "cosmossdk.io/core/testing"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -1070,6 +1071,75 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateWithOverrides(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	newTxBytes := func(counter int64) []byte {
+		txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, suite.ac, counter, counter))
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// the counters are incremented once per block
+	for height := int64(1); height <= 2; height++ {
+		res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{
+			Height: height,
+			Txs:    [][]byte{newTxBytes(height - 1)},
+		})
+		require.NoError(t, err)
+		require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// the latest state
+	_, result, err := suite.baseApp.SimulateWithOverrides(newTxBytes(2), 0, nil)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	// the state of a past height
+	_, result, err = suite.baseApp.SimulateWithOverrides(newTxBytes(1), 1, nil)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	// the counters overridden at a past height
+	counter := binary.AppendVarint(nil, 10)
+	overrides := []corestore.StateChanges{{
+		Actor: []byte(capKey1.Name()),
+		StateChanges: []corestore.KVPair{
+			{Key: anteKey, Value: counter},
+			{Key: deliverKey, Value: counter},
+		},
+	}}
+	gInfo, result, err := suite.baseApp.SimulateWithOverrides(newTxBytes(10), 1, overrides)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotZero(t, gInfo.GasUsed)
+
+	// the overrides are not written to the state
+	_, _, err = suite.baseApp.Simulate(newTxBytes(2))
+	require.NoError(t, err)
+
+	_, _, err = suite.baseApp.SimulateWithOverrides(newTxBytes(2), 0, []corestore.StateChanges{{
+		Actor:        []byte("unknown"),
+		StateChanges: []corestore.KVPair{{Key: anteKey, Value: counter}},
+	}})
+	require.ErrorContains(t, err, "unknown store key")
+
+	_, _, err = suite.baseApp.SimulateWithOverrides(newTxBytes(2), 3, nil)
+	require.ErrorContains(t, err, "cannot query with height in the future")
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -255,6 +255,9 @@ func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
 // - If no mempool is set or if the mempool is a no-op mempool, the transactions
 // requested from CometBFT will simply be returned, which, by default, are in
 // FIFO order.
+//
+// - If the mempool is a LanedMempool, the transactions are selected lane by lane,
+// each lane using at most its max block share of the block bytes and gas.
 func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
 		var maxBlockGas uint64
@@ -295,9 +298,13 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 		var (
 			resError        error
 			selectedTxsNums int
+			selectedTxsGas  uint64
 			invalidTxs      []sdk.Tx // invalid txs to be removed out of the loop to avoid dead lock
+			maxTxBytes      = uint64(req.MaxTxBytes)
+			laneMaxTxBytes  = maxTxBytes
+			laneMaxBlockGas = maxBlockGas
 		)
-		h.mempool.SelectBy(ctx, decodedTxs, func(memTx sdk.Tx) bool {
+		selectTx := func(memTx sdk.Tx) bool {
 			unorderedTx, ok := memTx.(sdk.TxWithUnordered)
 			isUnordered := ok && unorderedTx.GetUnordered()
 			txSignersSeqs := make(map[string]uint64)
@@ -344,12 +351,17 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			if err != nil {
 				invalidTxs = append(invalidTxs, memTx)
 			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
+				stop := h.txSelector.SelectTxForProposal(ctx, laneMaxTxBytes, laneMaxBlockGas, memTx, txBz)
 				if stop {
 					return false
 				}
 
 				txsLen := len(h.txSelector.SelectedTxs(ctx))
+				if txsLen != selectedTxsNums {
+					if gasTx, ok := memTx.(GasTx); ok {
+						selectedTxsGas += gasTx.GetGas()
+					}
+				}
 				// If the tx is unordered, we don't need to update the sender sequence.
 				if !isUnordered {
 					for sender, seq := range txSignersSeqs {
@@ -371,7 +383,43 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			}
 
 			return true
-		})
+		}
+
+		var selectedTxs [][]byte
+		lanedMempool, isLaned := h.mempool.(*mempool.LanedMempool)
+		if !isLaned {
+			h.mempool.SelectBy(ctx, decodedTxs, selectTx)
+			selectedTxs = h.txSelector.SelectedTxs(ctx)
+		} else {
+			// the txs of each lane are selected in lane order, within the max block
+			// share of the lane and the space left by the previous lanes
+			var usedTxBytes, usedBlockGas uint64
+			for i, lane := range lanedMempool.Lanes() {
+				laneMaxTxBytes = min(mempool.LaneLimit(maxTxBytes, lane.MaxBlockShare), maxTxBytes-usedTxBytes)
+				laneMaxBlockGas = min(mempool.LaneLimit(maxBlockGas, lane.MaxBlockShare), maxBlockGas-usedBlockGas)
+				if laneMaxTxBytes == 0 || (maxBlockGas > 0 && laneMaxBlockGas == 0) {
+					continue
+				}
+
+				h.txSelector.Clear()
+				selectedTxsNums, selectedTxsGas = 0, 0
+				var laneTxs []sdk.Tx
+				for _, tx := range decodedTxs {
+					if lanedMempool.LaneIndex(tx) == i {
+						laneTxs = append(laneTxs, tx)
+					}
+				}
+				lane.Mempool.SelectBy(ctx, laneTxs, selectTx)
+				if resError != nil {
+					break
+				}
+
+				laneSelectedTxs := h.txSelector.SelectedTxs(ctx)
+				selectedTxs = append(selectedTxs, laneSelectedTxs...)
+				usedTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs(toCmtTxs(laneSelectedTxs)))
+				usedBlockGas += selectedTxsGas
+			}
+		}
 
 		if resError != nil {
 			return nil, resError
@@ -384,10 +432,19 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			}
 		}
 
-		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		return &abci.PrepareProposalResponse{Txs: selectedTxs}, nil
 	}
 }
 
+// toCmtTxs converts the given txs bytes to CometBFT txs.
+func toCmtTxs(txs [][]byte) []cmttypes.Tx {
+	cmtTxs := make([]cmttypes.Tx, len(txs))
+	for i, tx := range txs {
+		cmtTxs[i] = tx
+	}
+	return cmtTxs
+}
+
 // ProcessProposalHandler returns the default implementation for processing an
 // ABCI proposal. Every transaction in the proposal must pass 2 conditions:
 //
@@ -395,6 +452,8 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 // 2. The transaction must be valid (i.e. pass runTx, AnteHandler only)
 //
 // If any transaction fails to pass either condition, the proposal is rejected.
+// If the mempool is a LanedMempool, the proposal is also rejected if its
+// transactions are not ordered by lane or exceed the max block share of their lane.
 // Note that step (2) is identical to the validation step performed in
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
@@ -407,27 +466,55 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 		return NoOpProcessProposal()
 	}
 
+	lanedMempool, _ := h.mempool.(*mempool.LanedMempool)
+
 	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
 		var totalTxGas uint64
 
-		var maxBlockGas int64
+		var maxBlockGas, maxBlockBytes int64
 		if b := ctx.ConsensusParams().Block; b != nil { //nolint:staticcheck // ignore linting error
 			maxBlockGas = b.MaxGas
+			maxBlockBytes = b.MaxBytes
 		}
 
+		var (
+			lane               int
+			laneBytes, laneGas uint64
+		)
 		for _, txBytes := range req.Txs {
 			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
 			if err != nil {
 				return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
 			}
 
+			var txGas uint64
+			if gasTx, ok := tx.(GasTx); ok {
+				txGas = gasTx.GetGas()
+			}
+
 			if maxBlockGas > 0 {
-				gasTx, ok := tx.(GasTx)
-				if ok {
-					totalTxGas += gasTx.GetGas()
+				totalTxGas += txGas
+				if totalTxGas > uint64(maxBlockGas) {
+					return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
 				}
+			}
 
-				if totalTxGas > uint64(maxBlockGas) {
+			// the txs must be ordered by lane and fit in the max block share of their
+			// lane, the txs matching no lane belong to the last lane
+			if lanedMempool != nil {
+				txLane := lanedMempool.ProposalLaneIndex(tx)
+				if txLane < lane {
+					return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+				}
+				if txLane > lane {
+					lane, laneBytes, laneGas = txLane, 0, 0
+				}
+
+				share := lanedMempool.Lanes()[lane].MaxBlockShare
+				laneBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBytes}))
+				laneGas += txGas
+				if (maxBlockBytes > 0 && laneBytes > mempool.LaneLimit(uint64(maxBlockBytes), share)) ||
+					(maxBlockGas > 0 && laneGas > mempool.LaneLimit(uint64(maxBlockGas), share)) {
 					return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
 				}
 			}
@@ -713,6 +713,109 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_LanedMempool() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	type testTx struct {
+		tx       sdk.Tx
+		priority int64
+		bz       []byte
+	}
+
+	// txs with a value starting with "p" are in the priority lane
+	var testTxs []testTx
+	for i, value := range []string{"p1", "p2", "d1", "d2"} {
+		tx := buildMsg(s.T(), txConfig, signingCtx.AddressCodec(), []byte(value), [][]byte{[]byte(value)}, []uint64{1})
+		bz, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		testTxs = append(testTxs, testTx{tx: tx, priority: int64(10 - i%2), bz: bz})
+	}
+	txSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{testTxs[0].bz})
+
+	newMempool := func() mempool.Mempool {
+		return mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		})
+	}
+	priorityLane := mempool.Lane{
+		Name: "priority",
+		Match: func(tx sdk.Tx) bool {
+			msgs := tx.GetMsgs()
+			msg, ok := msgs[0].(*baseapptestutil.MsgKeyValue)
+			return ok && len(msgs) == 1 && bytes.HasPrefix(msg.Value, []byte("p"))
+		},
+		Mempool:       newMempool(),
+		MaxBlockShare: 40,
+	}
+	mp, err := mempool.NewLanedMempool(priorityLane, mempool.Lane{Name: "default", Mempool: newMempool(), MaxBlockShare: 100})
+	s.Require().NoError(err)
+
+	ctrl := gomock.NewController(s.T())
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	ph := baseapp.NewDefaultProposalHandler(mp, app)
+
+	// the txs are inserted in reverse lane order
+	for i := len(testTxs) - 1; i >= 0; i-- {
+		v := testTxs[i]
+		app.EXPECT().PrepareProposalVerifyTx(v.tx).Return(v.bz, nil).AnyTimes()
+		app.EXPECT().ProcessProposalVerifyTx(v.bz).Return(v.tx, nil).AnyTimes()
+		s.Require().NoError(mp.Insert(s.ctx.WithPriority(v.priority), v.tx))
+	}
+	s.Require().Equal(4, mp.CountTx())
+
+	// the priority lane can only use 40% of the block, i.e. one tx
+	resp, err := ph.PrepareProposalHandler()(s.ctx, &abci.PrepareProposalRequest{MaxTxBytes: 4 * txSize})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{testTxs[0].bz, testTxs[2].bz, testTxs[3].bz}, resp.Txs)
+
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 4 * txSize, MaxGas: -1},
+	})
+	testCases := map[string]struct {
+		txs    []int
+		status abci.ProcessProposalStatus
+	}{
+		"txs in lane order": {
+			txs:    []int{0, 2, 3},
+			status: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"txs not in lane order": {
+			txs:    []int{2, 0, 3},
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"lane exceeding its max block share": {
+			txs:    []int{0, 1, 2},
+			status: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &abci.ProcessProposalRequest{}
+			for _, i := range tc.txs {
+				req.Txs = append(req.Txs, testTxs[i].bz)
+			}
+			resp, err := ph.ProcessProposalHandler()(ctx, req)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+
+	// the txs matching no lane, which other validators may propose, belong to the last lane
+	priorityMp, err := mempool.NewLanedMempool(priorityLane)
+	s.Require().NoError(err)
+	priorityPh := baseapp.NewDefaultProposalHandler(priorityMp, app)
+	processResp, err := priorityPh.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: [][]byte{testTxs[2].bz}})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_ACCEPT, processResp.Status)
+	processResp, err = priorityPh.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: [][]byte{testTxs[0].bz, testTxs[2].bz}})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.PROCESS_PROPOSAL_STATUS_REJECT, processResp.Status)
+}
+
 func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 	var buf bytes.Buffer
 	if err := protoio.NewDelimitedWriter(&buf).WriteMsg(msg); err != nil {
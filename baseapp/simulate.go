@@ -0,0 +1,85 @@
+package baseapp
+
+import (
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SimulateWithOverrides executes a tx in simulate mode like Simulate, against the
+// state of the given height, 0 for the latest state, with the given state overrides
+// written over it. The actor of the overrides is the name of their store key.
+// The state changes of the simulation, overrides included, are discarded.
+func (app *BaseApp) SimulateWithOverrides(txBytes []byte, height int64, overrides []corestore.StateChanges) (sdk.GasInfo, *sdk.Result, error) {
+	var ctx sdk.Context
+	if height == 0 {
+		ctx = app.getContextForTx(execModeSimulate, txBytes)
+	} else {
+		queryCtx, err := app.CreateQueryContext(height, false)
+		if err != nil {
+			return sdk.GasInfo{}, nil, err
+		}
+
+		// the tx is simulated as the first tx of the following block, as for
+		// the latest state
+		headerInfo := queryCtx.HeaderInfo()
+		headerInfo.Height = height + 1
+		ctx = queryCtx.
+			WithTxBytes(txBytes).
+			WithGasMeter(storetypes.NewInfiniteGasMeter()).
+			WithIsSigverifyTx(app.sigverifyTx).
+			WithHeaderInfo(headerInfo).
+			WithBlockHeight(height + 1).
+			WithExecMode(sdk.ExecMode(execModeSimulate))
+		ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	}
+
+	if err := app.applyStateOverrides(ctx, overrides); err != nil {
+		return sdk.GasInfo{}, nil, err
+	}
+
+	gasInfo, result, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes, nil)
+	return gasInfo, result, err
+}
+
+// applyStateOverrides writes the state overrides in the branched multi-store of
+// the simulation context.
+func (app *BaseApp) applyStateOverrides(ctx sdk.Context, overrides []corestore.StateChanges) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "state overrides are not supported by the commit multi-store")
+	}
+	keys := rms.StoreKeysByName()
+
+	for _, changes := range overrides {
+		key, ok := keys[string(changes.Actor)]
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown store key %q", changes.Actor)
+		}
+
+		store := ctx.MultiStore().GetKVStore(key)
+		for _, kv := range changes.StateChanges {
+			if len(kv.Key) == 0 {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "empty key override of store %q", changes.Actor)
+			}
+			switch {
+			case kv.Remove:
+				store.Delete(kv.Key)
+			case kv.Value == nil:
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "nil value override of store %q", changes.Actor)
+			default:
+				store.Set(kv.Key, kv.Value)
+			}
+		}
+	}
+
+	return nil
+}
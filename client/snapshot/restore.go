@@ -1,6 +1,11 @@
 package snapshot
 
 import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 
@@ -15,6 +20,8 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
 
+const flagTrustedAppHash = "trusted-app-hash"
+
 // RestoreSnapshotCmd returns a command to restore a snapshot
 func RestoreSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
 	cmd := &cobra.Command{
@@ -35,6 +42,15 @@ func RestoreSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCre
 				return err
 			}
 
+			trustedAppHashStr, err := cmd.Flags().GetString(flagTrustedAppHash)
+			if err != nil {
+				return err
+			}
+			trustedAppHash, err := hex.DecodeString(trustedAppHashStr)
+			if err != nil {
+				return fmt.Errorf("invalid trusted app hash: %w", err)
+			}
+
 			home := cfg.RootDir
 			db, err := openDB(home, server.GetAppDBBackend(viper))
 			if err != nil {
@@ -44,12 +60,52 @@ func RestoreSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCre
 			app := appCreator(logger, db, nil, viper)
 
 			sm := app.SnapshotManager()
-			return sm.RestoreLocalSnapshot(height, uint32(format))
+			if len(trustedAppHash) == 0 {
+				return sm.RestoreLocalSnapshot(height, uint32(format))
+			}
+
+			// the restored state can only be deleted if it is the only state
+			if version := app.CommitMultiStore().LastCommitID().Version; version != 0 {
+				return fmt.Errorf("the state must be empty to verify the restored state, found state at height %d", version)
+			}
+			if err := restoreAndVerify(app, height, uint32(format), trustedAppHash); err != nil {
+				if rmErr := removeState(app, home); rmErr != nil {
+					return errors.Join(err, fmt.Errorf("failed to delete the restored state: %w", rmErr))
+				}
+				return fmt.Errorf("the restored state was deleted: %w", err)
+			}
+			cmd.Printf("Restored state at height %d matches the trusted app hash %X\n", height, trustedAppHash)
+			return nil
 		},
 	}
+
+	cmd.Flags().String(flagTrustedAppHash, "", "Trusted app hash (hex) of the state at the snapshot height, the state must be empty and the restored state is deleted if it doesn't match")
+
 	return cmd
 }
 
+// restoreAndVerify restores the snapshot and verifies the restored state against
+// the trusted app hash.
+func restoreAndVerify(app servertypes.Application, height uint64, format uint32, trustedAppHash []byte) error {
+	if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+		return err
+	}
+	commitID := app.CommitMultiStore().LastCommitID()
+	if uint64(commitID.Version) != height || !bytes.Equal(commitID.Hash, trustedAppHash) {
+		return fmt.Errorf("restored app hash %X at height %d doesn't match the trusted app hash %X",
+			commitID.Hash, commitID.Version, trustedAppHash)
+	}
+	return nil
+}
+
+// removeState closes the app and deletes its application database.
+func removeState(app servertypes.Application, rootDir string) error {
+	if err := app.Close(); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(rootDir, "data", "application.db"))
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (corestore.KVStoreWithBatch, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
@@ -90,6 +90,10 @@ type Collection interface {
 	// ValueCodec returns the codec used to encode/decode values of the collection.
 	ValueCodec() codec.UntypedValueCodec
 
+	// KeyType returns the name of the type of the keys of the collection, as
+	// reported by the KeyCodec.
+	KeyType() string
+
 	genesisHandler
 
 	// collectionSchemaCodec returns the schema codec for this collection.
@@ -159,6 +163,8 @@ func (c collectionImpl[K, V]) ValueCodec() codec.UntypedValueCodec {
 	return codec.NewUntypedValueCodec(c.m.vc)
 }
 
+func (c collectionImpl[K, V]) KeyType() string { return c.m.kc.KeyType() }
+
 func (c collectionImpl[K, V]) GetName() string { return c.m.name }
 
 func (c collectionImpl[K, V]) GetPrefix() []byte { return NewPrefix(c.m.prefix) }
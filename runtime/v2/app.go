@@ -63,6 +63,20 @@ func (a *App[T]) ModuleManager() *MM[T] {
 	return a.moduleManager
 }
 
+// Modules returns the registered modules by name.
+func (a *App[T]) Modules() map[string]appmodulev2.AppModule {
+	return a.moduleManager.Modules()
+}
+
+// ModuleStoreKey returns the KV store key of the given module, i.e. the module
+// name unless overridden in the runtime module configuration.
+func (a *App[T]) ModuleStoreKey(moduleName string) string {
+	if override := storeKeyOverride(a.config, moduleName); override != nil {
+		return override.KvStoreKey
+	}
+	return moduleName
+}
+
 // DefaultGenesis returns a default genesis from the registered modules.
 func (a *App[T]) DefaultGenesis() map[string]json.RawMessage {
 	return a.moduleManager.DefaultGenesis()
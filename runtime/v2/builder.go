@@ -27,6 +27,7 @@ type AppBuilder[T transaction.Tx] struct {
 	branch      func(state store.ReaderMap) store.WriterMap
 	txValidator func(ctx context.Context, tx T) error
 	postTxExec  func(ctx context.Context, tx T, success bool) error
+	stfOptions  []stf.Option
 }
 
 // DefaultGenesis returns a default genesis from the registered AppModule's.
@@ -114,6 +115,7 @@ func (a *AppBuilder[T]) Build(opts ...AppBuilderOption[T]) (*App[T], error) {
 		valUpdate,
 		a.postTxExec,
 		a.branch,
+		a.stfOptions...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create STF: %w", err)
@@ -218,3 +220,12 @@ func AppBuilderWithPostTxExec[T transaction.Tx](postTxExec func(ctx context.Cont
 		a.postTxExec = postTxExec
 	}
 }
+
+// AppBuilderWithParallelExecution enables the optimistic parallel execution of the
+// txs of a block with the given number of workers, GOMAXPROCS if it is not positive.
+// All the modules of the app must be safe to be called concurrently.
+func AppBuilderWithParallelExecution[T transaction.Tx](workers int) AppBuilderOption[T] {
+	return func(a *AppBuilder[T]) {
+		a.stfOptions = append(a.stfOptions, stf.WithParallelExecution(workers))
+	}
+}
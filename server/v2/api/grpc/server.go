@@ -33,12 +33,22 @@ const (
 	BlockHeightHeader = "x-cosmos-block-height"
 )
 
+var _ grpc.ServiceRegistrar = (*Server[transaction.Tx])(nil)
+
 type Server[T transaction.Tx] struct {
 	logger     log.Logger
 	config     *Config
 	cfgOptions []CfgOption
 
 	grpcSrv *grpc.Server
+	// services are the services registered with RegisterService.
+	services []service
+}
+
+// service is a gRPC service and its implementation.
+type service struct {
+	desc *grpc.ServiceDesc
+	impl any
 }
 
 // New creates a new grpc server.
@@ -68,6 +78,10 @@ func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.L
 		),
 	)
 
+	for _, svc := range s.services {
+		grpcSrv.RegisterService(svc.desc, svc.impl)
+	}
+
 	// Reflection allows external clients to see what services and methods the gRPC server exposes.
 	gogoreflection.Register(grpcSrv, slices.Collect(maps.Keys(methodsMap)), logger.With("sub-module", "grpc-reflection"))
 
@@ -78,6 +92,13 @@ func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.L
 	return nil
 }
 
+// RegisterService implements grpc.ServiceRegistrar, it registers a service
+// served along with the query services of the app, e.g. a streaming service.
+// The services must be registered before Init.
+func (s *Server[T]) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.services = append(s.services, service{desc: desc, impl: impl})
+}
+
 func (s *Server[T]) StartCmdFlags() *pflag.FlagSet {
 	flags := pflag.NewFlagSet(s.Name(), pflag.ExitOnError)
 	flags.String(FlagAddress, "localhost:9090", "Listen address")
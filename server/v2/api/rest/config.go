@@ -0,0 +1,32 @@
+package rest
+
+func DefaultConfig() *Config {
+	return &Config{
+		Enable:  true,
+		Address: "localhost:8080",
+	}
+}
+
+type Config struct {
+	// Enable defines if the REST server should be enabled.
+	Enable bool `mapstructure:"enable" toml:"enable" comment:"Enable defines if the REST server should be enabled."`
+
+	// Address defines the address the REST server binds to.
+	Address string `mapstructure:"address" toml:"address" comment:"Address defines the address the REST server binds to."`
+}
+
+type CfgOption func(*Config)
+
+// OverwriteDefaultConfig overwrites the default config with the new config.
+func OverwriteDefaultConfig(newCfg *Config) CfgOption {
+	return func(cfg *Config) {
+		*cfg = *newCfg
+	}
+}
+
+// Disable the REST server by default (default enabled).
+func Disable() CfgOption {
+	return func(cfg *Config) {
+		cfg.Enable = false
+	}
+}
@@ -0,0 +1,296 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/api/grpc"
+)
+
+// maxBodySize is the maximum size of a request body.
+const maxBodySize = 10 << 20
+
+type querier interface {
+	Query(ctx context.Context, version uint64, msg transaction.Msg) (transaction.Msg, error)
+}
+
+// handler serves the query and Msg services of the app over HTTP/JSON, with
+// the proto3 JSON mapping of their requests and responses.
+//
+// Queries are executed with the query router of the app. Msg services are not
+// executed, as a Msg is only executed in a signed tx: their endpoints return
+// the proto encoding of the Msg as an Any, to be included in the body of a tx.
+type handler struct {
+	routes        []*route
+	queryHandlers map[string]appmodulev2.Handler
+	querier       querier
+
+	marshalOpts   protojson.MarshalOptions
+	unmarshalOpts protojson.UnmarshalOptions
+	resolver      *dynamicpb.Types
+}
+
+// newHandler returns the handler of the methods of the services of files whose
+// requests have a query handler, or are one of the given Msg type URLs.
+func newHandler(
+	files *protoregistry.Files,
+	queryHandlers map[string]appmodulev2.Handler,
+	msgTypeURLs []string,
+	q querier,
+) (*handler, error) {
+	resolver := dynamicpb.NewTypes(files)
+	h := &handler{
+		queryHandlers: queryHandlers,
+		querier:       q,
+		// the field names and default values are emitted, as with the gRPC-gateway
+		marshalOpts:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true, Resolver: resolver},
+		unmarshalOpts: protojson.UnmarshalOptions{Resolver: resolver},
+		resolver:      resolver,
+	}
+
+	var err error
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			methods := fd.Services().Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				md := methods.Get(j)
+				input := string(md.Input().FullName())
+				_, query := queryHandlers[input]
+				if !query && !slices.Contains(msgTypeURLs, "/"+input) {
+					continue
+				}
+
+				var routes []*route
+				routes, err = methodRoutes(md, query)
+				if err != nil {
+					return false
+				}
+				h.routes = append(h.routes, routes...)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(h.routes, func(a, b *route) int {
+		return b.template.literals() - a.template.literals()
+	})
+
+	return h, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, params, err := h.match(r)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	req, err := h.decodeRequest(r, route, params)
+	if err != nil {
+		h.writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+
+	if !route.query {
+		h.serveMsg(w, req)
+		return
+	}
+
+	resp, err := h.query(r, route, req)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	bz, err := h.marshalOpts.Marshal(resp)
+	if err != nil {
+		h.writeError(w, status.Errorf(codes.Internal, "failed to encode the response: %v", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// match returns the route of a request, and the values of its path variables.
+func (h *handler) match(r *http.Request) (*route, map[string]string, error) {
+	pathMatched := false
+	for _, route := range h.routes {
+		params, ok := route.template.match(r.URL.EscapedPath())
+		if !ok {
+			continue
+		}
+		if route.httpMethod == r.Method {
+			return route, params, nil
+		}
+		pathMatched = true
+	}
+
+	if pathMatched {
+		return nil, nil, status.Errorf(codes.Unimplemented, "method %s not allowed on %s", r.Method, r.URL.Path)
+	}
+	return nil, nil, status.Errorf(codes.NotFound, "no route for %s", r.URL.Path)
+}
+
+// decodeRequest decodes the request of a method from the body, the path
+// variables and the query parameters of an HTTP request.
+func (h *handler) decodeRequest(r *http.Request, route *route, params map[string]string) (*dynamicpb.Message, error) {
+	req := dynamicpb.NewMessage(route.method.Input())
+
+	if route.body != "" {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the body: %w", err)
+		}
+		if len(body) > maxBodySize {
+			return nil, fmt.Errorf("body larger than %d bytes", maxBodySize)
+		}
+
+		if len(body) > 0 {
+			target := req.ProtoReflect()
+			if route.body != "*" {
+				target = target.Mutable(findField(route.method.Input(), route.body)).Message()
+			}
+			if err := h.unmarshalOpts.Unmarshal(body, target.Interface()); err != nil {
+				return nil, fmt.Errorf("invalid body: %w", err)
+			}
+		}
+	}
+
+	for path, value := range params {
+		if err := setField(req, path, value); err != nil {
+			return nil, err
+		}
+	}
+
+	// all the fields are in the body
+	if route.body == "*" {
+		return req, nil
+	}
+
+	for path, values := range r.URL.Query() {
+		for _, value := range values {
+			// unknown query parameters are ignored, as with the gRPC-gateway
+			if err := setField(req, path, value); err != nil && !errors.Is(err, errFieldNotFound) {
+				return nil, err
+			}
+		}
+	}
+
+	return req, nil
+}
+
+// query executes a query with the query router of the app, at the height of
+// the block height header of the request, 0 for the latest height.
+func (h *handler) query(r *http.Request, route *route, req *dynamicpb.Message) (*dynamicpb.Message, error) {
+	height, err := heightFromRequest(r)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	queryHandler, ok := h.queryHandlers[string(route.method.Input().FullName())]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%s is not handled", route.method.FullName())
+	}
+
+	bz, err := proto.Marshal(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to encode the request: %v", err)
+	}
+	msg := queryHandler.MakeMsg()
+	if err := gogoproto.Unmarshal(bz, msg); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to decode the request: %v", err)
+	}
+
+	res, err := h.querier.Query(r.Context(), height, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err = gogoproto.Marshal(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode the response: %v", err)
+	}
+	resp := dynamicpb.NewMessage(route.method.Output())
+	if err := (proto.UnmarshalOptions{Resolver: h.resolver}).Unmarshal(bz, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode the response: %v", err)
+	}
+
+	return resp, nil
+}
+
+// serveMsg writes the proto encoding of a Msg as an Any.
+func (h *handler) serveMsg(w http.ResponseWriter, msg *dynamicpb.Message) {
+	bz, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		h.writeError(w, status.Errorf(codes.InvalidArgument, "failed to encode the msg: %v", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, struct {
+		TypeURL string `json:"type_url"`
+		Value   []byte `json:"value"`
+	}{
+		TypeURL: "/" + string(msg.Descriptor().FullName()),
+		Value:   bz,
+	})
+}
+
+// writeError writes an error with the HTTP status of its gRPC code.
+func (h *handler) writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeJSON(w, runtime.HTTPStatusFromCode(st.Code()), struct {
+		Code    codes.Code `json:"code"`
+		Message string     `json:"message"`
+	}{
+		Code:    st.Code(),
+		Message: st.Message(),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(bz)
+}
+
+// heightFromRequest returns the height of the block height header of a request.
+func heightFromRequest(r *http.Request) (uint64, error) {
+	header := r.Header.Get(grpc.BlockHeightHeader)
+	if header == "" {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseUint(header, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s header %q: %w", grpc.BlockHeightHeader, header, err)
+	}
+	return height, nil
+}
@@ -0,0 +1,194 @@
+package rest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	coinv1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/transaction"
+)
+
+type mockQuerier struct {
+	height uint64
+	req    transaction.Msg
+}
+
+func (m *mockQuerier) Query(_ context.Context, version uint64, msg transaction.Msg) (transaction.Msg, error) {
+	m.height, m.req = version, msg
+	switch req := msg.(type) {
+	case *bankv1beta1.QueryBalanceRequest:
+		if req.Denom == "unknown" {
+			return nil, status.Error(codes.NotFound, "unknown denom")
+		}
+		return &bankv1beta1.QueryBalanceResponse{Balance: &coinv1beta1.Coin{Denom: req.Denom, Amount: "10"}}, nil
+	case *bankv1beta1.QueryAllBalancesRequest:
+		return &bankv1beta1.QueryAllBalancesResponse{
+			Balances:   []*coinv1beta1.Coin{{Denom: "stake", Amount: "10"}},
+			Pagination: &basev1beta1.PageResponse{NextKey: []byte{0xfb, 0xff}, Total: 2},
+		}, nil
+	}
+	return nil, status.Error(codes.Unimplemented, "unexpected query")
+}
+
+func newTestHandler(t *testing.T) (*handler, *mockQuerier) {
+	t.Helper()
+
+	files, err := gogoproto.MergedRegistry()
+	require.NoError(t, err)
+
+	querier := &mockQuerier{}
+	h, err := newHandler(
+		files,
+		map[string]appmodulev2.Handler{
+			"cosmos.bank.v1beta1.QueryBalanceRequest": {
+				MakeMsg: func() transaction.Msg { return &bankv1beta1.QueryBalanceRequest{} },
+			},
+			"cosmos.bank.v1beta1.QueryAllBalancesRequest": {
+				MakeMsg: func() transaction.Msg { return &bankv1beta1.QueryAllBalancesRequest{} },
+			},
+		},
+		[]string{"/cosmos.bank.v1beta1.MsgSend"},
+		querier,
+	)
+	require.NoError(t, err)
+
+	return h, querier
+}
+
+func serve(h http.Handler, method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_Query(t *testing.T) {
+	h, querier := newTestHandler(t)
+
+	// google.api.http binding, with a path variable and a query parameter
+	rec := serve(h, http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr/by_denom?denom=stake", "", http.Header{"X-Cosmos-Block-Height": {"5"}})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.JSONEq(t, `{"balance":{"denom":"stake","amount":"10"}}`, rec.Body.String())
+	require.Equal(t, uint64(5), querier.height)
+	require.True(t, proto.Equal(&bankv1beta1.QueryBalanceRequest{Address: "cosmos1addr", Denom: "stake"}, querier.req.(proto.Message)))
+
+	// gRPC path, with the request as body
+	rec = serve(h, http.MethodPost, "/cosmos.bank.v1beta1.Query/Balance", `{"address":"cosmos1addr","denom":"atom"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.JSONEq(t, `{"balance":{"denom":"atom","amount":"10"}}`, rec.Body.String())
+	require.Equal(t, uint64(0), querier.height)
+
+	// query errors are mapped to the HTTP status of their gRPC code
+	rec = serve(h, http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr/by_denom?denom=unknown", "", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.JSONEq(t, `{"code":5,"message":"unknown denom"}`, rec.Body.String())
+}
+
+func TestHandler_Pagination(t *testing.T) {
+	h, querier := newTestHandler(t)
+
+	key := base64.StdEncoding.EncodeToString([]byte{0xfb, 0xef})
+	rec := serve(h, http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr?pagination.limit=1&pagination.count_total=true&pagination.key="+key+"&unknown=1", "", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.True(t, proto.Equal(&bankv1beta1.QueryAllBalancesRequest{
+		Address:    "cosmos1addr",
+		Pagination: &basev1beta1.PageRequest{Key: []byte{0xfb, 0xef}, Limit: 1, CountTotal: true},
+	}, querier.req.(proto.Message)))
+
+	var res struct {
+		Balances   []map[string]string `json:"balances"`
+		Pagination struct {
+			NextKey []byte `json:"next_key"`
+			Total   string `json:"total"`
+		} `json:"pagination"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, []map[string]string{{"denom": "stake", "amount": "10"}}, res.Balances)
+	require.Equal(t, []byte{0xfb, 0xff}, res.Pagination.NextKey)
+	require.Equal(t, "2", res.Pagination.Total)
+
+	rec = serve(h, http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr?pagination.limit=invalid", "", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandler_Msg(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	rec := serve(h, http.MethodPost, "/cosmos.bank.v1beta1.Msg/Send", `{"from_address":"cosmos1from","to_address":"cosmos1to","amount":[{"denom":"stake","amount":"1"}]}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var res struct {
+		TypeURL string `json:"type_url"`
+		Value   []byte `json:"value"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", res.TypeURL)
+
+	var msg bankv1beta1.MsgSend
+	require.NoError(t, proto.Unmarshal(res.Value, &msg))
+	require.True(t, proto.Equal(&bankv1beta1.MsgSend{
+		FromAddress: "cosmos1from",
+		ToAddress:   "cosmos1to",
+		Amount:      []*coinv1beta1.Coin{{Denom: "stake", Amount: "1"}},
+	}, &msg))
+
+	// Msgs which are not registered are not served
+	rec = serve(h, http.MethodPost, "/cosmos.bank.v1beta1.Msg/MultiSend", `{}`, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_Errors(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	rec := serve(h, http.MethodGet, "/cosmos/unknown", "", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(h, http.MethodDelete, "/cosmos/bank/v1beta1/balances/cosmos1addr", "", nil)
+	require.Equal(t, http.StatusNotImplemented, rec.Code)
+
+	rec = serve(h, http.MethodPost, "/cosmos.bank.v1beta1.Query/Balance", `{"unknown":1}`, nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = serve(h, http.MethodGet, "/cosmos/bank/v1beta1/balances/cosmos1addr", "", http.Header{"X-Cosmos-Block-Height": {"-1"}})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestPathTemplate(t *testing.T) {
+	tmpl, err := parsePathTemplate("/cosmos/bank/v1beta1/denoms_metadata/{denom=**}")
+	require.NoError(t, err)
+	params, ok := tmpl.match("/cosmos/bank/v1beta1/denoms_metadata/ibc/ABC%2FDEF")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"denom": "ibc/ABC/DEF"}, params)
+
+	tmpl, err = parsePathTemplate("/cosmos/gov/v1/proposals/{proposal_id}/votes/{voter}")
+	require.NoError(t, err)
+	params, ok = tmpl.match("/cosmos/gov/v1/proposals/1/votes/cosmos1voter")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"proposal_id": "1", "voter": "cosmos1voter"}, params)
+	_, ok = tmpl.match("/cosmos/gov/v1/proposals/1/votes")
+	require.False(t, ok)
+	_, ok = tmpl.match("/cosmos/gov/v1/proposals/1/votes/cosmos1voter/extra")
+	require.False(t, ok)
+
+	for _, invalid := range []string{"cosmos", "/cosmos/{a=**}/b", "/cosmos/{a=b/*}", "/cosmos/{}", "/cosmos:verb"} {
+		_, err := parsePathTemplate(invalid)
+		require.Error(t, err, invalid)
+	}
+}
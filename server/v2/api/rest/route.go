@@ -0,0 +1,305 @@
+package rest
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// route is an HTTP endpoint of a query or Msg service method.
+type route struct {
+	httpMethod string
+	template   pathTemplate
+	// body is the request field the HTTP body is decoded into, "*" for the
+	// whole request and "" when the request has no body.
+	body   string
+	method protoreflect.MethodDescriptor
+	query  bool
+}
+
+// methodRoutes returns the routes of a service method: the gRPC path of the
+// method, e.g. POST /cosmos.bank.v1beta1.Query/Balance, with the request as JSON
+// body, and for queries the google.api.http bindings of the method.
+func methodRoutes(md protoreflect.MethodDescriptor, query bool) ([]*route, error) {
+	grpcPath := fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name())
+	routes := []*route{{
+		httpMethod: http.MethodPost,
+		template:   pathTemplate{segments: []segment{{literal: string(md.Parent().FullName())}, {literal: string(md.Name())}}},
+		body:       "*",
+		method:     md,
+		query:      query,
+	}}
+	if !query || !proto.HasExtension(md.Options(), annotations.E_Http) {
+		return routes, nil
+	}
+
+	rule := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+	for _, rule := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+		r, err := ruleRoute(rule, md)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http rule of %s: %w", grpcPath, err)
+		}
+		routes = append(routes, r)
+	}
+
+	return routes, nil
+}
+
+// ruleRoute returns the route of a google.api.http binding of a query method.
+func ruleRoute(rule *annotations.HttpRule, md protoreflect.MethodDescriptor) (*route, error) {
+	var httpMethod, path string
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		httpMethod, path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Post:
+		httpMethod, path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Put:
+		httpMethod, path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Delete:
+		httpMethod, path = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		httpMethod, path = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		httpMethod, path = pattern.Custom.Kind, pattern.Custom.Path
+	default:
+		return nil, errors.New("missing pattern")
+	}
+
+	template, err := parsePathTemplate(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule.Body != "" && rule.Body != "*" {
+		fd := findField(md.Input(), rule.Body)
+		if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, fmt.Errorf("body %q is not a message field of %s", rule.Body, md.Input().FullName())
+		}
+	}
+
+	return &route{
+		httpMethod: httpMethod,
+		template:   template,
+		body:       rule.Body,
+		method:     md,
+		query:      true,
+	}, nil
+}
+
+// pathTemplate is a google.api.http path template. Only the templates used by
+// the SDK are supported: literal segments, variables matching one segment,
+// {field} or {field=*}, and a trailing variable matching the rest of the path,
+// {field=**}.
+type pathTemplate struct {
+	segments []segment
+}
+
+type segment struct {
+	literal string
+	// field is the field path of a variable segment.
+	field string
+	// multi is whether the variable segment matches the rest of the path.
+	multi bool
+}
+
+func parsePathTemplate(tmpl string) (pathTemplate, error) {
+	if !strings.HasPrefix(tmpl, "/") {
+		return pathTemplate{}, fmt.Errorf("path template %q must start with /", tmpl)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(tmpl, "/"), "/")
+	segments := make([]segment, len(parts))
+	for i, part := range parts {
+		if !strings.HasPrefix(part, "{") {
+			if part == "" || strings.ContainsAny(part, "{}*:") {
+				return pathTemplate{}, fmt.Errorf("unsupported segment %q in path template %q", part, tmpl)
+			}
+			segments[i] = segment{literal: part}
+			continue
+		}
+
+		field, pattern, _ := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}"), "=")
+		switch {
+		case !strings.HasSuffix(part, "}") || field == "":
+			return pathTemplate{}, fmt.Errorf("invalid variable %q in path template %q", part, tmpl)
+		case pattern == "" || pattern == "*":
+			segments[i] = segment{field: field}
+		case pattern == "**" && i == len(parts)-1:
+			segments[i] = segment{field: field, multi: true}
+		default:
+			return pathTemplate{}, fmt.Errorf("unsupported variable %q in path template %q", part, tmpl)
+		}
+	}
+
+	return pathTemplate{segments: segments}, nil
+}
+
+// match matches the escaped path of a request against the template, and returns
+// the values of its variables by field path.
+func (t pathTemplate) match(escapedPath string) (map[string]string, bool) {
+	parts := strings.Split(strings.TrimPrefix(escapedPath, "/"), "/")
+	params := make(map[string]string)
+	for i, seg := range t.segments {
+		if i >= len(parts) {
+			return nil, false
+		}
+
+		if seg.multi {
+			value, err := url.PathUnescape(strings.Join(parts[i:], "/"))
+			if err != nil || value == "" {
+				return nil, false
+			}
+			params[seg.field] = value
+			return params, true
+		}
+
+		value, err := url.PathUnescape(parts[i])
+		if err != nil {
+			return nil, false
+		}
+		switch {
+		case seg.field == "" && value != seg.literal:
+			return nil, false
+		case seg.field != "":
+			if value == "" {
+				return nil, false
+			}
+			params[seg.field] = value
+		}
+	}
+
+	return params, len(parts) == len(t.segments)
+}
+
+// literals returns the number of literal segments of the template, the most
+// specific templates being matched first.
+func (t pathTemplate) literals() int {
+	n := 0
+	for _, seg := range t.segments {
+		if seg.field == "" {
+			n++
+		}
+	}
+	return n
+}
+
+// errFieldNotFound is returned when setting a field missing from a message.
+var errFieldNotFound = errors.New("field not found")
+
+// setField sets the field of a message at a dotted field path, e.g.
+// pagination.limit, from its string value in a path or a query parameter.
+// The value is appended to repeated fields.
+func setField(msg protoreflect.Message, path, value string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := findField(msg.Descriptor(), name)
+		if fd == nil {
+			return fmt.Errorf("%w: %s in %s", errFieldNotFound, path, msg.Descriptor().FullName())
+		}
+
+		if i < len(names)-1 {
+			if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("%s of %s is not a message field", name, msg.Descriptor().FullName())
+			}
+			msg = msg.Mutable(fd).Message()
+			continue
+		}
+
+		switch {
+		case fd.IsMap():
+			return fmt.Errorf("map field %s of %s cannot be set from a parameter", name, msg.Descriptor().FullName())
+		case fd.IsList():
+			list := msg.Mutable(fd).List()
+			v, err := parseValue(fd, value, list.NewElement)
+			if err != nil {
+				return fmt.Errorf("invalid value %q of %s: %w", value, path, err)
+			}
+			list.Append(v)
+		default:
+			v, err := parseValue(fd, value, func() protoreflect.Value { return msg.NewField(fd) })
+			if err != nil {
+				return fmt.Errorf("invalid value %q of %s: %w", value, path, err)
+			}
+			msg.Set(fd, v)
+		}
+	}
+
+	return nil
+}
+
+// findField returns the field of a message by proto name or JSON name.
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	return md.Fields().ByJSONName(name)
+}
+
+// parseValue parses the string value of a scalar field, or of a message field
+// with a JSON string representation, e.g. a google.protobuf.Timestamp.
+func parseValue(fd protoreflect.FieldDescriptor, value string, newMessage func() protoreflect.Value) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(value)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		v, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BytesKind:
+		v, err := decodeBytes(value)
+		return protoreflect.ValueOfBytes(v), err
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		v := newMessage()
+		err := protojson.Unmarshal([]byte(strconv.Quote(value)), v.Message().Interface())
+		return v, err
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
+
+// decodeBytes decodes a bytes parameter, e.g. a pagination key, encoded in
+// standard or URL-safe base64, with or without padding. The + of standard
+// base64 are decoded as spaces in query parameters when not escaped.
+func decodeBytes(value string) ([]byte, error) {
+	value = strings.ReplaceAll(value, " ", "+")
+	var err error
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		var bz []byte
+		if bz, err = enc.DecodeString(value); err == nil {
+			return bz, nil
+		}
+	}
+	return nil, err
+}
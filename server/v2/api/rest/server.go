@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	serverv2 "cosmossdk.io/server/v2"
+)
+
+var (
+	_ serverv2.ServerComponent[transaction.Tx] = (*Server[transaction.Tx])(nil)
+	_ serverv2.HasConfig                       = (*Server[transaction.Tx])(nil)
+)
+
+const (
+	ServerName = "rest"
+
+	// msgInterfaceName is the name of the interface implemented by the Msgs.
+	msgInterfaceName = "cosmos.base.v1beta1.Msg"
+)
+
+// Server is a REST server exposing every query and Msg service of the app over
+// HTTP/JSON, from their proto descriptors, without gRPC-gateway codegen.
+type Server[T transaction.Tx] struct {
+	logger     log.Logger
+	config     *Config
+	cfgOptions []CfgOption
+
+	server  *http.Server
+	handler http.Handler
+}
+
+// New creates a new REST server.
+func New[T transaction.Tx](cfgOptions ...CfgOption) *Server[T] {
+	return &Server[T]{
+		cfgOptions: cfgOptions,
+	}
+}
+
+func (s *Server[T]) Name() string {
+	return ServerName
+}
+
+func (s *Server[T]) Config() any {
+	if s.config == nil || s.config.Address == "" {
+		cfg := DefaultConfig()
+		// overwrite the default config with the provided options
+		for _, opt := range s.cfgOptions {
+			opt(cfg)
+		}
+
+		return cfg
+	}
+
+	return s.config
+}
+
+// Init implements serverv2.ServerComponent.
+func (s *Server[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logger log.Logger) error {
+	serverCfg := s.Config().(*Config)
+	if len(cfg) > 0 {
+		if err := serverv2.UnmarshalSubConfig(cfg, s.Name(), &serverCfg); err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+
+	files, err := gogoproto.MergedRegistry()
+	if err != nil {
+		return fmt.Errorf("failed to get registry: %w", err)
+	}
+
+	handler, err := newHandler(
+		files,
+		appI.GetQueryHandlers(),
+		appI.InterfaceRegistry().ListImplementations(msgInterfaceName),
+		appI.GetAppManager(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register the REST routes: %w", err)
+	}
+
+	s.handler = handler
+	s.logger = logger.With(log.ModuleKey, s.Name())
+	s.config = serverCfg
+
+	return nil
+}
+
+func (s *Server[T]) Start(ctx context.Context) error {
+	if !s.config.Enable {
+		s.logger.Info(fmt.Sprintf("%s server is disabled via config", s.Name()))
+		return nil
+	}
+
+	s.server = &http.Server{
+		Addr:    s.config.Address,
+		Handler: s.handler,
+	}
+
+	s.logger.Info("starting REST server...", "address", s.config.Address)
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("failed to start REST server: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server[T]) Stop(ctx context.Context) error {
+	if !s.config.Enable || s.server == nil {
+		return nil
+	}
+
+	s.logger.Info("stopping REST server...", "address", s.config.Address)
+	return s.server.Shutdown(ctx)
+}
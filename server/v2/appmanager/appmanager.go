@@ -111,6 +111,28 @@ func (a AppManager[T]) DeliverBlock(
 	return blockResponse, newState, nil
 }
 
+// ReplayBlock executes again a committed block on top of the state of its previous
+// height, e.g. to trace the execution of its txs. The resulting state is not committed.
+func (a AppManager[T]) ReplayBlock(
+	ctx context.Context,
+	block *server.BlockRequest[T],
+) (*server.BlockResponse, error) {
+	if block.Height == 0 {
+		return nil, errors.New("cannot replay a block of height 0")
+	}
+	state, err := a.db.StateAt(block.Height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the state of height %d: %w", block.Height-1, err)
+	}
+
+	blockResponse, _, err := a.stf.DeliverBlock(ctx, block, state)
+	if err != nil {
+		return nil, fmt.Errorf("block replay failed: %w", err)
+	}
+
+	return blockResponse, nil
+}
+
 // ValidateTx will validate the tx against the latest storage state. This means that
 // only the stateful validation will be run, not the execution portion of the tx.
 // If full execution is needed, Simulate must be used.
@@ -123,6 +145,25 @@ func (a AppManager[T]) ValidateTx(ctx context.Context, tx T) (server.TxResult, e
 	return res, res.Error
 }
 
+// ValidateTxs validates the txs in order against the latest storage state, each tx
+// being validated on top of the validation changes of the previous ones, e.g. the
+// sequence increments of a sender. The returned state allows to validate more txs
+// on top of them with ValidateTxsWithState.
+func (a AppManager[T]) ValidateTxs(ctx context.Context, txs []T) ([]server.TxResult, corestore.WriterMap, error) {
+	_, latestState, err := a.db.StateLatest()
+	if err != nil {
+		return nil, nil, err
+	}
+	results, state := a.stf.ValidateTxs(ctx, latestState, a.config.ValidateTxGasLimit, txs)
+	return results, state, nil
+}
+
+// ValidateTxsWithState validates the txs in order against the provided state, as
+// ValidateTxs does against the latest storage state.
+func (a AppManager[T]) ValidateTxsWithState(ctx context.Context, state corestore.ReaderMap, txs []T) ([]server.TxResult, corestore.WriterMap) {
+	return a.stf.ValidateTxs(ctx, state, a.config.ValidateTxGasLimit, txs)
+}
+
 // Simulate runs validation and execution flow of a Tx.
 func (a AppManager[T]) Simulate(ctx context.Context, tx T) (server.TxResult, corestore.WriterMap, error) {
 	_, state, err := a.db.StateLatest()
@@ -133,6 +174,12 @@ func (a AppManager[T]) Simulate(ctx context.Context, tx T) (server.TxResult, cor
 	return result, cs, nil
 }
 
+// SimulateWithState runs validation and execution flow of a Tx on the provided state,
+// e.g. the state of a past version or a branch of it with overridden values.
+func (a AppManager[T]) SimulateWithState(ctx context.Context, state corestore.ReaderMap, tx T) (server.TxResult, corestore.WriterMap) {
+	return a.stf.Simulate(ctx, state, a.config.SimulationGasLimit, tx)
+}
+
 // Query queries the application at the provided version.
 // CONTRACT: Version must always be provided, if 0, get latest
 func (a AppManager[T]) Query(ctx context.Context, version uint64, request transaction.Msg) (transaction.Msg, error) {
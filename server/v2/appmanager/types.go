@@ -25,6 +25,14 @@ type StateTransitionFunction[T transaction.Tx] interface {
 		tx T,
 	) server.TxResult
 
+	// ValidateTxs validates transactions in order on a single branch of the state.
+	ValidateTxs(
+		ctx context.Context,
+		state store.ReaderMap,
+		gasLimit uint64,
+		txs []T,
+	) ([]server.TxResult, store.WriterMap)
+
 	// Simulate executes a transaction in simulation mode.
 	Simulate(
 		ctx context.Context,
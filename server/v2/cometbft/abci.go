@@ -28,6 +28,7 @@ import (
 	"cosmossdk.io/schema/appdata"
 	"cosmossdk.io/server/v2/appmanager"
 	"cosmossdk.io/server/v2/cometbft/client/grpc/cmtservice"
+	"cosmossdk.io/server/v2/cometbft/client/rpc"
 	"cosmossdk.io/server/v2/cometbft/handlers"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 	"cosmossdk.io/server/v2/cometbft/types"
@@ -49,6 +50,8 @@ type Consensus[T transaction.Tx] struct {
 	listener         *appdata.Listener
 	snapshotManager  *snapshots.Manager
 	mempool          mempool.Mempool[T]
+	rechecker        *mempool.Rechecker[T] // revalidates the mempool after a commit, nil if disabled
+	cometRPC         rpc.CometRPC          // the in-process node RPC, nil in standalone mode
 
 	cfg           Config
 	indexedEvents map[string]struct{}
@@ -130,13 +133,15 @@ func (c *Consensus[T]) RegisterSnapshotExtensions(extensions ...snapshots.Extens
 }
 
 // CheckTx implements types.Application.
-// It is called by cometbft to verify transaction validity
+// It is called by cometbft to verify transaction validity.
+// Valid new txs are inserted in the app-side mempool, and rechecked txs which are no longer valid are removed from it.
 func (c *Consensus[T]) CheckTx(ctx context.Context, req *abciproto.CheckTxRequest) (*abciproto.CheckTxResponse, error) {
 	decodedTx, err := c.txCodec.Decode(req.Tx)
 	if err != nil {
 		return nil, err
 	}
 
+	var cometResp *abciproto.CheckTxResponse
 	if c.checkTxHandler == nil {
 		resp, err := c.app.ValidateTx(ctx, decodedTx)
 		// we do not want to return a cometbft error, but a check tx response with the error
@@ -149,7 +154,7 @@ func (c *Consensus[T]) CheckTx(ctx context.Context, req *abciproto.CheckTxReques
 			return nil, err
 		}
 
-		cometResp := &abciproto.CheckTxResponse{
+		cometResp = &abciproto.CheckTxResponse{
 			Code:      0,
 			GasWanted: uint64ToInt64(resp.GasWanted),
 			GasUsed:   uint64ToInt64(resp.GasUsed),
@@ -161,11 +166,32 @@ func (c *Consensus[T]) CheckTx(ctx context.Context, req *abciproto.CheckTxReques
 			cometResp.Codespace = space
 			cometResp.Log = log
 		}
+	} else {
+		cometResp, err = c.checkTxHandler(c.app.ValidateTx)
+		if err != nil {
+			return nil, err
+		}
+	}
 
+	if cometResp.Code != 0 {
+		if req.Type == abciproto.CHECK_TX_TYPE_RECHECK {
+			if err := c.mempool.Remove(decodedTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return nil, fmt.Errorf("unable to remove tx: %w", err)
+			}
+		}
 		return cometResp, nil
 	}
 
-	return c.checkTxHandler(c.app.ValidateTx)
+	if req.Type != abciproto.CHECK_TX_TYPE_RECHECK {
+		if err := c.mempool.Insert(ctx, decodedTx); err != nil {
+			space, code, log := errorsmod.ABCIInfo(err, c.cfg.AppTomlConfig.Trace)
+			cometResp.Code = code
+			cometResp.Codespace = space
+			cometResp.Log = log
+		}
+	}
+
+	return cometResp, nil
 }
 
 // Info implements types.Application.
@@ -210,6 +236,22 @@ func (c *Consensus[T]) Info(ctx context.Context, _ *abciproto.InfoRequest) (*abc
 // Query implements types.Application.
 // It is called by cometbft to query application state.
 func (c *Consensus[T]) Query(ctx context.Context, req *abciproto.QueryRequest) (resp *abciproto.QueryResponse, err error) {
+	// the queries of the services served by the consensus rather than by the modules
+	var handleServiceQuery func(context.Context, *abciproto.QueryRequest) (*abciproto.QueryResponse, error)
+	switch req.Path {
+	case simulateQueryPath:
+		handleServiceQuery = c.handleSimulateQuery
+	case traceTxQueryPath:
+		handleServiceQuery = c.handleTraceTxQuery
+	}
+	if handleServiceQuery != nil {
+		resp, err = handleServiceQuery(ctx, req)
+		if err != nil {
+			return QueryResult(err, c.cfg.AppTomlConfig.Trace), nil
+		}
+		return resp, nil
+	}
+
 	resp, isGRPC, err := c.maybeRunGRPCQuery(ctx, req)
 	if isGRPC {
 		return resp, err
@@ -466,17 +508,59 @@ func (c *Consensus[T]) FinalizeBlock(
 		}, nil
 	}
 
+	resp, decodedTxs, stateChanges, appHash, err := c.deliverBlock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []event.Event
+	events = append(events, resp.PreBlockEvents...)
+	events = append(events, resp.BeginBlockEvents...)
+	for _, tx := range resp.TxResults {
+		events = append(events, tx.Events...)
+	}
+	events = append(events, resp.EndBlockEvents...)
+
+	// listen to state streaming changes in accordance with the block
+	err = c.streamDeliverBlockChanges(ctx, req.Height, req.Txs, resp.TxResults, events, stateChanges)
+	if err != nil {
+		return nil, err
+	}
+
+	// remove txs from the mempool, the txs proposed by other validators may not be in it
+	for _, tx := range decodedTxs {
+		if err = c.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return nil, fmt.Errorf("unable to remove tx: %w", err)
+		}
+	}
+
+	c.lastCommittedHeight.Store(req.Height)
+
+	cp, err := c.GetConsensusParams(ctx) // we get the consensus params from the latest state because we committed state above
+	if err != nil {
+		return nil, err
+	}
+
+	return finalizeBlockResponse(resp, cp, appHash, c.indexedEvents, c.cfg.AppTomlConfig.Trace)
+}
+
+// deliverBlock delivers the block of a FinalizeBlockRequest to the app and commits
+// its state changes, it returns the decoded txs of the block and the app hash.
+func (c *Consensus[T]) deliverBlock(
+	ctx context.Context,
+	req *abciproto.FinalizeBlockRequest,
+) (*server.BlockResponse, []T, []store.StateChanges, []byte, error) {
 	// TODO(tip): can we expect some txs to not decode? if so, what we do in this case? this does not seem to be the case,
 	// considering that prepare and process always decode txs, assuming they're the ones providing txs we should never
 	// have a tx that fails decoding.
 	decodedTxs, err := decodeTxs(req.Txs, c.txCodec)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	cid, err := c.store.LastCommitID()
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	blockReq := &server.BlockRequest[T]{
@@ -497,49 +581,21 @@ func (c *Consensus[T]) FinalizeBlock(
 
 	resp, newState, err := c.app.DeliverBlock(ciCtx, blockReq)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	// after we get the changeset we can produce the commit hash,
 	// from the store.
 	stateChanges, err := newState.GetStateChanges()
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 	appHash, err := c.store.Commit(&store.Changeset{Changes: stateChanges})
 	if err != nil {
-		return nil, fmt.Errorf("unable to commit the changeset: %w", err)
-	}
-
-	var events []event.Event
-	events = append(events, resp.PreBlockEvents...)
-	events = append(events, resp.BeginBlockEvents...)
-	for _, tx := range resp.TxResults {
-		events = append(events, tx.Events...)
-	}
-	events = append(events, resp.EndBlockEvents...)
-
-	// listen to state streaming changes in accordance with the block
-	err = c.streamDeliverBlockChanges(ctx, req.Height, req.Txs, resp.TxResults, events, stateChanges)
-	if err != nil {
-		return nil, err
-	}
-
-	// remove txs from the mempool
-	for _, tx := range decodedTxs {
-		if err = c.mempool.Remove(tx); err != nil {
-			return nil, fmt.Errorf("unable to remove tx: %w", err)
-		}
+		return nil, nil, nil, nil, fmt.Errorf("unable to commit the changeset: %w", err)
 	}
 
-	c.lastCommittedHeight.Store(req.Height)
-
-	cp, err := c.GetConsensusParams(ctx) // we get the consensus params from the latest state because we committed state above
-	if err != nil {
-		return nil, err
-	}
-
-	return finalizeBlockResponse(resp, cp, appHash, c.indexedEvents, c.cfg.AppTomlConfig.Trace)
+	return resp, decodedTxs, stateChanges, appHash, nil
 }
 
 // Commit implements types.Application.
@@ -549,6 +605,11 @@ func (c *Consensus[T]) Commit(ctx context.Context, _ *abciproto.CommitRequest) (
 
 	c.snapshotManager.SnapshotIfApplicable(lastCommittedHeight)
 
+	// evict the txs which are no longer valid against the committed state
+	if c.rechecker != nil {
+		c.rechecker.Start(lastCommittedHeight)
+	}
+
 	cp, err := c.GetConsensusParams(ctx)
 	if err != nil {
 		return nil, err
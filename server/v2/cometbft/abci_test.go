@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/server"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
@@ -323,6 +324,58 @@ func TestConsensus_CheckTx(t *testing.T) {
 	require.NotEqual(t, res.GasUsed, 0)
 }
 
+type mockSignerExtractor struct{}
+
+func (mockSignerExtractor) GetSigners(tx mock.Tx) ([]mempool.SignerData, error) {
+	return []mempool.SignerData{{Signer: tx.Sender}}, nil
+}
+
+func TestConsensus_CheckTx_Mempool(t *testing.T) {
+	cfg := mempool.DefaultPriorityNonceMempoolConfig[mock.Tx]()
+	cfg.SignerExtractor = mockSignerExtractor{}
+	mp := mempool.NewPriorityNonceMempool(cfg)
+
+	c := setUpConsensus(t, 100_000, mp)
+	_, err := c.InitChain(context.Background(), &abciproto.InitChainRequest{
+		Time:          time.Now(),
+		ChainId:       "test",
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+
+	// a valid tx is inserted in the mempool
+	res, err := c.CheckTx(context.Background(), &abciproto.CheckTxRequest{
+		Tx:   mockTx.Bytes(),
+		Type: abciproto.CHECK_TX_TYPE_CHECK,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code)
+	require.Equal(t, 1, mp.CountTx())
+
+	// a tx with the same sender and nonce but without a higher priority is rejected
+	replacement := mockTx
+	replacement.GasLimit = 90_000
+	res, err = c.CheckTx(context.Background(), &abciproto.CheckTxRequest{
+		Tx:   replacement.Bytes(),
+		Type: abciproto.CHECK_TX_TYPE_CHECK,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, uint32(0), res.Code)
+	require.Equal(t, 1, mp.CountTx())
+
+	// a tx failing on recheck is removed from the mempool
+	c.checkTxHandler = func(func(ctx context.Context, tx mock.Tx) (server.TxResult, error)) (*abciproto.CheckTxResponse, error) {
+		return &abciproto.CheckTxResponse{Code: 1}, nil
+	}
+	res, err = c.CheckTx(context.Background(), &abciproto.CheckTxRequest{
+		Tx:   mockTx.Bytes(),
+		Type: abciproto.CHECK_TX_TYPE_RECHECK,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, uint32(0), res.Code)
+	require.Equal(t, 0, mp.CountTx())
+}
+
 func TestConsensus_ExtendVote(t *testing.T) {
 	c := setUpConsensus(t, 100_000, mempool.NoOpMempool[mock.Tx]{})
 
@@ -418,7 +471,7 @@ func TestConsensus_PrepareProposal(t *testing.T) {
 	require.Error(t, err)
 
 	// NoOp handler
-	c.prepareProposalHandler = DefaultServerOptions[mock.Tx]().PrepareProposalHandler
+	c.prepareProposalHandler = handlers.NoOpPrepareProposal[mock.Tx]()
 	_, err = c.PrepareProposal(context.Background(), &abciproto.PrepareProposalRequest{
 		Height: 1,
 		Txs:    [][]byte{mockTx.Bytes()},
@@ -504,7 +557,7 @@ func TestConsensus_ProcessProposal(t *testing.T) {
 	require.Error(t, err)
 
 	// NoOp handler
-	c.processProposalHandler = DefaultServerOptions[mock.Tx]().ProcessProposalHandler
+	c.processProposalHandler = handlers.NoOpProcessProposal[mock.Tx]()
 	_, err = c.ProcessProposal(context.Background(), &abciproto.ProcessProposalRequest{
 		Height: 1,
 		Txs:    [][]byte{mockTx.Bytes()},
@@ -629,7 +682,7 @@ func setUpConsensus(t *testing.T, gasLimit uint64, mempool mempool.Mempool[mock.
 	t.Helper()
 
 	msgRouterBuilder := getMsgRouterBuilder(t, func(ctx context.Context, msg *gogotypes.BoolValue) (*gogotypes.BoolValue, error) {
-		return nil, nil
+		return msg, nil
 	})
 
 	queryRouterBuilder := getQueryRouterBuilder(t, func(ctx context.Context, q *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error) {
@@ -18,6 +18,7 @@ import (
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/yaml"
 
+	"cosmossdk.io/server/v2/cometbft/client/grpc/debugservice"
 	"cosmossdk.io/server/v2/cometbft/client/rpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -361,6 +362,52 @@ func QueryBlockResultsCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryTraceTxCmd implements the command tracing the execution of a committed tx.
+func QueryTraceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-tx [hash]",
+		Short: "Trace the execution of a committed tx",
+		Long: `Trace the execution of a committed tx, executed again on top of the state of the previous height.
+The queried node must enable the query with comet.trace-tx-query in app.toml.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			node, err := rpcClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			reqBz, err := gogoproto.Marshal(&debugservice.TraceTxRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			res, err := node.ABCIQuery(cmd.Context(), traceTxQueryPath, reqBz)
+			if err != nil {
+				return err
+			}
+			if !res.Response.IsOK() {
+				return errors.New(res.Response.Log)
+			}
+
+			var traceRes debugservice.TraceTxResponse
+			if err := gogoproto.Unmarshal(res.Response.Value, &traceRes); err != nil {
+				return err
+			}
+
+			traceResStr, err := json.Marshal(&traceRes)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(cmd, traceResStr)
+		},
+	}
+
+	AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parseOptionalHeight(heightStr string) (*int64, error) {
 	h, err := strconv.Atoi(heightStr)
 	if err != nil {
@@ -3,6 +3,8 @@ package cometbft
 import (
 	cmtcfg "github.com/cometbft/cometbft/config"
 
+	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/cometbft/handlers"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 )
 
@@ -22,7 +24,9 @@ func DefaultAppTomlConfig() *AppTomlConfig {
 		Transport:       "socket",
 		Trace:           false,
 		Standalone:      false,
+		TraceTxQuery:    false,
 		Mempool:         mempool.DefaultConfig(),
+		FeeMarket:       handlers.DefaultFeeMarketConfig(),
 	}
 }
 
@@ -35,9 +39,11 @@ type AppTomlConfig struct {
 	Transport       string   `mapstructure:"transport" toml:"transport" comment:"transport defines the CometBFT RPC server transport protocol: socket, grpc"`
 	Trace           bool     `mapstructure:"trace" toml:"trace" comment:"trace enables the CometBFT RPC server to output trace information about its internal operations."`
 	Standalone      bool     `mapstructure:"standalone" toml:"standalone" comment:"standalone starts the application without the CometBFT node. The node should be started separately."`
+	TraceTxQuery    bool     `mapstructure:"trace-tx-query" toml:"trace-tx-query" comment:"trace-tx-query enables the cosmos.base.debug.v1 TraceTx query, which executes again the block of a tx. It should only be enabled on non-public nodes."`
 
 	// Sub configs
-	Mempool mempool.Config `mapstructure:"mempool" toml:"mempool" comment:"mempool defines the configuration for the SDK built-in app-side mempool implementations."`
+	Mempool   mempool.Config           `mapstructure:"mempool" toml:"mempool" comment:"mempool defines the configuration for the SDK built-in app-side mempool implementations."`
+	FeeMarket handlers.FeeMarketConfig `mapstructure:"fee-market" toml:"fee-market" comment:"fee-market defines the configuration of the fee market selection of the proposal transactions."`
 }
 
 // CfgOption is a function that allows to overwrite the default server configuration.
@@ -56,3 +62,13 @@ func OverwriteDefaultAppTomlConfig(newCfg *AppTomlConfig) CfgOption {
 		cfg.AppTomlConfig = newCfg
 	}
 }
+
+// mempoolConfig returns the mempool configuration of the given app.toml config,
+// the default one if it cannot be decoded.
+func mempoolConfig(cfg map[string]any) mempool.Config {
+	appTomlConfig := DefaultAppTomlConfig()
+	if err := serverv2.UnmarshalSubConfig(cfg, ServerName, appTomlConfig); err != nil {
+		return mempool.DefaultConfig()
+	}
+	return appTomlConfig.Mempool
+}
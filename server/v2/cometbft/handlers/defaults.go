@@ -6,12 +6,15 @@ import (
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/core/server"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 	consensustypes "cosmossdk.io/x/consensus/types"
+
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 type AppManager[T transaction.Tx] interface {
@@ -31,6 +34,11 @@ func NewDefaultProposalHandler[T transaction.Tx](mp mempool.Mempool[T]) *Default
 	}
 }
 
+// SetTxSelector sets the TxSelector used to select the transactions of a proposal.
+func (h *DefaultProposalHandler[T]) SetTxSelector(ts TxSelector[T]) {
+	h.txSelector = ts
+}
+
 func (h *DefaultProposalHandler[T]) PrepareHandler() PrepareHandler[T] {
 	return func(ctx context.Context, app AppManager[T], codec transaction.Codec[T], req *abci.PrepareProposalRequest) ([]T, error) {
 		var maxBlockGas uint64
@@ -69,32 +77,90 @@ func (h *DefaultProposalHandler[T]) PrepareHandler() PrepareHandler[T] {
 			return h.txSelector.SelectedTxs(ctx), nil
 		}
 
-		iterator := h.mempool.Select(ctx, txs)
-		for iterator != nil {
-			memTx := iterator.Tx()
+		if lanedMempool, ok := h.mempool.(*mempool.LanedMempool[T]); ok {
+			return h.selectLaneTxs(ctx, app, lanedMempool, txs, uint64(req.MaxTxBytes), maxBlockGas)
+		}
 
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			_, err := app.ValidateTx(ctx, memTx)
-			if err != nil {
-				err := h.mempool.Remove(memTx)
-				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-					return nil, err
-				}
-			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx)
-				if stop {
-					break
-				}
+		if err := h.selectTxs(ctx, app, h.mempool.Select(ctx, txs), uint64(req.MaxTxBytes), maxBlockGas); err != nil {
+			return nil, err
+		}
+
+		return h.txSelector.SelectedTxs(ctx), nil
+	}
+}
+
+// selectTxs offers the valid txs of the iterator to the TxSelector, until it is full.
+func (h *DefaultProposalHandler[T]) selectTxs(ctx context.Context, app AppManager[T], iterator mempool.Iterator[T], maxTxBytes, maxBlockGas uint64) error {
+	for iterator != nil {
+		memTx := iterator.Tx()
+
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		_, err := app.ValidateTx(ctx, memTx)
+		if err != nil {
+			err := h.mempool.Remove(memTx)
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return err
 			}
+		} else {
+			stop := h.txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx)
+			if stop {
+				break
+			}
+		}
 
-			iterator = iterator.Next()
+		iterator = iterator.Next()
+	}
+
+	return nil
+}
+
+// selectLaneTxs selects the txs of a LanedMempool lane by lane, within the max
+// block share of each lane and the space left by the previous lanes.
+func (h *DefaultProposalHandler[T]) selectLaneTxs(
+	ctx context.Context,
+	app AppManager[T],
+	mp *mempool.LanedMempool[T],
+	txs []T,
+	maxTxBytes, maxBlockGas uint64,
+) ([]T, error) {
+	var (
+		selectedTxs               []T
+		usedTxBytes, usedBlockGas uint64
+	)
+	for i, lane := range mp.Lanes() {
+		laneMaxTxBytes := min(sdkmempool.LaneLimit(maxTxBytes, lane.MaxBlockShare), maxTxBytes-usedTxBytes)
+		laneMaxBlockGas := min(sdkmempool.LaneLimit(maxBlockGas, lane.MaxBlockShare), maxBlockGas-usedBlockGas)
+		if laneMaxTxBytes == 0 || (maxBlockGas > 0 && laneMaxBlockGas == 0) {
+			continue
 		}
 
-		return h.txSelector.SelectedTxs(ctx), nil
+		var laneTxs []T
+		for _, tx := range txs {
+			if mp.LaneIndex(tx) == i {
+				laneTxs = append(laneTxs, tx)
+			}
+		}
+
+		h.txSelector.Clear()
+		if err := h.selectTxs(ctx, app, lane.Mempool.Select(ctx, laneTxs), laneMaxTxBytes, laneMaxBlockGas); err != nil {
+			return nil, err
+		}
+
+		for _, tx := range h.txSelector.SelectedTxs(ctx) {
+			gas, err := tx.GetGasLimit()
+			if err != nil {
+				return nil, err
+			}
+			usedTxBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.Bytes()}))
+			usedBlockGas += gas
+			selectedTxs = append(selectedTxs, tx)
+		}
 	}
+
+	return selectedTxs, nil
 }
 
 func (h *DefaultProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
@@ -116,9 +182,12 @@ func (h *DefaultProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
 			return fmt.Errorf("unexpected consensus params response type; expected: %T, got: %T", &consensustypes.QueryParamsResponse{}, res)
 		}
 
-		var maxBlockGas uint64
+		var maxBlockGas, maxBlockBytes uint64
 		if b := paramsResp.GetParams().Block; b != nil {
 			maxBlockGas = uint64(b.MaxGas)
+			if b.MaxBytes > 0 {
+				maxBlockBytes = uint64(b.MaxBytes)
+			}
 		}
 
 		// Decode request txs bytes
@@ -151,10 +220,49 @@ func (h *DefaultProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
 			}
 		}
 
+		if lanedMempool, ok := h.mempool.(*mempool.LanedMempool[T]); ok {
+			return validateLanes(lanedMempool, txs, maxBlockBytes, maxBlockGas)
+		}
+
 		return nil
 	}
 }
 
+// validateLanes checks that the txs are ordered by lane and fit in the max block
+// share of their lane, the txs matching no lane belonging to the last lane.
+func validateLanes[T transaction.Tx](mp *mempool.LanedMempool[T], txs []T, maxBlockBytes, maxBlockGas uint64) error {
+	var (
+		lane               int
+		laneBytes, laneGas uint64
+	)
+	for _, tx := range txs {
+		txLane := mp.ProposalLaneIndex(tx)
+		if txLane < lane {
+			return fmt.Errorf("tx %X is not in lane order", tx.Hash())
+		}
+		if txLane > lane {
+			lane, laneBytes, laneGas = txLane, 0, 0
+		}
+
+		gas, err := tx.GetGasLimit()
+		if err != nil {
+			return errors.New("failed to get gas limit")
+		}
+		laneBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.Bytes()}))
+		laneGas += gas
+
+		l := mp.Lanes()[lane]
+		if maxBlockBytes > 0 && laneBytes > sdkmempool.LaneLimit(maxBlockBytes, l.MaxBlockShare) {
+			return fmt.Errorf("lane %s txs bytes exceed %d%% of the max block bytes", l.Name, l.MaxBlockShare)
+		}
+		if maxBlockGas > 0 && laneGas > sdkmempool.LaneLimit(maxBlockGas, l.MaxBlockShare) {
+			return fmt.Errorf("lane %s txs gas exceeds %d%% of the max block gas", l.Name, l.MaxBlockShare)
+		}
+	}
+
+	return nil
+}
+
 // decodeTxs decodes the txs bytes into a decoded txs
 // If there a fail decoding tx, remove from the list
 // Used for prepare proposal
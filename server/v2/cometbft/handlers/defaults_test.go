@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+	consensustypes "cosmossdk.io/x/consensus/types"
+)
+
+type mockAppManager struct {
+	params *cmtproto.ConsensusParams
+}
+
+func (m mockAppManager) ValidateTx(context.Context, feeTx) (server.TxResult, error) {
+	return server.TxResult{}, nil
+}
+
+func (m mockAppManager) Query(context.Context, uint64, transaction.Msg) (transaction.Msg, error) {
+	return &consensustypes.QueryParamsResponse{Params: m.params}, nil
+}
+
+type feeTxCodec map[string]feeTx
+
+func (c feeTxCodec) Decode(bz []byte) (feeTx, error) {
+	tx, ok := c[string(bz)]
+	if !ok {
+		return feeTx{}, errors.New("unknown tx")
+	}
+	return tx, nil
+}
+
+func (c feeTxCodec) DecodeJSON([]byte) (feeTx, error) {
+	return feeTx{}, errors.New("not implemented")
+}
+
+type feeTxSignerExtractor struct{}
+
+func (feeTxSignerExtractor) GetSigners(tx feeTx) ([]mempool.SignerData, error) {
+	return []mempool.SignerData{{Signer: []byte(tx.sender)}}, nil
+}
+
+func TestDefaultProposalHandler_LanedMempool(t *testing.T) {
+	ctx := context.Background()
+
+	newMempool := func() mempool.Mempool[feeTx] {
+		cfg := mempool.DefaultPriorityNonceMempoolConfig[feeTx]()
+		cfg.TxPriority.GetTxPriority = func(_ context.Context, tx feeTx) int64 { return tx.feePerGas }
+		cfg.SignerExtractor = feeTxSignerExtractor{}
+		return mempool.NewPriorityNonceMempool(cfg)
+	}
+	// the oracle txs have a StringValue msg and can use 30% of the block
+	oracleLane := mempool.Lane[feeTx]{
+		Name: "oracle",
+		Match: func(tx feeTx) bool {
+			_, ok := tx.msg.(*gogotypes.StringValue)
+			return ok
+		},
+		Mempool:       newMempool(),
+		MaxBlockShare: 30,
+	}
+	mp, err := mempool.NewLanedMempool(oracleLane, mempool.Lane[feeTx]{Name: "default", Mempool: newMempool(), MaxBlockShare: 100})
+	require.NoError(t, err)
+
+	txs := []feeTx{
+		{name: "a0", sender: "a", msg: &gogotypes.BoolValue{}, gas: 300, feePerGas: 10},
+		{name: "b0", sender: "b", msg: &gogotypes.BoolValue{}, gas: 400, feePerGas: 50},
+		{name: "o0", sender: "o", msg: &gogotypes.StringValue{}, gas: 200, feePerGas: 1},
+		{name: "p0", sender: "p", msg: &gogotypes.StringValue{}, gas: 200, feePerGas: 2},
+	}
+	codec := feeTxCodec{}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+		codec[string(tx.Bytes())] = tx
+	}
+
+	app := mockAppManager{params: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1000, MaxBytes: 1_000_000}}}
+	h := NewDefaultProposalHandler[feeTx](mp)
+
+	// only one oracle tx fits in the 300 gas of the oracle lane, the oracle txs come first
+	selected, err := h.PrepareHandler()(ctx, app, codec, &abci.PrepareProposalRequest{MaxTxBytes: 1_000_000})
+	require.NoError(t, err)
+	var names []string
+	for _, tx := range selected {
+		names = append(names, tx.name)
+	}
+	require.Equal(t, []string{"p0", "b0", "a0"}, names)
+
+	process := func(names ...string) error {
+		req := &abci.ProcessProposalRequest{}
+		for _, name := range names {
+			for _, tx := range txs {
+				if tx.name == name {
+					req.Txs = append(req.Txs, tx.Bytes())
+				}
+			}
+		}
+		return h.ProcessHandler()(ctx, app, codec, req)
+	}
+	require.NoError(t, process("p0", "b0", "a0"))
+	require.ErrorContains(t, process("b0", "p0", "a0"), "not in lane order")
+	require.ErrorContains(t, process("p0", "o0", "a0"), "lane oracle txs gas exceeds 30% of the max block gas")
+
+	// the txs matching no lane, which other validators may propose, belong to the last lane
+	oracleMp, err := mempool.NewLanedMempool(oracleLane)
+	require.NoError(t, err)
+	oracleHandler := NewDefaultProposalHandler[feeTx](oracleMp)
+	processOracle := func(txs ...feeTx) error {
+		req := &abci.ProcessProposalRequest{}
+		for _, tx := range txs {
+			req.Txs = append(req.Txs, tx.Bytes())
+		}
+		return oracleHandler.ProcessHandler()(ctx, app, codec, req)
+	}
+	require.NoError(t, processOracle(txs[0]))
+	require.ErrorContains(t, processOracle(txs[3], txs[0]), "lane oracle txs gas exceeds 30% of the max block gas")
+
+	// the bytes of the oracle lane are limited too
+	txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txs[3].Bytes()}))
+	app.params.Block.MaxBytes = int64(3 * txSize)
+	require.ErrorContains(t, process("p0"), "lane oracle txs bytes exceed 30% of the max block bytes")
+}
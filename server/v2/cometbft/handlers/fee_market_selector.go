@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// DefaultMaxCandidates is the default maximum number of transactions considered
+// by the fee market TxSelector for a proposal.
+const DefaultMaxCandidates = 10_000
+
+// FeeMarketConfig defines the configuration of the fee market TxSelector.
+type FeeMarketConfig struct {
+	// Enabled defines if the fee market TxSelector is used to build proposals.
+	Enabled bool `mapstructure:"enabled" toml:"enabled" comment:"enabled defines if the proposals are built by the fee market selector, including the transactions paying the highest fee per gas first."`
+	// MaxCandidates defines the maximum number of transactions considered for a proposal.
+	MaxCandidates int `mapstructure:"max-candidates" toml:"max-candidates" comment:"max-candidates defines the maximum number of transactions considered for a proposal. A value of 0 indicates no limit."`
+	// Lanes defines the blockspace reserved to the transactions of given message types.
+	Lanes []LaneQuota `mapstructure:"lanes" toml:"lanes" comment:"lanes defines the blockspace reserved to the transactions of given message types, e.g. oracle votes or IBC client updates.\nA lane is defined by a name, its message type URLs (msg-types) and the percentage of the block bytes and gas reserved to it (reserved-share), e.g. [{ name = \"ibc\", msg-types = [\"/ibc.core.client.v1.MsgUpdateClient\"], reserved-share = 10 }].\nThe lanes only apply to the proposals of this node, they are not enforced on the proposals of the other validators."`
+}
+
+// LaneQuota defines the blockspace reserved to the transactions of given message
+// types. A transaction belongs to a lane if all its messages are of the types of
+// the lane, a message type listed by several lanes belongs to the first one.
+type LaneQuota struct {
+	// Name is the name of the lane.
+	Name string `mapstructure:"name" toml:"name"`
+	// MsgTypes are the type URLs of the messages of the lane.
+	MsgTypes []string `mapstructure:"msg-types" toml:"msg-types"`
+	// ReservedShare is the percentage of the block bytes and gas reserved to the
+	// lane, which the transactions outside the lane cannot use.
+	ReservedShare uint64 `mapstructure:"reserved-share" toml:"reserved-share"`
+}
+
+// DefaultFeeMarketConfig returns the default configuration of the fee market TxSelector.
+func DefaultFeeMarketConfig() FeeMarketConfig {
+	return FeeMarketConfig{
+		Enabled:       false,
+		MaxCandidates: DefaultMaxCandidates,
+		Lanes:         []LaneQuota{},
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c FeeMarketConfig) Validate() error {
+	if c.MaxCandidates < 0 {
+		return errors.New("max-candidates cannot be negative")
+	}
+
+	var totalShare uint64
+	names := make(map[string]struct{}, len(c.Lanes))
+	for _, lane := range c.Lanes {
+		if lane.Name == "" {
+			return errors.New("lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+		if len(lane.MsgTypes) == 0 {
+			return fmt.Errorf("lane %s has no message types", lane.Name)
+		}
+		totalShare += lane.ReservedShare
+	}
+	if totalShare > 100 {
+		return fmt.Errorf("the reserved shares of the lanes sum to %d%%, more than 100%%", totalShare)
+	}
+
+	return nil
+}
+
+// feeMarketTxSelector is a TxSelector maximizing the fees of a proposal. The
+// transactions offered by SelectTxForProposal are buffered, then included by
+// decreasing fee per gas within the lane quotas first and the block limits then,
+// while keeping the transactions of a sender in their offering order. The space
+// reserved to a lane is never used by the transactions outside the lane. The
+// lanes are a local configuration of the proposer, they are not checked by
+// ProcessProposal as the validators may configure different lanes.
+type feeMarketTxSelector[T transaction.Tx] struct {
+	maxCandidates int
+	lanes         []LaneQuota
+	laneOf        map[string]int // msg type URL -> lane index
+	feePerGas     func(ctx context.Context, tx T) int64
+
+	maxTxBytes  uint64
+	maxBlockGas uint64
+	candidates  []*txCandidate[T]
+	selectedTxs []T
+	selected    bool
+}
+
+// txCandidate is a transaction offered for a proposal.
+type txCandidate[T transaction.Tx] struct {
+	tx        T
+	sender    string
+	size      uint64
+	gas       uint64
+	feePerGas int64
+	lane      int // -1 if the tx is in no lane
+	seq       int // offering order
+}
+
+// NewFeeMarketTxSelector returns a TxSelector including the transactions paying
+// the highest fee per gas first, as computed by the default mempool TxPriority,
+// and reserving blockspace to the lanes of the configuration.
+func NewFeeMarketTxSelector[T transaction.Tx](cfg FeeMarketConfig) (TxSelector[T], error) {
+	return NewFeeMarketTxSelectorWithFeePerGas(cfg, mempool.NewDefaultTxPriority[T]().GetTxPriority)
+}
+
+// NewFeeMarketTxSelectorWithFeePerGas returns a fee market TxSelector using the
+// given function to compute the fee per gas of a transaction.
+func NewFeeMarketTxSelectorWithFeePerGas[T transaction.Tx](cfg FeeMarketConfig, feePerGas func(ctx context.Context, tx T) int64) (TxSelector[T], error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	laneOf := make(map[string]int)
+	for i, lane := range cfg.Lanes {
+		for _, msgType := range lane.MsgTypes {
+			msgType = strings.TrimPrefix(msgType, "/")
+			if _, ok := laneOf[msgType]; !ok {
+				laneOf[msgType] = i
+			}
+		}
+	}
+
+	return &feeMarketTxSelector[T]{
+		maxCandidates: cfg.MaxCandidates,
+		lanes:         cfg.Lanes,
+		laneOf:        laneOf,
+		feePerGas:     feePerGas,
+	}, nil
+}
+
+func (ts *feeMarketTxSelector[T]) SelectedTxs(ctx context.Context) []T {
+	if !ts.selected {
+		ts.selectedTxs = ts.selectTxs()
+		ts.selected = true
+	}
+
+	txs := make([]T, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+func (ts *feeMarketTxSelector[T]) Clear() {
+	ts.maxTxBytes = 0
+	ts.maxBlockGas = 0
+	ts.candidates = nil
+	ts.selectedTxs = nil
+	ts.selected = false
+}
+
+func (ts *feeMarketTxSelector[T]) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, tx T) bool {
+	ts.maxTxBytes, ts.maxBlockGas = maxTxBytes, maxBlockGas
+	ts.selected = false
+
+	c := &txCandidate[T]{
+		tx:   tx,
+		size: uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.Bytes()})),
+		lane: ts.lane(tx),
+		seq:  len(ts.candidates),
+	}
+	gas, err := tx.GetGasLimit()
+	if err != nil {
+		return false
+	}
+	c.gas = gas
+	// a tx which cannot fit in a block is never selected
+	if c.size > maxTxBytes || (maxBlockGas > 0 && c.gas > maxBlockGas) {
+		return false
+	}
+
+	// the txs of a sender are kept in order, a tx without sender is alone in its queue
+	c.sender = fmt.Sprintf("#%d", c.seq)
+	if senders, err := tx.GetSenders(); err == nil && len(senders) > 0 {
+		c.sender = string(senders[0])
+	}
+	c.feePerGas = ts.feePerGas(ctx, tx)
+
+	ts.candidates = append(ts.candidates, c)
+	return ts.maxCandidates > 0 && len(ts.candidates) >= ts.maxCandidates
+}
+
+// lane returns the index of the lane of the tx, -1 if it is in no lane.
+func (ts *feeMarketTxSelector[T]) lane(tx T) int {
+	if len(ts.lanes) == 0 {
+		return -1
+	}
+
+	msgs, err := tx.GetMessages()
+	if err != nil || len(msgs) == 0 {
+		return -1
+	}
+
+	lane := -1
+	for _, msg := range msgs {
+		l, ok := ts.laneOf[gogoproto.MessageName(msg)]
+		if !ok || (lane != -1 && l != lane) {
+			return -1
+		}
+		lane = l
+	}
+	return lane
+}
+
+// selectTxs selects the candidates to include in the proposal. The lanes are
+// filled first, up to their reserved share, then the remaining space is filled
+// with the candidates of all the lanes, leaving free the reserved space the
+// lanes did not use. In both cases the candidate paying the highest fee per gas
+// among the next candidate of each sender is included first, and a sender whose
+// next candidate does not fit is skipped.
+func (ts *feeMarketTxSelector[T]) selectTxs() []T {
+	queues := make(map[string][]*txCandidate[T])
+	var senders []string
+	for _, c := range ts.candidates {
+		if _, ok := queues[c.sender]; !ok {
+			senders = append(senders, c.sender)
+		}
+		queues[c.sender] = append(queues[c.sender], c)
+	}
+
+	var (
+		selected             []T
+		totalBytes, totalGas uint64
+		laneBytes            = make([]uint64, len(ts.lanes))
+		laneGas              = make([]uint64, len(ts.lanes))
+	)
+	// fits reports whether c fits in the block, and in the reserved share of
+	// its lane when filling the lane, without using the reserved space left
+	// free by the other lanes.
+	fits := func(c *txCandidate[T], lane int) bool {
+		if totalBytes+c.size > ts.maxTxBytes || (ts.maxBlockGas > 0 && totalGas+c.gas > ts.maxBlockGas) {
+			return false
+		}
+		if lane >= 0 && (laneBytes[lane]+c.size > sdkmempool.LaneLimit(ts.maxTxBytes, ts.lanes[lane].ReservedShare) ||
+			(ts.maxBlockGas > 0 && laneGas[lane]+c.gas > sdkmempool.LaneLimit(ts.maxBlockGas, ts.lanes[lane].ReservedShare))) {
+			return false
+		}
+
+		var unusedBytes, unusedGas uint64
+		for i, l := range ts.lanes {
+			usedBytes, usedGas := laneBytes[i], laneGas[i]
+			if i == c.lane {
+				usedBytes, usedGas = usedBytes+c.size, usedGas+c.gas
+			}
+			unusedBytes += unusedReservation(ts.maxTxBytes, l.ReservedShare, usedBytes)
+			unusedGas += unusedReservation(ts.maxBlockGas, l.ReservedShare, usedGas)
+		}
+		return unusedBytes <= ts.maxTxBytes-totalBytes-c.size &&
+			(ts.maxBlockGas == 0 || unusedGas <= ts.maxBlockGas-totalGas-c.gas)
+	}
+	fill := func(lane int) {
+		h := &candidateHeap[T]{}
+		for _, sender := range senders {
+			if q := queues[sender]; len(q) > 0 && (lane < 0 || q[0].lane == lane) {
+				h.items = append(h.items, q[0])
+			}
+		}
+		heap.Init(h)
+
+		for h.Len() > 0 {
+			c := heap.Pop(h).(*txCandidate[T])
+			if !fits(c, lane) {
+				if lane < 0 {
+					// the next txs of the sender cannot be included without this one
+					queues[c.sender] = nil
+				}
+				continue
+			}
+
+			selected = append(selected, c.tx)
+			totalBytes, totalGas = totalBytes+c.size, totalGas+c.gas
+			if c.lane >= 0 {
+				laneBytes[c.lane], laneGas[c.lane] = laneBytes[c.lane]+c.size, laneGas[c.lane]+c.gas
+			}
+
+			q := queues[c.sender][1:]
+			queues[c.sender] = q
+			if len(q) > 0 && (lane < 0 || q[0].lane == lane) {
+				heap.Push(h, q[0])
+			}
+		}
+	}
+
+	for i, lane := range ts.lanes {
+		if lane.ReservedShare > 0 {
+			fill(i)
+		}
+	}
+	fill(-1)
+
+	return selected
+}
+
+// unusedReservation returns the part of the reserved share of a limit which is
+// not used.
+func unusedReservation(limit, share, used uint64) uint64 {
+	reserved := sdkmempool.LaneLimit(limit, share)
+	if used >= reserved {
+		return 0
+	}
+	return reserved - used
+}
+
+// candidateHeap is a max heap of candidates by fee per gas, the first offered
+// candidate first on ties.
+type candidateHeap[T transaction.Tx] struct {
+	items []*txCandidate[T]
+}
+
+func (h *candidateHeap[T]) Len() int { return len(h.items) }
+
+func (h *candidateHeap[T]) Less(i, j int) bool {
+	if h.items[i].feePerGas != h.items[j].feePerGas {
+		return h.items[i].feePerGas > h.items[j].feePerGas
+	}
+	return h.items[i].seq < h.items[j].seq
+}
+
+func (h *candidateHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *candidateHeap[T]) Push(x any) { h.items = append(h.items, x.(*txCandidate[T])) }
+
+func (h *candidateHeap[T]) Pop() any {
+	n := len(h.items)
+	x := h.items[n-1]
+	h.items = h.items[:n-1]
+	return x
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+)
+
+type feeTx struct {
+	name      string
+	sender    string
+	msg       transaction.Msg
+	gas       uint64
+	feePerGas int64
+}
+
+func (tx feeTx) Hash() [32]byte {
+	return sha256.Sum256(tx.Bytes())
+}
+
+func (tx feeTx) GetMessages() ([]transaction.Msg, error) {
+	return []transaction.Msg{tx.msg}, nil
+}
+
+func (tx feeTx) GetSenders() ([]transaction.Identity, error) {
+	return []transaction.Identity{[]byte(tx.sender)}, nil
+}
+
+func (tx feeTx) GetGasLimit() (uint64, error) {
+	return tx.gas, nil
+}
+
+func (tx feeTx) Bytes() []byte {
+	return []byte(fmt.Sprintf("%s/%s", tx.name, tx.sender))
+}
+
+func TestFeeMarketTxSelector(t *testing.T) {
+	ctx := context.Background()
+	txs := []feeTx{
+		{name: "a0", sender: "a", msg: &gogotypes.BoolValue{}, gas: 300, feePerGas: 1},
+		{name: "a1", sender: "a", msg: &gogotypes.BoolValue{}, gas: 300, feePerGas: 100},
+		{name: "b0", sender: "b", msg: &gogotypes.BoolValue{}, gas: 400, feePerGas: 50},
+		{name: "o0", sender: "o", msg: &gogotypes.StringValue{}, gas: 300, feePerGas: 1},
+		{name: "c0", sender: "c", msg: &gogotypes.BoolValue{}, gas: 2000, feePerGas: 1000},
+	}
+
+	selectTxs := func(cfg FeeMarketConfig) []string {
+		t.Helper()
+		ts, err := NewFeeMarketTxSelectorWithFeePerGas(cfg, func(_ context.Context, tx feeTx) int64 { return tx.feePerGas })
+		require.NoError(t, err)
+
+		for _, tx := range txs {
+			if ts.SelectTxForProposal(ctx, 1_000_000, 1000, tx) {
+				break
+			}
+		}
+
+		var names []string
+		for _, tx := range ts.SelectedTxs(ctx) {
+			names = append(names, tx.name)
+		}
+		ts.Clear()
+		require.Empty(t, ts.SelectedTxs(ctx))
+		return names
+	}
+
+	// c0 exceeds the block gas, a1 pays more than b0 but is included after a0
+	cfg := DefaultFeeMarketConfig()
+	require.Equal(t, []string{"b0", "a0", "a1"}, selectTxs(cfg))
+
+	// 30% of the block is reserved to the oracle txs
+	cfg.Lanes = []LaneQuota{{Name: "oracle", MsgTypes: []string{"/google.protobuf.StringValue"}, ReservedShare: 30}}
+	require.Equal(t, []string{"o0", "b0", "a0"}, selectTxs(cfg))
+
+	// the reserved share is too small for the oracle tx, which competes with the
+	// others, and the unused reserved space is left free for it
+	cfg.Lanes[0].ReservedShare = 20
+	require.Equal(t, []string{"b0", "a0", "o0"}, selectTxs(cfg))
+
+	// the selection stops after max candidates
+	cfg.MaxCandidates = 2
+	require.Equal(t, []string{"a0", "a1"}, selectTxs(cfg))
+
+	// without oracle tx, the reserved space is left empty
+	txs = txs[:3]
+	cfg.MaxCandidates = 0
+	require.Equal(t, []string{"b0", "a0"}, selectTxs(cfg))
+}
+
+func TestFeeMarketConfigValidate(t *testing.T) {
+	cfg := DefaultFeeMarketConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Lanes = []LaneQuota{
+		{Name: "oracle", MsgTypes: []string{"/oracle.v1.MsgVote"}, ReservedShare: 60},
+		{Name: "ibc", MsgTypes: []string{"/ibc.core.client.v1.MsgUpdateClient"}, ReservedShare: 40},
+	}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Lanes[1].ReservedShare = 41
+	require.ErrorContains(t, cfg.Validate(), "more than 100%")
+
+	cfg.Lanes[1] = LaneQuota{Name: "oracle", MsgTypes: []string{"/ibc.core.client.v1.MsgUpdateClient"}}
+	require.ErrorContains(t, cfg.Validate(), "duplicate lane")
+
+	cfg.Lanes[1] = LaneQuota{Name: "ibc"}
+	require.ErrorContains(t, cfg.Validate(), "no message types")
+
+	_, err := NewFeeMarketTxSelector[feeTx](cfg)
+	require.Error(t, err)
+}
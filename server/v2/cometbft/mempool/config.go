@@ -1,16 +1,50 @@
 package mempool
 
+import (
+	"cosmossdk.io/core/transaction"
+)
+
 var DefaultMaxTx = -1
 
+// DefaultReplacementFeeBump is the default percentage by which the priority of a
+// transaction must exceed the one of the pooled transaction it replaces.
+const DefaultReplacementFeeBump = 10
+
 // Config defines the configurations for the SDK built-in app-side mempool implementations.
 type Config struct {
 	// MaxTxs defines the maximum number of transactions that can be in the mempool.
 	MaxTxs int `mapstructure:"max-txs" toml:"max-txs" comment:"max-txs defines the maximum number of transactions that can be in the mempool. A value of 0 indicates an unbounded mempool, a negative value disables the app-side mempool."`
+	// MaxTxsPerSender defines the maximum number of transactions of a sender that can be in the mempool.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender" toml:"max-txs-per-sender" comment:"max-txs-per-sender defines the maximum number of transactions of a sender that can be in the mempool. A value of 0 indicates no limit."`
+	// ReplacementFeeBump defines the percentage by which the fee per gas of a transaction
+	// must exceed the one of the pooled transaction with the same sender and nonce to replace it.
+	ReplacementFeeBump uint64 `mapstructure:"replacement-fee-bump" toml:"replacement-fee-bump" comment:"replacement-fee-bump defines the percentage by which the fee per gas of a transaction must exceed the one of the pooled transaction with the same sender and nonce to replace it."`
+	// RecheckBatchSize defines the number of transactions revalidated at once when the mempool
+	// is rechecked after a block is committed.
+	RecheckBatchSize int `mapstructure:"recheck-batch-size" toml:"recheck-batch-size" comment:"recheck-batch-size defines the number of transactions revalidated at once when the mempool is rechecked after a block is committed, to evict the transactions no longer valid. A value of 0 disables the recheck."`
 }
 
 // DefaultConfig returns a default configuration for the SDK built-in app-side mempool implementations.
 func DefaultConfig() Config {
 	return Config{
-		MaxTxs: DefaultMaxTx,
+		MaxTxs:             DefaultMaxTx,
+		MaxTxsPerSender:    0,
+		ReplacementFeeBump: DefaultReplacementFeeBump,
+		RecheckBatchSize:   DefaultRecheckBatchSize,
+	}
+}
+
+// New returns the SDK built-in app-side mempool for the given configuration, a
+// NoOpMempool if MaxTxs is negative, a PriorityNonceMempool ordering transactions
+// by fee per gas otherwise.
+func New[T transaction.Tx](cfg Config) Mempool[T] {
+	if cfg.MaxTxs < 0 {
+		return NoOpMempool[T]{}
 	}
+
+	mpCfg := DefaultPriorityNonceMempoolConfig[T]()
+	mpCfg.MaxTx = cfg.MaxTxs
+	mpCfg.MaxTxPerSender = cfg.MaxTxsPerSender
+	mpCfg.TxReplacement = NewFeeBumpTxReplacement[T](cfg.ReplacementFeeBump)
+	return NewPriorityNonceMempool(mpCfg)
 }
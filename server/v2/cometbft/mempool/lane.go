@@ -0,0 +1,50 @@
+package mempool
+
+import (
+	"cosmossdk.io/core/transaction"
+
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ Mempool[transaction.Tx] = (*LanedMempool[transaction.Tx])(nil)
+
+// ErrNoLane is returned when a tx matches none of the lanes of a LanedMempool.
+var ErrNoLane = sdkmempool.ErrNoLane
+
+// Lane is a partition of a LanedMempool holding the transactions of a kind, e.g.
+// governance votes or IBC relaying, which are given a dedicated blockspace.
+type Lane[T transaction.Tx] sdkmempool.GenericLane[T, Iterator[T]]
+
+// LanedMempool is a mempool partitioned in lanes, the GenericLanedMempool of
+// types/mempool over the mempools of this package. A transaction belongs to the
+// first lane matching it, and the transactions are selected lane by lane, in the
+// order of the lanes and then in the order of the mempool of their lane.
+//
+// The default proposal handlers enforce the lane ordering and the maximum block
+// share of the lanes when building and processing a proposal.
+type LanedMempool[T transaction.Tx] struct {
+	*sdkmempool.GenericLanedMempool[T, Iterator[T]]
+}
+
+// NewLanedMempool returns a LanedMempool with the given lanes, ordered from the
+// first lane to be included in a block to the last one.
+func NewLanedMempool[T transaction.Tx](lanes ...Lane[T]) (*LanedMempool[T], error) {
+	genericLanes := make([]sdkmempool.GenericLane[T, Iterator[T]], len(lanes))
+	for i, lane := range lanes {
+		genericLanes[i] = sdkmempool.GenericLane[T, Iterator[T]](lane)
+	}
+
+	mp, err := sdkmempool.NewGenericLanedMempool(genericLanes...)
+	if err != nil {
+		return nil, err
+	}
+	return &LanedMempool[T]{GenericLanedMempool: mp}, nil
+}
+
+// Remove removes a transaction from the mempool of its lane.
+func (mp *LanedMempool[T]) Remove(tx T) error {
+	if mp.LaneIndex(tx) < 0 {
+		return ErrTxNotFound
+	}
+	return mp.GenericLanedMempool.Remove(tx)
+}
@@ -0,0 +1,55 @@
+package mempool_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/server/v2/cometbft/mempool"
+)
+
+func TestLanedMempool(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := mempool.NewLanedMempool[testTx]()
+	require.Error(t, err)
+	_, err = mempool.NewLanedMempool(mempool.Lane[testTx]{Name: "gov", Mempool: newTestMempool(0, 0)})
+	require.ErrorContains(t, err, "max block share")
+
+	// the txs of the senders starting with "gov" are in the gov lane
+	mp, err := mempool.NewLanedMempool(
+		mempool.Lane[testTx]{
+			Name:          "gov",
+			Match:         func(tx testTx) bool { return strings.HasPrefix(tx.sender, "gov") },
+			Mempool:       newTestMempool(0, 0),
+			MaxBlockShare: 20,
+		},
+		mempool.Lane[testTx]{Name: "default", Mempool: newTestMempool(0, 0), MaxBlockShare: 100},
+	)
+	require.NoError(t, err)
+
+	for _, tx := range []testTx{
+		{sender: "a", nonce: 0, priority: 100},
+		{sender: "gov1", nonce: 0, priority: 1},
+		{sender: "b", nonce: 0, priority: 200},
+		{sender: "gov2", nonce: 0, priority: 2},
+	} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+
+	// the txs are selected by lane, then by priority
+	require.Equal(t, []string{"gov20", "gov10", "b0", "a0"}, selectAll(mp))
+	var selected []string
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		selected = append(selected, iter.Tx().sender)
+	}
+	require.Equal(t, []string{"gov2", "gov1", "b", "a"}, selected)
+
+	require.NoError(t, mp.Remove(testTx{sender: "gov2", nonce: 0, priority: 2}))
+	require.ErrorIs(t, mp.Remove(testTx{sender: "gov2", nonce: 0, priority: 2}), mempool.ErrTxNotFound)
+	require.Equal(t, []string{"gov10", "b0", "a0"}, selectAll(mp))
+}
@@ -0,0 +1,336 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/core/transaction"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ErrSenderTxMaxCapacity   = errors.New("sender reached max tx capacity")
+	ErrTxReplacementRejected = errors.New("tx does not fit the replacement rule")
+)
+
+var _ Mempool[transaction.Tx] = (*PriorityNonceMempool[transaction.Tx, int64])(nil)
+
+// PriorityNonceMempoolConfig defines the configuration of a PriorityNonceMempool.
+type PriorityNonceMempoolConfig[T transaction.Tx, C any] struct {
+	// TxPriority defines the transaction priority and comparator.
+	TxPriority TxPriority[T, C]
+
+	// TxReplacement is called when a transaction with the same sender and nonce
+	// as a pooled one is inserted, the pooled transaction is replaced if it
+	// returns true. If nil, transactions are always replaced.
+	TxReplacement func(op, np C, oTx, nTx T) bool
+
+	// MaxTx sets the maximum number of transactions allowed in the mempool with
+	// the semantics:
+	// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
+	// - if MaxTx > 0, the mempool will cap the number of transactions it stores,
+	//   and will evict the transaction of lowest priority, last in the nonce order
+	//   of its sender, for a transaction of higher priority.
+	// - if MaxTx < 0, `Insert` is a no-op.
+	MaxTx int
+
+	// MaxTxPerSender sets the maximum number of transactions of a sender allowed
+	// in the mempool, there is no cap if it is not positive.
+	MaxTxPerSender int
+
+	// SignerExtractor extracts the signers of the transactions, the sender and
+	// nonce of a transaction are the ones of its first signer.
+	SignerExtractor SignerExtractor[T]
+}
+
+// DefaultPriorityNonceMempoolConfig returns the default configuration of a
+// PriorityNonceMempool, prioritizing transactions by fee per gas.
+func DefaultPriorityNonceMempoolConfig[T transaction.Tx]() PriorityNonceMempoolConfig[T, int64] {
+	return PriorityNonceMempoolConfig[T, int64]{
+		TxPriority:      NewDefaultTxPriority[T](),
+		TxReplacement:   NewFeeBumpTxReplacement[T](DefaultReplacementFeeBump),
+		SignerExtractor: DefaultSignerExtractor[T]{},
+	}
+}
+
+// NewFeeBumpTxReplacement returns a replacement rule allowing a transaction to
+// replace another one if its priority is higher by at least the given percentage.
+func NewFeeBumpTxReplacement[T transaction.Tx](bumpPercent uint64) func(op, np int64, oTx, nTx T) bool {
+	return func(op, np int64, _, _ T) bool {
+		if np <= op {
+			return false
+		}
+		// np * 100 >= op * (100 + bump)
+		lhs := new(big.Int).Mul(big.NewInt(np), big.NewInt(100))
+		rhs := new(big.Int).Mul(big.NewInt(op), new(big.Int).SetUint64(100+bumpPercent))
+		return lhs.Cmp(rhs) >= 0
+	}
+}
+
+// PriorityNonceMempool is a mempool implementation that orders transactions by
+// priority, while keeping the transactions of a sender in nonce (sequence) order.
+// Transactions are unique by sender and nonce, a transaction with the sender and
+// nonce of a pooled one replaces it under the TxReplacement rule.
+//
+// A transaction is selected once all the transactions of its sender with a lower
+// nonce are selected, the transactions of highest priority among the ones
+// selectable are selected first.
+type PriorityNonceMempool[T transaction.Tx, C any] struct {
+	mtx          sync.RWMutex
+	cfg          PriorityNonceMempoolConfig[T, C]
+	senders      map[string][]*poolTx[T, C] // ordered by nonce
+	senderCounts map[string]int
+	txs          map[[32]byte]*poolTx[T, C]
+	seq          uint64
+}
+
+// poolTx is a pooled transaction.
+type poolTx[T transaction.Tx, C any] struct {
+	tx       T
+	hash     [32]byte
+	sender   string // the signer, unique per tx for unordered txs
+	signer   string
+	nonce    uint64
+	priority C
+	seq      uint64 // arrival order, used as a tiebreaker
+}
+
+// NewPriorityNonceMempool returns a new PriorityNonceMempool.
+func NewPriorityNonceMempool[T transaction.Tx, C any](cfg PriorityNonceMempoolConfig[T, C]) *PriorityNonceMempool[T, C] {
+	if cfg.SignerExtractor == nil {
+		cfg.SignerExtractor = DefaultSignerExtractor[T]{}
+	}
+	return &PriorityNonceMempool[T, C]{
+		cfg:          cfg,
+		senders:      make(map[string][]*poolTx[T, C]),
+		senderCounts: make(map[string]int),
+		txs:          make(map[[32]byte]*poolTx[T, C]),
+	}
+}
+
+// DefaultPriorityNonceMempool returns a PriorityNonceMempool with the default
+// configuration.
+func DefaultPriorityNonceMempool[T transaction.Tx]() *PriorityNonceMempool[T, int64] {
+	return NewPriorityNonceMempool(DefaultPriorityNonceMempoolConfig[T]())
+}
+
+// Insert attempts to insert a Tx into the app-side mempool. Sender and nonce are
+// derived from the transaction's first signature.
+//
+// Inserting a tx already in the mempool is a no-op. Unordered txs are not ordered
+// by nonce, they neither replace nor are replaced by other txs.
+func (mp *PriorityNonceMempool[T, C]) Insert(ctx context.Context, tx T) error {
+	if mp.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	signers, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(signers) == 0 {
+		return errors.New("tx must have at least one signer")
+	}
+
+	ptx := &poolTx[T, C]{
+		tx:       tx,
+		hash:     tx.Hash(),
+		signer:   string(signers[0].Signer),
+		nonce:    signers[0].Sequence,
+		priority: mp.cfg.TxPriority.GetTxPriority(ctx, tx),
+	}
+	ptx.sender = ptx.signer
+	if unordered, ok := any(tx).(sdk.TxWithUnordered); ok && unordered.GetUnordered() {
+		ptx.sender = ptx.signer + "/" + hex.EncodeToString(ptx.hash[:])
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if _, ok := mp.txs[ptx.hash]; ok {
+		return nil
+	}
+
+	senderTxs := mp.senders[ptx.sender]
+	i := sort.Search(len(senderTxs), func(i int) bool { return senderTxs[i].nonce >= ptx.nonce })
+	if i < len(senderTxs) && senderTxs[i].nonce == ptx.nonce {
+		old := senderTxs[i]
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(old.priority, ptx.priority, old.tx, tx) {
+			return fmt.Errorf("%w: old priority %v, new priority %v", ErrTxReplacementRejected, old.priority, ptx.priority)
+		}
+		mp.seq++
+		ptx.seq = mp.seq
+		senderTxs[i] = ptx
+		delete(mp.txs, old.hash)
+		mp.txs[ptx.hash] = ptx
+		return nil
+	}
+
+	if mp.cfg.MaxTxPerSender > 0 && mp.senderCounts[ptx.signer] >= mp.cfg.MaxTxPerSender {
+		return ErrSenderTxMaxCapacity
+	}
+	if mp.cfg.MaxTx > 0 && len(mp.txs) >= mp.cfg.MaxTx {
+		if err := mp.evictFor(ptx); err != nil {
+			return err
+		}
+	}
+
+	mp.seq++
+	ptx.seq = mp.seq
+	senderTxs = append(senderTxs, nil)
+	copy(senderTxs[i+1:], senderTxs[i:])
+	senderTxs[i] = ptx
+	mp.senders[ptx.sender] = senderTxs
+	mp.senderCounts[ptx.signer]++
+	mp.txs[ptx.hash] = ptx
+
+	return nil
+}
+
+// evictFor evicts the tx of lowest priority, among the last txs in the nonce order
+// of the other senders, if its priority is lower than the one of the given tx.
+func (mp *PriorityNonceMempool[T, C]) evictFor(ptx *poolTx[T, C]) error {
+	var lowest *poolTx[T, C]
+	for sender, senderTxs := range mp.senders {
+		if sender == ptx.sender || len(senderTxs) == 0 {
+			continue
+		}
+		last := senderTxs[len(senderTxs)-1]
+		if lowest == nil || mp.less(last, lowest) {
+			lowest = last
+		}
+	}
+	if lowest == nil || mp.cfg.TxPriority.Compare(lowest.priority, ptx.priority) >= 0 {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	mp.remove(lowest)
+	return nil
+}
+
+// less reports whether a should be selected after b, i.e. a has a lower
+// priority or arrived later.
+func (mp *PriorityNonceMempool[T, C]) less(a, b *poolTx[T, C]) bool {
+	if c := mp.cfg.TxPriority.Compare(a.priority, b.priority); c != 0 {
+		return c < 0
+	}
+	return a.seq > b.seq
+}
+
+// Remove removes a transaction from the mempool, it returns ErrTxNotFound if the
+// transaction is not in the mempool.
+func (mp *PriorityNonceMempool[T, C]) Remove(tx T) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	ptx, ok := mp.txs[tx.Hash()]
+	if !ok {
+		return ErrTxNotFound
+	}
+	mp.remove(ptx)
+	return nil
+}
+
+func (mp *PriorityNonceMempool[T, C]) remove(ptx *poolTx[T, C]) {
+	senderTxs := mp.senders[ptx.sender]
+	for i, stx := range senderTxs {
+		if stx == ptx {
+			senderTxs = append(senderTxs[:i], senderTxs[i+1:]...)
+			break
+		}
+	}
+	if len(senderTxs) == 0 {
+		delete(mp.senders, ptx.sender)
+	} else {
+		mp.senders[ptx.sender] = senderTxs
+	}
+
+	if mp.senderCounts[ptx.signer]--; mp.senderCounts[ptx.signer] <= 0 {
+		delete(mp.senderCounts, ptx.signer)
+	}
+	delete(mp.txs, ptx.hash)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *PriorityNonceMempool[T, C]) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	return len(mp.txs)
+}
+
+// Select returns an iterator over a snapshot of the mempool, in priority and
+// nonce order, or nil if the mempool is empty. The given txs are ignored.
+func (mp *PriorityNonceMempool[T, C]) Select(_ context.Context, _ []T) Iterator[T] {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	iter := &priorityNonceIterator[T, C]{
+		less:    mp.less,
+		senders: make(map[string][]*poolTx[T, C], len(mp.senders)),
+	}
+	for sender, senderTxs := range mp.senders {
+		// the slices are copied as the mempool updates them in place
+		iter.senders[sender] = slices.Clone(senderTxs[1:])
+		iter.heads = append(iter.heads, senderTxs[0])
+	}
+	heap.Init(iter)
+
+	return iter.next()
+}
+
+// SelectBy calls the callback on the transactions of the mempool, in priority and
+// nonce order, until it returns false. The callback may modify the mempool.
+func (mp *PriorityNonceMempool[T, C]) SelectBy(ctx context.Context, txs []T, callback func(T) bool) {
+	for iter := mp.Select(ctx, txs); iter != nil && callback(iter.Tx()); iter = iter.Next() {
+	}
+}
+
+// priorityNonceIterator iterates over a snapshot of a PriorityNonceMempool. The
+// heads of the senders are kept in a max heap of priority.
+type priorityNonceIterator[T transaction.Tx, C any] struct {
+	less    func(a, b *poolTx[T, C]) bool
+	heads   []*poolTx[T, C]
+	senders map[string][]*poolTx[T, C]
+	current *poolTx[T, C]
+}
+
+func (it *priorityNonceIterator[T, C]) next() Iterator[T] {
+	if len(it.heads) == 0 {
+		return nil
+	}
+	it.current = heap.Pop(it).(*poolTx[T, C])
+	if rest := it.senders[it.current.sender]; len(rest) > 0 {
+		heap.Push(it, rest[0])
+		it.senders[it.current.sender] = rest[1:]
+	}
+	return it
+}
+
+// Next implements Iterator.
+func (it *priorityNonceIterator[T, C]) Next() Iterator[T] { return it.next() }
+
+// Tx implements Iterator.
+func (it *priorityNonceIterator[T, C]) Tx() T { return it.current.tx }
+
+// heap.Interface implementation, the heap is a max heap of priority.
+func (it *priorityNonceIterator[T, C]) Len() int           { return len(it.heads) }
+func (it *priorityNonceIterator[T, C]) Less(i, j int) bool { return it.less(it.heads[j], it.heads[i]) }
+func (it *priorityNonceIterator[T, C]) Swap(i, j int) {
+	it.heads[i], it.heads[j] = it.heads[j], it.heads[i]
+}
+func (it *priorityNonceIterator[T, C]) Push(x any) { it.heads = append(it.heads, x.(*poolTx[T, C])) }
+
+func (it *priorityNonceIterator[T, C]) Pop() any {
+	n := len(it.heads)
+	x := it.heads[n-1]
+	it.heads = it.heads[:n-1]
+	return x
+}
@@ -0,0 +1,150 @@
+package mempool_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+)
+
+type testTx struct {
+	sender   string
+	nonce    uint64
+	priority int64
+	id       string
+}
+
+func (tx testTx) Hash() [32]byte {
+	return sha256.Sum256(tx.Bytes())
+}
+
+func (tx testTx) GetMessages() ([]transaction.Msg, error)      { return nil, nil }
+func (tx testTx) GetSenders() ([]transaction.Identity, error) { return nil, nil }
+func (tx testTx) GetGasLimit() (uint64, error)                 { return 0, nil }
+
+func (tx testTx) Bytes() []byte {
+	return []byte(fmt.Sprintf("%s/%d/%d/%s", tx.sender, tx.nonce, tx.priority, tx.id))
+}
+
+type testSignerExtractor struct{}
+
+func (testSignerExtractor) GetSigners(tx testTx) ([]mempool.SignerData, error) {
+	return []mempool.SignerData{{Signer: []byte(tx.sender), Sequence: tx.nonce}}, nil
+}
+
+func newTestMempool(maxTx, maxTxPerSender int) *mempool.PriorityNonceMempool[testTx, int64] {
+	cfg := mempool.DefaultPriorityNonceMempoolConfig[testTx]()
+	cfg.TxPriority.GetTxPriority = func(_ context.Context, tx testTx) int64 { return tx.priority }
+	cfg.SignerExtractor = testSignerExtractor{}
+	cfg.MaxTx = maxTx
+	cfg.MaxTxPerSender = maxTxPerSender
+	return mempool.NewPriorityNonceMempool(cfg)
+}
+
+func selectAll(mp mempool.Mempool[testTx]) []string {
+	var txs []string
+	mp.SelectBy(context.Background(), nil, func(tx testTx) bool {
+		txs = append(txs, fmt.Sprintf("%s%d", tx.sender, tx.nonce))
+		return true
+	})
+	return txs
+}
+
+func TestPriorityNonceMempool_Select(t *testing.T) {
+	mp := newTestMempool(0, 0)
+	ctx := context.Background()
+
+	for _, tx := range []testTx{
+		{sender: "a", nonce: 1, priority: 20},
+		{sender: "a", nonce: 0, priority: 5},
+		{sender: "a", nonce: 2, priority: 1},
+		{sender: "b", nonce: 0, priority: 10},
+		{sender: "b", nonce: 1, priority: 30},
+		{sender: "c", nonce: 0, priority: 10},
+	} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, 6, mp.CountTx())
+
+	// the txs of a sender are in nonce order, b0 and c0 are tied and ordered by arrival
+	require.Equal(t, []string{"b0", "b1", "c0", "a0", "a1", "a2"}, selectAll(mp))
+
+	// inserting the same tx is a no-op
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "c", nonce: 0, priority: 10}))
+	require.Equal(t, 6, mp.CountTx())
+
+	// the iterator is not affected by the removals
+	iter := mp.Select(ctx, nil)
+	require.NoError(t, mp.Remove(testTx{sender: "b", nonce: 0, priority: 10}))
+	require.ErrorIs(t, mp.Remove(testTx{sender: "b", nonce: 0, priority: 10}), mempool.ErrTxNotFound)
+	var count int
+	for ; iter != nil; iter = iter.Next() {
+		count++
+	}
+	require.Equal(t, 6, count)
+	require.Equal(t, []string{"b1", "c0", "a0", "a1", "a2"}, selectAll(mp))
+
+	require.Nil(t, newTestMempool(0, 0).Select(ctx, nil))
+}
+
+func TestPriorityNonceMempool_Replacement(t *testing.T) {
+	mp := newTestMempool(0, 0)
+	ctx := context.Background()
+
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 100}))
+	// the priority must be bumped by 10%
+	err := mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 109})
+	require.ErrorIs(t, err, mempool.ErrTxReplacementRejected)
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 110, id: "replacement"}))
+	require.Equal(t, 1, mp.CountTx())
+
+	mp.SelectBy(ctx, nil, func(tx testTx) bool {
+		require.Equal(t, "replacement", tx.id)
+		return true
+	})
+	require.ErrorIs(t, mp.Remove(testTx{sender: "a", nonce: 0, priority: 100}), mempool.ErrTxNotFound)
+	require.NoError(t, mp.Remove(testTx{sender: "a", nonce: 0, priority: 110, id: "replacement"}))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_Limits(t *testing.T) {
+	mp := newTestMempool(4, 2)
+	ctx := context.Background()
+
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 10}))
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "a", nonce: 1, priority: 1}))
+	require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "a", nonce: 2, priority: 100}), mempool.ErrSenderTxMaxCapacity)
+
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "b", nonce: 0, priority: 5}))
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "c", nonce: 0, priority: 5}))
+	require.Equal(t, 4, mp.CountTx())
+
+	// the mempool is full, a tx of lower or equal priority than the evictable ones is rejected
+	require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "d", nonce: 0, priority: 1}), mempool.ErrMempoolTxMaxCapacity)
+
+	// a1 is the last tx of a and has the lowest priority, it is evicted
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "d", nonce: 0, priority: 6}))
+	require.Equal(t, []string{"a0", "d0", "b0", "c0"}, selectAll(mp))
+
+	// b0 and c0 are tied as lowest evictable txs, c0 which arrived later is evicted
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "e", nonce: 0, priority: 7}))
+	require.Equal(t, []string{"a0", "e0", "d0", "b0"}, selectAll(mp))
+
+	// a disabled mempool ignores the txs
+	disabled := newTestMempool(-1, 0)
+	require.NoError(t, disabled.Insert(ctx, testTx{sender: "a", nonce: 0, priority: 10}))
+	require.Equal(t, 0, disabled.CountTx())
+}
+
+func TestNew(t *testing.T) {
+	cfg := mempool.DefaultConfig()
+	require.IsType(t, mempool.NoOpMempool[testTx]{}, mempool.New[testTx](cfg))
+
+	cfg.MaxTxs = 0
+	require.IsType(t, &mempool.PriorityNonceMempool[testTx, int64]{}, mempool.New[testTx](cfg))
+}
@@ -0,0 +1,201 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/core/log"
+	"cosmossdk.io/core/server"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+// DefaultRecheckBatchSize is the default number of transactions revalidated in a
+// batch by the Rechecker.
+const DefaultRecheckBatchSize = 1000
+
+// TxValidator validates transactions in order, each transaction on top of the
+// validation changes of the previous ones, e.g. the sequence increments of its
+// sender, as the AppManager does.
+type TxValidator[T transaction.Tx] interface {
+	// ValidateTxs validates the transactions against the latest committed state and
+	// returns the state to validate the next transactions on.
+	ValidateTxs(ctx context.Context, txs []T) ([]server.TxResult, corestore.WriterMap, error)
+	// ValidateTxsWithState validates the transactions against the provided state
+	// and returns the state to validate the next transactions on.
+	ValidateTxsWithState(ctx context.Context, state corestore.ReaderMap, txs []T) ([]server.TxResult, corestore.WriterMap)
+}
+
+// RecheckResult contains the outcome of a recheck of the mempool.
+type RecheckResult struct {
+	// Checked is the number of transactions revalidated.
+	Checked int
+	// Evicted is the number of invalid transactions removed from the mempool.
+	Evicted int
+}
+
+// Rechecker revalidates the transactions of a mempool after a block is committed
+// and evicts the ones which are no longer valid against the new state, e.g. a
+// sequence already used or an insufficient balance to pay the fees.
+// Transactions are revalidated in the mempool order, which preserves the nonce
+// order of the transactions of a sender, each one on top of the previous ones so
+// that the pending transactions after the next nonce of a sender remain valid.
+// They are revalidated in batches of bounded size, a recheck is aborted between
+// two batches when a new one is started, as its state is outdated.
+type Rechecker[T transaction.Tx] struct {
+	logger    log.Logger
+	mempool   Mempool[T]
+	validator TxValidator[T]
+	batchSize int
+
+	mtx    sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewRechecker returns a Rechecker revalidating the transactions of the given
+// mempool in batches of batchSize transactions, DefaultRecheckBatchSize if it is
+// not positive.
+func NewRechecker[T transaction.Tx](logger log.Logger, mp Mempool[T], validator TxValidator[T], batchSize int) *Rechecker[T] {
+	if batchSize <= 0 {
+		batchSize = DefaultRecheckBatchSize
+	}
+
+	return &Rechecker[T]{
+		logger:    logger,
+		mempool:   mp,
+		validator: validator,
+		batchSize: batchSize,
+	}
+}
+
+// Start starts a recheck of the mempool in the background, aborting the ongoing
+// one if any.
+func (r *Rechecker[T]) Start(height int64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	r.cancel, r.done = cancel, done
+
+	go func() {
+		defer close(done)
+
+		res, err := r.Recheck(ctx)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				r.logger.Error("failed to recheck mempool", "height", height, "err", err)
+			}
+			return
+		}
+		if res.Evicted > 0 {
+			r.logger.Debug("evicted invalid txs from mempool", "height", height, "checked", res.Checked, "evicted", res.Evicted)
+		}
+	}()
+}
+
+// Stop aborts the ongoing recheck if any and waits for it to return.
+func (r *Rechecker[T]) Stop() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.stop()
+}
+
+// Wait waits for the ongoing recheck if any to complete.
+func (r *Rechecker[T]) Wait() {
+	r.mtx.Lock()
+	done := r.done
+	r.mtx.Unlock()
+
+	if done != nil {
+		<-done
+	}
+}
+
+func (r *Rechecker[T]) stop() {
+	if r.cancel == nil {
+		return
+	}
+
+	r.cancel()
+	<-r.done
+	r.cancel, r.done = nil, nil
+}
+
+// Recheck revalidates the transactions of the mempool and removes the invalid
+// ones. The transactions inserted during the recheck are not revalidated.
+func (r *Rechecker[T]) Recheck(ctx context.Context) (RecheckResult, error) {
+	defer metrics.MeasureSince([]string{"server", "mempool", "recheck"}, time.Now())
+
+	txs := make([]T, 0, r.mempool.CountTx())
+	r.mempool.SelectBy(ctx, nil, func(tx T) bool {
+		txs = append(txs, tx)
+		return true
+	})
+
+	var (
+		res   RecheckResult
+		state corestore.ReaderMap
+	)
+	for len(txs) > 0 {
+		batch := txs[:min(r.batchSize, len(txs))]
+		var err error
+		if state, err = r.recheckBatch(ctx, state, batch, &res); err != nil {
+			return res, err
+		}
+		txs = txs[len(batch):]
+	}
+
+	metrics.SetGauge([]string{"server", "mempool", "size"}, float32(r.mempool.CountTx()))
+	return res, nil
+}
+
+// recheckBatch revalidates a batch of transactions on top of the given state, the
+// latest committed state if nil, and returns the state to revalidate the next batch on.
+func (r *Rechecker[T]) recheckBatch(ctx context.Context, state corestore.ReaderMap, batch []T, res *RecheckResult) (corestore.ReaderMap, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var (
+		results []server.TxResult
+		next    corestore.WriterMap
+	)
+	if state == nil {
+		var err error
+		if results, next, err = r.validator.ValidateTxs(ctx, batch); err != nil {
+			return nil, err
+		}
+	} else {
+		results, next = r.validator.ValidateTxsWithState(ctx, state, batch)
+	}
+
+	var evicted int
+	for i, tx := range batch {
+		if results[i].Error == nil {
+			continue
+		}
+
+		if err := r.mempool.Remove(tx); err != nil {
+			if errors.Is(err, ErrTxNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		evicted++
+	}
+
+	res.Checked += len(batch)
+	res.Evicted += evicted
+	metrics.IncrCounter([]string{"server", "mempool", "recheck", "checked"}, float32(len(batch)))
+	metrics.IncrCounter([]string{"server", "mempool", "recheck", "evicted"}, float32(evicted))
+	return next, nil
+}
@@ -0,0 +1,97 @@
+package mempool_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/server"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+)
+
+// sequenceState is the state of a sequenceValidator, the next sequence of each sender.
+type sequenceState struct {
+	corestore.WriterMap
+	sequences map[string]uint64
+}
+
+// sequenceValidator is a TxValidator requiring the nonce of a tx to be exactly the
+// sequence of its sender, as x/auth does, and incrementing it on success.
+type sequenceValidator struct {
+	committed map[string]uint64
+	validated int
+	err       error
+}
+
+func (v *sequenceValidator) ValidateTxs(ctx context.Context, txs []testTx) ([]server.TxResult, corestore.WriterMap, error) {
+	if v.err != nil {
+		return nil, nil, v.err
+	}
+	results, state := v.ValidateTxsWithState(ctx, sequenceState{sequences: maps.Clone(v.committed)}, txs)
+	return results, state, nil
+}
+
+func (v *sequenceValidator) ValidateTxsWithState(_ context.Context, state corestore.ReaderMap, txs []testTx) ([]server.TxResult, corestore.WriterMap) {
+	s := state.(sequenceState)
+	results := make([]server.TxResult, len(txs))
+	for i, tx := range txs {
+		v.validated++
+		if seq := s.sequences[tx.sender]; tx.nonce != seq {
+			results[i].Error = fmt.Errorf("account sequence mismatch, expected %d, got %d", seq, tx.nonce)
+			continue
+		}
+		s.sequences[tx.sender]++
+	}
+	return results, s
+}
+
+func TestRechecker(t *testing.T) {
+	mp := newTestMempool(0, 0)
+	ctx := context.Background()
+
+	for _, tx := range []testTx{
+		{sender: "a", nonce: 0, priority: 10},
+		{sender: "a", nonce: 1, priority: 10},
+		{sender: "a", nonce: 2, priority: 10},
+		{sender: "b", nonce: 0, priority: 20},
+		{sender: "c", nonce: 3, priority: 5},
+		{sender: "d", nonce: 0, priority: 1},
+	} {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	// the committed state has used the nonce 0 of a and 3 of c, the pending txs
+	// of a after its next nonce remain valid, including across batches
+	validator := &sequenceValidator{committed: map[string]uint64{"a": 1, "c": 4}}
+	rechecker := mempool.NewRechecker(log.NewNopLogger(), mp, validator, 2)
+	res, err := rechecker.Recheck(ctx)
+	require.NoError(t, err)
+	require.Equal(t, mempool.RecheckResult{Checked: 6, Evicted: 2}, res)
+	require.Equal(t, 6, validator.validated)
+	require.Equal(t, []string{"b0", "a1", "a2", "d0"}, selectAll(mp))
+
+	// a recheck in the background
+	validator.committed["b"] = 1
+	rechecker.Start(2)
+	rechecker.Wait()
+	require.Equal(t, []string{"a1", "a2", "d0"}, selectAll(mp))
+
+	// an error not caused by the txs aborts the recheck without evicting them
+	failing := mempool.NewRechecker(log.NewNopLogger(), mp, &sequenceValidator{err: errors.New("state not found")}, 2)
+	_, err = failing.Recheck(ctx)
+	require.ErrorContains(t, err, "state not found")
+	require.Equal(t, 3, mp.CountTx())
+
+	// a canceled recheck stops between two batches
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = rechecker.Recheck(canceledCtx)
+	require.ErrorIs(t, err, context.Canceled)
+	rechecker.Stop()
+}
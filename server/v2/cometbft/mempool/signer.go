@@ -0,0 +1,101 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/core/transaction"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// SignerData contains the signer of a transaction and its sequence.
+type SignerData struct {
+	Signer   []byte
+	Sequence uint64
+}
+
+// SignerExtractor extracts the signers of a transaction.
+type SignerExtractor[T transaction.Tx] interface {
+	GetSigners(T) ([]SignerData, error)
+}
+
+var _ SignerExtractor[transaction.Tx] = DefaultSignerExtractor[transaction.Tx]{}
+
+// DefaultSignerExtractor extracts the signers from the signatures of a cosmos-sdk
+// tx, as the DefaultSignerExtractionAdapter of types/mempool.
+type DefaultSignerExtractor[T transaction.Tx] struct{}
+
+// GetSigners implements SignerExtractor.
+func (DefaultSignerExtractor[T]) GetSigners(tx T) ([]SignerData, error) {
+	sdkTx, ok := any(tx).(sdk.Tx)
+	if !ok {
+		return nil, fmt.Errorf("tx of type %T does not implement sdk.Tx", tx)
+	}
+
+	signers, err := sdkmempool.NewDefaultSignerExtractionAdapter().GetSigners(sdkTx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]SignerData, len(signers))
+	for i, s := range signers {
+		data[i] = SignerData{Signer: s.Signer, Sequence: s.Sequence}
+	}
+	return data, nil
+}
+
+// TxPriority defines a type that is used to retrieve and compare transaction
+// priorities.
+type TxPriority[T transaction.Tx, C any] struct {
+	// GetTxPriority returns the priority of the transaction.
+	GetTxPriority func(ctx context.Context, tx T) C
+
+	// Compare compares two transaction priorities. The result must be 0 if
+	// a == b, -1 if a < b, and +1 if a > b.
+	Compare func(a, b C) int
+}
+
+// NewDefaultTxPriority returns a TxPriority using the fee per gas of the
+// transaction as priority, the lowest of its fee denominations. Transactions not
+// implementing sdk.FeeTx have a zero priority.
+func NewDefaultTxPriority[T transaction.Tx]() TxPriority[T, int64] {
+	return TxPriority[T, int64]{
+		GetTxPriority: func(_ context.Context, tx T) int64 {
+			feeTx, ok := any(tx).(sdk.FeeTx)
+			if !ok || feeTx.GetGas() == 0 {
+				return 0
+			}
+			return feePerGas(feeTx.GetFee(), feeTx.GetGas())
+		},
+		Compare: func(a, b int64) int {
+			switch {
+			case a < b:
+				return -1
+			case a > b:
+				return 1
+			default:
+				return 0
+			}
+		},
+	}
+}
+
+// feePerGas returns the amount of the smallest denomination of the gas price of
+// a fee, as the priority computed by the x/auth fee checker.
+func feePerGas(fee sdk.Coins, gas uint64) int64 {
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(int64(min(gas, math.MaxInt64)))
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+	return priority
+}
@@ -1,6 +1,8 @@
 package cometbft
 
 import (
+	"fmt"
+
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	cmted22519 "github.com/cometbft/cometbft/crypto/ed25519"
 
@@ -8,6 +10,7 @@ import (
 	"cosmossdk.io/server/v2/cometbft/handlers"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 	"cosmossdk.io/server/v2/cometbft/types"
+	"cosmossdk.io/server/v2/streaming"
 	"cosmossdk.io/store/v2/snapshots"
 )
 
@@ -15,6 +18,9 @@ type keyGenF = func() (cmtcrypto.PrivKey, error)
 
 // ServerOptions defines the options for the CometBFT server.
 // When an option takes a map[string]any, it can access the app.tom's cometbft section and the config.toml config.
+// When the PrepareProposalHandler and ProcessProposalHandler are nil, the default handlers of the mempool are used,
+// i.e. no-op handlers for a NoOpMempool and the handlers selecting txs from the mempool otherwise, with the fee market
+// TxSelector if it is enabled in app.toml.
 type ServerOptions[T transaction.Tx] struct {
 	PrepareProposalHandler     handlers.PrepareHandler[T]
 	ProcessProposalHandler     handlers.ProcessHandler[T]
@@ -28,21 +34,44 @@ type ServerOptions[T transaction.Tx] struct {
 
 	AddrPeerFilter types.PeerFilter // filter peers by address and port
 	IdPeerFilter   types.PeerFilter // filter peers by node ID
+
+	// StreamingListeners are notified of the finalized blocks and their state changes,
+	// e.g. streaming.Subscriptions.
+	StreamingListeners []streaming.Listener
 }
 
 // DefaultServerOptions returns the default server options.
-// It defaults to a NoOpMempool and NoOp handlers.
+// It defaults to the mempool configured in app.toml, a NoOpMempool unless mempool.max-txs is set
+// to a non-negative value, and to the proposal handlers of the mempool.
 func DefaultServerOptions[T transaction.Tx]() ServerOptions[T] {
 	return ServerOptions[T]{
-		PrepareProposalHandler:     handlers.NoOpPrepareProposal[T](),
-		ProcessProposalHandler:     handlers.NoOpProcessProposal[T](),
+		PrepareProposalHandler:     nil,
+		ProcessProposalHandler:     nil,
 		CheckTxHandler:             nil,
 		VerifyVoteExtensionHandler: handlers.NoOpVerifyVoteExtensionHandler(),
 		ExtendVoteHandler:          handlers.NoOpExtendVote(),
-		Mempool:                    func(cfg map[string]any) mempool.Mempool[T] { return mempool.NoOpMempool[T]{} },
+		Mempool:                    func(cfg map[string]any) mempool.Mempool[T] { return mempool.New[T](mempoolConfig(cfg)) },
 		SnapshotOptions:            func(cfg map[string]any) snapshots.SnapshotOptions { return snapshots.NewSnapshotOptions(0, 0) },
 		AddrPeerFilter:             nil,
 		IdPeerFilter:               nil,
+		StreamingListeners:         nil,
 		KeygenF:                    func() (cmtcrypto.PrivKey, error) { return cmted22519.GenPrivKey(), nil },
 	}
 }
+
+// defaultProposalHandlers returns the default proposal handlers of a mempool,
+// selecting the proposal txs with the fee market TxSelector when it is enabled.
+func defaultProposalHandlers[T transaction.Tx](mp mempool.Mempool[T], feeMarket handlers.FeeMarketConfig) (handlers.PrepareHandler[T], handlers.ProcessHandler[T], error) {
+	proposalHandler := handlers.NewDefaultProposalHandler(mp)
+	if feeMarket.Enabled {
+		txSelector, err := handlers.NewFeeMarketTxSelector[T](feeMarket)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid fee market config: %w", err)
+		}
+		proposalHandler.SetTxSelector(txSelector)
+	} else if _, isNoOp := mp.(mempool.NoOpMempool[T]); mp == nil || isNoOp {
+		return handlers.NoOpPrepareProposal[T](), handlers.NoOpProcessProposal[T](), nil
+	}
+
+	return proposalHandler.PrepareHandler(), proposalHandler.ProcessHandler(), nil
+}
@@ -0,0 +1,412 @@
+package cometbft
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+	"github.com/spf13/cobra"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema/appdata"
+	serverv2 "cosmossdk.io/server/v2"
+	cometlog "cosmossdk.io/server/v2/cometbft/log"
+	"cosmossdk.io/server/v2/stf"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	flagRecord  = "record"
+	flagCompare = "compare"
+	flagAppHash = "app-hash"
+)
+
+// replayedBlock is the record of a replayed block, with the state changes of each
+// of its stages.
+type replayedBlock struct {
+	Height  int64             `json:"height"`
+	AppHash cmtbytes.HexBytes `json:"app_hash"`
+	Stages  []replayedStage   `json:"stages"`
+}
+
+// replayedStage is the record of a stage of a replayed block.
+type replayedStage struct {
+	Stage   string            `json:"stage"`
+	TxIndex int32             `json:"tx_index,omitempty"`
+	TxHash  cmtbytes.HexBytes `json:"tx_hash,omitempty"`
+	Changes []replayedChange  `json:"changes"`
+}
+
+// replayedChange is a key written or removed by a stage, the changes of a stage
+// are sorted by actor then by key.
+type replayedChange struct {
+	Actor  string            `json:"actor"`
+	Key    cmtbytes.HexBytes `json:"key"`
+	Value  cmtbytes.HexBytes `json:"value,omitempty"`
+	Remove bool              `json:"remove,omitempty"`
+}
+
+func (c replayedChange) String() string {
+	if c.Remove {
+		return "removed"
+	}
+	return c.Value.String()
+}
+
+// replayDivergence is the first difference between two replays of a block.
+type replayDivergence struct {
+	Height int64
+	// Stage is the first diverging stage, nil if the stages are the same but the
+	// app hashes differ.
+	Stage *replayedStage
+	// Key is the first diverging key of the stage, nil if the stages diverge on
+	// their kind, e.g. a different number of txs.
+	Key      *replayedChange
+	Expected string
+	Actual   string
+}
+
+func (d replayDivergence) String() string {
+	msg := fmt.Sprintf("height %d", d.Height)
+	if d.Stage != nil {
+		msg += fmt.Sprintf(", stage %s", d.Stage.Stage)
+		if d.Stage.Stage == stageName(appdata.TxProcessingStage) {
+			msg += fmt.Sprintf(", tx %d (%s)", d.Stage.TxIndex, d.Stage.TxHash)
+		}
+	}
+	if d.Key != nil {
+		msg += fmt.Sprintf(", actor %s, key %s", d.Key.Actor, d.Key.Key)
+	}
+	return fmt.Sprintf("%s: expected %s, got %s", msg, d.Expected, d.Actual)
+}
+
+// stageName returns the name of a block stage in the replay records.
+func stageName(stage appdata.BlockStage) string {
+	switch stage {
+	case appdata.PreBlockStage:
+		return "pre_block"
+	case appdata.BeginBlockStage:
+		return "begin_block"
+	case appdata.TxProcessingStage:
+		return "tx"
+	case appdata.EndBlockStage:
+		return "end_block"
+	default:
+		return "unknown"
+	}
+}
+
+// newReplayedStage returns the record of the state changes of a stage.
+func newReplayedStage(stage appdata.BlockStage, txIndex int32, changes []corestore.StateChanges) replayedStage {
+	rs := replayedStage{Stage: stageName(stage), TxIndex: txIndex, Changes: []replayedChange{}}
+	for _, sc := range changes {
+		for _, kv := range sc.StateChanges {
+			rs.Changes = append(rs.Changes, replayedChange{
+				Actor:  string(sc.Actor),
+				Key:    kv.Key,
+				Value:  kv.Value,
+				Remove: kv.Remove,
+			})
+		}
+	}
+	// the actors of the state changes are not ordered
+	sort.SliceStable(rs.Changes, func(i, j int) bool {
+		return rs.Changes[i].Actor < rs.Changes[j].Actor
+	})
+	return rs
+}
+
+// diffReplayedBlocks returns the first divergence between the expected and the
+// actual replay of a block, nil if they are the same.
+func diffReplayedBlocks(expected, actual *replayedBlock) *replayDivergence {
+	for i := 0; i < len(expected.Stages) || i < len(actual.Stages); i++ {
+		if i >= len(expected.Stages) || i >= len(actual.Stages) {
+			d := &replayDivergence{Height: actual.Height, Expected: "no stage", Actual: "no stage"}
+			if i < len(expected.Stages) {
+				d.Stage = &expected.Stages[i]
+				d.Expected = expected.Stages[i].Stage
+			} else {
+				d.Stage = &actual.Stages[i]
+				d.Actual = actual.Stages[i].Stage
+			}
+			return d
+		}
+
+		exp, act := &expected.Stages[i], &actual.Stages[i]
+		if exp.Stage != act.Stage || exp.TxIndex != act.TxIndex || !bytes.Equal(exp.TxHash, act.TxHash) {
+			return &replayDivergence{
+				Height:   actual.Height,
+				Stage:    exp,
+				Expected: fmt.Sprintf("%s %d %s", exp.Stage, exp.TxIndex, exp.TxHash),
+				Actual:   fmt.Sprintf("%s %d %s", act.Stage, act.TxIndex, act.TxHash),
+			}
+		}
+		if key, expChange, actChange := diffChanges(exp.Changes, act.Changes); key != nil {
+			return &replayDivergence{Height: actual.Height, Stage: exp, Key: key, Expected: expChange, Actual: actChange}
+		}
+	}
+
+	if !bytes.Equal(expected.AppHash, actual.AppHash) {
+		return &replayDivergence{Height: actual.Height, Expected: expected.AppHash.String(), Actual: actual.AppHash.String()}
+	}
+	return nil
+}
+
+// diffChanges returns the first key changed differently by the expected and the
+// actual changes of a stage, with its expected and actual change.
+func diffChanges(expected, actual []replayedChange) (*replayedChange, string, string) {
+	compare := func(a, b replayedChange) int {
+		if a.Actor != b.Actor {
+			if a.Actor < b.Actor {
+				return -1
+			}
+			return 1
+		}
+		return bytes.Compare(a.Key, b.Key)
+	}
+
+	i, j := 0, 0
+	for i < len(expected) || j < len(actual) {
+		switch {
+		case j == len(actual) || (i < len(expected) && compare(expected[i], actual[j]) < 0):
+			return &expected[i], expected[i].String(), "unchanged"
+		case i == len(expected) || compare(expected[i], actual[j]) > 0:
+			return &actual[j], "unchanged", actual[j].String()
+		case expected[i].Remove != actual[j].Remove || !bytes.Equal(expected[i].Value, actual[j].Value):
+			return &expected[i], expected[i].String(), actual[j].String()
+		}
+		i++
+		j++
+	}
+	return nil, "", ""
+}
+
+// replayBlock replays a block of the block store on top of the app state, and
+// commits its state changes.
+func (c *Consensus[T]) replayBlock(
+	cmd *cobra.Command,
+	blockStore *cmtstore.BlockStore,
+	stateStore sm.Store,
+	initialHeight, height int64,
+) (*replayedBlock, error) {
+	block, _ := blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found in the block store", height)
+	}
+
+	rb := &replayedBlock{Height: height, Stages: []replayedStage{}}
+	// the genesis block is not delivered, see FinalizeBlock
+	if height == initialHeight {
+		appHash, err := c.store.Commit(corestore.NewChangeset())
+		if err != nil {
+			return nil, fmt.Errorf("unable to commit the changeset: %w", err)
+		}
+		rb.AppHash = appHash
+		return rb, nil
+	}
+
+	lastValSet, err := stateStore.LoadValidators(height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the validators of height %d: %w", height-1, err)
+	}
+
+	ctx := stf.ContextWithStateChangesRecorder(cmd.Context(), func(stage appdata.BlockStage, txIndex int32, changes []corestore.StateChanges) {
+		rs := newReplayedStage(stage, txIndex, changes)
+		if txIndex > 0 {
+			rs.TxHash = block.Txs[txIndex-1].Hash()
+		}
+		rb.Stages = append(rb.Stages, rs)
+	})
+	_, _, _, appHash, err := c.deliverBlock(ctx, &abciproto.FinalizeBlockRequest{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  sm.BuildLastCommitInfo(block, lastValSet, initialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to replay block %d: %w", height, err)
+	}
+	rb.AppHash = appHash
+
+	return rb, nil
+}
+
+// ReplayCmd returns a command replaying the blocks of the CometBFT block store on
+// top of the app state, to debug app hash mismatches.
+func (s *CometBFTServer[T]) ReplayCmd(newApp serverv2.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [from-height] [to-height]",
+		Short: "Replay blocks of the block store and diff their state changes",
+		Long: `Replay the blocks of the CometBFT block store on top of the app state, default from the height
+following the latest app height to the latest block. The state changes of the blocks are committed,
+the command must be run against a copy of the app data directory.
+
+The state changes of each stage of the blocks, including each tx, are recorded. The app hash of each
+block is checked against the one of the following block in the block store, or --app-hash for the
+last block, and the replay stops on the first mismatch.
+
+The records can be written to a file with --record, and compared with the records of another binary
+with --compare, in which case the first diverging tx and key are reported.`,
+		Example: "<appd> replay 1000 1010 --compare records.json",
+		Args:    cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := serverv2.GetViperFromCmd(cmd)
+			logger := log.NewLogger(cmd.ErrOrStderr())
+			app := newApp(logger, v)
+			if closer, ok := app.GetStore().(io.Closer); ok {
+				defer closer.Close()
+			}
+			if err := s.Init(app, v.AllSettings(), logger); err != nil {
+				return err
+			}
+
+			cfg := client.GetConfigFromCmd(cmd)
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+
+			blockStore := cmtstore.NewBlockStore(blockStoreDB, cmtstore.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout))
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				Logger:      cometlog.CometLoggerWrapper{Logger: logger},
+				DBKeyLayout: cfg.Storage.ExperimentalKeyLayout,
+			})
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+			if state.IsEmpty() {
+				return errors.New("the CometBFT state is empty")
+			}
+
+			latest, err := s.Consensus.store.GetLatestVersion()
+			if err != nil {
+				return err
+			}
+			from, to := int64(latest)+1, blockStore.Height()
+			if len(args) > 0 {
+				if from, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+			if len(args) > 1 {
+				if to, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return err
+				}
+			}
+			if from != int64(latest)+1 {
+				return fmt.Errorf("the app state is at height %d, the replay must start from height %d, got %d", latest, latest+1, from)
+			}
+			if from < blockStore.Base() || to > blockStore.Height() || from > to {
+				return fmt.Errorf("invalid height range [%d, %d], the block store has the blocks [%d, %d]", from, to, blockStore.Base(), blockStore.Height())
+			}
+
+			var lastAppHash []byte
+			if appHash, _ := cmd.Flags().GetString(flagAppHash); appHash != "" {
+				if lastAppHash, err = hex.DecodeString(appHash); err != nil {
+					return fmt.Errorf("invalid app hash: %w", err)
+				}
+			}
+
+			var records *json.Encoder
+			if path, _ := cmd.Flags().GetString(flagRecord); path != "" {
+				f, err := os.Create(path)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				records = json.NewEncoder(f)
+			}
+			var expectedRecords *json.Decoder
+			if path, _ := cmd.Flags().GetString(flagCompare); path != "" {
+				f, err := os.Open(path)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				expectedRecords = json.NewDecoder(f)
+			}
+
+			for height := from; height <= to; height++ {
+				rb, err := s.Consensus.replayBlock(cmd, blockStore, stateStore, state.InitialHeight, height)
+				if err != nil {
+					return err
+				}
+				if records != nil {
+					if err := records.Encode(rb); err != nil {
+						return err
+					}
+				}
+
+				if expectedRecords != nil {
+					expected, err := nextReplayedBlock(expectedRecords, height)
+					if err != nil {
+						return err
+					}
+					if d := diffReplayedBlocks(expected, rb); d != nil {
+						return fmt.Errorf("replay diverges at %s", d)
+					}
+				}
+
+				expectedAppHash := lastAppHash
+				if meta := blockStore.LoadBlockMeta(height + 1); meta != nil {
+					expectedAppHash = meta.Header.AppHash
+				}
+				if expectedAppHash != nil && !bytes.Equal(expectedAppHash, rb.AppHash) {
+					return fmt.Errorf("app hash mismatch at height %d: expected %X, got %X", height, expectedAppHash, rb.AppHash)
+				}
+				cmd.Printf("height %d app hash %X, %d stages replayed\n", height, rb.AppHash, len(rb.Stages))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagRecord, "", "Write the records of the replayed blocks to this file")
+	cmd.Flags().String(flagCompare, "", "Compare the replayed blocks with the records of this file")
+	cmd.Flags().String(flagAppHash, "", "Expected app hash, in hex, of the last replayed block")
+
+	return cmd
+}
+
+// nextReplayedBlock returns the record of the given height, skipping the records
+// of the lower heights.
+func nextReplayedBlock(dec *json.Decoder, height int64) (*replayedBlock, error) {
+	for {
+		rb := &replayedBlock{}
+		if err := dec.Decode(rb); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("no record of height %d to compare with", height)
+			}
+			return nil, err
+		}
+		if rb.Height == height {
+			return rb, nil
+		}
+		if rb.Height > height {
+			return nil, fmt.Errorf("no record of height %d to compare with", height)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package cometbft
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/schema/appdata"
+)
+
+func TestDiffReplayedBlocks(t *testing.T) {
+	newBlock := func(txValue string) *replayedBlock {
+		return &replayedBlock{
+			Height:  10,
+			AppHash: []byte("apphash"),
+			Stages: []replayedStage{
+				newReplayedStage(appdata.BeginBlockStage, 0, []corestore.StateChanges{
+					{Actor: []byte("mint"), StateChanges: corestore.KVPairs{{Key: []byte("minter"), Value: []byte("1")}}},
+				}),
+				newReplayedStage(appdata.TxProcessingStage, 1, []corestore.StateChanges{
+					{Actor: []byte("bank"), StateChanges: corestore.KVPairs{
+						{Key: []byte("a"), Value: []byte("1")},
+						{Key: []byte("b"), Value: []byte(txValue)},
+					}},
+					{Actor: []byte("auth"), StateChanges: corestore.KVPairs{{Key: []byte("seq"), Value: []byte("1")}}},
+				}),
+			},
+		}
+	}
+
+	expected := newBlock("2")
+	// the changes are sorted by actor
+	require.Equal(t, "auth", expected.Stages[1].Changes[0].Actor)
+	require.Nil(t, diffReplayedBlocks(expected, newBlock("2")))
+
+	// the records are stable through their JSON encoding
+	var buf bytes.Buffer
+	require.NoError(t, json.NewEncoder(&buf).Encode(expected))
+	decoded, err := nextReplayedBlock(json.NewDecoder(&buf), 10)
+	require.NoError(t, err)
+	require.Nil(t, diffReplayedBlocks(decoded, newBlock("2")))
+
+	d := diffReplayedBlocks(expected, newBlock("3"))
+	require.NotNil(t, d)
+	require.Equal(t, "tx", d.Stage.Stage)
+	require.Equal(t, int32(1), d.Stage.TxIndex)
+	require.Equal(t, []byte("b"), []byte(d.Key.Key))
+	require.Equal(t, "height 10, stage tx, tx 1 (), actor bank, key 62: expected 32, got 33", d.String())
+
+	// a key written by one replay only
+	actual := newBlock("2")
+	actual.Stages[0].Changes = append(actual.Stages[0].Changes, replayedChange{Actor: "mint", Key: []byte("params"), Remove: true})
+	d = diffReplayedBlocks(expected, actual)
+	require.NotNil(t, d)
+	require.Equal(t, "begin_block", d.Stage.Stage)
+	require.Equal(t, "unchanged", d.Expected)
+	require.Equal(t, "removed", d.Actual)
+
+	// a missing stage
+	actual = newBlock("2")
+	actual.Stages = actual.Stages[:1]
+	d = diffReplayedBlocks(expected, actual)
+	require.NotNil(t, d)
+	require.Equal(t, "tx", d.Expected)
+	require.Equal(t, "no stage", d.Actual)
+
+	// same state changes but different app hashes
+	actual = newBlock("2")
+	actual.AppHash = []byte("other")
+	d = diffReplayedBlocks(expected, actual)
+	require.NotNil(t, d)
+	require.Nil(t, d.Stage)
+
+	_, err = nextReplayedBlock(json.NewDecoder(&buf), 11)
+	require.ErrorContains(t, err, "no record of height 11")
+}
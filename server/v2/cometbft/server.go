@@ -15,6 +15,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	rpclocal "github.com/cometbft/cometbft/rpc/client/local"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -24,6 +25,7 @@ import (
 	cometlog "cosmossdk.io/server/v2/cometbft/log"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 	"cosmossdk.io/server/v2/cometbft/types"
+	"cosmossdk.io/server/v2/streaming"
 	"cosmossdk.io/store/v2/snapshots"
 
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -99,11 +101,12 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 
 	s.logger = logger.With(log.ModuleKey, s.Name())
 	store := appI.GetStore().(types.Store)
+	mp := s.serverOptions.Mempool(cfg)
 	consensus := NewConsensus(
 		s.logger,
 		appI.Name(),
 		appI.GetAppManager(),
-		s.serverOptions.Mempool(cfg),
+		mp,
 		indexEvents,
 		appI.GetQueryHandlers(),
 		store,
@@ -113,11 +116,27 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 	)
 	consensus.prepareProposalHandler = s.serverOptions.PrepareProposalHandler
 	consensus.processProposalHandler = s.serverOptions.ProcessProposalHandler
+	if consensus.prepareProposalHandler == nil || consensus.processProposalHandler == nil {
+		prepare, process, err := defaultProposalHandlers(mp, s.config.AppTomlConfig.FeeMarket)
+		if err != nil {
+			return err
+		}
+		if consensus.prepareProposalHandler == nil {
+			consensus.prepareProposalHandler = prepare
+		}
+		if consensus.processProposalHandler == nil {
+			consensus.processProposalHandler = process
+		}
+	}
+	if _, isNoOp := mp.(mempool.NoOpMempool[T]); mp != nil && !isNoOp && s.config.AppTomlConfig.Mempool.RecheckBatchSize > 0 {
+		consensus.rechecker = mempool.NewRechecker(s.logger, mp, appI.GetAppManager(), s.config.AppTomlConfig.Mempool.RecheckBatchSize)
+	}
 	consensus.checkTxHandler = s.serverOptions.CheckTxHandler
 	consensus.verifyVoteExt = s.serverOptions.VerifyVoteExtensionHandler
 	consensus.extendVote = s.serverOptions.ExtendVoteHandler
 	consensus.addrPeerFilter = s.serverOptions.AddrPeerFilter
 	consensus.idPeerFilter = s.serverOptions.IdPeerFilter
+	consensus.SetStreamingManager(streaming.Manager{Listeners: s.serverOptions.StreamingListeners})
 
 	ss := store.GetStateStorage().(snapshots.StorageSnapshotter)
 	sc := store.GetStateCommitment().(snapshots.CommitSnapshotter)
@@ -178,11 +197,16 @@ func (s *CometBFTServer[T]) Start(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	s.Consensus.cometRPC = rpclocal.New(s.Node)
 
 	return s.Node.Start()
 }
 
 func (s *CometBFTServer[T]) Stop(context.Context) error {
+	if s.Consensus != nil && s.Consensus.rechecker != nil {
+		s.Consensus.rechecker.Stop()
+	}
+
 	if s.Node != nil && s.Node.IsRunning() {
 		return s.Node.Stop()
 	}
@@ -264,6 +288,7 @@ func (s *CometBFTServer[T]) CLICommands() serverv2.CLIConfig {
 			QueryBlockCmd(),
 			QueryBlocksCmd(),
 			QueryBlockResultsCmd(),
+			QueryTraceTxCmd(),
 		},
 	}
 }
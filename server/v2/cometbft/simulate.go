@@ -0,0 +1,102 @@
+package cometbft
+
+import (
+	"context"
+
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors/v2"
+	cometerrors "cosmossdk.io/server/v2/cometbft/types/errors"
+	"cosmossdk.io/server/v2/stf/branch"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// simulateQueryPath is the ABCI query path of the Simulate query of the tx service.
+const simulateQueryPath = "/cosmos.tx.v1beta1.Service/Simulate"
+
+// handleSimulateQuery handles the Simulate ABCI query of the tx service, which
+// simulates a tx against the state of a height, with the state overrides of the
+// request written over it.
+func (c *Consensus[T]) handleSimulateQuery(ctx context.Context, req *abciproto.QueryRequest) (*abciproto.QueryResponse, error) {
+	simReq := &txtypes.SimulateRequest{}
+	if err := gogoproto.Unmarshal(req.Data, simReq); err != nil {
+		return nil, errorsmod.Wrap(err, "unable to decode the Simulate request")
+	}
+
+	txBytes := simReq.TxBytes
+	if txBytes == nil && simReq.Tx != nil {
+		// backwards compatibility with the deprecated tx field
+		var err error
+		txBytes, err = gogoproto.Marshal(simReq.Tx)
+		if err != nil {
+			return nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "invalid tx: %v", err)
+		}
+	}
+	if txBytes == nil {
+		return nil, errorsmod.Wrap(cometerrors.ErrInvalidRequest, "empty txBytes is not allowed")
+	}
+
+	tx, err := c.txCodec.Decode(txBytes)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to decode tx")
+	}
+
+	height, state, err := c.simulationState(simReq.Height, simReq.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	txResult, _ := c.app.SimulateWithState(ctx, state, tx)
+	if txResult.Error != nil {
+		return nil, errorsmod.Wrapf(txResult.Error, "with gas used: '%d'", txResult.GasUsed)
+	}
+
+	result, err := intoSDKResult(txResult, c.indexedEvents)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal txResult")
+	}
+
+	return queryResponse(&txtypes.SimulateResponse{
+		GasInfo: &sdk.GasInfo{
+			GasWanted: txResult.GasWanted,
+			GasUsed:   txResult.GasUsed,
+		},
+		Result: result,
+	}, int64(height))
+}
+
+// simulationState returns the state of the given height, the latest one if 0,
+// branched with the state overrides written over it.
+func (c *Consensus[T]) simulationState(height int64, overrides []*txtypes.StateOverride) (uint64, store.ReaderMap, error) {
+	var (
+		version = uint64(height)
+		state   store.ReaderMap
+		err     error
+	)
+	switch {
+	case height < 0:
+		return 0, nil, errorsmod.Wrap(cometerrors.ErrInvalidRequest, "height cannot be negative")
+	case height == 0:
+		version, state, err = c.store.StateLatest()
+	default:
+		state, err = c.store.StateAt(version)
+	}
+	if err != nil {
+		return 0, nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "unable to get the state of height %d: %v", height, err)
+	}
+
+	if len(overrides) == 0 {
+		return version, state, nil
+	}
+
+	overridden := branch.DefaultNewWriterMap(state)
+	if err := overridden.ApplyStateChanges(txtypes.StateOverridesToStateChanges(overrides)); err != nil {
+		return 0, nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "invalid state overrides: %v", err)
+	}
+
+	return version, overridden, nil
+}
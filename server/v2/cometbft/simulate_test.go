@@ -0,0 +1,79 @@
+package cometbft
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	cometmock "cosmossdk.io/server/v2/cometbft/internal/mock"
+	"cosmossdk.io/server/v2/stf/mock"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestConsensus_Simulate(t *testing.T) {
+	c := setUpConsensus(t, 100_000, cometmock.MockMempool[mock.Tx]{})
+
+	_, err := c.InitChain(context.Background(), &abciproto.InitChainRequest{
+		Time:          time.Now(),
+		ChainId:       "test",
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		_, err = c.FinalizeBlock(context.Background(), &abciproto.FinalizeBlockRequest{
+			Time:   time.Now(),
+			Height: height,
+			Hash:   sum[:],
+			Txs:    [][]byte{mockTx.Bytes()},
+		})
+		require.NoError(t, err)
+	}
+
+	simulate := func(req *txtypes.SimulateRequest) *abciproto.QueryResponse {
+		data, err := gogoproto.Marshal(req)
+		require.NoError(t, err)
+		res, err := c.Query(context.Background(), &abciproto.QueryRequest{Path: simulateQueryPath, Data: data})
+		require.NoError(t, err)
+		return res
+	}
+
+	// the latest state
+	res := simulate(&txtypes.SimulateRequest{TxBytes: mockTx.Bytes()})
+	require.Zero(t, res.Code, res.Log)
+	require.Equal(t, int64(2), res.Height)
+
+	var simRes txtypes.SimulateResponse
+	require.NoError(t, gogoproto.Unmarshal(res.Value, &simRes))
+	require.Equal(t, mockTx.GasLimit, simRes.GasInfo.GasWanted)
+	require.Len(t, simRes.Result.MsgResponses, 1)
+
+	// the state of a past height, with overrides
+	res = simulate(&txtypes.SimulateRequest{
+		TxBytes: mockTx.Bytes(),
+		Height:  1,
+		StateOverrides: []*txtypes.StateOverride{
+			{StoreKey: string(actorName), Key: []byte("key"), Value: []byte("value")},
+			{StoreKey: string(actorName), Key: []byte("other"), Delete: true},
+		},
+	})
+	require.Zero(t, res.Code, res.Log)
+	require.Equal(t, int64(1), res.Height)
+
+	res = simulate(&txtypes.SimulateRequest{
+		TxBytes:        mockTx.Bytes(),
+		StateOverrides: []*txtypes.StateOverride{{StoreKey: string(actorName), Key: []byte("key")}},
+	})
+	require.Contains(t, res.Log, "invalid state overrides")
+
+	res = simulate(&txtypes.SimulateRequest{TxBytes: mockTx.Bytes(), Height: -1})
+	require.Contains(t, res.Log, "height cannot be negative")
+
+	res = simulate(&txtypes.SimulateRequest{})
+	require.Contains(t, res.Log, "empty txBytes is not allowed")
+}
@@ -0,0 +1,163 @@
+package cometbft
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/server"
+	errorsmod "cosmossdk.io/errors/v2"
+	"cosmossdk.io/server/v2/cometbft/client/grpc/debugservice"
+	cometerrors "cosmossdk.io/server/v2/cometbft/types/errors"
+	"cosmossdk.io/server/v2/stf"
+)
+
+// traceTxQueryPath is the ABCI query path of the TraceTx query of the debug service.
+const traceTxQueryPath = "/cosmos.base.debug.v1.Service/TraceTx"
+
+// validatorsPerPage is the maximum page size of the CometBFT validators RPC.
+const validatorsPerPage = 100
+
+// handleTraceTxQuery handles the TraceTx ABCI query.
+func (c *Consensus[T]) handleTraceTxQuery(ctx context.Context, req *abciproto.QueryRequest) (*abciproto.QueryResponse, error) {
+	if !c.cfg.AppTomlConfig.TraceTxQuery {
+		return nil, errors.New("the trace-tx query is disabled, see comet.trace-tx-query in app.toml")
+	}
+
+	traceReq := &debugservice.TraceTxRequest{}
+	if err := gogoproto.Unmarshal(req.Data, traceReq); err != nil {
+		return nil, fmt.Errorf("unable to decode the TraceTx request: %w", err)
+	}
+
+	res, err := c.traceTx(ctx, traceReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return queryResponse(res, res.Height)
+}
+
+// traceTx executes again the block of a committed tx on top of the state of the
+// previous height, and returns the execution trace of the tx.
+func (c *Consensus[T]) traceTx(ctx context.Context, req *debugservice.TraceTxRequest) (*debugservice.TraceTxResponse, error) {
+	if c.cometRPC == nil {
+		return nil, errors.New("the trace-tx query requires the in-process CometBFT node")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil {
+		return nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "invalid tx hash %q: %v", req.Hash, err)
+	}
+
+	txRes, err := c.cometRPC.Tx(ctx, hash, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find tx %s: %w", req.Hash, err)
+	}
+
+	blockRes, err := c.cometRPC.Block(ctx, &txRes.Height)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load block %d: %w", txRes.Height, err)
+	}
+	block := blockRes.Block
+
+	// the txs of the initial block are not executed, see FinalizeBlock
+	if block.LastCommit.Size() == 0 {
+		return nil, fmt.Errorf("the txs of the initial block %d are not executed", block.Height)
+	}
+
+	lastValSet, err := c.validatorSet(ctx, block.Height-1)
+	if err != nil {
+		return nil, err
+	}
+	if len(lastValSet.Validators) != block.LastCommit.Size() {
+		return nil, fmt.Errorf("the last commit of block %d does not match the validators of height %d", block.Height, block.Height-1)
+	}
+
+	decodedTxs, err := decodeTxs(block.Txs.ToSliceOfBytes(), c.txCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	ciCtx := contextWithCometInfo(ctx, comet.Info{
+		Evidence:        toCoreEvidence(block.Evidence.Evidence.ToABCI()),
+		ValidatorsHash:  block.NextValidatorsHash,
+		ProposerAddress: block.ProposerAddress,
+		// the initial height is only used when the last commit is empty
+		LastCommit: toCoreCommitInfo(sm.BuildLastCommitInfo(block, lastValSet, 0)),
+	})
+
+	trace := &stf.TxTrace{}
+	resp, err := c.app.ReplayBlock(stf.ContextWithTxTrace(ciCtx, int32(txRes.Index)+1, trace), &server.BlockRequest[T]{
+		Height:  uint64(block.Height),
+		Time:    block.Time,
+		Hash:    block.Hash(),
+		AppHash: block.AppHash,
+		ChainId: c.chainID,
+		Txs:     decodedTxs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if int(txRes.Index) >= len(resp.TxResults) {
+		return nil, fmt.Errorf("no result for tx %d of block %d", txRes.Index, block.Height)
+	}
+
+	return newTraceTxResponse(block.Height, txRes.Index, resp.TxResults[txRes.Index], trace), nil
+}
+
+// validatorSet returns the validator set of the given height.
+func (c *Consensus[T]) validatorSet(ctx context.Context, height int64) (*cmttypes.ValidatorSet, error) {
+	valSet := &cmttypes.ValidatorSet{}
+	for page, perPage := 1, validatorsPerPage; ; page++ {
+		res, err := c.cometRPC.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the validators of height %d: %w", height, err)
+		}
+		valSet.Validators = append(valSet.Validators, res.Validators...)
+		if len(res.Validators) == 0 || len(valSet.Validators) >= res.Total {
+			return valSet, nil
+		}
+	}
+}
+
+// newTraceTxResponse converts the execution trace of a tx to a TraceTx response.
+func newTraceTxResponse(height int64, index uint32, txResult server.TxResult, trace *stf.TxTrace) *debugservice.TraceTxResponse {
+	res := &debugservice.TraceTxResponse{
+		Height:    height,
+		Index:     index,
+		GasWanted: txResult.GasWanted,
+		GasUsed:   txResult.GasUsed,
+		Steps:     make([]*debugservice.TraceStep, len(trace.Steps)),
+	}
+	if txResult.Error != nil {
+		res.Error = txResult.Error.Error()
+	}
+
+	for i, step := range trace.Steps {
+		attrs := make([]*debugservice.EventAttribute, len(step.Attributes))
+		for j, attr := range step.Attributes {
+			attrs[j] = &debugservice.EventAttribute{Key: attr.Key, Value: attr.Value}
+		}
+		res.Steps[i] = &debugservice.TraceStep{
+			Kind:       string(step.Kind),
+			Phase:      string(step.Phase),
+			Depth:      uint32(step.Depth),
+			TypeUrl:    step.TypeURL,
+			Actor:      step.Actor,
+			Key:        step.Key,
+			Value:      step.Value,
+			Gas:        step.Gas,
+			Attributes: attrs,
+			Error:      step.Error,
+		}
+	}
+
+	return res
+}
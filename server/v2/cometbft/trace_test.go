@@ -0,0 +1,135 @@
+package cometbft
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	abciproto "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/server/v2/cometbft/client/grpc/debugservice"
+	"cosmossdk.io/server/v2/cometbft/client/rpc"
+	cometmock "cosmossdk.io/server/v2/cometbft/internal/mock"
+	"cosmossdk.io/server/v2/stf"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+// mockCometRPC serves a single block and the validators of its previous height.
+type mockCometRPC struct {
+	rpc.CometRPC
+
+	block      *cmttypes.Block
+	validators []*cmttypes.Validator
+}
+
+func (m mockCometRPC) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	for i, tx := range m.block.Txs {
+		if string(tx.Hash()) == string(hash) {
+			return &coretypes.ResultTx{Hash: hash, Height: m.block.Height, Index: uint32(i), Tx: tx}, nil
+		}
+	}
+	return nil, errors.New("tx not found")
+}
+
+func (m mockCometRPC) Block(_ context.Context, _ *int64) (*coretypes.ResultBlock, error) {
+	return &coretypes.ResultBlock{Block: m.block}, nil
+}
+
+func (m mockCometRPC) Validators(_ context.Context, height *int64, page, _ *int) (*coretypes.ResultValidators, error) {
+	// one validator per page
+	return &coretypes.ResultValidators{
+		BlockHeight: *height,
+		Validators:  m.validators[*page-1 : *page],
+		Count:       1,
+		Total:       len(m.validators),
+	}, nil
+}
+
+func TestConsensus_TraceTx(t *testing.T) {
+	c := setUpConsensus(t, 100_000, cometmock.MockMempool[mock.Tx]{})
+
+	_, err := c.InitChain(context.Background(), &abciproto.InitChainRequest{
+		Time:          time.Now(),
+		ChainId:       "test",
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+
+	txs := cmttypes.Txs{invalidMockTx.Bytes(), mockTx.Bytes()}
+	validators := []*cmttypes.Validator{
+		cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10),
+		cmttypes.NewValidator(ed25519.GenPrivKey().PubKey(), 10),
+	}
+	block := cmttypes.MakeBlock(2, txs, &cmttypes.Commit{
+		Height: 1,
+		Signatures: []cmttypes.CommitSig{
+			{BlockIDFlag: cmttypes.BlockIDFlagCommit, ValidatorAddress: validators[0].Address},
+			{BlockIDFlag: cmttypes.BlockIDFlagAbsent},
+		},
+	}, nil)
+	block.Time = time.Now()
+	block.ValidatorsHash = cmttypes.NewValidatorSet(validators).Hash()
+
+	_, err = c.FinalizeBlock(context.Background(), &abciproto.FinalizeBlockRequest{
+		Time:   block.Time,
+		Height: 1,
+		Hash:   sum[:],
+		Txs:    txs.ToSliceOfBytes(),
+	})
+	require.NoError(t, err)
+	cid, err := c.store.LastCommitID()
+	require.NoError(t, err)
+	block.AppHash = cid.Hash
+
+	_, err = c.FinalizeBlock(context.Background(), &abciproto.FinalizeBlockRequest{
+		Time:   block.Time,
+		Height: 2,
+		Hash:   block.Hash(),
+		Txs:    txs.ToSliceOfBytes(),
+	})
+	require.NoError(t, err)
+	c.cometRPC = mockCometRPC{block: block, validators: validators}
+
+	query := func(hash string) *abciproto.QueryResponse {
+		data, err := gogoproto.Marshal(&debugservice.TraceTxRequest{Hash: hash})
+		require.NoError(t, err)
+		res, err := c.Query(context.Background(), &abciproto.QueryRequest{Path: traceTxQueryPath, Data: data})
+		require.NoError(t, err)
+		return res
+	}
+
+	// the query is disabled by default
+	queryRes := query(hex.EncodeToString(txs[1].Hash()))
+	require.Contains(t, queryRes.Log, "trace-tx query is disabled")
+
+	c.cfg.AppTomlConfig.TraceTxQuery = true
+	queryRes = query(hex.EncodeToString(txs[1].Hash()))
+	require.Zero(t, queryRes.Code, queryRes.Log)
+	require.Equal(t, int64(2), queryRes.Height)
+
+	var traceRes debugservice.TraceTxResponse
+	require.NoError(t, gogoproto.Unmarshal(queryRes.Value, &traceRes))
+	require.Equal(t, uint32(1), traceRes.Index)
+	require.Empty(t, traceRes.Error)
+	require.Equal(t, uint64(mockTx.GasLimit), traceRes.GasWanted)
+	require.Len(t, traceRes.Steps, 1)
+	require.Equal(t, string(stf.TraceStepCall), traceRes.Steps[0].Kind)
+	require.Equal(t, string(stf.TracePhaseExec), traceRes.Steps[0].Phase)
+	require.Equal(t, gogoproto.MessageName(&gogotypes.BoolValue{}), traceRes.Steps[0].TypeUrl)
+
+	// the first tx of the block
+	require.NoError(t, gogoproto.Unmarshal(query(hex.EncodeToString(txs[0].Hash())).Value, &traceRes))
+	require.Equal(t, uint32(0), traceRes.Index)
+	require.Equal(t, uint64(invalidMockTx.GasLimit), traceRes.GasWanted)
+
+	queryRes = query("invalid")
+	require.Contains(t, queryRes.Log, "invalid tx hash")
+}
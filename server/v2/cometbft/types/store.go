@@ -14,6 +14,10 @@ type Store interface {
 	// associated with it.
 	StateLatest() (uint64, store.ReaderMap, error)
 
+	// StateAt returns a readonly view over the committed state of the store
+	// at the provided version.
+	StateAt(version uint64) (store.ReaderMap, error)
+
 	// SetInitialVersion sets the initial version of the store.
 	SetInitialVersion(uint64) error
 
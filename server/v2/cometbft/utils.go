@@ -154,6 +154,24 @@ func intoABCIEvents(events []event.Event, indexSet map[string]struct{}) ([]abci.
 }
 
 func intoABCISimulationResponse(txRes server.TxResult, indexSet map[string]struct{}) ([]byte, error) {
+	result, err := intoSDKResult(txRes, indexSet)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sdk.SimulationResponse{
+		GasInfo: sdk.GasInfo{
+			GasWanted: txRes.GasWanted,
+			GasUsed:   txRes.GasUsed,
+		},
+		Result: result,
+	}
+
+	return gogoproto.Marshal(res)
+}
+
+// intoSDKResult converts the result of a simulated tx into a sdk.Result.
+func intoSDKResult(txRes server.TxResult, indexSet map[string]struct{}) (*sdk.Result, error) {
 	indexAll := len(indexSet) == 0
 	abciEvents := make([]abci.Event, len(txRes.Events))
 	for i, e := range txRes.Events {
@@ -186,20 +204,17 @@ func intoABCISimulationResponse(txRes server.TxResult, indexSet map[string]struc
 		msgResponses[i] = anyMsg
 	}
 
-	res := &sdk.SimulationResponse{
-		GasInfo: sdk.GasInfo{
-			GasWanted: txRes.GasWanted,
-			GasUsed:   txRes.GasUsed,
-		},
-		Result: &sdk.Result{
-			Data:         []byte{},
-			Log:          txRes.Error.Error(),
-			Events:       abciEvents,
-			MsgResponses: msgResponses,
-		},
+	var log string
+	if txRes.Error != nil {
+		log = txRes.Error.Error()
 	}
 
-	return gogoproto.Marshal(res)
+	return &sdk.Result{
+		Data:         []byte{},
+		Log:          log,
+		Events:       abciEvents,
+		MsgResponses: msgResponses,
+	}, nil
 }
 
 // ToSDKEvidence takes comet evidence and returns sdk evidence
@@ -0,0 +1,443 @@
+package branch
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/tidwall/btree"
+
+	"cosmossdk.io/core/store"
+)
+
+// Version identifies the incarnation of the transaction of a block which wrote
+// a value. Reads served by the base state have the BaseVersion.
+type Version struct {
+	TxIndex     int
+	Incarnation int
+}
+
+// BaseVersion is the version of the values read from the base state.
+var BaseVersion = Version{TxIndex: -1}
+
+// MultiVersionMap is the multi-version memory of an optimistic parallel execution
+// of the transactions of a block (Block-STM). It stores, for each key, the values
+// written by each transaction, so that a transaction reads the latest writes of the
+// transactions preceding it in the block on top of the base state.
+// The reads of a transaction are recorded in a ReadSet, which is validated against
+// the memory to detect the ones invalidated by the re-execution of a preceding
+// transaction.
+//
+// The base state is only accessed under a lock, it does not need to be safe for
+// concurrent use, but it must not be written to while the map is in use.
+type MultiVersionMap struct {
+	base *lockedReaderMap
+
+	mtx    sync.RWMutex
+	actors map[string]*btree.BTreeG[*mvItem]
+	writes map[int][]store.StateChanges
+}
+
+// NewMultiVersionMap creates a MultiVersionMap on top of the given base state.
+func NewMultiVersionMap(base store.ReaderMap) *MultiVersionMap {
+	return &MultiVersionMap{
+		base:   &lockedReaderMap{state: base},
+		actors: make(map[string]*btree.BTreeG[*mvItem]),
+		writes: make(map[int][]store.StateChanges),
+	}
+}
+
+// View returns the state as seen by the transaction at the given index of the block.
+func (m *MultiVersionMap) View(txIndex int) *VersionedView {
+	return &VersionedView{mv: m, txIndex: txIndex}
+}
+
+// Record replaces the writes of the transaction at the given index by the state
+// changes of its given incarnation.
+func (m *MultiVersionMap) Record(txIndex, incarnation int, changes []store.StateChanges) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for _, sc := range m.writes[txIndex] {
+		tree := m.actors[string(sc.Actor)]
+		for _, kv := range sc.StateChanges {
+			if it, ok := tree.Get(&mvItem{key: kv.Key}); ok {
+				it.remove(txIndex)
+			}
+		}
+	}
+
+	for _, sc := range changes {
+		tree, ok := m.actors[string(sc.Actor)]
+		if !ok {
+			tree = btree.NewBTreeGOptions(func(a, b *mvItem) bool {
+				return bytes.Compare(a.key, b.key) < 0
+			}, btree.Options{Degree: bTreeDegree, NoLocks: true})
+			m.actors[string(sc.Actor)] = tree
+		}
+		for _, kv := range sc.StateChanges {
+			it, ok := tree.Get(&mvItem{key: kv.Key})
+			if !ok {
+				it = &mvItem{key: kv.Key}
+				tree.Set(it)
+			}
+			value := kv.Value
+			if kv.Remove {
+				value = nil
+			}
+			it.put(mvWrite{version: Version{TxIndex: txIndex, Incarnation: incarnation}, value: value})
+		}
+	}
+	m.writes[txIndex] = changes
+}
+
+// Validate reports whether the reads of the transaction at the given index are
+// still the ones it would make against the current state of the memory.
+func (m *MultiVersionMap) Validate(txIndex int, rs ReadSet) bool {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for _, r := range rs.keys {
+		_, version := m.read(r.actor, r.key, txIndex)
+		if version != r.version {
+			return false
+		}
+	}
+	for _, r := range rs.ranges {
+		writes := m.visible(r.actor, r.start, r.end, txIndex)
+		if len(writes) != len(r.writes) {
+			return false
+		}
+		for i, w := range writes {
+			if w.version != r.writes[i].version || !bytes.Equal(w.key, r.writes[i].key) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// read returns the latest value of the key written before the given transaction,
+// and its version. The base version is returned if the key was not written.
+func (m *MultiVersionMap) read(actor, key []byte, txIndex int) ([]byte, Version) {
+	tree, ok := m.actors[string(actor)]
+	if !ok {
+		return nil, BaseVersion
+	}
+	it, ok := tree.Get(&mvItem{key: key})
+	if !ok {
+		return nil, BaseVersion
+	}
+	w, ok := it.latest(txIndex)
+	if !ok {
+		return nil, BaseVersion
+	}
+	return w.value, w.version
+}
+
+// visible returns the latest writes, before the given transaction, of the keys
+// in the given range in ascending order.
+func (m *MultiVersionMap) visible(actor, start, end []byte, txIndex int) []keyRead {
+	tree, ok := m.actors[string(actor)]
+	if !ok {
+		return nil
+	}
+
+	var writes []keyRead
+	iter := func(it *mvItem) bool {
+		if end != nil && bytes.Compare(it.key, end) >= 0 {
+			return false
+		}
+		if w, ok := it.latest(txIndex); ok {
+			writes = append(writes, keyRead{key: it.key, value: w.value, version: w.version})
+		}
+		return true
+	}
+	if start == nil {
+		tree.Scan(iter)
+	} else {
+		tree.Ascend(&mvItem{key: start}, iter)
+	}
+	return writes
+}
+
+// mvItem holds the writes of a key ordered by transaction index.
+type mvItem struct {
+	key    []byte
+	writes []mvWrite
+}
+
+type mvWrite struct {
+	version Version
+	value   []byte // nil if the key was deleted
+}
+
+func (it *mvItem) put(w mvWrite) {
+	i := it.search(w.version.TxIndex)
+	if i < len(it.writes) && it.writes[i].version.TxIndex == w.version.TxIndex {
+		it.writes[i] = w
+		return
+	}
+	it.writes = append(it.writes, mvWrite{})
+	copy(it.writes[i+1:], it.writes[i:])
+	it.writes[i] = w
+}
+
+func (it *mvItem) remove(txIndex int) {
+	i := it.search(txIndex)
+	if i < len(it.writes) && it.writes[i].version.TxIndex == txIndex {
+		it.writes = append(it.writes[:i], it.writes[i+1:]...)
+	}
+}
+
+// latest returns the last write made before the given transaction.
+func (it *mvItem) latest(txIndex int) (mvWrite, bool) {
+	i := it.search(txIndex)
+	if i == 0 {
+		return mvWrite{}, false
+	}
+	return it.writes[i-1], true
+}
+
+// search returns the index of the first write made by the given transaction or
+// by a later one.
+func (it *mvItem) search(txIndex int) int {
+	lo, hi := 0, len(it.writes)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if it.writes[mid].version.TxIndex < txIndex {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+// ReadSet holds the reads made by a transaction through a VersionedView.
+type ReadSet struct {
+	keys   []keyRead
+	ranges []rangeRead
+}
+
+// keyRead is the read of a key.
+type keyRead struct {
+	actor   []byte
+	key     []byte
+	value   []byte
+	version Version
+}
+
+// rangeRead is the iteration over a range of keys, the iteration result depends
+// on the base state and on the writes of the range visible to the transaction.
+type rangeRead struct {
+	actor      []byte
+	start, end []byte
+	writes     []keyRead
+}
+
+var _ store.ReaderMap = (*VersionedView)(nil)
+
+// VersionedView is the state seen by a transaction of the block, it records the
+// reads of the transaction. It must not be used concurrently.
+type VersionedView struct {
+	mv      *MultiVersionMap
+	txIndex int
+	reads   ReadSet
+}
+
+// ReadSet returns the reads made through the view.
+func (v *VersionedView) ReadSet() ReadSet {
+	return v.reads
+}
+
+// GetReader implements store.ReaderMap.
+func (v *VersionedView) GetReader(actor []byte) (store.Reader, error) {
+	parent, err := v.mv.base.getReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	return versionedReader{view: v, actor: bytes.Clone(actor), parent: parent}, nil
+}
+
+// versionedReader reads the keys of an actor, from the writes of the preceding
+// transactions first, and then from the base state.
+type versionedReader struct {
+	view   *VersionedView
+	actor  []byte
+	parent store.Reader
+}
+
+func (r versionedReader) get(key []byte) ([]byte, bool) {
+	r.view.mv.mtx.RLock()
+	value, version := r.view.mv.read(r.actor, key, r.view.txIndex)
+	r.view.mv.mtx.RUnlock()
+
+	r.view.reads.keys = append(r.view.reads.keys, keyRead{actor: r.actor, key: bytes.Clone(key), version: version})
+	return value, version != BaseVersion
+}
+
+// Get implements store.Reader.
+func (r versionedReader) Get(key []byte) ([]byte, error) {
+	if value, found := r.get(key); found {
+		return value, nil
+	}
+	return r.parent.Get(key)
+}
+
+// Has implements store.Reader.
+func (r versionedReader) Has(key []byte) (bool, error) {
+	if value, found := r.get(key); found {
+		return value != nil, nil
+	}
+	return r.parent.Has(key)
+}
+
+// Iterator implements store.Reader.
+func (r versionedReader) Iterator(start, end []byte) (store.Iterator, error) {
+	return r.iterator(start, end, true)
+}
+
+// ReverseIterator implements store.Reader.
+func (r versionedReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	return r.iterator(start, end, false)
+}
+
+func (r versionedReader) iterator(start, end []byte, ascending bool) (store.Iterator, error) {
+	r.view.mv.mtx.RLock()
+	writes := r.view.mv.visible(r.actor, start, end, r.view.txIndex)
+	r.view.mv.mtx.RUnlock()
+
+	r.view.reads.ranges = append(r.view.reads.ranges, rangeRead{
+		actor:  r.actor,
+		start:  bytes.Clone(start),
+		end:    bytes.Clone(end),
+		writes: writes,
+	})
+
+	cache := newChangeSet()
+	for _, w := range writes {
+		cache.set(w.key, w.value)
+	}
+
+	var (
+		parent, cacheIter store.Iterator
+		err               error
+	)
+	if ascending {
+		if parent, err = r.parent.Iterator(start, end); err != nil {
+			return nil, err
+		}
+		if cacheIter, err = cache.iterator(start, end); err != nil {
+			return nil, err
+		}
+	} else {
+		if parent, err = r.parent.ReverseIterator(start, end); err != nil {
+			return nil, err
+		}
+		if cacheIter, err = cache.reverseIterator(start, end); err != nil {
+			return nil, err
+		}
+	}
+	return mergeIterators(parent, cacheIter, ascending), nil
+}
+
+// lockedReaderMap serializes the accesses to a base state which is not safe for
+// concurrent use, such as a WriterMap.
+type lockedReaderMap struct {
+	mtx   sync.Mutex
+	state store.ReaderMap
+}
+
+func (l *lockedReaderMap) getReader(actor []byte) (store.Reader, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	reader, err := l.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	return lockedReader{mtx: &l.mtx, reader: reader}, nil
+}
+
+type lockedReader struct {
+	mtx    *sync.Mutex
+	reader store.Reader
+}
+
+func (l lockedReader) Get(key []byte) ([]byte, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.reader.Get(key)
+}
+
+func (l lockedReader) Has(key []byte) (bool, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.reader.Has(key)
+}
+
+func (l lockedReader) Iterator(start, end []byte) (store.Iterator, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	iter, err := l.reader.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return lockedIterator{mtx: l.mtx, iter: iter}, nil
+}
+
+func (l lockedReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	iter, err := l.reader.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return lockedIterator{mtx: l.mtx, iter: iter}, nil
+}
+
+type lockedIterator struct {
+	mtx  *sync.Mutex
+	iter store.Iterator
+}
+
+func (l lockedIterator) Domain() (start, end []byte) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.iter.Domain()
+}
+
+func (l lockedIterator) Valid() bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.iter.Valid()
+}
+
+func (l lockedIterator) Next() {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.iter.Next()
+}
+
+func (l lockedIterator) Key() []byte {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.iter.Key()
+}
+
+func (l lockedIterator) Value() []byte {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.iter.Value()
+}
+
+func (l lockedIterator) Error() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.iter.Error()
+}
+
+func (l lockedIterator) Close() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.iter.Close()
+}
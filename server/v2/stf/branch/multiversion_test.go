@@ -0,0 +1,108 @@
+package branch
+
+import (
+	"testing"
+
+	"cosmossdk.io/core/store"
+)
+
+type memStateMap map[string]memStore
+
+func (m memStateMap) GetReader(actor []byte) (store.Reader, error) {
+	return m[string(actor)], nil
+}
+
+func TestMultiVersionMap(t *testing.T) {
+	actor := []byte("actor")
+	base := newMemState()
+	_ = base.Set([]byte("a"), []byte("base-a"))
+	_ = base.Set([]byte("c"), []byte("base-c"))
+	mv := NewMultiVersionMap(memStateMap{string(actor): base})
+
+	get := func(view *VersionedView, key, wantValue string) {
+		t.Helper()
+		reader, err := view.GetReader(actor)
+		if err != nil {
+			t.Fatalf("GetReader error: %v", err)
+		}
+		value, err := reader.Get([]byte(key))
+		if err != nil {
+			t.Fatalf("Get error: %v", err)
+		}
+		if string(value) != wantValue {
+			t.Errorf("Expected value: %q, got: %q", wantValue, value)
+		}
+	}
+	keys := func(view *VersionedView, wantKeys ...string) {
+		t.Helper()
+		reader, err := view.GetReader(actor)
+		if err != nil {
+			t.Fatalf("GetReader error: %v", err)
+		}
+		iter, err := reader.Iterator(nil, nil)
+		if err != nil {
+			t.Fatalf("Iterator error: %v", err)
+		}
+		defer iter.Close()
+		var got []string
+		for ; iter.Valid(); iter.Next() {
+			got = append(got, string(iter.Key()))
+		}
+		if len(got) != len(wantKeys) {
+			t.Fatalf("Expected keys: %v, got: %v", wantKeys, got)
+		}
+		for i := range got {
+			if got[i] != wantKeys[i] {
+				t.Fatalf("Expected keys: %v, got: %v", wantKeys, got)
+			}
+		}
+	}
+	write := func(txIndex, incarnation int, changes ...store.KVPair) {
+		mv.Record(txIndex, incarnation, []store.StateChanges{{Actor: actor, StateChanges: changes}})
+	}
+
+	// tx 0 overwrites a and adds b, tx 2 deletes c
+	write(0, 1, store.KVPair{Key: []byte("a"), Value: []byte("tx0-a")}, store.KVPair{Key: []byte("b"), Value: []byte("tx0-b")})
+	write(2, 1, store.KVPair{Key: []byte("c"), Remove: true})
+
+	view0 := mv.View(0)
+	get(view0, "a", "base-a")
+	keys(view0, "a", "c")
+
+	view1 := mv.View(1)
+	get(view1, "a", "tx0-a")
+	get(view1, "c", "base-c")
+	keys(view1, "a", "b", "c")
+
+	view3 := mv.View(3)
+	get(view3, "b", "tx0-b")
+	get(view3, "c", "")
+	keys(view3, "a", "b")
+
+	for i, view := range []*VersionedView{view0, view1, view3} {
+		if !mv.Validate(view.txIndex, view.ReadSet()) {
+			t.Errorf("Expected reads of view %d to be valid", i)
+		}
+	}
+
+	// the re-execution of tx 0 no longer writes b
+	write(0, 2, store.KVPair{Key: []byte("a"), Value: []byte("tx0-a")})
+	if !mv.Validate(0, view0.ReadSet()) {
+		t.Error("Expected reads of tx 0 to be valid")
+	}
+	if mv.Validate(1, view1.ReadSet()) {
+		t.Error("Expected reads of tx 1 to be invalid")
+	}
+	if mv.Validate(3, view3.ReadSet()) {
+		t.Error("Expected reads of tx 3 to be invalid")
+	}
+	keys(mv.View(3), "a")
+
+	// tx 1 writes a key read only by tx 3 through an iterator
+	view3 = mv.View(3)
+	keys(view3, "a")
+	write(1, 1, store.KVPair{Key: []byte("d"), Value: []byte("tx1-d")})
+	if mv.Validate(3, view3.ReadSet()) {
+		t.Error("Expected iteration of tx 3 to be invalid")
+	}
+}
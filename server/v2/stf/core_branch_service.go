@@ -64,6 +64,7 @@ func (bs BranchService) execute(ctx *executionContext, f func(ctx context.Contex
 		makeGasMeteredStore: ctx.makeGasMeteredStore,
 		msgRouter:           ctx.msgRouter,
 		queryRouter:         ctx.queryRouter,
+		tracer:              ctx.tracer,
 	}
 
 	err := f(branchedCtx)
@@ -44,16 +44,24 @@ func (em *eventManager) Emit(tev transaction.Msg) error {
 		return err
 	}
 
-	em.executionContext.events = append(em.executionContext.events, res)
+	em.emit(res)
 	return nil
 }
 
 // EmitKV emits a key value pair event.
 func (em *eventManager) EmitKV(eventType string, attrs ...event.Attribute) error {
-	em.executionContext.events = append(em.executionContext.events, event.NewEvent(eventType, attrs...))
+	em.emit(event.NewEvent(eventType, attrs...))
 	return nil
 }
 
+// emit appends an event to the events of the execution context.
+func (em *eventManager) emit(e event.Event) {
+	em.executionContext.events = append(em.executionContext.events, e)
+	if em.executionContext.tracer != nil {
+		em.executionContext.tracer.traceEvent(e)
+	}
+}
+
 // TypedEventToEvent takes typed event and converts to Event object
 func TypedEventToEvent(tev transaction.Msg) (event.Event, error) {
 	evtType := gogoproto.MessageName(tev)
@@ -38,7 +38,7 @@ func (m msgRouterService) Invoke(ctx context.Context, msg transaction.Msg) (tran
 		return nil, err
 	}
 
-	return exCtx.msgRouter.Invoke(ctx, msg)
+	return exCtx.invoke(ctx, exCtx.msgRouter, msg)
 }
 
 // NewQueryRouterService implements router.Service.
@@ -70,5 +70,5 @@ func (m queryRouterService) Invoke(
 		return nil, err
 	}
 
-	return exCtx.queryRouter.Invoke(ctx, req)
+	return exCtx.invoke(ctx, exCtx.queryRouter, req)
 }
@@ -1,6 +1,9 @@
 package mock
 
 import (
+	"bytes"
+	"sort"
+
 	"cosmossdk.io/core/store"
 )
 
@@ -31,8 +34,55 @@ func (m memState) Get(bytes []byte) ([]byte, error) {
 	return m.kv[string(key)], nil
 }
 
-func (m memState) Iterator(start, end []byte) (store.Iterator, error) { panic("implement me") }
+func (m memState) Iterator(start, end []byte) (store.Iterator, error) {
+	return m.iterator(start, end, true), nil
+}
 
 func (m memState) ReverseIterator(start, end []byte) (store.Iterator, error) {
-	panic("implement me")
+	return m.iterator(start, end, false), nil
+}
+
+func (m memState) iterator(start, end []byte, ascending bool) *memIterator {
+	iter := &memIterator{start: start, end: end}
+	for k := range m.kv {
+		key := []byte(k)
+		if !bytes.HasPrefix(key, m.address) {
+			continue
+		}
+		key = key[len(m.address):]
+		if (start != nil && bytes.Compare(key, start) < 0) || (end != nil && bytes.Compare(key, end) >= 0) {
+			continue
+		}
+		iter.keys = append(iter.keys, key)
+	}
+	sort.Slice(iter.keys, func(i, j int) bool {
+		return bytes.Compare(iter.keys[i], iter.keys[j]) < 0 == ascending
+	})
+	for _, key := range iter.keys {
+		iter.values = append(iter.values, m.kv[string(m.address)+string(key)])
+	}
+	return iter
+}
+
+// memIterator iterates over a snapshot of the keys of a memState.
+type memIterator struct {
+	start, end []byte
+	keys       [][]byte
+	values     [][]byte
+}
+
+func (i *memIterator) Domain() (start, end []byte) { return i.start, i.end }
+
+func (i *memIterator) Valid() bool { return len(i.keys) > 0 }
+
+func (i *memIterator) Next() {
+	i.keys, i.values = i.keys[1:], i.values[1:]
 }
+
+func (i *memIterator) Key() []byte { return i.keys[0] }
+
+func (i *memIterator) Value() []byte { return i.values[0] }
+
+func (i *memIterator) Error() error { return nil }
+
+func (i *memIterator) Close() error { return nil }
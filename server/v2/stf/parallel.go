@@ -0,0 +1,159 @@
+package stf
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/server/v2/stf/branch"
+)
+
+// Option is an option of the STF.
+type Option func(*options)
+
+type options struct {
+	// parallelism is the number of workers executing the txs of a block,
+	// the txs are executed sequentially if it is lower than 2.
+	parallelism int
+}
+
+// WithParallelExecution enables the optimistic parallel execution of the txs of
+// a block (Block-STM) with the given number of workers, GOMAXPROCS if it is not
+// positive.
+//
+// The txs are executed in parallel against a multi-version view of the state and
+// the ones which read a value since written by a preceding tx are re-executed, so
+// that the block results and state changes are the same as the sequential ones.
+// The modules must not hold any state outside of the store, and must be safe to
+// be called concurrently.
+func WithParallelExecution(workers int) Option {
+	return func(o *options) {
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		o.parallelism = workers
+	}
+}
+
+// txExecution is the outcome of the latest incarnation of a tx.
+type txExecution struct {
+	incarnation int
+	result      server.TxResult
+	reads       branch.ReadSet
+	changes     []store.StateChanges
+	err         error
+}
+
+// deliverTxsParallel executes the txs of a block in parallel and applies their
+// state changes to the provided state in block order.
+//
+// The txs are executed in rounds. Each round executes the pending txs in parallel,
+// then commits the txs in block order as long as their reads are still valid. The
+// first invalid tx is re-executed right away, as its predecessors are committed
+// its new incarnation is valid and is committed as well. The next round executes
+// the txs whose reads were invalidated by the writes of the re-executed tx.
+// At least one tx is committed per round, the execution is sequential at worst.
+func (s STF[T]) deliverTxsParallel(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]server.TxResult, error) {
+	mv := branch.NewMultiVersionMap(state)
+	execs := make([]txExecution, len(txs))
+
+	execute := func(i int) {
+		view := mv.View(i)
+		txState := s.branchFn(view)
+		result := s.deliverTx(ctx, txState, txs[i], transaction.ExecModeFinalize, hi, int32(i+1))
+		changes, err := txState.GetStateChanges()
+		incarnation := execs[i].incarnation + 1
+		mv.Record(i, incarnation, changes)
+		execs[i] = txExecution{
+			incarnation: incarnation,
+			result:      result,
+			reads:       view.ReadSet(),
+			changes:     changes,
+			err:         err,
+		}
+	}
+
+	pending := make([]int, len(txs))
+	for i := range pending {
+		pending[i] = i
+	}
+	committed := 0
+	for committed < len(txs) {
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+		s.executeParallel(pending, execute)
+
+		for committed < len(txs) && mv.Validate(committed, execs[committed].reads) {
+			committed++
+		}
+		if committed == len(txs) {
+			break
+		}
+		execute(committed)
+		committed++
+
+		pending = pending[:0]
+		for i := committed; i < len(txs); i++ {
+			if !mv.Validate(i, execs[i].reads) {
+				pending = append(pending, i)
+			}
+		}
+	}
+
+	recorder := stateChangesRecorderFromContext(ctx)
+	txResults := make([]server.TxResult, len(txs))
+	for i, exec := range execs {
+		if exec.err != nil {
+			return nil, exec.err
+		}
+		if recorder != nil {
+			recorder(appdata.TxProcessingStage, int32(i+1), exec.changes)
+		}
+		if err := state.ApplyStateChanges(exec.changes); err != nil {
+			return nil, err
+		}
+		txResults[i] = exec.result
+	}
+	return txResults, nil
+}
+
+// executeParallel executes the given txs with the configured number of workers,
+// the txs are picked in ascending order.
+func (s STF[T]) executeParallel(txIndexes []int, execute func(i int)) {
+	var (
+		wg   sync.WaitGroup
+		mtx  sync.Mutex
+		next int
+	)
+	workers := min(s.options.parallelism, len(txIndexes))
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mtx.Lock()
+				if next == len(txIndexes) {
+					mtx.Unlock()
+					return
+				}
+				i := txIndexes[next]
+				next++
+				mtx.Unlock()
+
+				execute(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
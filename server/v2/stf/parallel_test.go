@@ -0,0 +1,354 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/stf/branch"
+	"cosmossdk.io/server/v2/stf/gas"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+var (
+	bankActor = []byte("bank")
+	authActor = []byte("auth")
+)
+
+func balanceKey(account string) []byte { return append([]byte("balance/"), account...) }
+
+func noError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func getUint(state store.Reader, key []byte) (uint64, error) {
+	bz, err := state.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func addUint(state store.Writer, key []byte, v int64) (uint64, error) {
+	current, err := getUint(state, key)
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 && current < uint64(-v) {
+		return current, fmt.Errorf("insufficient funds: %d < %d", current, -v)
+	}
+	next := uint64(int64(current) + v)
+	if next == 0 {
+		return next, state.Delete(key)
+	}
+	return next, state.Set(key, binary.BigEndian.AppendUint64(nil, next))
+}
+
+func emit(ctx context.Context, ty string, attrs ...event.Attribute) {
+	ctx.(*executionContext).events = append(ctx.(*executionContext).events, event.NewEvent(ty, attrs...))
+}
+
+// newBankSTF returns an STF moving balances between accounts. The tx validation
+// increments the nonce of the sender and charges a fee to a fee collector, the
+// messages are transfers, mints and sums of all the balances.
+func newBankSTF(t *testing.T, opts ...Option) *STF[mock.Tx] {
+	t.Helper()
+
+	b := NewMsgRouterBuilder()
+	// transfer of "to:amount" from the sender
+	noError(t, b.RegisterHandler(msgTypeURL(&gogotypes.StringValue{}), func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+		to, amount, _ := strings.Cut(msg.(*gogotypes.StringValue).Value, ":")
+		var value int64
+		if _, err := fmt.Sscan(amount, &value); err != nil {
+			return nil, err
+		}
+		from := string(ctx.(*executionContext).sender)
+
+		bank, err := ctx.(*executionContext).state.GetWriter(bankActor)
+		if err != nil {
+			return nil, err
+		}
+		left, err := addUint(bank, balanceKey(from), -value)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := addUint(bank, balanceKey(to), value); err != nil {
+			return nil, err
+		}
+		emit(ctx, "transfer", event.NewAttribute("from", from), event.NewAttribute("to", to))
+		return &gogotypes.UInt64Value{Value: left}, nil
+	}))
+	// mint to the sender
+	noError(t, b.RegisterHandler(msgTypeURL(&gogotypes.UInt64Value{}), func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+		bank, err := ctx.(*executionContext).state.GetWriter(bankActor)
+		if err != nil {
+			return nil, err
+		}
+		_, err = addUint(bank, balanceKey(string(ctx.(*executionContext).sender)), int64(msg.(*gogotypes.UInt64Value).Value))
+		return nil, err
+	}))
+	// sum of the balances, iterated in the given order
+	noError(t, b.RegisterHandler(msgTypeURL(&gogotypes.BoolValue{}), func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+		bank, err := ctx.(*executionContext).state.GetWriter(bankActor)
+		if err != nil {
+			return nil, err
+		}
+		iterate := bank.Iterator
+		if msg.(*gogotypes.BoolValue).Value {
+			iterate = bank.ReverseIterator
+		}
+		iter, err := iterate(balanceKey(""), balanceKey("\xff"))
+		if err != nil {
+			return nil, err
+		}
+		defer iter.Close()
+
+		var sum, accounts uint64
+		for ; iter.Valid(); iter.Next() {
+			sum += binary.BigEndian.Uint64(iter.Value())
+			accounts++
+		}
+		if err := bank.Set([]byte("total"), binary.BigEndian.AppendUint64(nil, sum)); err != nil {
+			return nil, err
+		}
+		emit(ctx, "sum", event.NewAttribute("accounts", fmt.Sprint(accounts)))
+		return &gogotypes.UInt64Value{Value: sum}, nil
+	}))
+	msgRouter, err := b.build()
+	noError(t, err)
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &STF[mock.Tx]{
+		msgRouter:   msgRouter,
+		queryRouter: msgRouter,
+		doPreBlock:  func(ctx context.Context, txs []mock.Tx) error { return nil },
+		doBeginBlock: func(ctx context.Context) error {
+			bank, err := ctx.(*executionContext).state.GetWriter(bankActor)
+			if err != nil {
+				return err
+			}
+			return bank.Delete([]byte("fees"))
+		},
+		doEndBlock: func(ctx context.Context) error {
+			bank, err := ctx.(*executionContext).state.GetWriter(bankActor)
+			if err != nil {
+				return err
+			}
+			fees, err := getUint(bank, []byte("fees"))
+			emit(ctx, "fees", event.NewAttribute("amount", fmt.Sprint(fees)))
+			return err
+		},
+		doValidatorUpdate: func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+		doTxValidation: func(ctx context.Context, tx mock.Tx) error {
+			auth, err := ctx.(*executionContext).state.GetWriter(authActor)
+			if err != nil {
+				return err
+			}
+			if _, err := addUint(auth, tx.Sender, 1); err != nil {
+				return err
+			}
+			// txs of senders prefixed with "payer" pay a fee, all the fees conflict
+			if bytes.HasPrefix(tx.Sender, []byte("payer")) {
+				bank, err := ctx.(*executionContext).state.GetWriter(bankActor)
+				if err != nil {
+					return err
+				}
+				if _, err := addUint(bank, []byte("fees"), 1); err != nil {
+					return err
+				}
+			}
+			emit(ctx, "validate", event.NewAttribute("sender", string(tx.Sender)))
+			return nil
+		},
+		postTxExec: func(ctx context.Context, tx mock.Tx, success bool) error {
+			if success {
+				return nil
+			}
+			auth, err := ctx.(*executionContext).state.GetWriter(authActor)
+			if err != nil {
+				return err
+			}
+			_, err = addUint(auth, append([]byte("failed/"), tx.Sender...), 1)
+			return err
+		},
+		branchFn:            branch.DefaultNewWriterMap,
+		makeGasMeter:        gas.DefaultGasMeter,
+		makeGasMeteredState: gas.DefaultWrapWithGasMeter,
+		options:             o,
+	}
+}
+
+// randomBankTxs returns random txs of the given number of accounts, the fewer
+// accounts the more conflicts between the txs.
+func randomBankTxs(r *rand.Rand, n, accounts int) []mock.Tx {
+	account := func() string {
+		prefix := "acc"
+		if r.Intn(4) == 0 {
+			prefix = "payer"
+		}
+		return fmt.Sprintf("%s%d", prefix, r.Intn(accounts))
+	}
+
+	txs := make([]mock.Tx, n)
+	for i := range txs {
+		tx := mock.Tx{Sender: []byte(account()), GasLimit: 1_000_000}
+		switch k := r.Intn(20); {
+		case k < 14:
+			tx.Msg = &gogotypes.StringValue{Value: fmt.Sprintf("%s:%d", account(), r.Intn(600))}
+		case k < 17:
+			tx.Msg = &gogotypes.UInt64Value{Value: uint64(r.Intn(100))}
+		case k < 19:
+			tx.Msg = &gogotypes.BoolValue{Value: k == 18}
+		default:
+			// runs out of gas
+			tx.Msg = &gogotypes.StringValue{Value: fmt.Sprintf("%s:%d", account(), r.Intn(600))}
+			tx.GasLimit = 8_000
+		}
+		txs[i] = tx
+	}
+	return txs
+}
+
+// blockOutcome is the comparable outcome of the execution of a block.
+type blockOutcome struct {
+	Events    [][]string
+	TxResults []txOutcome
+	Changes   []store.StateChanges
+}
+
+type txOutcome struct {
+	Events    [][]string
+	GasUsed   uint64
+	GasWanted uint64
+	Resp      []transaction.Msg
+	Error     string
+}
+
+func eventsOutcome(t *testing.T, events []event.Event) [][]string {
+	t.Helper()
+	out := make([][]string, len(events))
+	for i, e := range events {
+		attrs, err := e.Attributes()
+		noError(t, err)
+		out[i] = []string{e.Type, fmt.Sprint(e.BlockStage, e.TxIndex, e.MsgIndex, e.EventIndex), fmt.Sprint(attrs)}
+	}
+	return out
+}
+
+func newBlockOutcome(t *testing.T, resp *server.BlockResponse, state store.WriterMap) blockOutcome {
+	t.Helper()
+	outcome := blockOutcome{}
+	for _, events := range [][]event.Event{resp.PreBlockEvents, resp.BeginBlockEvents, resp.EndBlockEvents} {
+		outcome.Events = append(outcome.Events, eventsOutcome(t, events)...)
+	}
+	for _, res := range resp.TxResults {
+		tx := txOutcome{
+			Events:    eventsOutcome(t, res.Events),
+			GasUsed:   res.GasUsed,
+			GasWanted: res.GasWanted,
+			Resp:      res.Resp,
+		}
+		if res.Error != nil {
+			tx.Error = res.Error.Error()
+		}
+		outcome.TxResults = append(outcome.TxResults, tx)
+	}
+
+	changes, err := state.GetStateChanges()
+	noError(t, err)
+	sort.Slice(changes, func(i, j int) bool { return bytes.Compare(changes[i].Actor, changes[j].Actor) < 0 })
+	outcome.Changes = changes
+	return outcome
+}
+
+// TestParallelExecutionDeterminism executes random blocks both sequentially and
+// in parallel, and checks that the results and the state changes are the same.
+func TestParallelExecutionDeterminism(t *testing.T) {
+	sequential := newBankSTF(t)
+
+	for _, tc := range []struct {
+		accounts int
+		workers  int
+	}{
+		{accounts: 2, workers: 4},    // conflicting txs
+		{accounts: 10, workers: 8},   // some conflicts
+		{accounts: 1000, workers: 8}, // barely any conflict
+		{accounts: 10, workers: 0},
+	} {
+		t.Run(fmt.Sprintf("accounts=%d/workers=%d", tc.accounts, tc.workers), func(t *testing.T) {
+			parallel := newBankSTF(t, WithParallelExecution(tc.workers))
+			for seed := int64(0); seed < 5; seed++ {
+				r := rand.New(rand.NewSource(seed))
+
+				// fund the accounts
+				var mints []mock.Tx
+				for i := 0; i < tc.accounts && i < 20; i++ {
+					for _, prefix := range []string{"acc", "payer"} {
+						mints = append(mints, mock.Tx{
+							Sender:   []byte(fmt.Sprintf("%s%d", prefix, i)),
+							Msg:      &gogotypes.UInt64Value{Value: 1000},
+							GasLimit: 1_000_000,
+						})
+					}
+				}
+				_, state, err := sequential.DeliverBlock(context.Background(), &server.BlockRequest[mock.Tx]{
+					Height:  1,
+					Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+					AppHash: make([]byte, 32),
+					Hash:    make([]byte, 32),
+					Txs:     mints,
+				}, mock.DB())
+				noError(t, err)
+
+				block := &server.BlockRequest[mock.Tx]{
+					Height:  2,
+					Time:    time.Date(2024, 2, 3, 18, 23, 5, 0, time.UTC),
+					AppHash: make([]byte, 32),
+					Hash:    make([]byte, 32),
+					Txs:     randomBankTxs(r, 200, tc.accounts),
+				}
+				seqResp, seqState, err := sequential.DeliverBlock(context.Background(), block, state)
+				noError(t, err)
+				parResp, parState, err := parallel.DeliverBlock(context.Background(), block, state)
+				noError(t, err)
+
+				expected := newBlockOutcome(t, seqResp, seqState)
+				if actual := newBlockOutcome(t, parResp, parState); !reflect.DeepEqual(expected, actual) {
+					t.Fatalf("seed %d: parallel outcome differs from the sequential one\nexpected: %+v\nactual: %+v", seed, expected, actual)
+				}
+
+				// the outcome must not be trivial
+				var failed int
+				for _, res := range expected.TxResults {
+					if res.Error != "" {
+						failed++
+					}
+				}
+				if failed == 0 || failed == len(expected.TxResults) {
+					t.Fatalf("seed %d: expected some txs to fail, %d failed", seed, failed)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package stf
+
+import (
+	"context"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/schema/appdata"
+)
+
+type stateChangesRecorderKey struct{}
+
+// StateChangesRecorder is called by DeliverBlock with the state changes of each
+// stage of the block, in execution order: the pre block, the begin block, each
+// tx with its index starting from 1, and the end block. The txIndex is 0 for the
+// stages other than the txs.
+type StateChangesRecorder func(stage appdata.BlockStage, txIndex int32, changes []store.StateChanges)
+
+// ContextWithStateChangesRecorder returns a context recording the state changes
+// of the blocks delivered with it, e.g. to pinpoint the origin of an app hash
+// mismatch. Each stage of the block is executed on its own branch of the block
+// state, the resulting state changes are the same.
+func ContextWithStateChangesRecorder(ctx context.Context, recorder StateChangesRecorder) context.Context {
+	return context.WithValue(ctx, stateChangesRecorderKey{}, recorder)
+}
+
+// stateChangesRecorderFromContext returns the recorder of the context, nil if none.
+func stateChangesRecorderFromContext(ctx context.Context) StateChangesRecorder {
+	recorder, _ := ctx.Value(stateChangesRecorderKey{}).(StateChangesRecorder)
+	return recorder
+}
+
+// recordStateChanges records the state changes of a stage executed on a branch of
+// the block state, then applies them to the block state.
+func recordStateChanges(
+	recorder StateChangesRecorder,
+	stage appdata.BlockStage,
+	txIndex int32,
+	blockState, stageState store.WriterMap,
+) error {
+	changes, err := stageState.GetStateChanges()
+	if err != nil {
+		return err
+	}
+	recorder(stage, txIndex, changes)
+	return blockState.ApplyStateChanges(changes)
+}
@@ -0,0 +1,99 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/schema/appdata"
+	"cosmossdk.io/server/v2/stf/branch"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+type recordedStage struct {
+	stage   appdata.BlockStage
+	txIndex int32
+	changes []store.StateChanges
+}
+
+// TestStateChangesRecorder checks that the recorded state changes of the stages
+// of a block add up to the state changes of the block, which are not altered by
+// the recording.
+func TestStateChangesRecorder(t *testing.T) {
+	txs := []mock.Tx{
+		{Sender: []byte("payer0"), Msg: &gogotypes.UInt64Value{Value: 1000}, GasLimit: 1_000_000},
+		{Sender: []byte("acc0"), Msg: &gogotypes.UInt64Value{Value: 1000}, GasLimit: 1_000_000},
+	}
+	txs = append(txs, randomBankTxs(rand.New(rand.NewSource(0)), 50, 2)...)
+	block := &server.BlockRequest[mock.Tx]{
+		Height:  2,
+		Time:    time.Date(2024, 2, 3, 18, 23, 5, 0, time.UTC),
+		AppHash: make([]byte, 32),
+		Hash:    make([]byte, 32),
+		Txs:     txs,
+	}
+
+	for name, s := range map[string]*STF[mock.Tx]{
+		"sequential": newBankSTF(t),
+		"parallel":   newBankSTF(t, WithParallelExecution(4)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, state, err := s.DeliverBlock(context.Background(), block, mock.DB())
+			noError(t, err)
+
+			var stages []recordedStage
+			ctx := ContextWithStateChangesRecorder(context.Background(), func(stage appdata.BlockStage, txIndex int32, changes []store.StateChanges) {
+				stages = append(stages, recordedStage{stage: stage, txIndex: txIndex, changes: changes})
+			})
+			recordedResp, recordedState, err := s.DeliverBlock(ctx, block, mock.DB())
+			noError(t, err)
+
+			expected := newBlockOutcome(t, resp, state)
+			if actual := newBlockOutcome(t, recordedResp, recordedState); !reflect.DeepEqual(expected, actual) {
+				t.Fatalf("recorded outcome differs\nexpected: %+v\nactual: %+v", expected, actual)
+			}
+
+			if len(stages) != len(txs)+3 {
+				t.Fatalf("expected %d recorded stages, got %d", len(txs)+3, len(stages))
+			}
+			if stages[0].stage != appdata.PreBlockStage || stages[1].stage != appdata.BeginBlockStage ||
+				stages[len(stages)-1].stage != appdata.EndBlockStage {
+				t.Fatalf("unexpected block stages %v, %v, %v", stages[0].stage, stages[1].stage, stages[len(stages)-1].stage)
+			}
+			for i, stage := range stages[2 : len(stages)-1] {
+				if stage.stage != appdata.TxProcessingStage || stage.txIndex != int32(i+1) {
+					t.Fatalf("unexpected stage %v of tx %d at index %d", stage.stage, stage.txIndex, i+1)
+				}
+			}
+
+			// the header info is set before the first stage
+			hi, err := s.getHeaderInfo(recordedState)
+			noError(t, err)
+			replayed := branch.DefaultNewWriterMap(mock.DB())
+			noError(t, s.setHeaderInfo(replayed, hi))
+			for _, stage := range stages {
+				noError(t, replayed.ApplyStateChanges(stage.changes))
+			}
+			expectedChanges := sortedStateChanges(t, recordedState)
+			if actual := sortedStateChanges(t, replayed); !reflect.DeepEqual(expectedChanges, actual) {
+				t.Fatalf("recorded state changes differ\nexpected: %+v\nactual: %+v", expectedChanges, actual)
+			}
+		})
+	}
+}
+
+func sortedStateChanges(t *testing.T, state store.WriterMap) []store.StateChanges {
+	t.Helper()
+	changes, err := state.GetStateChanges()
+	noError(t, err)
+	sort.Slice(changes, func(i, j int) bool { return bytes.Compare(changes[i].Actor, changes[j].Actor) < 0 })
+	return changes
+}
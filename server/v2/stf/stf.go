@@ -42,6 +42,8 @@ type STF[T transaction.Tx] struct {
 	branchFn            branchFn // branchFn is a function that given a readonly state it returns a writable version of it.
 	makeGasMeter        makeGasMeterFn
 	makeGasMeteredState makeGasMeteredStateFn
+
+	options options
 }
 
 // NewSTF returns a new STF instance.
@@ -56,6 +58,7 @@ func NewSTF[T transaction.Tx](
 	doValidatorUpdate func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error),
 	postTxExec func(ctx context.Context, tx T, success bool) error,
 	branch func(store store.ReaderMap) store.WriterMap,
+	opts ...Option,
 ) (*STF[T], error) {
 	msgRouter, err := msgRouterBuilder.build()
 	if err != nil {
@@ -66,6 +69,11 @@ func NewSTF[T transaction.Tx](
 		return nil, fmt.Errorf("build query router: %w", err)
 	}
 
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return &STF[T]{
 		logger:              logger,
 		msgRouter:           msgRouter,
@@ -79,6 +87,7 @@ func NewSTF[T transaction.Tx](
 		branchFn:            branch,
 		makeGasMeter:        stfgas.DefaultGasMeter,
 		makeGasMeteredState: stfgas.DefaultWrapWithGasMeter,
+		options:             o,
 	}, nil
 }
 
@@ -108,11 +117,16 @@ func (s STF[T]) DeliverBlock(
 
 	exCtx := s.makeContext(ctx, ConsensusIdentity, newState, internal.ExecModeFinalize)
 	exCtx.setHeaderInfo(hi)
+	recorder := stateChangesRecorderFromContext(ctx)
 
 	// reset events
 	exCtx.events = make([]event.Event, 0)
 	// pre block is called separate from begin block in order to prepopulate state
-	preBlockEvents, err := s.preBlock(exCtx, block.Txs)
+	var preBlockEvents []event.Event
+	err = s.runBlockStage(exCtx, recorder, appdata.PreBlockStage, func(stageCtx *executionContext) (err error) {
+		preBlockEvents, err = s.preBlock(stageCtx, block.Txs)
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -127,7 +141,10 @@ func (s STF[T]) DeliverBlock(
 	var beginBlockEvents []event.Event
 	if !block.IsGenesis {
 		// begin block
-		beginBlockEvents, err = s.beginBlock(exCtx)
+		err = s.runBlockStage(exCtx, recorder, appdata.BeginBlockStage, func(stageCtx *executionContext) (err error) {
+			beginBlockEvents, err = s.beginBlock(stageCtx)
+			return err
+		})
 		if err != nil {
 			return nil, nil, err
 		}
@@ -141,17 +158,46 @@ func (s STF[T]) DeliverBlock(
 	// execute txs
 	txResults := make([]server.TxResult, len(block.Txs))
 	// TODO: skip first tx if vote extensions are enabled (marko)
-	for i, txBytes := range block.Txs {
-		// check if we need to return early or continue delivering txs
-		if err = isCtxCancelled(ctx); err != nil {
+	traceReq, traced := ctx.Value(txTraceKey{}).(txTraceRequest)
+	if s.options.parallelism > 1 && len(block.Txs) > 1 && !traced {
+		txResults, err = s.deliverTxsParallel(exCtx, newState, block.Txs, hi)
+		if err != nil {
 			return nil, nil, err
 		}
-		txResults[i] = s.deliverTx(exCtx, newState, txBytes, transaction.ExecModeFinalize, hi, int32(i+1))
+	} else {
+		for i, txBytes := range block.Txs {
+			// check if we need to return early or continue delivering txs
+			if err = isCtxCancelled(ctx); err != nil {
+				return nil, nil, err
+			}
+			// the execution of the traced tx is recorded
+			exCtx.tracer = nil
+			if traced && traceReq.txIndex == int32(i+1) {
+				exCtx.tracer = &txTracer{trace: traceReq.trace}
+			}
+			if recorder == nil {
+				txResults[i] = s.deliverTx(exCtx, newState, txBytes, transaction.ExecModeFinalize, hi, int32(i+1))
+				continue
+			}
+			txState := s.branchFn(newState)
+			txResults[i] = s.deliverTx(exCtx, txState, txBytes, transaction.ExecModeFinalize, hi, int32(i+1))
+			if err = recordStateChanges(recorder, appdata.TxProcessingStage, int32(i+1), newState, txState); err != nil {
+				return nil, nil, err
+			}
+		}
+		exCtx.tracer = nil
 	}
 	// reset events
 	exCtx.events = make([]event.Event, 0)
 	// end block
-	endBlockEvents, valset, err := s.endBlock(exCtx)
+	var (
+		endBlockEvents []event.Event
+		valset         []appmodulev2.ValidatorUpdate
+	)
+	err = s.runBlockStage(exCtx, recorder, appdata.EndBlockStage, func(stageCtx *executionContext) (err error) {
+		endBlockEvents, valset, err = s.endBlock(stageCtx)
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -165,6 +211,27 @@ func (s STF[T]) DeliverBlock(
 	}, newState, nil
 }
 
+// runBlockStage runs a stage of the block with the block execution context. When
+// the state changes are recorded, the stage runs on a branch of the block state.
+func (s STF[T]) runBlockStage(
+	exCtx *executionContext,
+	recorder StateChangesRecorder,
+	stage appdata.BlockStage,
+	run func(stageCtx *executionContext) error,
+) error {
+	if recorder == nil {
+		return run(exCtx)
+	}
+
+	stageState := s.branchFn(exCtx.unmeteredState)
+	stageCtx := s.makeContext(exCtx.Context, ConsensusIdentity, stageState, internal.ExecModeFinalize)
+	stageCtx.setHeaderInfo(exCtx.headerInfo)
+	if err := run(stageCtx); err != nil {
+		return err
+	}
+	return recordStateChanges(recorder, stage, 0, exCtx.unmeteredState, stageState)
+}
+
 // deliverTx executes a TX and returns the result.
 func (s STF[T]) deliverTx(
 	ctx context.Context,
@@ -243,6 +310,7 @@ func (s STF[T]) validateTx(
 		return 0, nil, err
 	}
 	validateCtx := s.makeContext(ctx, RuntimeIdentity, validateState, execMode)
+	validateCtx.tracer.setPhase(TracePhaseValidate)
 	validateCtx.setHeaderInfo(hi)
 	validateCtx.setGasLimit(gasLimit)
 	err = s.doTxValidation(validateCtx, tx)
@@ -264,12 +332,15 @@ func (s STF[T]) execTx(
 	execMode transaction.ExecMode,
 	hi header.Info,
 ) ([]transaction.Msg, uint64, []event.Event, error) {
+	tracer := txTracerFromContext(ctx)
+	tracer.setPhase(TracePhaseExec)
 	execState := s.branchFn(state)
 
 	msgsResp, gasUsed, runTxMsgsEvents, txErr := s.runTxMsgs(ctx, execState, gasLimit, tx, execMode, hi)
 	if txErr != nil {
 		// in case of error during message execution, we do not apply the exec state.
 		// instead we run the post exec handler in a new branchFn from the initial state.
+		tracer.setPhase(TracePhasePostTxExec)
 		postTxState := s.branchFn(state)
 		postTxCtx := s.makeContext(ctx, RuntimeIdentity, postTxState, execMode)
 		postTxCtx.setHeaderInfo(hi)
@@ -297,6 +368,7 @@ func (s STF[T]) execTx(
 	// tx execution went fine, now we use the same state to run the post tx exec handler,
 	// in case the execution of the post tx fails, then no state change is applied and the
 	// whole execution step is rolled back.
+	tracer.setPhase(TracePhasePostTxExec)
 	postTxCtx := s.makeContext(ctx, RuntimeIdentity, execState, execMode) // NO gas limit.
 	postTxCtx.setHeaderInfo(hi)
 	postTxErr := s.postTxExec(postTxCtx, tx, true)
@@ -346,7 +418,7 @@ func (s STF[T]) runTxMsgs(
 	for i, msg := range msgs {
 		execCtx.sender = txSenders[i]
 		execCtx.events = make([]event.Event, 0) // reset events
-		resp, err := s.msgRouter.Invoke(execCtx, msg)
+		resp, err := execCtx.invoke(execCtx, s.msgRouter, msg)
 		if err != nil {
 			return nil, 0, nil, err // do not wrap the error or we lose the original error type
 		}
@@ -465,6 +537,30 @@ func (s STF[T]) ValidateTx(
 	}
 }
 
+// ValidateTxs runs the validation steps of the transactions in order over a single
+// branch of the provided state, so that the validation of a transaction sees the
+// changes of the previous valid ones, e.g. the sequence of their signers. The
+// branch is returned to validate subsequent transactions on top of it.
+func (s STF[T]) ValidateTxs(
+	ctx context.Context,
+	state store.ReaderMap,
+	gasLimit uint64,
+	txs []T,
+) ([]server.TxResult, store.WriterMap) {
+	validationState := s.branchFn(state)
+	results := make([]server.TxResult, len(txs))
+	for i, tx := range txs {
+		gasUsed, events, err := s.validateTx(ctx, validationState, gasLimit, tx, transaction.ExecModeCheck)
+		results[i] = server.TxResult{
+			Events:  events,
+			GasUsed: gasUsed,
+			Error:   err,
+		}
+	}
+
+	return results, validationState
+}
+
 // Query executes the query on the provided state with the provided gas limits.
 func (s STF[T]) Query(
 	ctx context.Context,
@@ -498,6 +594,7 @@ func (s STF[T]) clone() STF[T] {
 		branchFn:            s.branchFn,
 		makeGasMeter:        s.makeGasMeter,
 		makeGasMeteredState: s.makeGasMeteredState,
+		options:             s.options,
 	}
 }
 
@@ -527,6 +624,10 @@ type executionContext struct {
 
 	msgRouter   router.Service
 	queryRouter router.Service
+
+	// tracer records the execution of the traced tx, it is nil if the execution
+	// is not traced.
+	tracer *txTracer
 }
 
 // setHeaderInfo sets the header info in the state to be used by queries in the future.
@@ -568,6 +669,10 @@ func (s STF[T]) makeContext(
 	execMode transaction.ExecMode,
 ) *executionContext {
 	valuedCtx := context.WithValue(ctx, corecontext.ExecModeKey, execMode)
+	var tracer *txTracer
+	if parent, ok := ctx.(*executionContext); ok {
+		tracer = parent.tracer
+	}
 	return newExecutionContext(
 		valuedCtx,
 		s.makeGasMeter,
@@ -578,6 +683,7 @@ func (s STF[T]) makeContext(
 		execMode,
 		s.msgRouter,
 		s.queryRouter,
+		tracer,
 	)
 }
 
@@ -591,7 +697,12 @@ func newExecutionContext(
 	execMode transaction.ExecMode,
 	msgRouter coreRouterImpl,
 	queryRouter coreRouterImpl,
+	tracer *txTracer,
 ) *executionContext {
+	// the store operations of a traced tx are recorded
+	if tracer != nil {
+		makeGasMeteredStoreFn = traceGasMeteredState(tracer, makeGasMeteredStoreFn)
+	}
 	meter := makeGasMeterFn(gas.NoGasLimit)
 	meteredState := makeGasMeteredStoreFn(meter, state)
 
@@ -609,6 +720,7 @@ func newExecutionContext(
 		makeGasMeteredStore: makeGasMeteredStoreFn,
 		msgRouter:           msgRouter,
 		queryRouter:         queryRouter,
+		tracer:              tracer,
 	}
 }
 
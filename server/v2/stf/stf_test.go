@@ -449,6 +449,35 @@ func TestSTF(t *testing.T) {
 			t.Errorf("validateTx error: %v", err)
 		}
 	})
+
+	t.Run("validate txs on a single state", func(t *testing.T) {
+		// the validation of a tx fails if a previous one was validated
+		s := s.clone()
+		s.doTxValidation = func(ctx context.Context, tx mock.Tx) error {
+			state, err := ctx.(*executionContext).state.GetWriter(actorName)
+			if err != nil {
+				return err
+			}
+			validated, err := state.Has([]byte("validate"))
+			if err != nil {
+				return err
+			}
+			if validated {
+				return errors.New("sequence already used")
+			}
+			kvSet(t, ctx, "validate")
+			return nil
+		}
+		results, newState := s.ValidateTxs(context.Background(), state, mockTx.GasLimit, []mock.Tx{mockTx, mockTx})
+		if results[0].Error != nil {
+			t.Errorf("Expected no error, got %v", results[0].Error)
+		}
+		if results[1].Error == nil || !strings.Contains(results[1].Error.Error(), "sequence already used") {
+			t.Errorf("Expected error to contain 'sequence already used', got %v", results[1].Error)
+		}
+		stateHas(t, newState, "validate")
+		stateNotHas(t, state, "validate")
+	})
 }
 
 var actorName = []byte("cookies")
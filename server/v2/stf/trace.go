@@ -0,0 +1,281 @@
+package stf
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/gas"
+	"cosmossdk.io/core/router"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+// TraceStepKind is the kind of an operation of a tx execution trace.
+type TraceStepKind string
+
+const (
+	// TraceStepCall is a message or query routed by the router, the steps of the
+	// call follow it with a greater depth.
+	TraceStepCall TraceStepKind = "call"
+	// TraceStepEvent is an emitted event.
+	TraceStepEvent TraceStepKind = "event"
+	// TraceStepGet is a read of a key.
+	TraceStepGet TraceStepKind = "get"
+	// TraceStepHas is a check of the existence of a key.
+	TraceStepHas TraceStepKind = "has"
+	// TraceStepSet is a write of a key.
+	TraceStepSet TraceStepKind = "set"
+	// TraceStepDelete is a removal of a key.
+	TraceStepDelete TraceStepKind = "delete"
+	// TraceStepIterator is the creation of an iterator over the [Key, Value) range.
+	TraceStepIterator TraceStepKind = "iterator"
+	// TraceStepNext is a move of an iterator to the next key.
+	TraceStepNext TraceStepKind = "next"
+)
+
+// TracePhase is the phase of the execution of a tx.
+type TracePhase string
+
+const (
+	TracePhaseValidate   TracePhase = "validate"
+	TracePhaseExec       TracePhase = "exec"
+	TracePhasePostTxExec TracePhase = "post_tx_exec"
+)
+
+// TxTrace is the execution trace of a tx.
+type TxTrace struct {
+	Steps []TraceStep
+}
+
+// TraceStep is an operation of the execution of a tx.
+type TraceStep struct {
+	Kind  TraceStepKind
+	Phase TracePhase
+	// Depth is the number of router calls the operation is nested in.
+	Depth int
+	// TypeURL is the type of the message of a call or the type of an event.
+	TypeURL string
+	// Actor is the store of a store operation.
+	Actor []byte
+	// Key and Value are the key and value read or written by a store operation.
+	Key   []byte
+	Value []byte
+	// Gas is the gas consumed by a store operation, or by a call and its steps.
+	Gas gas.Gas
+	// Attributes are the attributes of an event.
+	Attributes []event.Attribute
+	// Error is the error of a call or of a store operation.
+	Error string
+}
+
+type txTraceKey struct{}
+
+// txTraceRequest is the trace requested for a tx of a block.
+type txTraceRequest struct {
+	txIndex int32
+	trace   *TxTrace
+}
+
+// ContextWithTxTrace returns a context recording in trace the execution of the tx
+// of the given index, starting from 1, of the blocks delivered with it. The txs
+// of a traced block are executed sequentially.
+func ContextWithTxTrace(ctx context.Context, txIndex int32, trace *TxTrace) context.Context {
+	return context.WithValue(ctx, txTraceKey{}, txTraceRequest{txIndex: txIndex, trace: trace})
+}
+
+// txTracer records the trace of the tx being executed, it is set on the
+// execution contexts of the tx.
+type txTracer struct {
+	trace *TxTrace
+	phase TracePhase
+	depth int
+}
+
+// txTracerFromContext returns the tracer of the execution context, nil if the
+// execution is not traced.
+func txTracerFromContext(ctx context.Context) *txTracer {
+	if exCtx, ok := ctx.(*executionContext); ok {
+		return exCtx.tracer
+	}
+	return nil
+}
+
+// setPhase sets the phase of the next steps, it is a no-op on a nil tracer.
+func (t *txTracer) setPhase(phase TracePhase) {
+	if t != nil {
+		t.phase = phase
+	}
+}
+
+// record appends a step to the trace and returns its index.
+func (t *txTracer) record(step TraceStep) int {
+	step.Phase = t.phase
+	step.Depth = t.depth
+	t.trace.Steps = append(t.trace.Steps, step)
+	return len(t.trace.Steps) - 1
+}
+
+// invoke routes req with the router, recording the call if the execution is
+// traced.
+func (e *executionContext) invoke(ctx context.Context, r router.Service, req transaction.Msg) (transaction.Msg, error) {
+	if e.tracer == nil {
+		return r.Invoke(ctx, req)
+	}
+	return e.tracer.traceCall(ctx, e.meter, req, r.Invoke)
+}
+
+// traceCall records a router call, and the gas it consumed and its error once it
+// returns.
+func (t *txTracer) traceCall(
+	ctx context.Context,
+	meter gas.Meter,
+	req transaction.Msg,
+	invoke func(ctx context.Context, req transaction.Msg) (transaction.Msg, error),
+) (transaction.Msg, error) {
+	consumed := meter.Consumed()
+
+	i := t.record(TraceStep{Kind: TraceStepCall, TypeURL: msgTypeURL(req)})
+	t.depth++
+	res, err := invoke(ctx, req)
+	t.depth--
+
+	t.trace.Steps[i].Gas = meter.Consumed() - consumed
+	if err != nil {
+		t.trace.Steps[i].Error = err.Error()
+	}
+	return res, err
+}
+
+// traceEvent records an emitted event.
+func (t *txTracer) traceEvent(e event.Event) {
+	attrs, err := e.Attributes()
+	step := TraceStep{Kind: TraceStepEvent, TypeURL: e.Type, Attributes: attrs}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	t.record(step)
+}
+
+// tracedWriterMap records the store operations on a gas metered state.
+type tracedWriterMap struct {
+	store.WriterMap
+	tracer *txTracer
+	meter  gas.Meter
+}
+
+// traceGasMeteredState wraps a makeGasMeteredStateFn so that the operations on
+// the metered state are recorded.
+func traceGasMeteredState(tracer *txTracer, makeGasMeteredState makeGasMeteredStateFn) makeGasMeteredStateFn {
+	return func(meter gas.Meter, state store.WriterMap) store.WriterMap {
+		return tracedWriterMap{WriterMap: makeGasMeteredState(meter, state), tracer: tracer, meter: meter}
+	}
+}
+
+func (m tracedWriterMap) GetReader(actor []byte) (store.Reader, error) {
+	return m.GetWriter(actor)
+}
+
+func (m tracedWriterMap) GetWriter(actor []byte) (store.Writer, error) {
+	w, err := m.WriterMap.GetWriter(actor)
+	if err != nil {
+		return nil, err
+	}
+	return tracedStore{Writer: w, actor: actor, tracer: m.tracer, meter: m.meter}, nil
+}
+
+// tracedStore records the operations on the store of an actor.
+type tracedStore struct {
+	store.Writer
+	actor  []byte
+	tracer *txTracer
+	meter  gas.Meter
+}
+
+// trace runs a store operation and records it with the gas it consumed.
+func (s tracedStore) trace(kind TraceStepKind, key, value []byte, op func() ([]byte, error)) error {
+	consumed := s.meter.Consumed()
+	read, err := op()
+	if read != nil {
+		value = read
+	}
+	step := TraceStep{
+		Kind:  kind,
+		Actor: s.actor,
+		Key:   key,
+		Value: value,
+		Gas:   s.meter.Consumed() - consumed,
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	s.tracer.record(step)
+	return err
+}
+
+func (s tracedStore) Get(key []byte) (value []byte, err error) {
+	err = s.trace(TraceStepGet, key, nil, func() ([]byte, error) {
+		value, err = s.Writer.Get(key)
+		return value, err
+	})
+	return value, err
+}
+
+func (s tracedStore) Has(key []byte) (has bool, err error) {
+	err = s.trace(TraceStepHas, key, nil, func() ([]byte, error) {
+		has, err = s.Writer.Has(key)
+		return nil, err
+	})
+	return has, err
+}
+
+func (s tracedStore) Set(key, value []byte) error {
+	return s.trace(TraceStepSet, key, value, func() ([]byte, error) {
+		return nil, s.Writer.Set(key, value)
+	})
+}
+
+func (s tracedStore) Delete(key []byte) error {
+	return s.trace(TraceStepDelete, key, nil, func() ([]byte, error) {
+		return nil, s.Writer.Delete(key)
+	})
+}
+
+func (s tracedStore) Iterator(start, end []byte) (iter store.Iterator, err error) {
+	err = s.trace(TraceStepIterator, start, end, func() ([]byte, error) {
+		iter, err = s.Writer.Iterator(start, end)
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tracedIterator{Iterator: iter, store: s}, nil
+}
+
+func (s tracedStore) ReverseIterator(start, end []byte) (iter store.Iterator, err error) {
+	err = s.trace(TraceStepIterator, start, end, func() ([]byte, error) {
+		iter, err = s.Writer.ReverseIterator(start, end)
+		return nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tracedIterator{Iterator: iter, store: s}, nil
+}
+
+// tracedIterator records the moves of an iterator, with the key and value it
+// moves to.
+type tracedIterator struct {
+	store.Iterator
+	store tracedStore
+}
+
+func (it tracedIterator) Next() {
+	_ = it.store.trace(TraceStepNext, nil, nil, func() ([]byte, error) {
+		it.Iterator.Next()
+		return nil, nil
+	})
+	if steps := it.store.tracer.trace.Steps; it.Iterator.Valid() {
+		steps[len(steps)-1].Key = it.Iterator.Key()
+		steps[len(steps)-1].Value = it.Iterator.Value()
+	}
+}
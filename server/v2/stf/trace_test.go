@@ -0,0 +1,110 @@
+package stf
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+func TestTxTrace(t *testing.T) {
+	block := &server.BlockRequest[mock.Tx]{
+		Height:  2,
+		Time:    time.Date(2024, 2, 3, 18, 23, 5, 0, time.UTC),
+		AppHash: make([]byte, 32),
+		Hash:    make([]byte, 32),
+		Txs: []mock.Tx{
+			{Sender: []byte("acc0"), Msg: &gogotypes.UInt64Value{Value: 1000}, GasLimit: 1_000_000},
+			{Sender: []byte("acc0"), Msg: &gogotypes.StringValue{Value: "acc1:10"}, GasLimit: 1_000_000},
+			{Sender: []byte("acc1"), Msg: &gogotypes.StringValue{Value: "acc0:1"}, GasLimit: 1_000_000},
+		},
+	}
+
+	var expected *TxTrace
+	for name, s := range map[string]*STF[mock.Tx]{
+		"sequential": newBankSTF(t),
+		"parallel":   newBankSTF(t, WithParallelExecution(4)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			trace := &TxTrace{}
+			resp, _, err := s.DeliverBlock(ContextWithTxTrace(context.Background(), 2, trace), block, mock.DB())
+			noError(t, err)
+			if resp.TxResults[1].Error != nil {
+				t.Fatal(resp.TxResults[1].Error)
+			}
+
+			// the tx nonce is incremented in the validation
+			if len(trace.Steps) == 0 || trace.Steps[0].Phase != TracePhaseValidate || trace.Steps[0].Kind != TraceStepGet ||
+				string(trace.Steps[0].Actor) != string(authActor) || trace.Steps[0].Gas == 0 {
+				t.Fatalf("unexpected first step %+v", trace.Steps)
+			}
+
+			var call *TraceStep
+			var sets, gas int
+			for i, step := range trace.Steps {
+				switch {
+				case step.Kind == TraceStepCall:
+					call = &trace.Steps[i]
+				case step.Kind == TraceStepSet && step.Phase == TracePhaseExec:
+					if step.Depth != 1 || string(step.Actor) != string(bankActor) {
+						t.Fatalf("unexpected set step %+v", step)
+					}
+					sets++
+				}
+				if step.Phase == TracePhaseExec && step.Depth == 1 {
+					gas += int(step.Gas)
+				}
+			}
+			if call == nil || call.TypeURL != msgTypeURL(&gogotypes.StringValue{}) || call.Phase != TracePhaseExec || call.Depth != 0 {
+				t.Fatalf("unexpected call step %+v", call)
+			}
+			// the gas of the call is the gas of its store operations
+			if call.Gas == 0 || int(call.Gas) != gas {
+				t.Fatalf("expected the call to consume %d gas, got %d", gas, call.Gas)
+			}
+			if sets != 2 {
+				t.Fatalf("expected 2 balance writes, got %d", sets)
+			}
+
+			// the parallel execution is not used for traced blocks
+			if expected == nil {
+				expected = trace
+			} else if !reflect.DeepEqual(expected, trace) {
+				t.Fatalf("traces differ\nexpected: %+v\nactual: %+v", expected, trace)
+			}
+		})
+	}
+}
+
+func TestTxTraceEvents(t *testing.T) {
+	trace := &TxTrace{}
+	exCtx := &executionContext{Context: context.Background(), tracer: &txTracer{trace: trace, phase: TracePhaseExec}}
+
+	em := NewEventService().EventManager(exCtx)
+	noError(t, em.EmitKV("transfer", event.NewAttribute("from", "acc0"), event.NewAttribute("to", "acc1")))
+	noError(t, em.EmitKV("fees", event.NewAttribute("amount", "10")))
+
+	if len(exCtx.events) != 2 || len(trace.Steps) != 2 {
+		t.Fatalf("expected 2 events and 2 steps, got %d and %d", len(exCtx.events), len(trace.Steps))
+	}
+	if step := trace.Steps[0]; step.Kind != TraceStepEvent || step.Phase != TracePhaseExec || step.TypeURL != "transfer" ||
+		!reflect.DeepEqual(step.Attributes, []event.Attribute{{Key: "from", Value: "acc0"}, {Key: "to", Value: "acc1"}}) {
+		t.Fatalf("unexpected event step %+v", step)
+	}
+	if step := trace.Steps[1]; step.Kind != TraceStepEvent || step.TypeURL != "fees" {
+		t.Fatalf("unexpected event step %+v", step)
+	}
+
+	// the events of an execution which is not traced are not recorded
+	exCtx.tracer = nil
+	noError(t, NewEventService().EventManager(exCtx).EmitKV("transfer"))
+	if len(exCtx.events) != 3 || len(trace.Steps) != 2 {
+		t.Fatalf("expected 3 events and 2 steps, got %d and %d", len(exCtx.events), len(trace.Steps))
+	}
+}
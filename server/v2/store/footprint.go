@@ -0,0 +1,217 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/log"
+	"cosmossdk.io/schema"
+	serverv2 "cosmossdk.io/server/v2"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/analytics"
+)
+
+const (
+	flagFromHeight   = "from-height"
+	flagPrefixLength = "prefix-length"
+	flagTopPrefixes  = "top"
+	flagOutput       = "output"
+)
+
+// hasModules is implemented by apps exposing their modules and their store
+// keys, such as runtime/v2.App. It is used to name the key prefixes after the
+// collections schemas of the modules.
+type hasModules interface {
+	Modules() map[string]appmodulev2.AppModule
+	ModuleStoreKey(moduleName string) string
+}
+
+// FootprintCmd returns a command reporting the storage footprint and the key
+// space of the stores.
+func (s *Server[T]) FootprintCmd(newApp serverv2.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "footprint [height]",
+		Short: "Report the storage footprint and key space of the stores",
+		Long: `Report the storage footprint and key space of the stores at the given height, default to the latest height.
+
+For each store, the number of keys and their size in the state storage (SS) are reported, along with
+the largest key prefixes, named after the collections of the module when available. The footprint of
+the state commitment (SC) on disk, including all retained versions, is reported as well.
+
+With --from-height, the growth of the stores between the two heights is reported instead.`,
+		Example: fmt.Sprintf("%s store footprint --from-height 1000 --top 5", "<appd>"),
+		Args:    cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v := serverv2.GetViperFromCmd(cmd)
+
+			fromHeight, err := cmd.Flags().GetUint64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+			opts := analytics.DefaultOptions()
+			if opts.PrefixLength, err = cmd.Flags().GetInt(flagPrefixLength); err != nil {
+				return err
+			}
+			if opts.TopPrefixes, err = cmd.Flags().GetInt(flagTopPrefixes); err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output != "text" && output != "json" {
+				return fmt.Errorf("unsupported output format %s", output)
+			}
+
+			logger := log.NewLogger(cmd.ErrOrStderr())
+			app := newApp(logger, v)
+			rootStore := app.GetStore().(storev2.RootStore)
+			if mods, ok := app.(hasModules); ok {
+				opts.Namer = collectionsNamer(mods)
+			}
+
+			height, err := rootStore.GetLatestVersion()
+			if err != nil {
+				return err
+			}
+			if len(args) > 0 {
+				if height, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			if fromHeight == 0 {
+				report, err := analytics.Analyze(rootStore, nil, height, opts)
+				if err != nil {
+					return err
+				}
+				return printFootprint(cmd, output, report)
+			}
+
+			if fromHeight >= height {
+				return fmt.Errorf("from height %d must be lower than height %d", fromHeight, height)
+			}
+			// compare all the prefixes, the top ones are selected on the growth
+			topPrefixes := opts.TopPrefixes
+			opts.TopPrefixes = 0
+			from, err := analytics.Analyze(rootStore, nil, fromHeight, opts)
+			if err != nil {
+				return err
+			}
+			to, err := analytics.Analyze(rootStore, nil, height, opts)
+			if err != nil {
+				return err
+			}
+
+			return printGrowth(cmd, output, analytics.Compare(from, to, topPrefixes))
+		},
+	}
+
+	cmd.Flags().Uint64(flagFromHeight, 0, "Report the growth of the stores since this height")
+	cmd.Flags().Int(flagPrefixLength, 1, "Length in bytes of the key prefixes keys are grouped by")
+	cmd.Flags().Int(flagTopPrefixes, 10, "Number of largest key prefixes reported per store, 0 for all")
+	cmd.Flags().StringP(flagOutput, "o", "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// collectionsNamer returns a PrefixNamer naming the key prefixes after the
+// collections of the modules implementing schema.HasModuleCodec. The modules
+// are looked up by their store key, which may differ from their name.
+func collectionsNamer(app hasModules) analytics.PrefixNamer {
+	modules := make(map[string]appmodulev2.AppModule)
+	for name, mod := range app.Modules() {
+		modules[app.ModuleStoreKey(name)] = mod
+	}
+
+	codecs := make(map[string]*schema.ModuleCodec)
+	return func(storeKey string, key, value []byte) string {
+		cdc, ok := codecs[storeKey]
+		if !ok {
+			if mod, ok := modules[storeKey].(schema.HasModuleCodec); ok {
+				if c, err := mod.ModuleCodec(); err == nil && c.KVDecoder != nil {
+					cdc = &c
+				}
+			}
+			codecs[storeKey] = cdc
+		}
+		if cdc == nil {
+			return ""
+		}
+
+		// the name of the collection is returned even if the key or value
+		// cannot be decoded
+		updates, _ := cdc.KVDecoder(schema.KVPairUpdate{Key: key, Value: value})
+		if len(updates) == 0 {
+			return ""
+		}
+		return updates[0].TypeName
+	}
+}
+
+func printFootprint(cmd *cobra.Command, output string, report *analytics.Report) error {
+	if output == "json" {
+		return printJSON(cmd, report)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Height %d\n\n", report.Height)
+	fmt.Fprintln(w, "STORE\tKEYS\tKEY BYTES\tVALUE BYTES\tSC ENTRIES\tSC BYTES\tSC VERSIONS")
+	for _, sr := range report.Stores {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d", sr.Name, sr.Keys, sr.KeyBytes, sr.ValueBytes)
+		if sr.SC != nil {
+			fmt.Fprintf(w, "\t%d\t%d\t%d\n", sr.SC.Entries, sr.SC.Bytes, sr.SC.Versions)
+		} else {
+			fmt.Fprint(w, "\t-\t-\t-\n")
+		}
+	}
+	for _, sr := range report.Stores {
+		if len(sr.Prefixes) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s\nPREFIX\tNAME\tKEYS\tBYTES\n", sr.Name)
+		for _, pr := range sr.Prefixes {
+			fmt.Fprintf(w, "%X\t%s\t%d\t%d\n", pr.Prefix, pr.Name, pr.Keys, pr.Bytes)
+		}
+	}
+
+	return w.Flush()
+}
+
+func printGrowth(cmd *cobra.Command, output string, growth *analytics.Growth) error {
+	if output == "json" {
+		return printJSON(cmd, growth)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Growth from height %d to %d\n\n", growth.FromHeight, growth.ToHeight)
+	fmt.Fprintln(w, "STORE\tKEYS\tBYTES")
+	for _, sg := range growth.Stores {
+		fmt.Fprintf(w, "%s\t%+d\t%+d\n", sg.Name, sg.Keys, sg.Bytes)
+	}
+	for _, sg := range growth.Stores {
+		if len(sg.Prefixes) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\n%s\nPREFIX\tNAME\tKEYS\tBYTES\n", sg.Name)
+		for _, pg := range sg.Prefixes {
+			fmt.Fprintf(w, "%X\t%s\t%+d\t%+d\n", pg.Prefix, pg.Name, pg.Keys, pg.Bytes)
+		}
+	}
+
+	return w.Flush()
+}
+
+func printJSON(cmd *cobra.Command, v any) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(bz))
+	return nil
+}
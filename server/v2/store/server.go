@@ -19,7 +19,7 @@ var (
 
 const ServerName = "store"
 
-// Server manages store config and contains prune & snapshot commands
+// Server manages store config and contains prune, snapshot & footprint commands
 type Server[T transaction.Tx] struct {
 	config *Config
 	// saving appCreator for only RestoreSnapshotCmd
@@ -64,6 +64,7 @@ func (s *Server[T]) CLICommands() serverv2.CLIConfig {
 			s.DumpArchiveCmd(),
 			s.LoadArchiveCmd(),
 			s.RestoreSnapshotCmd(s.appCreator),
+			s.FootprintCmd(s.appCreator),
 		},
 	}
 }
@@ -4,6 +4,10 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -22,7 +26,16 @@ import (
 	"cosmossdk.io/store/v2/snapshots/types"
 )
 
-const SnapshotFileName = "_snapshot"
+const (
+	SnapshotFileName = "_snapshot"
+	ManifestFileName = "_manifest"
+
+	flagTrustedAppHash = "trusted-app-hash"
+	flagTrustedHeader  = "trusted-header"
+	flagTrustedKey     = "trusted-key"
+	flagSignKey        = "sign-key"
+	flagAppHash        = "app-hash"
+)
 
 // ExportSnapshotCmd exports app state to snapshot store.
 func (s *Server[T]) ExportSnapshotCmd() *cobra.Command {
@@ -80,8 +93,12 @@ func (s *Server[T]) RestoreSnapshotCmd(newApp serverv2.AppCreator[T]) *cobra.Com
 	cmd := &cobra.Command{
 		Use:   "restore <height> <format>",
 		Short: "Restore app state from local snapshot",
-		Long:  "Restore app state from local snapshot",
-		Args:  cobra.ExactArgs(2),
+		Long: `Restore app state from local snapshot.
+
+When a trusted app hash or header is given, the state must be empty before the restore.
+The commit info of the restored state is recomputed, and the restored state is deleted
+if it doesn't match the trusted app hash.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := serverv2.GetViperFromCmd(cmd)
 
@@ -94,6 +111,14 @@ func (s *Server[T]) RestoreSnapshotCmd(newApp serverv2.AppCreator[T]) *cobra.Com
 				return err
 			}
 
+			trustedHeight, trustedAppHash, err := getTrustedState(cmd, height)
+			if err != nil {
+				return err
+			}
+			if trustedAppHash != nil && trustedHeight != height {
+				return fmt.Errorf("trusted header height %d doesn't match the snapshot height %d", trustedHeight+1, height)
+			}
+
 			logger := log.NewLogger(cmd.OutOrStdout())
 			app := newApp(logger, v)
 			rootStore := app.GetStore().(storev2.RootStore)
@@ -103,15 +128,69 @@ func (s *Server[T]) RestoreSnapshotCmd(newApp serverv2.AppCreator[T]) *cobra.Com
 				return err
 			}
 
-			return sm.RestoreLocalSnapshot(height, uint32(format))
+			if trustedAppHash == nil {
+				if err := sm.RestoreLocalSnapshot(height, uint32(format)); err != nil {
+					return err
+				}
+				cmd.Println("WARNING: the restored state was not verified, use --trusted-app-hash or --trusted-header to verify it")
+				return nil
+			}
+
+			// the restored state can only be deleted if it is the only state
+			latestVersion, err := rootStore.GetLatestVersion()
+			if err != nil {
+				return err
+			}
+			if latestVersion != 0 {
+				return fmt.Errorf("the state must be empty to verify the restored state, found state at height %d", latestVersion)
+			}
+
+			if err := restoreAndVerify(sm, rootStore, height, uint32(format), trustedAppHash); err != nil {
+				if rmErr := removeState(rootStore, v.GetString(serverv2.FlagHome)); rmErr != nil {
+					return errors.Join(err, fmt.Errorf("failed to delete the restored state: %w", rmErr))
+				}
+				return fmt.Errorf("the restored state was deleted: %w", err)
+			}
+
+			cmd.Printf("Restored state at height %d matches the trusted app hash %X\n", height, trustedAppHash)
+			return nil
 		},
 	}
 
 	addSnapshotFlagsToCmd(cmd)
+	cmd.Flags().String(flagTrustedAppHash, "", "Trusted app hash (hex) of the state at the snapshot height")
+	cmd.Flags().String(flagTrustedHeader, "", "Path to a trusted CometBFT header (JSON) of the block following the snapshot height")
+	cmd.MarkFlagsMutuallyExclusive(flagTrustedAppHash, flagTrustedHeader)
 
 	return cmd
 }
 
+// restoreAndVerify restores the snapshot and verifies the restored state against
+// the trusted app hash.
+func restoreAndVerify(sm *snapshots.Manager, rootStore storev2.RootStore, height uint64, format uint32, trustedAppHash []byte) error {
+	if err := sm.RestoreLocalSnapshot(height, format); err != nil {
+		return err
+	}
+	commitInfo, err := rootStore.GetStateCommitment().GetCommitInfo(height)
+	if err != nil {
+		return err
+	}
+	return snapshots.VerifyAppHash(commitInfo, height, trustedAppHash)
+}
+
+// removeState closes the root store and deletes its state commitment and
+// storage databases.
+func removeState(rootStore storev2.RootStore, home string) error {
+	if err := rootStore.Close(); err != nil {
+		return err
+	}
+	dataDir := filepath.Join(home, "data")
+	return errors.Join(
+		os.RemoveAll(filepath.Join(dataDir, "application.db")),
+		os.RemoveAll(filepath.Join(dataDir, "ss")),
+	)
+}
+
 // ListSnapshotsCmd returns the command to list local snapshots
 func (s *Server[T]) ListSnapshotsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -206,6 +285,24 @@ func (s *Server[T]) DumpArchiveCmd() *cobra.Command {
 				return errors.New("snapshot doesn't exist")
 			}
 
+			var signKey ed25519.PrivateKey
+			if keyFile, _ := cmd.Flags().GetString(flagSignKey); keyFile != "" {
+				if signKey, err = loadManifestKey(keyFile); err != nil {
+					return err
+				}
+			}
+			appHashStr, err := cmd.Flags().GetString(flagAppHash)
+			if err != nil {
+				return err
+			}
+			appHash, err := hex.DecodeString(appHashStr)
+			if err != nil {
+				return fmt.Errorf("invalid app hash: %w", err)
+			}
+			if len(appHash) == 0 {
+				appHash = nil
+			}
+
 			bz, err := snapshot.Marshal()
 			if err != nil {
 				return err
@@ -234,6 +331,27 @@ func (s *Server[T]) DumpArchiveCmd() *cobra.Command {
 				return fmt.Errorf("failed to write snapshot to tar: %w", err)
 			}
 
+			if signKey != nil {
+				signedManifest, err := snapshots.NewManifest(snapshot, appHash).Sign(signKey)
+				if err != nil {
+					return fmt.Errorf("failed to sign manifest: %w", err)
+				}
+				bz, err := json.Marshal(signedManifest)
+				if err != nil {
+					return err
+				}
+				if err := tarWriter.WriteHeader(&tar.Header{
+					Name: ManifestFileName,
+					Mode: 0o644,
+					Size: int64(len(bz)),
+				}); err != nil {
+					return fmt.Errorf("failed to write manifest header to tar: %w", err)
+				}
+				if _, err := tarWriter.Write(bz); err != nil {
+					return fmt.Errorf("failed to write manifest to tar: %w", err)
+				}
+			}
+
 			for i := uint32(0); i < snapshot.Chunks; i++ {
 				path := snapshotStore.PathChunk(height, uint32(format), i)
 				tarName := strconv.FormatUint(uint64(i), 10)
@@ -255,16 +373,22 @@ func (s *Server[T]) DumpArchiveCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringP("output", "o", "", "output file")
+	cmd.Flags().String(flagSignKey, "", "Path to a CometBFT node key (JSON) used to sign the archive manifest")
+	cmd.Flags().String(flagAppHash, "", "App hash (hex) of the snapshotted state to include in the signed manifest")
 
 	return cmd
 }
 
 // LoadArchiveCmd load a portable archive format snapshot into snapshot store
 func (s *Server[T]) LoadArchiveCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "load <archive-file>",
 		Short: "Load a snapshot archive file (.tar.gz) into snapshot store",
-		Args:  cobra.ExactArgs(1),
+		Long: `Load a snapshot archive file (.tar.gz) into snapshot store.
+
+When trusted keys are given, the archive must contain a manifest signed by one of them,
+and every chunk is verified against the manifest before being saved.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := serverv2.GetViperFromCmd(cmd)
 			snapshotStore, err := snapshots.NewStore(filepath.Join(v.GetString(serverv2.FlagHome), "data", "snapshots"))
@@ -272,11 +396,17 @@ func (s *Server[T]) LoadArchiveCmd() *cobra.Command {
 				return err
 			}
 
+			trustedKeys, err := getTrustedKeys(cmd)
+			if err != nil {
+				return err
+			}
+
 			path := args[0]
 			fp, err := os.Open(path)
 			if err != nil {
 				return fmt.Errorf("failed to open archive file: %w", err)
 			}
+			defer fp.Close()
 			reader, err := gzip.NewReader(fp)
 			if err != nil {
 				return fmt.Errorf("failed to create gzip reader: %w", err)
@@ -300,6 +430,47 @@ func (s *Server[T]) LoadArchiveCmd() *cobra.Command {
 				return fmt.Errorf("failed to unmarshal snapshot: %w", err)
 			}
 
+			hdr, err = tr.Next()
+			if err != nil && !errors.Is(err, io.EOF) {
+				return err
+			}
+
+			var manifest *snapshots.Manifest
+			if hdr != nil && hdr.Name == ManifestFileName {
+				bz, err := io.ReadAll(tr)
+				if err != nil {
+					return fmt.Errorf("failed to read manifest file: %w", err)
+				}
+				var signedManifest snapshots.SignedManifest
+				if err := json.Unmarshal(bz, &signedManifest); err != nil {
+					return fmt.Errorf("failed to unmarshal manifest: %w", err)
+				}
+				if len(trustedKeys) > 0 {
+					if err := signedManifest.Verify(trustedKeys); err != nil {
+						return err
+					}
+				}
+				manifest = &signedManifest.Manifest
+				if err := manifest.ValidateSnapshot(&snapshot); err != nil {
+					return err
+				}
+
+				hdr, err = tr.Next()
+				if err != nil && !errors.Is(err, io.EOF) {
+					return err
+				}
+			} else if len(trustedKeys) > 0 {
+				return errors.New("invalid archive, no signed manifest found")
+			}
+
+			existing, err := snapshotStore.Get(snapshot.Height, snapshot.Format)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return fmt.Errorf("snapshot at height %d format %d already exists", snapshot.Height, snapshot.Format)
+			}
+
 			// make sure the channel is unbuffered, because the tar reader can't do concurrency
 			chunks := make(chan io.ReadCloser)
 			quitChan := make(chan *types.Snapshot)
@@ -314,22 +485,40 @@ func (s *Server[T]) LoadArchiveCmd() *cobra.Command {
 				quitChan <- savedSnapshot
 			}()
 
-			for i := uint32(0); i < snapshot.Chunks; i++ {
-				hdr, err = tr.Next()
-				if err != nil {
-					if errors.Is(err, io.EOF) {
-						break
+			// abort fails the snapshot being saved and removes the saved chunks.
+			abort := func(err error) error {
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(err)
+				chunks <- pr
+				close(chunks)
+				<-quitChan
+				_ = snapshotStore.Delete(snapshot.Height, snapshot.Format)
+				return err
+			}
+
+			for i := uint32(0); i < snapshot.Chunks && hdr != nil; i++ {
+				if i > 0 {
+					hdr, err = tr.Next()
+					if err != nil {
+						if errors.Is(err, io.EOF) {
+							break
+						}
+						return abort(err)
 					}
-					return err
 				}
 
 				if hdr.Name != strconv.FormatInt(int64(i), 10) {
-					return fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name)
+					return abort(fmt.Errorf("invalid archive, expect file: %d, got: %s", i, hdr.Name))
 				}
 
 				bz, err := io.ReadAll(tr)
 				if err != nil {
-					return fmt.Errorf("failed to read chunk file: %w", err)
+					return abort(fmt.Errorf("failed to read chunk file: %w", err))
+				}
+				if manifest != nil {
+					if err := manifest.ValidateChunk(i, bz); err != nil {
+						return abort(err)
+					}
 				}
 				chunks <- io.NopCloser(bytes.NewReader(bz))
 			}
@@ -345,9 +534,22 @@ func (s *Server[T]) LoadArchiveCmd() *cobra.Command {
 				return errors.New("invalid archive, the saved snapshot is not equal to the original one")
 			}
 
+			if manifest != nil && manifest.AppHash != nil {
+				// the app hash of an unverified manifest is given by anyone and can't be trusted
+				if len(trustedKeys) > 0 {
+					cmd.Printf("Snapshot loaded, restore it with --%s %X to verify the restored state\n", flagTrustedAppHash, manifest.AppHash)
+				} else {
+					cmd.Printf("Snapshot loaded, the archive manifest app hash %X is unverified, use --%s to verify it\n", manifest.AppHash, flagTrustedKey)
+				}
+			}
+
 			return nil
 		},
 	}
+
+	cmd.Flags().StringSlice(flagTrustedKey, nil, "Trusted ed25519 public keys (hex or base64) of the archive manifest signers")
+
+	return cmd
 }
 
 func createSnapshotsManager(cmd *cobra.Command, v *viper.Viper, logger log.Logger, store storev2.RootStore) (*snapshots.Manager, error) {
@@ -406,3 +608,96 @@ func processChunk(tarWriter *tar.Writer, path, tarName string) error {
 
 	return nil
 }
+
+// getTrustedState returns the trusted height and app hash given by the flags,
+// the app hash is nil if no trusted state was given. A trusted app hash is the
+// one of the state at the snapshot height.
+func getTrustedState(cmd *cobra.Command, snapshotHeight uint64) (uint64, []byte, error) {
+	headerFile, err := cmd.Flags().GetString(flagTrustedHeader)
+	if err != nil {
+		return 0, nil, err
+	}
+	if headerFile != "" {
+		return loadTrustedHeader(headerFile)
+	}
+
+	appHashStr, err := cmd.Flags().GetString(flagTrustedAppHash)
+	if err != nil {
+		return 0, nil, err
+	}
+	if appHashStr == "" {
+		return snapshotHeight, nil, nil
+	}
+	appHash, err := hex.DecodeString(appHashStr)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid trusted app hash: %w", err)
+	}
+	return snapshotHeight, appHash, nil
+}
+
+// loadTrustedHeader reads a CometBFT header in JSON. The app hash of a header
+// is the one of the state after executing the previous block, so the returned
+// trusted height is the height of the header minus one.
+func loadTrustedHeader(path string) (uint64, []byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read trusted header: %w", err)
+	}
+	var header struct {
+		Height  string `json:"height"`
+		AppHash string `json:"app_hash"`
+	}
+	if err := json.Unmarshal(bz, &header); err != nil {
+		return 0, nil, fmt.Errorf("failed to unmarshal trusted header: %w", err)
+	}
+	height, err := strconv.ParseUint(header.Height, 10, 64)
+	if err != nil || height < 2 {
+		return 0, nil, fmt.Errorf("invalid trusted header height %q", header.Height)
+	}
+	appHash, err := hex.DecodeString(header.AppHash)
+	if err != nil || len(appHash) == 0 {
+		return 0, nil, fmt.Errorf("invalid trusted header app hash %q", header.AppHash)
+	}
+	return height - 1, appHash, nil
+}
+
+// getTrustedKeys parses the trusted manifest signer keys given by the flags.
+func getTrustedKeys(cmd *cobra.Command) ([]ed25519.PublicKey, error) {
+	keys, err := cmd.Flags().GetStringSlice(flagTrustedKey)
+	if err != nil {
+		return nil, err
+	}
+	trustedKeys := make([]ed25519.PublicKey, 0, len(keys))
+	for _, key := range keys {
+		bz, err := hex.DecodeString(key)
+		if err != nil {
+			bz, err = base64.StdEncoding.DecodeString(key)
+		}
+		if err != nil || len(bz) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid trusted key %q", key)
+		}
+		trustedKeys = append(trustedKeys, bz)
+	}
+	return trustedKeys, nil
+}
+
+// loadManifestKey reads the ed25519 private key of a CometBFT node key file.
+func loadManifestKey(path string) (ed25519.PrivateKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sign key: %w", err)
+	}
+	var nodeKey struct {
+		PrivKey struct {
+			Type  string `json:"type"`
+			Value []byte `json:"value"`
+		} `json:"priv_key"`
+	}
+	if err := json.Unmarshal(bz, &nodeKey); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sign key: %w", err)
+	}
+	if nodeKey.PrivKey.Type != "tendermint/PrivKeyEd25519" || len(nodeKey.PrivKey.Value) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("sign key must be an ed25519 key, got %s", nodeKey.PrivKey.Type)
+	}
+	return nodeKey.PrivKey.Value, nil
+}
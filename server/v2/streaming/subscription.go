@@ -0,0 +1,181 @@
+package streaming
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultRetainBlocks is the default number of recent blocks retained by the
+// subscriptions, to resume them.
+const DefaultRetainBlocks = 100
+
+var (
+	_ Listener                  = (*Subscriptions)(nil)
+	_ SubscriptionServiceServer = (*Subscriptions)(nil)
+)
+
+// Subscriptions is a Listener streaming the finalized blocks to the subscribers
+// of the SubscriptionService.
+// The recent blocks are retained in memory, so that a subscription can be
+// resumed from the height following the last block received, e.g. after a
+// disconnection. The subscribers falling behind the retained blocks are
+// disconnected with an OUT_OF_RANGE error.
+type Subscriptions struct {
+	retainBlocks int
+
+	mu      sync.Mutex
+	pending *SubscribeResponse
+	// blocks are the retained blocks, by increasing height.
+	blocks []*SubscribeResponse
+	// published is closed when a block is published, and then replaced.
+	published chan struct{}
+}
+
+// NewSubscriptions returns Subscriptions retaining the given number of recent
+// blocks, at least one.
+func NewSubscriptions(retainBlocks int) *Subscriptions {
+	return &Subscriptions{
+		retainBlocks: max(retainBlocks, 1),
+		published:    make(chan struct{}),
+	}
+}
+
+// ListenDeliverBlock implements Listener, the block is published with its state
+// changes, which are listened next.
+func (s *Subscriptions) ListenDeliverBlock(_ context.Context, req ListenDeliverBlockRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending = &SubscribeResponse{
+		BlockHeight: req.BlockHeight,
+		Events:      req.Events,
+		Txs:         req.Txs,
+		TxResults:   req.TxResults,
+	}
+	return nil
+}
+
+// ListenStateChanges implements Listener, it publishes the block delivered last.
+func (s *Subscriptions) ListenStateChanges(_ context.Context, changeSet []*StoreKVPair) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pending == nil {
+		return nil
+	}
+	block := s.pending
+	block.StateChanges = changeSet
+	s.pending = nil
+
+	// the blocks are kept ordered by height if a height is delivered again, the
+	// retained blocks are copied as the subscribers may be reading them
+	if i := slices.IndexFunc(s.blocks, func(b *SubscribeResponse) bool { return b.BlockHeight >= block.BlockHeight }); i >= 0 {
+		s.blocks = slices.Clone(s.blocks[:i])
+	}
+	s.blocks = append(s.blocks, block)
+	if len(s.blocks) > s.retainBlocks {
+		s.blocks = slices.Clone(s.blocks[len(s.blocks)-s.retainBlocks:])
+	}
+
+	close(s.published)
+	s.published = make(chan struct{})
+	return nil
+}
+
+// Subscribe implements SubscriptionServiceServer.
+func (s *Subscriptions) Subscribe(req *SubscribeRequest, stream SubscriptionService_SubscribeServer) error {
+	if req.StartHeight < 0 {
+		return status.Error(codes.InvalidArgument, "start height cannot be negative")
+	}
+
+	next := req.StartHeight
+	if next == 0 {
+		s.mu.Lock()
+		if len(s.blocks) > 0 {
+			next = s.blocks[len(s.blocks)-1].BlockHeight + 1
+		}
+		s.mu.Unlock()
+	}
+
+	for {
+		blocks, published, err := s.blocksFrom(next)
+		if err != nil {
+			return err
+		}
+
+		for _, block := range blocks {
+			if err := stream.Send(filterBlock(block, req)); err != nil {
+				return err
+			}
+			next = block.BlockHeight + 1
+		}
+
+		if len(blocks) == 0 {
+			select {
+			case <-published:
+			case <-stream.Context().Done():
+				return stream.Context().Err()
+			}
+		}
+	}
+}
+
+// blocksFrom returns the retained blocks from a height, 0 for the first block
+// published, and the channel closed when the next block is published.
+func (s *Subscriptions) blocksFrom(height int64) ([]*SubscribeResponse, <-chan struct{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.blocks) == 0 {
+		return nil, s.published, nil
+	}
+
+	oldest := s.blocks[0].BlockHeight
+	if height != 0 && height < oldest {
+		return nil, nil, status.Errorf(codes.OutOfRange, "block %d is not retained anymore, the oldest retained block is %d", height, oldest)
+	}
+
+	i, _ := slices.BinarySearchFunc(s.blocks, height, func(b *SubscribeResponse, height int64) int {
+		return cmp.Compare(b.BlockHeight, height)
+	})
+	return s.blocks[i:], s.published, nil
+}
+
+// filterBlock returns the block with the events, txs and state changes of a
+// subscription.
+func filterBlock(block *SubscribeResponse, req *SubscribeRequest) *SubscribeResponse {
+	res := &SubscribeResponse{
+		BlockHeight: block.BlockHeight,
+		Events:      block.Events,
+	}
+
+	if len(req.EventTypes) > 0 {
+		res.Events = nil
+		for _, event := range block.Events {
+			if slices.Contains(req.EventTypes, event.Type) {
+				res.Events = append(res.Events, event)
+			}
+		}
+	}
+
+	if req.TxResults {
+		res.Txs = block.Txs
+		res.TxResults = block.TxResults
+	}
+
+	for _, kv := range block.StateChanges {
+		if slices.ContainsFunc(req.StateChanges, func(filter *StateChangeFilter) bool {
+			return bytes.Equal(kv.Address, filter.Address) && bytes.HasPrefix(kv.Key, filter.KeyPrefix)
+		}) {
+			res.StateChanges = append(res.StateChanges, kv)
+		}
+	}
+
+	return res
+}
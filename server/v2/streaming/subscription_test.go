@@ -0,0 +1,113 @@
+package streaming
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockSubscribeStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	responses chan *SubscribeResponse
+}
+
+func (m *mockSubscribeStream) Context() context.Context { return m.ctx }
+
+func (m *mockSubscribeStream) Send(res *SubscribeResponse) error {
+	m.responses <- res
+	return nil
+}
+
+func publishBlock(t *testing.T, s *Subscriptions, height int64) {
+	t.Helper()
+
+	require.NoError(t, s.ListenDeliverBlock(context.Background(), ListenDeliverBlockRequest{
+		BlockHeight: height,
+		Txs:         [][]byte{[]byte("tx")},
+		TxResults:   []*ExecTxResult{{GasUsed: height}},
+		Events:      []*Event{{Type: "transfer"}, {Type: "message"}},
+	}))
+	require.NoError(t, s.ListenStateChanges(context.Background(), []*StoreKVPair{
+		{Address: []byte("bank"), Key: []byte{0x02, 0x01}, Value: []byte("balance")},
+		{Address: []byte("bank"), Key: []byte{0x03}, Value: []byte("supply")},
+		{Address: []byte("staking"), Key: []byte{0x02}, Delete: true},
+	}))
+}
+
+func TestSubscriptions(t *testing.T) {
+	s := NewSubscriptions(2)
+	for height := int64(1); height <= 3; height++ {
+		publishBlock(t, s, height)
+	}
+
+	// the block 1 is not retained anymore
+	err := s.Subscribe(&SubscribeRequest{StartHeight: 1}, &mockSubscribeStream{ctx: context.Background()})
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockSubscribeStream{ctx: ctx, responses: make(chan *SubscribeResponse, 10)}
+	done := make(chan error)
+	go func() {
+		done <- s.Subscribe(&SubscribeRequest{
+			StartHeight:  2,
+			EventTypes:   []string{"transfer"},
+			StateChanges: []*StateChangeFilter{{Address: []byte("bank"), KeyPrefix: []byte{0x02}}},
+		}, stream)
+	}()
+
+	// the retained blocks are streamed from the start height, then the next blocks
+	for _, height := range []int64{2, 3} {
+		res := <-stream.responses
+		require.Equal(t, height, res.BlockHeight)
+		require.Equal(t, []*Event{{Type: "transfer"}}, res.Events)
+		require.Empty(t, res.Txs)
+		require.Empty(t, res.TxResults)
+		require.Equal(t, []*StoreKVPair{{Address: []byte("bank"), Key: []byte{0x02, 0x01}, Value: []byte("balance")}}, res.StateChanges)
+	}
+	publishBlock(t, s, 4)
+	require.Equal(t, int64(4), (<-stream.responses).BlockHeight)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestSubscriptions_NextBlock(t *testing.T) {
+	s := NewSubscriptions(DefaultRetainBlocks)
+	publishBlock(t, s, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockSubscribeStream{ctx: ctx, responses: make(chan *SubscribeResponse, 10)}
+	go func() {
+		_ = s.Subscribe(&SubscribeRequest{TxResults: true}, stream)
+	}()
+
+	// the subscription starts from the next block published once subscribed,
+	// with all the events and no state changes
+	var res *SubscribeResponse
+	height := int64(2)
+	for ; res == nil; height++ {
+		publishBlock(t, s, height)
+		select {
+		case res = <-stream.responses:
+			require.Greater(t, res.BlockHeight, int64(1))
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	for ; height <= res.BlockHeight+1; height++ {
+		publishBlock(t, s, height)
+	}
+	next := <-stream.responses
+	require.Equal(t, res.BlockHeight+1, next.BlockHeight)
+	require.Len(t, next.Events, 2)
+	require.Equal(t, [][]byte{[]byte("tx")}, next.Txs)
+	require.Equal(t, []*ExecTxResult{{GasUsed: next.BlockHeight}}, next.TxResults)
+	require.Empty(t, next.StateChanges)
+}
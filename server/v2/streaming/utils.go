@@ -3,7 +3,7 @@ package streaming
 import "cosmossdk.io/core/event"
 
 func IntoStreamingEvents(events []event.Event) ([]*Event, error) {
-	streamingEvents := make([]*Event, len(events))
+	streamingEvents := make([]*Event, 0, len(events))
 
 	for _, event := range events {
 		strEvent := &Event{
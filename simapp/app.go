@@ -316,6 +316,8 @@ func NewSimApp(
 		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount),
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
+		accountstd.AddAccount(lockup.CLIFF_LOCKING_ACCOUNT, lockup.NewCliffLockingAccount),
+		accountstd.AddAccount(lockup.MILESTONE_LOCKING_ACCOUNT, lockup.NewMilestoneLockingAccount),
 		accountstd.AddAccount("multisig", multisig.NewAccount),
 		// PRODUCTION: add
 		baseaccount.NewAccount("base", txConfig.SignModeHandler(), baseaccount.WithSecp256K1PubKey()),
@@ -664,6 +666,7 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				ConsensusKeeper:          app.ConsensusParamsKeeper,
 				SignModeHandler:          txConfig.SignModeHandler(),
 				FeegrantKeeper:           app.FeeGrantKeeper,
+				PaymasterKeeper:          app.AccountsKeeper,
 				SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
 				UnorderedTxManager:       app.UnorderedTxManager,
 			},
@@ -826,7 +829,13 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(
+		app.BaseApp.GRPCQueryRouter(),
+		clientCtx,
+		app.BaseApp.Simulate,
+		app.interfaceRegistry,
+		authtx.WithSimulateWithOverrides(app.BaseApp.SimulateWithOverrides),
+	)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
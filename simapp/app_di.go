@@ -162,6 +162,11 @@ func NewSimApp(
 				// inject desired account types:
 				multisigdepinject.ProvideAccount,
 				basedepinject.ProvideAccount,
+				basedepinject.ProvideSessionKeyAccount,
+				basedepinject.ProvideRecoveryAccount,
+				basedepinject.ProvideSubscriptionAccount,
+				basedepinject.ProvidePaymasterAccount,
+				basedepinject.ProvidePolicyAccount,
 				lockupdepinject.ProvideAllLockupAccounts,
 
 				// provide base account options
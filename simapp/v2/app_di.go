@@ -124,6 +124,11 @@ func NewSimApp[T transaction.Tx](
 				// inject desired account types:
 				multisigdepinject.ProvideAccount,
 				basedepinject.ProvideAccount,
+				basedepinject.ProvideSessionKeyAccount,
+				basedepinject.ProvideRecoveryAccount,
+				basedepinject.ProvideSubscriptionAccount,
+				basedepinject.ProvidePaymasterAccount,
+				basedepinject.ProvidePolicyAccount,
 				lockupdepinject.ProvideAllLockupAccounts,
 
 				// provide base account options
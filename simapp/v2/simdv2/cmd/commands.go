@@ -15,9 +15,11 @@ import (
 	runtimev2 "cosmossdk.io/runtime/v2"
 	serverv2 "cosmossdk.io/server/v2"
 	"cosmossdk.io/server/v2/api/grpc"
+	"cosmossdk.io/server/v2/api/rest"
 	"cosmossdk.io/server/v2/api/telemetry"
 	"cosmossdk.io/server/v2/cometbft"
 	"cosmossdk.io/server/v2/store"
+	"cosmossdk.io/server/v2/streaming"
 	"cosmossdk.io/simapp/v2"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
 
@@ -70,22 +72,31 @@ func initRootCmd[T transaction.Tx](
 	)
 
 	// wire server commands
+	// the finalized blocks are streamed to the subscribers of the gRPC server
+	subscriptions := streaming.NewSubscriptions(streaming.DefaultRetainBlocks)
+	cometOptions := initCometOptions[T]()
+	cometOptions.StreamingListeners = append(cometOptions.StreamingListeners, subscriptions)
+	cometbftServer := cometbft.New(
+		&genericTxDecoder[T]{txConfig},
+		cometOptions,
+		initCometConfig(),
+	)
+	grpcServer := grpc.New[T]()
+	streaming.RegisterSubscriptionServiceServer(grpcServer, subscriptions)
 	if err = serverv2.AddCommands(
 		rootCmd,
 		newApp,
 		logger,
 		initServerConfig(),
-		cometbft.New(
-			&genericTxDecoder[T]{txConfig},
-			initCometOptions[T](),
-			initCometConfig(),
-		),
-		grpc.New[T](),
+		cometbftServer,
+		grpcServer,
+		rest.New[T](),
 		store.New[T](newApp),
 		telemetry.New[T](),
 	); err != nil {
 		panic(err)
 	}
+	rootCmd.AddCommand(cometbftServer.ReplayCmd(newApp))
 }
 
 // genesisCommand builds genesis-related `simd genesis` command.
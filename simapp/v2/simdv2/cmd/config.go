@@ -91,12 +91,13 @@ func initCometConfig() cometbft.CfgOption {
 func initCometOptions[T transaction.Tx]() cometbft.ServerOptions[T] {
 	serverOptions := cometbft.DefaultServerOptions[T]()
 
-	// overwrite app mempool, using max-txs option
+	// overwrite app mempool, using max-txs option and a custom tx priority
 	// serverOptions.Mempool = func(cfg map[string]any) mempool.Mempool[T] {
 	// 	if maxTxs := cast.ToInt(cfg[cometbft.FlagMempoolMaxTxs]); maxTxs >= 0 {
-	// 		return sdkmempool.NewSenderNonceMempool(
-	// 			sdkmempool.SenderNonceMaxTxOpt(maxTxs),
-	// 		)
+	// 		mpConfig := mempool.DefaultPriorityNonceMempoolConfig[T]()
+	// 		mpConfig.MaxTx = maxTxs
+	// 		mpConfig.TxPriority = customTxPriority[T]()
+	// 		return mempool.NewPriorityNonceMempool(mpConfig)
 	// 	}
 
 	// 	return mempool.NoOpMempool[T]{}
@@ -0,0 +1,174 @@
+// Package analytics reports the storage footprint and the key space of the
+// stores of a RootStore, to help targeting pruning and state bloat.
+package analytics
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+)
+
+// PrefixNamer returns a human readable name of the key prefix of a store, e.g.
+// the name of the collection stored under that prefix. The first key having the
+// prefix and its value are given as a sample. An empty name is returned if the
+// prefix is unknown.
+type PrefixNamer func(storeKey string, key, value []byte) string
+
+// Options are the options of an analysis.
+type Options struct {
+	// PrefixLength is the length of the key prefixes keys are grouped by.
+	PrefixLength int
+	// TopPrefixes is the number of largest prefixes reported per store.
+	TopPrefixes int
+	// Namer names the key prefixes, it is optional.
+	Namer PrefixNamer
+}
+
+// DefaultOptions returns the default options, grouping keys by their first
+// byte, which is the prefix of most collections.
+func DefaultOptions() Options {
+	return Options{
+		PrefixLength: 1,
+		TopPrefixes:  10,
+	}
+}
+
+// footprinter is implemented by state commitments able to report the storage
+// footprint of their trees, e.g. commitment.CommitStore.
+type footprinter interface {
+	Footprint(storeKey string) (commitment.Footprint, error)
+}
+
+// Report is the analysis of all the stores at a given height.
+type Report struct {
+	Height uint64        `json:"height"`
+	Stores []StoreReport `json:"stores"`
+}
+
+// StoreReport is the analysis of a store. Keys and bytes are the ones of the
+// state storage (SS) at the height of the report, while the footprint is the
+// one of the state commitment (SC) on disk, including all retained versions.
+type StoreReport struct {
+	Name       string                `json:"name"`
+	Keys       uint64                `json:"keys"`
+	KeyBytes   uint64                `json:"key_bytes"`
+	ValueBytes uint64                `json:"value_bytes"`
+	SC         *commitment.Footprint `json:"sc,omitempty"`
+	Prefixes   []PrefixReport        `json:"prefixes"`
+}
+
+// Bytes returns the total size of the keys and values of the store.
+func (r StoreReport) Bytes() uint64 {
+	return r.KeyBytes + r.ValueBytes
+}
+
+// PrefixReport is the analysis of the keys of a store sharing a prefix.
+type PrefixReport struct {
+	Prefix []byte `json:"prefix"`
+	Name   string `json:"name,omitempty"`
+	Keys   uint64 `json:"keys"`
+	Bytes  uint64 `json:"bytes"`
+}
+
+// Analyze scans the given stores at the given height. If no store key is given,
+// the stores of the commit info at that height are analyzed.
+func Analyze(rs store.RootStore, storeKeys []string, height uint64, opts Options) (*Report, error) {
+	if len(storeKeys) == 0 {
+		cInfo, err := rs.GetStateCommitment().GetCommitInfo(height)
+		if err != nil {
+			return nil, err
+		}
+		if cInfo == nil {
+			return nil, fmt.Errorf("no commit info found for height %d", height)
+		}
+		for _, si := range cInfo.StoreInfos {
+			storeKeys = append(storeKeys, string(si.Name))
+		}
+	}
+
+	fp, _ := rs.GetStateCommitment().(footprinter)
+	report := &Report{Height: height}
+	for _, storeKey := range storeKeys {
+		sr, err := analyzeStore(rs.GetStateStorage(), storeKey, height, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze store %s: %w", storeKey, err)
+		}
+		if fp != nil {
+			footprint, err := fp.Footprint(storeKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the SC footprint of store %s: %w", storeKey, err)
+			}
+			sr.SC = &footprint
+		}
+		report.Stores = append(report.Stores, *sr)
+	}
+
+	sort.SliceStable(report.Stores, func(i, j int) bool {
+		return report.Stores[i].Bytes() > report.Stores[j].Bytes()
+	})
+
+	return report, nil
+}
+
+func analyzeStore(ss store.VersionedDatabase, storeKey string, height uint64, opts Options) (*StoreReport, error) {
+	itr, err := ss.Iterator([]byte(storeKey), height, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	sr := &StoreReport{Name: storeKey}
+	var prefixes []PrefixReport
+	for ; itr.Valid(); itr.Next() {
+		key, value := itr.Key(), itr.Value()
+		sr.Keys++
+		sr.KeyBytes += uint64(len(key))
+		sr.ValueBytes += uint64(len(value))
+
+		prefix := key
+		if len(prefix) > opts.PrefixLength {
+			prefix = prefix[:opts.PrefixLength]
+		}
+		// keys are sorted, so a new prefix starts a new group
+		if n := len(prefixes); n == 0 || !bytes.Equal(prefixes[n-1].Prefix, prefix) {
+			pr := PrefixReport{Prefix: bytes.Clone(prefix)}
+			if opts.Namer != nil {
+				pr.Name = opts.Namer(storeKey, key, value)
+			}
+			prefixes = append(prefixes, pr)
+		}
+		pr := &prefixes[len(prefixes)-1]
+		pr.Keys++
+		pr.Bytes += uint64(len(key) + len(value))
+	}
+	if err := itr.Error(); err != nil {
+		return nil, err
+	}
+
+	sr.Prefixes = topPrefixes(prefixes, opts.TopPrefixes, func(pr PrefixReport) int64 { return int64(pr.Bytes) })
+
+	return sr, nil
+}
+
+// topPrefixes returns the n first prefixes by decreasing size, all of them if n
+// is not positive.
+func topPrefixes[P any](prefixes []P, n int, size func(P) int64) []P {
+	sort.SliceStable(prefixes, func(i, j int) bool {
+		return abs(size(prefixes[i])) > abs(size(prefixes[j]))
+	})
+	if n > 0 && len(prefixes) > n {
+		prefixes = prefixes[:n]
+	}
+
+	return prefixes
+}
+
+func abs(i int64) int64 {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
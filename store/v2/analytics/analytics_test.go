@@ -0,0 +1,106 @@
+package analytics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/analytics"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+func newRootStore(t *testing.T, storeKeys ...string) store.RootStore {
+	t.Helper()
+	logger := coretesting.NewNopLogger()
+
+	scDB := dbm.NewMemDB()
+	trees := make(map[string]commitment.Tree)
+	for _, storeKey := range storeKeys {
+		trees[storeKey] = iavl.NewIavlTree(dbm.NewPrefixDB(scDB, []byte(storeKey)), logger, iavl.DefaultConfig())
+	}
+	sc, err := commitment.NewCommitStore(trees, nil, scDB, logger)
+	require.NoError(t, err)
+
+	ssDB, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(ssDB, logger)
+
+	rs, err := root.New(logger, ss, sc, pruning.NewManager(sc, ss, nil, nil), nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, rs.LoadLatestVersion())
+	t.Cleanup(func() { require.NoError(t, rs.Close()) })
+
+	return rs
+}
+
+func TestAnalyze(t *testing.T) {
+	rs := newRootStore(t, "bank", "staking")
+
+	// version 1: 10 balances and 1 param in bank, 2 validators in staking
+	cs := corestore.NewChangeset()
+	for i := 0; i < 10; i++ {
+		cs.Add([]byte("bank"), []byte(fmt.Sprintf("\x02addr%d", i)), []byte("100stake"), false)
+	}
+	cs.Add([]byte("bank"), []byte("\x05"), []byte("params"), false)
+	cs.Add([]byte("staking"), []byte("\x21val1"), []byte("validator"), false)
+	cs.Add([]byte("staking"), []byte("\x21val2"), []byte("validator"), false)
+	_, err := rs.Commit(cs)
+	require.NoError(t, err)
+
+	// version 2: 5 new balances, a validator removed
+	cs = corestore.NewChangeset()
+	for i := 10; i < 15; i++ {
+		cs.Add([]byte("bank"), []byte(fmt.Sprintf("\x02addr%d", i)), []byte("100stake"), false)
+	}
+	cs.Add([]byte("staking"), []byte("\x21val2"), nil, true)
+	_, err = rs.Commit(cs)
+	require.NoError(t, err)
+
+	opts := analytics.DefaultOptions()
+	opts.Namer = func(storeKey string, key, _ []byte) string {
+		if storeKey == "bank" && key[0] == 0x02 {
+			return "balances"
+		}
+		return ""
+	}
+
+	from, err := analytics.Analyze(rs, nil, 1, opts)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), from.Height)
+	require.Len(t, from.Stores, 2)
+
+	bank := from.Stores[0]
+	require.Equal(t, "bank", bank.Name)
+	require.Equal(t, uint64(11), bank.Keys)
+	require.Equal(t, uint64(10*len("100stake")+len("params")), bank.ValueBytes)
+	require.Len(t, bank.Prefixes, 2)
+	require.Equal(t, analytics.PrefixReport{Prefix: []byte{0x02}, Name: "balances", Keys: 10, Bytes: 10 * (6 + 8)}, bank.Prefixes[0])
+	require.NotNil(t, bank.SC)
+	require.Equal(t, uint64(2), bank.SC.Versions)
+	require.Positive(t, bank.SC.Nodes)
+
+	to, err := analytics.Analyze(rs, []string{"bank", "staking"}, 2, opts)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), to.Stores[0].Keys)
+	require.Equal(t, uint64(1), to.Stores[1].Keys)
+
+	growth := analytics.Compare(from, to, 0)
+	require.Equal(t, uint64(1), growth.FromHeight)
+	require.Equal(t, uint64(2), growth.ToHeight)
+	require.Len(t, growth.Stores, 2)
+	require.Equal(t, "bank", growth.Stores[0].Name)
+	require.Equal(t, int64(5), growth.Stores[0].Keys)
+	require.Equal(t, []analytics.PrefixGrowth{{Prefix: []byte{0x02}, Name: "balances", Keys: 5, Bytes: 5 * (7 + 8)}}, growth.Stores[0].Prefixes)
+	require.Equal(t, "staking", growth.Stores[1].Name)
+	require.Equal(t, int64(-1), growth.Stores[1].Keys)
+}
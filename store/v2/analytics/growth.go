@@ -0,0 +1,106 @@
+package analytics
+
+import (
+	"sort"
+)
+
+// Growth is the growth of the stores between the heights of two reports.
+type Growth struct {
+	FromHeight uint64        `json:"from_height"`
+	ToHeight   uint64        `json:"to_height"`
+	Stores     []StoreGrowth `json:"stores"`
+}
+
+// StoreGrowth is the growth of a store, negative if it shrank.
+type StoreGrowth struct {
+	Name     string         `json:"name"`
+	Keys     int64          `json:"keys"`
+	Bytes    int64          `json:"bytes"`
+	Prefixes []PrefixGrowth `json:"prefixes"`
+}
+
+// PrefixGrowth is the growth of the keys of a store sharing a prefix.
+type PrefixGrowth struct {
+	Prefix []byte `json:"prefix"`
+	Name   string `json:"name,omitempty"`
+	Keys   int64  `json:"keys"`
+	Bytes  int64  `json:"bytes"`
+}
+
+// Compare returns the growth of the stores between two reports, ordered by
+// decreasing absolute growth. The reports should be made with the same options,
+// and with all the prefixes reported, i.e. a non-positive TopPrefixes, for the
+// growth of the prefixes to be exact.
+func Compare(from, to *Report, topPrefixesN int) *Growth {
+	growth := &Growth{FromHeight: from.Height, ToHeight: to.Height}
+
+	stores := make(map[string]*StoreGrowth)
+	var names []string
+	storeGrowth := func(name string) *StoreGrowth {
+		sg, ok := stores[name]
+		if !ok {
+			sg = &StoreGrowth{Name: name}
+			stores[name] = sg
+			names = append(names, name)
+		}
+		return sg
+	}
+	prefixes := make(map[string]map[string]*PrefixGrowth)
+	prefixGrowth := func(store string, pr PrefixReport) *PrefixGrowth {
+		if prefixes[store] == nil {
+			prefixes[store] = make(map[string]*PrefixGrowth)
+		}
+		pg, ok := prefixes[store][string(pr.Prefix)]
+		if !ok {
+			pg = &PrefixGrowth{Prefix: pr.Prefix, Name: pr.Name}
+			prefixes[store][string(pr.Prefix)] = pg
+		}
+		return pg
+	}
+
+	for _, sr := range from.Stores {
+		sg := storeGrowth(sr.Name)
+		sg.Keys -= int64(sr.Keys)
+		sg.Bytes -= int64(sr.Bytes())
+		for _, pr := range sr.Prefixes {
+			pg := prefixGrowth(sr.Name, pr)
+			pg.Keys -= int64(pr.Keys)
+			pg.Bytes -= int64(pr.Bytes)
+		}
+	}
+	for _, sr := range to.Stores {
+		sg := storeGrowth(sr.Name)
+		sg.Keys += int64(sr.Keys)
+		sg.Bytes += int64(sr.Bytes())
+		for _, pr := range sr.Prefixes {
+			pg := prefixGrowth(sr.Name, pr)
+			pg.Keys += int64(pr.Keys)
+			pg.Bytes += int64(pr.Bytes)
+			if pg.Name == "" {
+				pg.Name = pr.Name
+			}
+		}
+	}
+
+	for _, name := range names {
+		sg := stores[name]
+		keys := make([]string, 0, len(prefixes[name]))
+		for prefix := range prefixes[name] {
+			keys = append(keys, prefix)
+		}
+		// sort first for the order of equally growing prefixes to be deterministic
+		sort.Strings(keys)
+		for _, prefix := range keys {
+			if pg := prefixes[name][prefix]; pg.Keys != 0 || pg.Bytes != 0 {
+				sg.Prefixes = append(sg.Prefixes, *pg)
+			}
+		}
+		sg.Prefixes = topPrefixes(sg.Prefixes, topPrefixesN, func(pg PrefixGrowth) int64 { return pg.Bytes })
+		growth.Stores = append(growth.Stores, *sg)
+	}
+	sort.SliceStable(growth.Stores, func(i, j int) bool {
+		return abs(growth.Stores[i].Bytes) > abs(growth.Stores[j].Bytes)
+	})
+
+	return growth
+}
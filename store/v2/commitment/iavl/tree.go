@@ -1,6 +1,7 @@
 package iavl
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/iavl"
@@ -13,13 +14,23 @@ import (
 )
 
 var (
-	_ commitment.Tree      = (*IavlTree)(nil)
-	_ store.PausablePruner = (*IavlTree)(nil)
+	_ commitment.Tree           = (*IavlTree)(nil)
+	_ commitment.FootprintTree  = (*IavlTree)(nil)
+	_ commitment.ResettableTree = (*IavlTree)(nil)
+	_ store.PausablePruner      = (*IavlTree)(nil)
+)
+
+const (
+	// nodeKeyPrefix and nodeKeySize describe the iavl node keys, i.e.
+	// 's' | version (8 bytes) | nonce (4 bytes).
+	nodeKeyPrefix = 's'
+	nodeKeySize   = 13
 )
 
 // IavlTree is a wrapper around iavl.MutableTree.
 type IavlTree struct {
 	tree *iavl.MutableTree
+	db   corestore.KVStoreWithBatch
 }
 
 // NewIavlTree creates a new IavlTree instance.
@@ -27,6 +38,7 @@ func NewIavlTree(db corestore.KVStoreWithBatch, logger log.Logger, cfg *Config)
 	tree := iavl.NewMutableTree(db, cfg.CacheSize, cfg.SkipFastStorageUpgrade, logger, iavl.AsyncPruningOption(true))
 	return &IavlTree{
 		tree: tree,
+		db:   db,
 	}
 }
 
@@ -60,6 +72,17 @@ func (t *IavlTree) LoadVersion(version uint64) error {
 	return t.tree.LoadVersionForOverwriting(int64(version))
 }
 
+// Reset deletes all the versions of the tree, back to the empty tree. It must be
+// called before loading a version, as iavl caches the first version of the tree.
+func (t *IavlTree) Reset() error {
+	if err := t.tree.DeleteVersionsFrom(1); err != nil {
+		return err
+	}
+	t.tree.Rollback()
+	_, err := t.tree.LoadVersion(0)
+	return err
+}
+
 // Commit commits the current state to the tree.
 func (t *IavlTree) Commit() ([]byte, uint64, error) {
 	hash, v, err := t.tree.SaveVersion()
@@ -139,6 +162,37 @@ func (t *IavlTree) Import(version uint64) (commitment.Importer, error) {
 	}, nil
 }
 
+// Footprint scans the database of the tree and returns its storage footprint.
+// Nodes are stored under 's' | version | nonce keys, so the versions are counted
+// from the node keys, which are sorted by version.
+func (t *IavlTree) Footprint() (commitment.Footprint, error) {
+	var (
+		fp          commitment.Footprint
+		lastVersion []byte
+	)
+	itr, err := t.db.Iterator(nil, nil)
+	if err != nil {
+		return fp, err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		key := itr.Key()
+		fp.Entries++
+		fp.Bytes += uint64(len(key) + len(itr.Value()))
+		if len(key) != nodeKeySize || key[0] != nodeKeyPrefix {
+			continue
+		}
+		fp.Nodes++
+		if version := key[1:9]; !bytes.Equal(version, lastVersion) {
+			fp.Versions++
+			lastVersion = bytes.Clone(version)
+		}
+	}
+
+	return fp, itr.Error()
+}
+
 // Close closes the iavl tree.
 func (t *IavlTree) Close() error {
 	return t.tree.Close()
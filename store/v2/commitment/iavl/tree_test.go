@@ -133,3 +133,28 @@ func TestIavlTree(t *testing.T) {
 	// close the db
 	require.NoError(t, tree.Close())
 }
+
+func TestIavlTreeReset(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := NewIavlTree(db, coretesting.NewNopLogger(), DefaultConfig())
+	emptyHash := tree.WorkingHash()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, tree.Set([]byte("key"), []byte{byte(i)}))
+		_, _, err := tree.Commit()
+		require.NoError(t, err)
+	}
+
+	// resetting the reopened tree deletes all its versions
+	tree = NewIavlTree(db, coretesting.NewNopLogger(), DefaultConfig())
+	require.NoError(t, tree.Reset())
+	v, err := tree.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), v)
+	require.Equal(t, emptyHash, tree.WorkingHash())
+
+	// the tree can be committed from the version 1 again
+	require.NoError(t, tree.Set([]byte("key"), []byte{0}))
+	_, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), version)
+}
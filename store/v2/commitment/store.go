@@ -1,11 +1,14 @@
 package commitment
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
 	"maps"
 	"math"
+	"runtime"
 	"slices"
 
 	protoio "github.com/cosmos/gogoproto/io"
@@ -23,10 +26,17 @@ import (
 var (
 	_ store.Committer             = (*CommitStore)(nil)
 	_ store.UpgradeableStore      = (*CommitStore)(nil)
+	_ store.ResettableStore       = (*CommitStore)(nil)
 	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
 	_ store.PausablePruner        = (*CommitStore)(nil)
 )
 
+const (
+	// snapshotBufferSize is the number of nodes buffered per store while
+	// exporting or importing the trees of a snapshot.
+	snapshotBufferSize = 1024
+)
+
 // MountTreeFn is a function that mounts a tree given a store key.
 // It is used to lazily mount trees when needed (e.g. during upgrade or proof generation).
 type MountTreeFn func(storeKey string) (Tree, error)
@@ -43,6 +53,9 @@ type CommitStore struct {
 	// oldTrees is a map of store keys to old trees that have been deleted or renamed.
 	// It is used to get the proof for the old store keys.
 	oldTrees map[string]Tree
+	// snapshotWorkers is the maximum number of trees exported or imported
+	// concurrently, it defaults to GOMAXPROCS when not positive.
+	snapshotWorkers int
 }
 
 // NewCommitStore creates a new CommitStore instance.
@@ -55,6 +68,19 @@ func NewCommitStore(trees, oldTrees map[string]Tree, db corestore.KVStoreWithBat
 	}, nil
 }
 
+// SetSnapshotWorkers sets the maximum number of trees exported or imported
+// concurrently when taking or restoring a snapshot.
+func (c *CommitStore) SetSnapshotWorkers(workers int) {
+	c.snapshotWorkers = workers
+}
+
+func (c *CommitStore) snapshotConcurrency() int {
+	if c.snapshotWorkers > 0 {
+		return c.snapshotWorkers
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
 func (c *CommitStore) WriteChangeset(cs *corestore.Changeset) error {
 	for _, pairs := range cs.Changes {
 		key := conv.UnsafeBytesToStr(pairs.Actor)
@@ -186,6 +212,38 @@ func (c *CommitStore) loadVersion(targetVersion uint64, storeKeys []string) erro
 	return nil
 }
 
+// Reset implements store.ResettableStore, deleting the versions of all the trees
+// and their commit infos. All the trees but the memory ones must implement
+// ResettableTree, and no version must be loaded yet.
+func (c *CommitStore) Reset() error {
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	for version := latestVersion; version > 0; version-- {
+		if err := c.metadata.deleteCommitInfo(version); err != nil {
+			return err
+		}
+	}
+	if err := c.metadata.setLatestVersion(0); err != nil {
+		return err
+	}
+
+	for storeKey, tree := range c.multiTrees {
+		if internal.IsMemoryStoreKey(storeKey) {
+			continue
+		}
+		resettable, ok := tree.(ResettableTree)
+		if !ok {
+			return fmt.Errorf("tree %s cannot be reset", storeKey)
+		}
+		if err := resettable.Reset(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
 	storeInfos := make([]proof.StoreInfo, 0, len(c.multiTrees))
 
@@ -332,6 +390,11 @@ func (c *CommitStore) PausePruning(pause bool) {
 }
 
 // Snapshot implements snapshotstypes.CommitSnapshotter.
+//
+// The trees are exported concurrently by a bounded pool of workers, while the
+// items are written to the protoWriter in the sorted order of the store keys so
+// that the snapshot layout is deterministic. Each store is terminated by a
+// checksum item covering its exported nodes.
 func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error {
 	if version == 0 {
 		return errors.New("the snapshot version must be greater than 0")
@@ -345,53 +408,127 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 		return fmt.Errorf("the snapshot version %d is greater than the latest version %d", version, latestVersion)
 	}
 
-	for storeKey, tree := range c.multiTrees {
-		// TODO: check the parallelism of this loop
-		if err := func() error {
-			exporter, err := tree.Export(version)
-			if err != nil {
-				return fmt.Errorf("failed to export tree for version %d: %w", version, err)
+	storeKeys := slices.Sorted(maps.Keys(c.multiTrees))
+	exports := make([]chan exportResult, len(storeKeys))
+	for i := range exports {
+		exports[i] = make(chan exportResult, snapshotBufferSize)
+	}
+
+	// done is closed when the snapshot returns, so that the workers of the
+	// remaining stores stop exporting if an error occurred.
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		sem := make(chan struct{}, c.snapshotConcurrency())
+		for i, storeKey := range storeKeys {
+			// the workers are started in the order of the store keys, so the store
+			// being written always holds a slot and the pool can not deadlock.
+			select {
+			case sem <- struct{}{}:
+			case <-done:
+				return
 			}
-			defer exporter.Close()
+			go func(tree Tree, ch chan<- exportResult) {
+				defer func() { <-sem }()
+				exportTree(tree, version, ch, done)
+			}(c.multiTrees[storeKey], exports[i])
+		}
+	}()
 
-			err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-				Item: &snapshotstypes.SnapshotItem_Store{
-					Store: &snapshotstypes.SnapshotStoreItem{
-						Name: storeKey,
-					},
+	for i, storeKey := range storeKeys {
+		err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_Store{
+				Store: &snapshotstypes.SnapshotStoreItem{
+					Name: storeKey,
 				},
-			})
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write store name: %w", err)
+		}
+
+		hasher := sha256.New()
+		for res := range exports[i] {
+			if res.err != nil {
+				return fmt.Errorf("failed to export store %s: %w", storeKey, res.err)
+			}
+			bz, err := res.item.Marshal()
 			if err != nil {
-				return fmt.Errorf("failed to write store name: %w", err)
+				return fmt.Errorf("failed to marshal iavl node: %w", err)
 			}
+			hasher.Write(bz)
 
-			for {
-				item, err := exporter.Next()
-				if errors.Is(err, ErrorExportDone) {
-					break
-				} else if err != nil {
-					return fmt.Errorf("failed to get the next export node: %w", err)
-				}
-
-				if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
-					Item: &snapshotstypes.SnapshotItem_IAVL{
-						IAVL: item,
-					},
-				}); err != nil {
-					return fmt.Errorf("failed to write iavl node: %w", err)
-				}
+			if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+				Item: &snapshotstypes.SnapshotItem_IAVL{
+					IAVL: res.item,
+				},
+			}); err != nil {
+				return fmt.Errorf("failed to write iavl node: %w", err)
 			}
+		}
 
-			return nil
-		}(); err != nil {
-			return err
+		if err = protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_StoreChecksum{
+				StoreChecksum: &snapshotstypes.SnapshotStoreChecksum{
+					Name:     storeKey,
+					Checksum: hasher.Sum(nil),
+				},
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to write store checksum: %w", err)
 		}
 	}
 
 	return nil
 }
 
+// exportResult is either an exported node or the error which aborted the export.
+type exportResult struct {
+	item *snapshotstypes.SnapshotIAVLItem
+	err  error
+}
+
+// exportTree exports the tree at the given version and passes the nodes through
+// ch, which is closed once the export is complete or has failed.
+func exportTree(tree Tree, version uint64, ch chan<- exportResult, done <-chan struct{}) {
+	defer close(ch)
+
+	send := func(res exportResult) bool {
+		select {
+		case ch <- res:
+			return true
+		case <-done:
+			return false
+		}
+	}
+
+	exporter, err := tree.Export(version)
+	if err != nil {
+		send(exportResult{err: fmt.Errorf("failed to export tree for version %d: %w", version, err)})
+		return
+	}
+	defer exporter.Close()
+
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			return
+		} else if err != nil {
+			send(exportResult{err: fmt.Errorf("failed to get the next export node: %w", err)})
+			return
+		}
+		if !send(exportResult{item: item}) {
+			return
+		}
+	}
+}
+
 // Restore implements snapshotstypes.CommitSnapshotter.
+//
+// The nodes of each store are handed over to a dedicated importer goroutine, so
+// that up to the snapshot concurrency trees are imported at the same time while
+// the stream is being read.
 func (c *CommitStore) Restore(
 	version uint64,
 	format uint32,
@@ -399,11 +536,47 @@ func (c *CommitStore) Restore(
 	chStorage chan<- *corestore.StateChanges,
 ) (snapshotstypes.SnapshotItem, error) {
 	var (
-		importer     Importer
+		importer     *storeImporter
+		importers    []*storeImporter
 		snapshotItem snapshotstypes.SnapshotItem
 		storeKey     []byte
+		hasher       = sha256.New()
+		sem          = make(chan struct{}, c.snapshotConcurrency())
 	)
 
+	// finish verifies the store checksum, which is required from the format
+	// introducing the checksum items, and hands the last nodes over to the
+	// current importer, which only commits the tree if the checksum matches.
+	finish := func(checksum []byte) error {
+		if importer == nil {
+			return nil
+		}
+		var err error
+		switch {
+		case checksum == nil && format >= snapshotstypes.FormatStoreChecksums:
+			err = fmt.Errorf("store %s: %w: missing checksum", storeKey, snapshotstypes.ErrStoreChecksumMismatch)
+		case checksum != nil && !bytes.Equal(checksum, hasher.Sum(nil)):
+			err = fmt.Errorf("store %s: %w: expected %X, got %X",
+				storeKey, snapshotstypes.ErrStoreChecksumMismatch, checksum, hasher.Sum(nil))
+		}
+		importer.aborted = err != nil
+		close(importer.nodes)
+		importer = nil
+		return err
+	}
+
+	// abort discards the current import and waits for the importers to exit.
+	abort := func(err error) (snapshotstypes.SnapshotItem, error) {
+		if importer != nil {
+			importer.aborted = true
+			close(importer.nodes)
+		}
+		for _, imp := range importers {
+			<-imp.done
+		}
+		return snapshotstypes.SnapshotItem{}, err
+	}
+
 loop:
 	for {
 		snapshotItem = snapshotstypes.SnapshotItem{}
@@ -411,40 +584,53 @@ loop:
 		if errors.Is(err, io.EOF) {
 			break
 		} else if err != nil {
-			return snapshotstypes.SnapshotItem{}, fmt.Errorf("invalid protobuf message: %w", err)
+			return abort(fmt.Errorf("invalid protobuf message: %w", err))
 		}
 
 		switch item := snapshotItem.Item.(type) {
 		case *snapshotstypes.SnapshotItem_Store:
-			if importer != nil {
-				if err := importer.Commit(); err != nil {
-					return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to commit importer: %w", err)
-				}
-				if err := importer.Close(); err != nil {
-					return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to close importer: %w", err)
-				}
+			if err := finish(nil); err != nil {
+				return abort(err)
 			}
 
 			storeKey = []byte(item.Store.Name)
 			tree := c.multiTrees[item.Store.Name]
 			if tree == nil {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("store %s not found", item.Store.Name)
+				return abort(fmt.Errorf("store %s not found", item.Store.Name))
 			}
-			importer, err = tree.Import(version)
-			if err != nil {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to import tree for version %d: %w", version, err)
+			hasher.Reset()
+
+			sem <- struct{}{}
+			importer = newStoreImporter(tree)
+			importers = append(importers, importer)
+			go func(imp *storeImporter) {
+				defer func() { <-sem }()
+				imp.run(version)
+			}(importer)
+
+		case *snapshotstypes.SnapshotItem_StoreChecksum:
+			if importer == nil || item.StoreChecksum.Name != string(storeKey) {
+				return abort(fmt.Errorf("unexpected checksum item for store %s", item.StoreChecksum.Name))
+			}
+			if err := finish(item.StoreChecksum.Checksum); err != nil {
+				return abort(err)
 			}
-			defer importer.Close()
 
 		case *snapshotstypes.SnapshotItem_IAVL:
 			if importer == nil {
-				return snapshotstypes.SnapshotItem{}, errors.New("received IAVL node item before store item")
+				return abort(errors.New("received IAVL node item before store item"))
 			}
 			node := item.IAVL
 			if node.Height > int32(math.MaxInt8) {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
+				return abort(fmt.Errorf("node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8))
+			}
+			bz, err := node.Marshal()
+			if err != nil {
+				return abort(fmt.Errorf("failed to marshal iavl node: %w", err))
 			}
+			_, _ = hasher.Write(bz)
+
 			// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
 			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
 			if node.Key == nil {
@@ -466,24 +652,88 @@ loop:
 					},
 				}
 			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to add node to importer: %w", err)
+			select {
+			case importer.nodes <- node:
+			case <-importer.done:
+				return abort(importer.err)
 			}
 		default:
 			break loop
 		}
 	}
 
-	if importer != nil {
-		if err := importer.Commit(); err != nil {
-			return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to commit importer: %w", err)
+	if err := finish(nil); err != nil {
+		return abort(err)
+	}
+	for _, imp := range importers {
+		<-imp.done
+		if imp.err != nil {
+			return snapshotstypes.SnapshotItem{}, imp.err
 		}
 	}
 
 	return snapshotItem, c.LoadVersion(version)
 }
 
+// storeImporter imports the nodes of a single store received through a channel.
+type storeImporter struct {
+	tree  Tree
+	nodes chan *snapshotstypes.SnapshotIAVLItem
+	// aborted is set before closing nodes when the import must not be committed.
+	aborted bool
+	// done is closed once the import has completed, err is set if it failed.
+	done chan struct{}
+	err  error
+}
+
+func newStoreImporter(tree Tree) *storeImporter {
+	return &storeImporter{
+		tree:  tree,
+		nodes: make(chan *snapshotstypes.SnapshotIAVLItem, snapshotBufferSize),
+		done:  make(chan struct{}),
+	}
+}
+
+// run imports the nodes until the nodes channel is closed and commits the tree.
+func (s *storeImporter) run(version uint64) {
+	defer close(s.done)
+
+	importer, err := s.tree.Import(version)
+	if err != nil {
+		s.err = fmt.Errorf("failed to import tree for version %d: %w", version, err)
+		return
+	}
+	defer importer.Close()
+
+	for node := range s.nodes {
+		if err := importer.Add(node); err != nil {
+			s.err = fmt.Errorf("failed to add node to importer: %w", err)
+			return
+		}
+	}
+	if s.aborted {
+		return
+	}
+
+	if err := importer.Commit(); err != nil {
+		s.err = fmt.Errorf("failed to commit importer: %w", err)
+	}
+}
+
+// Footprint returns the storage footprint of the tree of the given store key.
+func (c *CommitStore) Footprint(storeKey string) (Footprint, error) {
+	tree, ok := c.multiTrees[storeKey]
+	if !ok {
+		return Footprint{}, fmt.Errorf("store %s not found", storeKey)
+	}
+	fpTree, ok := tree.(FootprintTree)
+	if !ok {
+		return Footprint{}, fmt.Errorf("store %s does not support footprint", storeKey)
+	}
+
+	return fpTree.Footprint()
+}
+
 func (c *CommitStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
 	return c.metadata.GetCommitInfo(version)
 }
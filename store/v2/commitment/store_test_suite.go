@@ -6,6 +6,7 @@ import (
 	"io"
 	"sync"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
 	corelog "cosmossdk.io/core/log"
@@ -125,6 +126,121 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 	}
 }
 
+// itemsWriter collects the written snapshot items in memory.
+type itemsWriter struct {
+	items []snapshotstypes.SnapshotItem
+}
+
+func (w *itemsWriter) WriteMsg(msg proto.Message) error {
+	w.items = append(w.items, *msg.(*snapshotstypes.SnapshotItem))
+	return nil
+}
+
+// itemsReader replays the given snapshot items.
+type itemsReader struct {
+	items []snapshotstypes.SnapshotItem
+}
+
+func (r *itemsReader) ReadMsg(msg proto.Message) error {
+	if len(r.items) == 0 {
+		return io.EOF
+	}
+	*msg.(*snapshotstypes.SnapshotItem) = r.items[0]
+	r.items = r.items[1:]
+	return nil
+}
+
+func (s *CommitStoreTestSuite) TestStore_SnapshotConcurrency() {
+	storeKeys := []string{storeKey1, storeKey2, storeKey3}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(5)
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			for j := 0; j < 10; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+				value := []byte(fmt.Sprintf("value-%d-%d", i, j))
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+
+	// the snapshot layout must not depend on the number of workers
+	var snapshots []*itemsWriter
+	for _, workers := range []int{1, 2, 8} {
+		commitStore.SetSnapshotWorkers(workers)
+		w := &itemsWriter{}
+		s.Require().NoError(commitStore.Snapshot(latestVersion, w))
+		snapshots = append(snapshots, w)
+	}
+	for _, w := range snapshots[1:] {
+		s.Require().Equal(snapshots[0].items, w.items)
+	}
+
+	names := []string{}
+	for _, item := range snapshots[0].items {
+		if store := item.GetStore(); store != nil {
+			names = append(names, store.Name)
+		}
+		if checksum := item.GetStoreChecksum(); checksum != nil {
+			s.Require().Equal(names[len(names)-1], checksum.Name)
+		}
+	}
+	s.Require().Equal(storeKeys, names)
+
+	// restore with all the workers available
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	chStorage := make(chan *corestore.StateChanges, 1000)
+	_, err = targetStore.Restore(latestVersion, snapshotstypes.CurrentFormat, &itemsReader{items: snapshots[0].items}, chStorage)
+	s.Require().NoError(err)
+	s.Require().Equal(commitStore.WorkingCommitInfo(latestVersion).Hash(), targetStore.WorkingCommitInfo(latestVersion).Hash())
+
+	// a corrupted store checksum must fail the restore
+	items := make([]snapshotstypes.SnapshotItem, len(snapshots[0].items))
+	copy(items, snapshots[0].items)
+	for i, item := range items {
+		if checksum := item.GetStoreChecksum(); checksum != nil && checksum.Name == storeKey2 {
+			items[i] = snapshotstypes.SnapshotItem{
+				Item: &snapshotstypes.SnapshotItem_StoreChecksum{
+					StoreChecksum: &snapshotstypes.SnapshotStoreChecksum{Name: storeKey2, Checksum: []byte{1, 2, 3}},
+				},
+			}
+		}
+	}
+	targetStore, err = s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	chStorage = make(chan *corestore.StateChanges, 1000)
+	_, err = targetStore.Restore(latestVersion, snapshotstypes.CurrentFormat, &itemsReader{items: items}, chStorage)
+	s.Require().ErrorIs(err, snapshotstypes.ErrStoreChecksumMismatch)
+	// the tree of the corrupted store must not be committed
+	version, err := targetStore.multiTrees[storeKey2].GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Zero(version)
+
+	// a missing store checksum must fail the restore
+	items = items[:0]
+	for _, item := range snapshots[0].items {
+		if checksum := item.GetStoreChecksum(); checksum == nil || checksum.Name != storeKey3 {
+			items = append(items, item)
+		}
+	}
+	targetStore, err = s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	chStorage = make(chan *corestore.StateChanges, 1000)
+	_, err = targetStore.Restore(latestVersion, snapshotstypes.CurrentFormat, &itemsReader{items: items}, chStorage)
+	s.Require().ErrorIs(err, snapshotstypes.ErrStoreChecksumMismatch)
+	s.Require().ErrorContains(err, "missing checksum")
+	version, err = targetStore.multiTrees[storeKey3].GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Zero(version)
+}
+
 func (s *CommitStoreTestSuite) TestStore_LoadVersion() {
 	storeKeys := []string{storeKey1, storeKey2}
 	mdb := dbm.NewMemDB()
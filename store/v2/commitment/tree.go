@@ -42,6 +42,30 @@ type Tree interface {
 	io.Closer
 }
 
+// Footprint is the storage footprint of a tree in its database, including the
+// nodes of all the retained versions and the ones not yet pruned.
+type Footprint struct {
+	// Entries is the number of entries in the database.
+	Entries uint64
+	// Bytes is the total size of the keys and values of the entries.
+	Bytes uint64
+	// Nodes is the number of tree nodes.
+	Nodes uint64
+	// Versions is the number of versions having nodes in the database.
+	Versions uint64
+}
+
+// ResettableTree is implemented by the trees which can delete all their versions,
+// back to the empty tree, before a version is loaded.
+type ResettableTree interface {
+	Reset() error
+}
+
+// FootprintTree is implemented by trees able to report their storage footprint.
+type FootprintTree interface {
+	Footprint() (Footprint, error)
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
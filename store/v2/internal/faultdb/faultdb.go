@@ -0,0 +1,180 @@
+// Package faultdb provides database wrappers which simulate a process crash at
+// an arbitrary write point. It is used to test the crash consistency of the
+// store.
+package faultdb
+
+import (
+	"errors"
+	"sync"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+)
+
+// ErrCrashed is returned by every write attempted at or after the crash point.
+var ErrCrashed = errors.New("faultdb: simulated crash")
+
+// Injector counts the write points of all the databases it wraps. Once armed,
+// it lets a given number of writes go through and fails every later write, as
+// if the process had crashed. A write point is an atomic write to the
+// underlying database, i.e. a single Set or Delete, or a batch Write.
+//
+// An Injector is safe for concurrent use.
+type Injector struct {
+	mtx     sync.Mutex
+	writes  int
+	crashAt int
+	crashed bool
+}
+
+// NewInjector returns a disarmed Injector.
+func NewInjector() *Injector {
+	return &Injector{crashAt: -1}
+}
+
+// CrashAfter arms the injector so that the next n writes succeed and every
+// following write fails with ErrCrashed.
+func (i *Injector) CrashAfter(n int) {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	i.crashAt = i.writes + n
+}
+
+// Writes returns the number of successful writes.
+func (i *Injector) Writes() int {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	return i.writes
+}
+
+// Crashed returns true if a write has been rejected.
+func (i *Injector) Crashed() bool {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	return i.crashed
+}
+
+func (i *Injector) write(fn func() error) error {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	if i.crashed || (i.crashAt >= 0 && i.writes >= i.crashAt) {
+		i.crashed = true
+		return ErrCrashed
+	}
+	if err := fn(); err != nil {
+		return err
+	}
+	i.writes++
+
+	return nil
+}
+
+var _ corestore.KVStoreWithBatch = (*DB)(nil)
+
+// DB wraps a corestore.KVStoreWithBatch and routes its writes through an
+// Injector. Reads are passed through unchanged.
+type DB struct {
+	db       corestore.KVStoreWithBatch
+	injector *Injector
+}
+
+// NewDB returns a DB wrapping the given database.
+func NewDB(db corestore.KVStoreWithBatch, injector *Injector) *DB {
+	return &DB{db: db, injector: injector}
+}
+
+func (db *DB) Get(key []byte) ([]byte, error) {
+	return db.db.Get(key)
+}
+
+func (db *DB) Has(key []byte) (bool, error) {
+	return db.db.Has(key)
+}
+
+func (db *DB) Set(key, value []byte) error {
+	return db.injector.write(func() error { return db.db.Set(key, value) })
+}
+
+func (db *DB) Delete(key []byte) error {
+	return db.injector.write(func() error { return db.db.Delete(key) })
+}
+
+func (db *DB) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return db.db.Iterator(start, end)
+}
+
+func (db *DB) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return db.db.ReverseIterator(start, end)
+}
+
+func (db *DB) NewBatch() corestore.Batch {
+	return &batch{Batch: db.db.NewBatch(), injector: db.injector}
+}
+
+func (db *DB) NewBatchWithSize(size int) corestore.Batch {
+	return &batch{Batch: db.db.NewBatchWithSize(size), injector: db.injector}
+}
+
+func (db *DB) Close() error {
+	return db.db.Close()
+}
+
+// batch wraps a corestore.Batch. As a batch is written atomically, writing it
+// is a single write point.
+type batch struct {
+	corestore.Batch
+	injector *Injector
+}
+
+func (b *batch) Write() error {
+	return b.injector.write(b.Batch.Write)
+}
+
+func (b *batch) WriteSync() error {
+	return b.injector.write(b.Batch.WriteSync)
+}
+
+var _ storage.Database = (*StorageDB)(nil)
+
+// StorageDB wraps a storage.Database, i.e. a SS backend, and routes its writes
+// through an Injector.
+type StorageDB struct {
+	storage.Database
+	injector *Injector
+}
+
+// NewStorageDB returns a StorageDB wrapping the given SS backend.
+func NewStorageDB(db storage.Database, injector *Injector) *StorageDB {
+	return &StorageDB{Database: db, injector: injector}
+}
+
+func (db *StorageDB) NewBatch(version uint64) (store.Batch, error) {
+	b, err := db.Database.NewBatch(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageBatch{Batch: b, injector: db.injector}, nil
+}
+
+func (db *StorageDB) SetLatestVersion(version uint64) error {
+	return db.injector.write(func() error { return db.Database.SetLatestVersion(version) })
+}
+
+func (db *StorageDB) Prune(version uint64) error {
+	return db.injector.write(func() error { return db.Database.Prune(version) })
+}
+
+type storageBatch struct {
+	store.Batch
+	injector *Injector
+}
+
+func (b *storageBatch) Write() error {
+	return b.injector.write(b.Batch.Write)
+}
@@ -149,6 +149,8 @@ func (m *Manager) Migrate(height uint64) error {
 							},
 						}
 					}
+				case *snapshotstypes.SnapshotItem_StoreChecksum:
+					// the store checksum is only verified when restoring the commitment
 				default:
 					break loop
 				}
@@ -0,0 +1,236 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/internal/faultdb"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+const crashTestBlocks = 4
+
+// crashBackend opens the raw SC database of a crash test node. Reopening a
+// memdb backend returns the same instance, as its content would be lost
+// otherwise.
+type crashBackend struct {
+	name string
+	open func(t *testing.T, dir string) corestore.KVStoreWithBatch
+	// async enables the async SS commit, the queue sharing the SC database
+	async bool
+}
+
+func crashBackends() []crashBackend {
+	diskDB := func(dbType dbm.DBType) func(t *testing.T, dir string) corestore.KVStoreWithBatch {
+		return func(t *testing.T, dir string) corestore.KVStoreWithBatch {
+			t.Helper()
+			db, err := dbm.NewDB(dbType, "sc", dir, nil)
+			require.NoError(t, err)
+			return db
+		}
+	}
+	memDBs := make(map[string]corestore.KVStoreWithBatch)
+	memDB := func(_ *testing.T, dir string) corestore.KVStoreWithBatch {
+		if _, ok := memDBs[dir]; !ok {
+			memDBs[dir] = dbm.NewMemDB()
+		}
+		return memDBs[dir]
+	}
+
+	return []crashBackend{
+		{name: string(dbm.DBTypePebbleDB), open: diskDB(dbm.DBTypePebbleDB)},
+		{name: string(dbm.DBTypeGoLevelDB), open: diskDB(dbm.DBTypeGoLevelDB)},
+		{name: "memdb", open: memDB},
+		{name: "pebbledb_async", open: diskDB(dbm.DBTypePebbleDB), async: true},
+		{name: "memdb_async", open: memDB, async: true},
+	}
+}
+
+// crashNode is a root store along with its raw SC database, which is shared
+// by the trees and thus not closed by the store.
+type crashNode struct {
+	store.RootStore
+	db corestore.KVStoreWithBatch
+}
+
+// syncSS waits for the async SS commit, if enabled, to catch up.
+func (n *crashNode) syncSS() error {
+	if as, ok := n.GetStateStorage().(*storage.AsyncStorageStore); ok {
+		return as.Sync()
+	}
+	return nil
+}
+
+func (n *crashNode) Close() error {
+	return errors.Join(n.RootStore.Close(), n.db.Close())
+}
+
+// openCrashStore opens a root store whose SC and SS writes go through the
+// given fault injector.
+func openCrashStore(t *testing.T, backend crashBackend, dir string, injector *faultdb.Injector) *crashNode {
+	t.Helper()
+	logger := coretesting.NewNopLogger()
+
+	rawDB := backend.open(t, dir)
+	scDB := faultdb.NewDB(rawDB, injector)
+	trees := make(map[string]commitment.Tree, len(testStoreKeys))
+	for _, storeKey := range testStoreKeys {
+		trees[storeKey] = iavl.NewIavlTree(dbm.NewPrefixDB(scDB, []byte(storeKey)), logger, iavl.DefaultConfig())
+	}
+	sc, err := commitment.NewCommitStore(trees, nil, dbm.NewPrefixDB(scDB, []byte("metadata")), logger)
+	require.NoError(t, err)
+
+	ssDB, err := pebbledb.New(filepath.Join(dir, "ss"))
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(faultdb.NewStorageDB(ssDB, injector), logger)
+
+	var rs store.RootStore
+	if backend.async {
+		scVersion, err := sc.GetLatestVersion()
+		require.NoError(t, err)
+		asyncSS, err := storage.NewAsyncStorageStore(ss, dbm.NewPrefixDB(scDB, []byte(ssQueuePrefix)), sc, scVersion, logger)
+		require.NoError(t, err)
+		rs, err = New(logger, asyncSS, sc, pruning.NewManager(sc, asyncSS, nil, nil), nil, nil)
+		require.NoError(t, err)
+	} else {
+		rs, err = New(logger, ss, sc, pruning.NewManager(sc, ss, nil, nil), nil, nil)
+		require.NoError(t, err)
+	}
+	require.NoError(t, rs.LoadLatestVersion())
+
+	return &crashNode{RootStore: rs, db: rawDB}
+}
+
+// crashChangeset returns the deterministic changeset of the given block. Each
+// block updates, adds and removes keys in every store.
+func crashChangeset(version uint64) *corestore.Changeset {
+	cs := corestore.NewChangeset()
+	for _, storeKey := range testStoreKeys {
+		for i := uint64(0); i < 10; i++ {
+			key := []byte(fmt.Sprintf("key%03d", version*5+i))
+			cs.Add([]byte(storeKey), key, []byte(fmt.Sprintf("value%03d_%d", version*5+i, version)), false)
+		}
+		if version > 1 {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key%03d", (version-1)*5)), nil, true)
+		}
+	}
+	return cs
+}
+
+func commitCrashBlock(rs store.RootStore, version uint64) ([]byte, error) {
+	return rs.Commit(crashChangeset(version))
+}
+
+// TestCrashConsistency simulates a crash at every write point of the commit of
+// a block, then restarts the store and checks that it recovers a consistent
+// version from which the chain can be replayed. Both the first block, which
+// leaves SS empty, and a later block are crashed.
+func TestCrashConsistency(t *testing.T) {
+	for _, backend := range crashBackends() {
+		t.Run(backend.name, func(t *testing.T) {
+			// reference run, recording the app hashes and the number of write
+			// points of every block
+			injector := faultdb.NewInjector()
+			rs := openCrashStore(t, backend, t.TempDir(), injector)
+			hashes := make(map[uint64][]byte, crashTestBlocks)
+			writes := make(map[uint64]int, crashTestBlocks)
+			for version := uint64(1); version <= crashTestBlocks; version++ {
+				before := injector.Writes()
+				hash, err := commitCrashBlock(rs, version)
+				require.NoError(t, err)
+				hashes[version] = hash
+				writes[version] = injector.Writes() - before
+			}
+			require.NoError(t, rs.Close())
+
+			for _, crashBlock := range []uint64{1, crashTestBlocks} {
+				require.Greater(t, writes[crashBlock], 1)
+				for crashAt := 0; crashAt <= writes[crashBlock]; crashAt++ {
+					t.Run(fmt.Sprintf("block_%d/crash_at_%d", crashBlock, crashAt), func(t *testing.T) {
+						testCrashAt(t, backend, hashes, crashBlock, crashAt, writes[crashBlock])
+					})
+				}
+			}
+		})
+	}
+}
+
+// testCrashAt commits the blocks up to crashBlock, crashing after crashAt of
+// the writes of crashBlock, then restarts the store and checks its state.
+func testCrashAt(t *testing.T, backend crashBackend, hashes map[uint64][]byte, crashBlock uint64, crashAt, writes int) {
+	t.Helper()
+	dir := t.TempDir()
+	injector := faultdb.NewInjector()
+	rs := openCrashStore(t, backend, dir, injector)
+	for version := uint64(1); version < crashBlock; version++ {
+		_, err := commitCrashBlock(rs, version)
+		require.NoError(t, err)
+	}
+
+	injector.CrashAfter(crashAt)
+	_, err := commitCrashBlock(rs, crashBlock)
+	switch {
+	case backend.async:
+		// with the async SS commit, the write points of the
+		// worker are not bound to the commit of the block
+		if err != nil {
+			require.ErrorIs(t, err, faultdb.ErrCrashed)
+		}
+	case crashAt < writes:
+		require.ErrorIs(t, err, faultdb.ErrCrashed)
+	default:
+		require.NoError(t, err)
+	}
+	// the process is gone, closing only releases the file locks
+	_ = rs.Close()
+
+	rs = openCrashStore(t, backend, dir, faultdb.NewInjector())
+	defer func() {
+		require.NoError(t, rs.Close())
+	}()
+
+	commitID, err := rs.LastCommitID()
+	require.NoError(t, err)
+	require.Contains(t, []uint64{crashBlock - 1, crashBlock}, commitID.Version)
+	if commitID.Version > 0 {
+		require.Equal(t, hashes[commitID.Version], commitID.Hash)
+	}
+	ssVersion, err := rs.GetStateStorage().GetLatestVersion()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, ssVersion, commitID.Version)
+
+	// replay the lost block, if any, and check the resulting state
+	for version := commitID.Version + 1; version <= crashTestBlocks; version++ {
+		hash, err := commitCrashBlock(rs, version)
+		require.NoError(t, err)
+		require.Equal(t, hashes[version], hash)
+	}
+	hash, err := commitCrashBlock(rs, crashTestBlocks+1)
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+	require.NoError(t, rs.syncSS())
+
+	for version := uint64(1); version <= crashTestBlocks; version++ {
+		for _, change := range crashChangeset(version).Changes {
+			for _, kv := range change.StateChanges {
+				ssValue, err := rs.GetStateStorage().Get(change.Actor, crashTestBlocks, kv.Key)
+				require.NoError(t, err)
+				scValue, err := rs.GetStateCommitment().Get(change.Actor, crashTestBlocks, kv.Key)
+				require.NoError(t, err)
+				require.Equal(t, scValue, ssValue, "store %s key %s", change.Actor, kv.Key)
+			}
+		}
+	}
+}
@@ -31,12 +31,16 @@ const (
 	SSTypeRocks  SSType = "rocksdb"
 	SCTypeIavl   SCType = "iavl"
 	SCTypeIavlV2 SCType = "iavl-v2"
+
+	// ssQueuePrefix is the prefix of the async SS commit queue in the SC database.
+	ssQueuePrefix = "ss_queue/"
 )
 
 // app.toml config options
 type Options struct {
 	SSType          SSType               `mapstructure:"ss-type" toml:"ss-type" comment:"SState storage database type. Currently we support: \"sqlite\", \"pebble\" and \"rocksdb\""`
 	SCType          SCType               `mapstructure:"sc-type" toml:"sc-type" comment:"State commitment database type. Currently we support: \"iavl\" and \"iavl-v2\""`
+	SSAsyncCommit   bool                 `mapstructure:"ss-async-commit" toml:"ss-async-commit" comment:"Apply state storage writes asynchronously from a durable queue, off the block commit critical path. Queries at heights not yet applied to the state storage are served by the state commitment."`
 	SSPruningOption *store.PruningOption `mapstructure:"ss-pruning-option" toml:"ss-pruning-option" comment:"Pruning options for state storage"`
 	SCPruningOption *store.PruningOption `mapstructure:"sc-pruning-option" toml:"sc-pruning-option" comment:"Pruning options for state commitment"`
 	IavlConfig      *iavl.Config         `mapstructure:"iavl-config" toml:"iavl-config"`
@@ -176,6 +180,17 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 		return nil, err
 	}
 
+	if storeOpts.SSAsyncCommit {
+		// the queue shares the SC database, so that it is as durable as the SC
+		queue := db.NewPrefixDB(opts.SCRawDB, []byte(ssQueuePrefix))
+		asyncSS, err := storage.NewAsyncStorageStore(ss, queue, sc, latestVersion, opts.Logger)
+		if err != nil {
+			return nil, err
+		}
+		pm := pruning.NewManager(sc, asyncSS, storeOpts.SCPruningOption, storeOpts.SSPruningOption)
+		return New(opts.Logger, asyncSS, sc, pm, nil, nil)
+	}
+
 	pm := pruning.NewManager(sc, ss, storeOpts.SCPruningOption, storeOpts.SSPruningOption)
 	return New(opts.Logger, ss, sc, pm, nil, nil)
 }
@@ -228,9 +228,50 @@ func (s *Store) LoadLatestVersion() error {
 		return err
 	}
 
+	// SS and SC are committed in parallel, so a crash during Commit may leave SS
+	// one version behind SC. In that case, roll SC back to the SS version so that
+	// the last block is replayed and committed to both backends.
+	if !s.isMigrating && lv > 0 {
+		ssVersion, err := s.stateStorage.GetLatestVersion()
+		if err != nil {
+			return err
+		}
+		if ssVersion+1 == lv {
+			s.logger.Info("SS is behind SC, rolling back SC", "ss_version", ssVersion, "sc_version", lv)
+			lv = ssVersion
+		}
+	}
+
+	// A crash during the first Commit may leave the version 1 in the SC trees,
+	// with or without its commit info. As loading the version 0 loads the latest
+	// version of the trees, SC is reset instead, which deletes nothing for a new
+	// store.
+	if !s.isMigrating && lv == 0 {
+		if sc, ok := s.stateCommitment.(store.ResettableStore); ok {
+			return s.resetSC(sc)
+		}
+	}
+
 	return s.loadVersion(lv, nil)
 }
 
+// resetSC deletes all the versions of SC, which may have been left by a crash
+// during the first Commit, and loads the empty store.
+func (s *Store) resetSC(sc store.ResettableStore) error {
+	if err := sc.Reset(); err != nil {
+		return fmt.Errorf("failed to reset SC: %w", err)
+	}
+
+	s.commitHeader = nil
+
+	var err error
+	s.lastCommitInfo, err = s.stateCommitment.GetCommitInfo(0)
+	if err != nil {
+		return fmt.Errorf("failed to get commit info for version 0: %w", err)
+	}
+	return nil
+}
+
 func (s *Store) LoadVersion(version uint64) error {
 	if s.telemetry != nil {
 		now := time.Now()
@@ -94,9 +94,16 @@ func TestLoadVersion(t *testing.T) {
 	err := rs.LoadLatestVersion()
 	require.Error(t, err)
 	sc.EXPECT().GetLatestVersion().Return(uint64(1), nil)
+	ss.EXPECT().GetLatestVersion().Return(uint64(1), nil)
 	sc.EXPECT().LoadVersion(uint64(1)).Return(errors.New("error"))
 	err = rs.LoadLatestVersion()
 	require.Error(t, err)
+	// SS behind SC after a crash during the first commit
+	sc.EXPECT().GetLatestVersion().Return(uint64(1), nil)
+	ss.EXPECT().GetLatestVersion().Return(uint64(0), nil)
+	sc.EXPECT().LoadVersion(uint64(0)).Return(errors.New("error"))
+	err = rs.LoadLatestVersion()
+	require.Error(t, err)
 
 	// LoadVersion
 	sc.EXPECT().LoadVersion(gomock.Any()).Return(nil)
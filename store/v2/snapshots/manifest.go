@@ -0,0 +1,139 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+// Manifest describes the content of a snapshot archive. It is signed by the
+// provider of the archive, so that an archive downloaded from an untrusted
+// mirror can be verified chunk by chunk before being loaded.
+type Manifest struct {
+	Height      uint64   `json:"height"`
+	Format      uint32   `json:"format"`
+	Hash        []byte   `json:"hash"`
+	ChunkHashes [][]byte `json:"chunk_hashes"`
+	// AppHash is the app hash of the snapshotted state, if known by the signer.
+	AppHash []byte `json:"app_hash,omitempty"`
+}
+
+// SignedManifest is a manifest along with the ed25519 signature of its signer.
+type SignedManifest struct {
+	Manifest  Manifest `json:"manifest"`
+	PubKey    []byte   `json:"pub_key"`
+	Signature []byte   `json:"signature"`
+}
+
+// NewManifest creates the manifest of the given snapshot.
+func NewManifest(snapshot *types.Snapshot, appHash []byte) *Manifest {
+	return &Manifest{
+		Height:      snapshot.Height,
+		Format:      snapshot.Format,
+		Hash:        snapshot.Hash,
+		ChunkHashes: snapshot.Metadata.ChunkHashes,
+		AppHash:     appHash,
+	}
+}
+
+// SignBytes returns the canonical bytes of the manifest to be signed.
+func (m *Manifest) SignBytes() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// Sign signs the manifest with the given private key.
+func (m *Manifest) Sign(key ed25519.PrivateKey) (*SignedManifest, error) {
+	bz, err := m.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &SignedManifest{
+		Manifest:  *m,
+		PubKey:    key.Public().(ed25519.PublicKey),
+		Signature: ed25519.Sign(key, bz),
+	}, nil
+}
+
+// ValidateSnapshot checks that the snapshot metadata matches the manifest.
+func (m *Manifest) ValidateSnapshot(snapshot *types.Snapshot) error {
+	if snapshot.Height != m.Height || snapshot.Format != m.Format {
+		return fmt.Errorf("%w: snapshot at height %d format %d, manifest at height %d format %d",
+			types.ErrInvalidManifest, snapshot.Height, snapshot.Format, m.Height, m.Format)
+	}
+	if !bytes.Equal(snapshot.Hash, m.Hash) {
+		return fmt.Errorf("%w: snapshot hash %X, manifest hash %X", types.ErrInvalidManifest, snapshot.Hash, m.Hash)
+	}
+	if snapshot.Chunks != uint32(len(m.ChunkHashes)) || len(snapshot.Metadata.ChunkHashes) != len(m.ChunkHashes) {
+		return fmt.Errorf("%w: snapshot has %d chunks, manifest has %d",
+			types.ErrInvalidManifest, snapshot.Chunks, len(m.ChunkHashes))
+	}
+	for i, hash := range m.ChunkHashes {
+		if !bytes.Equal(snapshot.Metadata.ChunkHashes[i], hash) {
+			return fmt.Errorf("%w: chunk %d hash mismatch", types.ErrInvalidManifest, i)
+		}
+	}
+	return nil
+}
+
+// ValidateChunk checks the SHA-256 hash of the chunk at the given index.
+func (m *Manifest) ValidateChunk(index uint32, chunk []byte) error {
+	if int(index) >= len(m.ChunkHashes) {
+		return fmt.Errorf("%w: unexpected chunk %d", types.ErrInvalidManifest, index)
+	}
+	hash := sha256.Sum256(chunk)
+	if !bytes.Equal(hash[:], m.ChunkHashes[index]) {
+		return fmt.Errorf("chunk %d: %w: expected %X, got %X", index, types.ErrChunkHashMismatch, m.ChunkHashes[index], hash)
+	}
+	return nil
+}
+
+// Verify verifies the signature of the manifest and that it was signed by one
+// of the trusted keys.
+func (s *SignedManifest) Verify(trustedKeys []ed25519.PublicKey) error {
+	if len(s.PubKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("%w: invalid public key size %d", types.ErrInvalidManifest, len(s.PubKey))
+	}
+	trusted := false
+	for _, key := range trustedKeys {
+		if key.Equal(ed25519.PublicKey(s.PubKey)) {
+			trusted = true
+			break
+		}
+	}
+	if !trusted {
+		return fmt.Errorf("%w: manifest signed by untrusted key %X", types.ErrInvalidManifest, s.PubKey)
+	}
+
+	bz, err := s.Manifest.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(s.PubKey, bz, s.Signature) {
+		return fmt.Errorf("%w: invalid signature", types.ErrInvalidManifest)
+	}
+	return nil
+}
+
+// VerifyAppHash recomputes the hash of the commit info and checks it against
+// the trusted app hash of the same height.
+func VerifyAppHash(commitInfo *proof.CommitInfo, height uint64, appHash []byte) error {
+	if commitInfo == nil {
+		return fmt.Errorf("%w: no commit info found at height %d", types.ErrAppHashMismatch, height)
+	}
+	if commitInfo.Version != height {
+		return fmt.Errorf("%w: commit info at height %d, trusted height %d",
+			types.ErrAppHashMismatch, commitInfo.Version, height)
+	}
+	// recompute the hash from the store infos rather than trusting the cached one
+	recomputed := *commitInfo
+	recomputed.CommitHash = nil
+	if hash := recomputed.Hash(); !bytes.Equal(hash, appHash) {
+		return fmt.Errorf("%w: expected %X, got %X", types.ErrAppHashMismatch, appHash, hash)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package snapshots_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+func TestManifest_SignVerify(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}}
+	snapshot := &types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Chunks:   2,
+		Hash:     []byte{7, 8, 9},
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signed, err := snapshots.NewManifest(snapshot, []byte{0xa, 0xb}).Sign(priv)
+	require.NoError(t, err)
+	require.NoError(t, signed.Verify([]ed25519.PublicKey{otherPub, pub}))
+	require.ErrorIs(t, signed.Verify([]ed25519.PublicKey{otherPub}), types.ErrInvalidManifest)
+	require.ErrorIs(t, signed.Verify(nil), types.ErrInvalidManifest)
+
+	require.NoError(t, signed.Manifest.ValidateSnapshot(snapshot))
+	require.NoError(t, signed.Manifest.ValidateChunk(0, chunks[0]))
+	require.NoError(t, signed.Manifest.ValidateChunk(1, chunks[1]))
+	require.ErrorIs(t, signed.Manifest.ValidateChunk(1, chunks[0]), types.ErrChunkHashMismatch)
+	require.ErrorIs(t, signed.Manifest.ValidateChunk(2, chunks[0]), types.ErrInvalidManifest)
+
+	// tampering with the manifest invalidates the signature
+	tampered := *signed
+	tampered.Manifest.AppHash = []byte{0xc}
+	require.ErrorIs(t, tampered.Verify([]ed25519.PublicKey{pub}), types.ErrInvalidManifest)
+
+	// a snapshot not matching the manifest is rejected
+	other := *snapshot
+	other.Hash = []byte{1}
+	require.ErrorIs(t, signed.Manifest.ValidateSnapshot(&other), types.ErrInvalidManifest)
+	other = *snapshot
+	other.Metadata = types.Metadata{ChunkHashes: checksums([][]byte{chunks[1], chunks[0]})}
+	require.ErrorIs(t, signed.Manifest.ValidateSnapshot(&other), types.ErrInvalidManifest)
+}
+
+func TestVerifyAppHash(t *testing.T) {
+	hash := sha256.Sum256([]byte("store"))
+	commitInfo := &proof.CommitInfo{
+		Version: 5,
+		StoreInfos: []proof.StoreInfo{
+			{Name: []byte("store1"), CommitID: proof.CommitID{Version: 5, Hash: hash[:]}},
+		},
+	}
+	appHash := commitInfo.Hash()
+
+	require.NoError(t, snapshots.VerifyAppHash(commitInfo, 5, appHash))
+	require.ErrorIs(t, snapshots.VerifyAppHash(commitInfo, 4, appHash), types.ErrAppHashMismatch)
+	require.ErrorIs(t, snapshots.VerifyAppHash(commitInfo, 5, []byte{1}), types.ErrAppHashMismatch)
+	require.ErrorIs(t, snapshots.VerifyAppHash(nil, 5, appHash), types.ErrAppHashMismatch)
+
+	// a forged cached hash is not trusted
+	forged := *commitInfo
+	forged.StoreInfos = []proof.StoreInfo{
+		{Name: []byte("store1"), CommitID: proof.CommitID{Version: 5, Hash: []byte("forged")}},
+	}
+	forged.CommitHash = appHash
+	require.ErrorIs(t, snapshots.VerifyAppHash(&forged, 5, appHash), types.ErrAppHashMismatch)
+}
@@ -14,6 +14,15 @@ var (
 	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
 	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
 
+	// ErrStoreChecksumMismatch is returned when the checksum of a restored store doesn't match.
+	ErrStoreChecksumMismatch = errors.New("store checksum verification failed")
+
+	// ErrInvalidManifest is returned when a snapshot manifest is invalid or untrusted.
+	ErrInvalidManifest = errors.New("invalid snapshot manifest")
+
+	// ErrAppHashMismatch is returned when the restored state doesn't match the trusted app hash.
+	ErrAppHashMismatch = errors.New("restored app hash mismatch")
+
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
 )
@@ -3,4 +3,8 @@ package types
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 3
+const CurrentFormat uint32 = 4
+
+// FormatStoreChecksums is the first format terminating the items of each store with
+// a checksum item, which restores require from this format on.
+const FormatStoreChecksums uint32 = 4
@@ -151,11 +151,11 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
 	//	*SnapshotItem_ExtensionPayload
+	//	*SnapshotItem_StoreChecksum
 	Item isSnapshotItem_Item `protobuf_oneof:"item"`
 }
 
@@ -210,11 +210,15 @@ type SnapshotItem_Extension struct {
 type SnapshotItem_ExtensionPayload struct {
 	ExtensionPayload *SnapshotExtensionPayload `protobuf:"bytes,4,opt,name=extension_payload,json=extensionPayload,proto3,oneof" json:"extension_payload,omitempty"`
 }
+type SnapshotItem_StoreChecksum struct {
+	StoreChecksum *SnapshotStoreChecksum `protobuf:"bytes,5,opt,name=store_checksum,json=storeChecksum,proto3,oneof" json:"store_checksum,omitempty"`
+}
 
 func (*SnapshotItem_Store) isSnapshotItem_Item()            {}
 func (*SnapshotItem_IAVL) isSnapshotItem_Item()             {}
 func (*SnapshotItem_Extension) isSnapshotItem_Item()        {}
 func (*SnapshotItem_ExtensionPayload) isSnapshotItem_Item() {}
+func (*SnapshotItem_StoreChecksum) isSnapshotItem_Item()    {}
 
 func (m *SnapshotItem) GetItem() isSnapshotItem_Item {
 	if m != nil {
@@ -251,6 +255,13 @@ func (m *SnapshotItem) GetExtensionPayload() *SnapshotExtensionPayload {
 	return nil
 }
 
+func (m *SnapshotItem) GetStoreChecksum() *SnapshotStoreChecksum {
+	if x, ok := m.GetItem().(*SnapshotItem_StoreChecksum); ok {
+		return x.StoreChecksum
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -258,6 +269,7 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 		(*SnapshotItem_IAVL)(nil),
 		(*SnapshotItem_Extension)(nil),
 		(*SnapshotItem_ExtensionPayload)(nil),
+		(*SnapshotItem_StoreChecksum)(nil),
 	}
 }
 
@@ -306,6 +318,60 @@ func (m *SnapshotStoreItem) GetName() string {
 	return ""
 }
 
+// SnapshotStoreChecksum terminates the items of a snapshotted store.
+type SnapshotStoreChecksum struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// checksum is the SHA-256 hash over the serialized IAVL items of the store.
+	Checksum []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *SnapshotStoreChecksum) Reset()         { *m = SnapshotStoreChecksum{} }
+func (m *SnapshotStoreChecksum) String() string { return proto.CompactTextString(m) }
+func (*SnapshotStoreChecksum) ProtoMessage()    {}
+func (*SnapshotStoreChecksum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6851f1463fcbb80c, []int{4}
+}
+func (m *SnapshotStoreChecksum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotStoreChecksum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotStoreChecksum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotStoreChecksum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotStoreChecksum.Merge(m, src)
+}
+func (m *SnapshotStoreChecksum) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotStoreChecksum) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotStoreChecksum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotStoreChecksum proto.InternalMessageInfo
+
+func (m *SnapshotStoreChecksum) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SnapshotStoreChecksum) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
 // SnapshotIAVLItem is an exported IAVL node.
 type SnapshotIAVLItem struct {
 	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -320,7 +386,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6851f1463fcbb80c, []int{4}
+	return fileDescriptor_6851f1463fcbb80c, []int{5}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +453,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6851f1463fcbb80c, []int{5}
+	return fileDescriptor_6851f1463fcbb80c, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +505,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6851f1463fcbb80c, []int{6}
+	return fileDescriptor_6851f1463fcbb80c, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,6 +546,7 @@ func init() {
 	proto.RegisterType((*Metadata)(nil), "cosmos.store.snapshots.v2.Metadata")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v2.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v2.SnapshotStoreItem")
+	proto.RegisterType((*SnapshotStoreChecksum)(nil), "cosmos.store.snapshots.v2.SnapshotStoreChecksum")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v2.SnapshotIAVLItem")
 	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v2.SnapshotExtensionMeta")
 	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v2.SnapshotExtensionPayload")
@@ -490,41 +557,44 @@ func init() {
 }
 
 var fileDescriptor_6851f1463fcbb80c = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0x69, 0x36, 0x31, 0x7d, 0xbb, 0x62, 0x3a, 0x56, 0x59, 0x7b, 0xd8, 0xae, 0x11, 0x61,
-	0x41, 0xb3, 0x29, 0x5b, 0xf1, 0x20, 0x82, 0x18, 0x2c, 0x24, 0xa8, 0x50, 0xa6, 0x20, 0xe2, 0x25,
-	0x4c, 0x9b, 0x31, 0x1b, 0x92, 0xcd, 0x84, 0xcc, 0x34, 0x98, 0xa3, 0xff, 0xc0, 0x3f, 0xe2, 0xcd,
-	0x1f, 0xd1, 0x63, 0xf1, 0xe4, 0xa9, 0x48, 0xf2, 0x17, 0xfc, 0x01, 0x32, 0x33, 0xbb, 0xb1, 0xd4,
-	0x8d, 0xd4, 0xdb, 0xfb, 0xde, 0xbc, 0xef, 0x9b, 0x79, 0xdf, 0xcc, 0x3c, 0x08, 0x4f, 0xb8, 0x48,
-	0xb9, 0x68, 0x0a, 0xc9, 0xa7, 0xac, 0x29, 0xc6, 0x74, 0x22, 0x12, 0x2e, 0x45, 0x73, 0x16, 0xaf,
-	0x40, 0x34, 0x99, 0x72, 0xc9, 0xf1, 0x3d, 0x53, 0x19, 0xe9, 0xca, 0x68, 0x55, 0x19, 0xcd, 0xe2,
-	0x9d, 0xed, 0x3e, 0xef, 0x73, 0x5d, 0xd5, 0x54, 0x91, 0x21, 0xec, 0x64, 0x84, 0xae, 0x59, 0xc8,
-	0xd8, 0x1a, 0xd4, 0xbf, 0x22, 0xa8, 0x1e, 0x65, 0x0a, 0xf8, 0x2e, 0x54, 0x12, 0x36, 0xe8, 0x27,
-	0xd2, 0x43, 0x01, 0x0a, 0x6d, 0x92, 0x21, 0x95, 0xff, 0xc8, 0xa7, 0x29, 0x95, 0xde, 0x46, 0x80,
-	0xc2, 0x9b, 0x24, 0x43, 0x2a, 0x7f, 0x92, 0x9c, 0x8e, 0x87, 0xc2, 0x2b, 0x99, 0xbc, 0x41, 0x18,
-	0x83, 0x9d, 0x50, 0x91, 0x78, 0x76, 0x80, 0x42, 0x97, 0xe8, 0x18, 0x1f, 0x40, 0x35, 0x65, 0x92,
-	0xf6, 0xa8, 0xa4, 0x5e, 0x39, 0x40, 0xa1, 0x13, 0x3f, 0x88, 0xd6, 0xf6, 0x11, 0xbd, 0xcd, 0x4a,
-	0x5b, 0xf6, 0xd9, 0xc5, 0xae, 0x45, 0x56, 0xd4, 0x7a, 0x03, 0xaa, 0xf9, 0x1a, 0xbe, 0x0f, 0xae,
-	0xde, 0xb0, 0xab, 0x36, 0x60, 0xc2, 0x43, 0x41, 0x29, 0x74, 0x89, 0xa3, 0x73, 0x6d, 0x9d, 0xaa,
-	0xff, 0xda, 0x00, 0x37, 0x6f, 0xaf, 0x23, 0x59, 0x8a, 0x5f, 0x41, 0x59, 0x6f, 0xa7, 0x3b, 0x74,
-	0xe2, 0xc7, 0xff, 0x38, 0x43, 0xce, 0x3b, 0x52, 0x4b, 0x8a, 0xdc, 0xb6, 0x88, 0x21, 0xe3, 0xd7,
-	0x60, 0x0f, 0xe8, 0x6c, 0xa4, 0xed, 0x70, 0xe2, 0x47, 0xd7, 0x10, 0xe9, 0xbc, 0x7c, 0xf7, 0x46,
-	0x69, 0xb4, 0xaa, 0x8b, 0x8b, 0x5d, 0x5b, 0xa1, 0xb6, 0x45, 0xb4, 0x08, 0x3e, 0x84, 0x4d, 0xf6,
-	0x49, 0xb2, 0xb1, 0x18, 0xf0, 0xb1, 0x36, 0xd2, 0x89, 0xf7, 0xae, 0xa1, 0x78, 0x90, 0x73, 0x94,
-	0x1f, 0x6d, 0x8b, 0xfc, 0x11, 0xc1, 0xc7, 0xb0, 0xb5, 0x02, 0xdd, 0x09, 0x9d, 0x8f, 0x38, 0xed,
-	0xe9, 0xcb, 0x70, 0xe2, 0xfd, 0xff, 0x51, 0x3e, 0x34, 0xd4, 0xb6, 0x45, 0x6a, 0xec, 0x4a, 0xee,
-	0xd9, 0xed, 0xef, 0xdf, 0x1a, 0xb7, 0x8c, 0x56, 0x43, 0xf4, 0x86, 0xc1, 0x5e, 0xf4, 0xe4, 0x69,
-	0xab, 0x02, 0xf6, 0x40, 0xb2, 0xb4, 0xfe, 0x1c, 0xb6, 0xfe, 0x72, 0x4f, 0xbd, 0x8a, 0x31, 0x4d,
-	0x8d, 0xf3, 0x9b, 0x44, 0xc7, 0x85, 0x2a, 0xf5, 0xcf, 0x08, 0x6a, 0x57, 0x7d, 0xc3, 0x35, 0x28,
-	0x0d, 0xd9, 0x5c, 0x93, 0x5d, 0xa2, 0x42, 0xbc, 0x0d, 0xe5, 0x19, 0x1d, 0x9d, 0x32, 0x7d, 0x0b,
-	0x2e, 0x31, 0x00, 0x7b, 0x70, 0x63, 0xc6, 0xa6, 0x2b, 0x2f, 0x4b, 0x24, 0x87, 0x97, 0x5e, 0xb7,
-	0xb2, 0xa2, 0x9c, 0xbf, 0xee, 0xe2, 0x33, 0xbc, 0x87, 0x3b, 0x85, 0x46, 0x17, 0x75, 0xb1, 0xee,
-	0x7f, 0x14, 0x2b, 0x77, 0xc0, 0x5b, 0x67, 0xb4, 0x3a, 0x7c, 0x7e, 0x5d, 0xa6, 0xd1, 0x1c, 0x16,
-	0xdb, 0xfd, 0xe2, 0x6c, 0xe1, 0xa3, 0xf3, 0x85, 0x8f, 0x7e, 0x2e, 0x7c, 0xf4, 0x65, 0xe9, 0x5b,
-	0xe7, 0x4b, 0xdf, 0xfa, 0xb1, 0xf4, 0xad, 0x0f, 0x0f, 0x4d, 0xa9, 0xe8, 0x0d, 0xa3, 0x01, 0xcf,
-	0x46, 0xca, 0xa5, 0x41, 0x22, 0x9a, 0x72, 0x3e, 0x61, 0xe2, 0xb8, 0xa2, 0x87, 0xc0, 0xfe, 0xef,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x23, 0x48, 0xd5, 0x7c, 0x04, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xb6, 0x1b, 0x27, 0x7f, 0x3a, 0x4e, 0x7f, 0xd2, 0xa5, 0x45, 0x26, 0x07, 0xd7, 0x18, 0x21,
+	0x59, 0x82, 0x38, 0x95, 0x8b, 0x38, 0x20, 0x24, 0x44, 0xa0, 0xc2, 0x11, 0x20, 0x55, 0x5b, 0x09,
+	0x01, 0x97, 0xc8, 0x4d, 0x96, 0x38, 0x4a, 0x9c, 0x8d, 0xb2, 0x4e, 0x44, 0x8e, 0xbc, 0x01, 0x2f,
+	0xc2, 0x8d, 0x87, 0xe8, 0x8d, 0x8a, 0x13, 0xa7, 0x0a, 0x25, 0x2f, 0x82, 0x76, 0xd7, 0x36, 0x51,
+	0xeb, 0xa0, 0x70, 0xdb, 0x6f, 0x76, 0xbe, 0x6f, 0x77, 0xe6, 0x9b, 0x5d, 0x70, 0x3a, 0x94, 0x45,
+	0x94, 0x35, 0x58, 0x4c, 0x27, 0xa4, 0xc1, 0x46, 0xc1, 0x98, 0x85, 0x34, 0x66, 0x8d, 0x99, 0x97,
+	0x01, 0x77, 0x3c, 0xa1, 0x31, 0x45, 0xb7, 0x65, 0xa6, 0x2b, 0x32, 0xdd, 0x2c, 0xd3, 0x9d, 0x79,
+	0xb5, 0xbd, 0x1e, 0xed, 0x51, 0x91, 0xd5, 0xe0, 0x2b, 0x49, 0xa8, 0x25, 0x84, 0xb6, 0xdc, 0x48,
+	0xd8, 0x02, 0xd8, 0x5f, 0x55, 0x28, 0x9f, 0x26, 0x0a, 0xe8, 0x16, 0x94, 0x42, 0xd2, 0xef, 0x85,
+	0xb1, 0xa1, 0x5a, 0xaa, 0xa3, 0xe1, 0x04, 0xf1, 0xf8, 0x47, 0x3a, 0x89, 0x82, 0xd8, 0xd8, 0xb2,
+	0x54, 0x67, 0x07, 0x27, 0x88, 0xc7, 0x3b, 0xe1, 0x74, 0x34, 0x60, 0x46, 0x41, 0xc6, 0x25, 0x42,
+	0x08, 0xb4, 0x30, 0x60, 0xa1, 0xa1, 0x59, 0xaa, 0x53, 0xc1, 0x62, 0x8d, 0x8e, 0xa1, 0x1c, 0x91,
+	0x38, 0xe8, 0x06, 0x71, 0x60, 0x14, 0x2d, 0xd5, 0xd1, 0xbd, 0xbb, 0xee, 0xda, 0x3a, 0xdc, 0x37,
+	0x49, 0x6a, 0x53, 0x3b, 0xbf, 0x3c, 0x50, 0x70, 0x46, 0xb5, 0xeb, 0x50, 0x4e, 0xf7, 0xd0, 0x1d,
+	0xa8, 0x88, 0x03, 0xdb, 0xfc, 0x00, 0xc2, 0x0c, 0xd5, 0x2a, 0x38, 0x15, 0xac, 0x8b, 0x98, 0x2f,
+	0x42, 0xf6, 0xf7, 0x02, 0x54, 0xd2, 0xf2, 0x5a, 0x31, 0x89, 0xd0, 0x0b, 0x28, 0x8a, 0xe3, 0x44,
+	0x85, 0xba, 0xf7, 0xe0, 0x2f, 0x77, 0x48, 0x79, 0xa7, 0x7c, 0x8b, 0x93, 0x7d, 0x05, 0x4b, 0x32,
+	0x7a, 0x05, 0x5a, 0x3f, 0x98, 0x0d, 0x45, 0x3b, 0x74, 0xef, 0xfe, 0x06, 0x22, 0xad, 0x67, 0x6f,
+	0x5f, 0x73, 0x8d, 0x66, 0x79, 0x71, 0x79, 0xa0, 0x71, 0xe4, 0x2b, 0x58, 0x88, 0xa0, 0x13, 0xd8,
+	0x26, 0x9f, 0x62, 0x32, 0x62, 0x7d, 0x3a, 0x12, 0x8d, 0xd4, 0xbd, 0xc3, 0x0d, 0x14, 0x8f, 0x53,
+	0x0e, 0xef, 0x87, 0xaf, 0xe0, 0x3f, 0x22, 0xe8, 0x0c, 0x76, 0x33, 0xd0, 0x1e, 0x07, 0xf3, 0x21,
+	0x0d, 0xba, 0xc2, 0x0c, 0xdd, 0x3b, 0xfa, 0x17, 0xe5, 0x13, 0x49, 0xf5, 0x15, 0x5c, 0x25, 0x57,
+	0x62, 0xe8, 0x3d, 0xfc, 0x2f, 0x24, 0xda, 0x9d, 0x90, 0x74, 0x06, 0x6c, 0x1a, 0x19, 0xc5, 0x8d,
+	0xaf, 0x2e, 0x3a, 0xfa, 0x3c, 0xe1, 0xf9, 0x0a, 0xde, 0x61, 0xab, 0x81, 0xc7, 0x37, 0x7f, 0x7c,
+	0xab, 0xdf, 0x90, 0x2a, 0x75, 0xd6, 0x1d, 0x58, 0x87, 0xee, 0xc3, 0x47, 0xcd, 0x12, 0x68, 0xfd,
+	0x98, 0x44, 0xf6, 0x13, 0xd8, 0xbd, 0x66, 0x0c, 0x1f, 0xb8, 0x51, 0x10, 0x49, 0x53, 0xb7, 0xb1,
+	0x58, 0xe7, 0xaa, 0xd8, 0x2f, 0x61, 0x3f, 0xf7, 0x12, 0x79, 0x0a, 0xa8, 0x06, 0xe5, 0xac, 0xb8,
+	0x2d, 0x31, 0xca, 0x19, 0xb6, 0x3f, 0xab, 0x50, 0xbd, 0xea, 0x2d, 0xaa, 0x42, 0x61, 0x40, 0xe6,
+	0x42, 0xa3, 0x82, 0xf9, 0x12, 0xed, 0x41, 0x71, 0x16, 0x0c, 0xa7, 0x24, 0xe1, 0x4b, 0x80, 0x0c,
+	0xf8, 0x6f, 0x46, 0x26, 0x99, 0xdf, 0x05, 0x9c, 0xc2, 0x95, 0x17, 0xc8, 0xed, 0x2a, 0xa6, 0x2f,
+	0x30, 0xbf, 0x98, 0x77, 0xb0, 0x7f, 0xcd, 0x32, 0x3e, 0x0c, 0xb9, 0xc5, 0xac, 0x79, 0xc3, 0xf9,
+	0xca, 0x2d, 0x30, 0xd6, 0x0d, 0x03, 0xbf, 0x7c, 0x3a, 0x52, 0xb2, 0xd0, 0x14, 0xe6, 0xfb, 0xf6,
+	0xf4, 0x7c, 0x61, 0xaa, 0x17, 0x0b, 0x53, 0xfd, 0xb5, 0x30, 0xd5, 0x2f, 0x4b, 0x53, 0xb9, 0x58,
+	0x9a, 0xca, 0xcf, 0xa5, 0xa9, 0x7c, 0xb8, 0x27, 0x53, 0x59, 0x77, 0xe0, 0xf6, 0x69, 0xf2, 0xed,
+	0xad, 0x7c, 0x76, 0xac, 0x11, 0xcf, 0xc7, 0x84, 0x9d, 0x95, 0xc4, 0x47, 0x75, 0xf4, 0x7b, 0x00,
+	0x3a, 0xb9, 0xf9, 0x40, 0x20, 0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -730,6 +800,27 @@ func (m *SnapshotItem_ExtensionPayload) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SnapshotItem_StoreChecksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotItem_StoreChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.StoreChecksum != nil {
+		{
+			size, err := m.StoreChecksum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSnapshot(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SnapshotStoreItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -760,6 +851,43 @@ func (m *SnapshotStoreItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotStoreChecksum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotStoreChecksum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotStoreChecksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotIAVLItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -982,6 +1110,18 @@ func (m *SnapshotItem_ExtensionPayload) Size() (n int) {
 	}
 	return n
 }
+func (m *SnapshotItem_StoreChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StoreChecksum != nil {
+		l = m.StoreChecksum.Size()
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
 func (m *SnapshotStoreItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -995,6 +1135,23 @@ func (m *SnapshotStoreItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotStoreChecksum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	return n
+}
+
 func (m *SnapshotIAVLItem) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1478,6 +1635,41 @@ func (m *SnapshotItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &SnapshotItem_ExtensionPayload{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreChecksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SnapshotStoreChecksum{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &SnapshotItem_StoreChecksum{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1581,6 +1773,122 @@ func (m *SnapshotStoreItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotStoreChecksum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotStoreChecksum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotStoreChecksum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
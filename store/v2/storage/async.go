@@ -0,0 +1,402 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/snapshots"
+)
+
+var (
+	_ store.VersionedDatabase      = (*AsyncStorageStore)(nil)
+	_ snapshots.StorageSnapshotter = (*AsyncStorageStore)(nil)
+	_ store.Pruner                 = (*AsyncStorageStore)(nil)
+	_ store.UpgradableDatabase     = (*AsyncStorageStore)(nil)
+)
+
+// FallbackReader reads the state of versions which are not yet applied to the
+// state storage, typically the state commitment.
+type FallbackReader interface {
+	Get(storeKey []byte, version uint64, key []byte) ([]byte, error)
+}
+
+// AsyncStorageStore wraps a StorageStore and applies changesets asynchronously,
+// taking the state storage off the block commit critical path.
+//
+// ApplyChangeset only appends the changeset to a durable write-ahead queue, a
+// background worker then applies the queued changesets to the underlying
+// StorageStore in order. Until a version is applied, point reads at that
+// version are served by the fallback reader while iterators wait for the
+// worker to catch up.
+type AsyncStorageStore struct {
+	*StorageStore
+
+	logger   log.Logger
+	queue    corestore.KVStoreWithBatch
+	fallback FallbackReader
+
+	mtx  sync.Mutex
+	cond *sync.Cond
+	// queuedSeq and appliedSeq are the sequence numbers of the latest queued
+	// and applied changesets
+	queuedSeq, appliedSeq uint64
+	// queuedVersion and appliedVersion are the versions of the latest queued
+	// and applied changesets
+	queuedVersion, appliedVersion uint64
+	err                           error
+	closing                       bool
+
+	notify chan struct{}
+	done   chan struct{}
+}
+
+// NewAsyncStorageStore returns a new AsyncStorageStore using the given database
+// as the durable queue. The queue must be durable for at least as long as the
+// state commitment is, as changesets are recovered from it on restart.
+//
+// The changesets left in the queue by a previous run up to the given committed
+// version are applied before returning, while the ones above it, i.e. of a
+// block which was not committed, are discarded.
+func NewAsyncStorageStore(
+	ss *StorageStore,
+	queue corestore.KVStoreWithBatch,
+	fallback FallbackReader,
+	committedVersion uint64,
+	logger log.Logger,
+) (*AsyncStorageStore, error) {
+	as := &AsyncStorageStore{
+		StorageStore: ss,
+		logger:       logger,
+		queue:        queue,
+		fallback:     fallback,
+		notify:       make(chan struct{}, 1),
+		done:         make(chan struct{}),
+	}
+	as.cond = sync.NewCond(&as.mtx)
+
+	if err := as.recover(committedVersion); err != nil {
+		return nil, err
+	}
+
+	go as.run()
+
+	return as, nil
+}
+
+func queueKey(seq uint64) []byte {
+	var key [8]byte
+	binary.BigEndian.PutUint64(key[:], seq)
+	return key[:]
+}
+
+// recover applies the queued changesets up to the committed version and drops
+// the other ones. Changesets are removed from the queue once applied, so the
+// first one may have been applied already, which is harmless.
+func (as *AsyncStorageStore) recover(committedVersion uint64) error {
+	iter, err := as.queue.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	var seqs []uint64
+	for ; iter.Valid(); iter.Next() {
+		seqs = append(seqs, binary.BigEndian.Uint64(iter.Key()))
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, seq := range seqs {
+		version, cs, err := as.dequeue(seq)
+		if err != nil {
+			return err
+		}
+		if version <= committedVersion {
+			as.logger.Info("applying queued changeset to SS", "version", version)
+			if err := as.StorageStore.ApplyChangeset(version, cs); err != nil {
+				return err
+			}
+		}
+		if err := as.queue.Delete(queueKey(seq)); err != nil {
+			return err
+		}
+		as.queuedSeq = seq
+	}
+	as.appliedSeq = as.queuedSeq
+
+	latest, err := as.StorageStore.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	as.queuedVersion, as.appliedVersion = latest, latest
+
+	return nil
+}
+
+// dequeue reads the queued changeset with the given sequence number.
+func (as *AsyncStorageStore) dequeue(seq uint64) (uint64, *corestore.Changeset, error) {
+	bz, err := as.queue.Get(queueKey(seq))
+	if err != nil {
+		return 0, nil, err
+	}
+	if bz == nil {
+		return 0, nil, fmt.Errorf("changeset %d not found in the queue", seq)
+	}
+	version, n, err := encoding.DecodeUvarint(bz)
+	if err != nil {
+		return 0, nil, err
+	}
+	cs := corestore.NewChangeset()
+	if err := encoding.UnmarshalChangeset(cs, bz[n:]); err != nil {
+		return 0, nil, fmt.Errorf("failed to decode the changeset of version %d: %w", version, err)
+	}
+
+	return version, cs, nil
+}
+
+// apply applies the queued changeset with the given sequence number to the
+// state storage and removes it from the queue.
+func (as *AsyncStorageStore) apply(seq uint64) (uint64, error) {
+	version, cs, err := as.dequeue(seq)
+	if err != nil {
+		return 0, err
+	}
+	if err := as.StorageStore.ApplyChangeset(version, cs); err != nil {
+		return 0, err
+	}
+
+	return version, as.queue.Delete(queueKey(seq))
+}
+
+// run is the worker applying the queued changesets in order.
+func (as *AsyncStorageStore) run() {
+	defer close(as.done)
+
+	for {
+		as.mtx.Lock()
+		for as.appliedSeq == as.queuedSeq && !as.closing {
+			as.mtx.Unlock()
+			<-as.notify
+			as.mtx.Lock()
+		}
+		if as.appliedSeq == as.queuedSeq {
+			// closing and drained
+			as.mtx.Unlock()
+			return
+		}
+		seq := as.appliedSeq + 1
+		as.mtx.Unlock()
+
+		version, err := as.apply(seq)
+
+		as.mtx.Lock()
+		if err != nil {
+			as.err = fmt.Errorf("failed to apply changeset %d to SS: %w", seq, err)
+			as.logger.Error("async SS commit failed", "err", as.err)
+		} else {
+			as.appliedSeq, as.appliedVersion = seq, version
+		}
+		as.cond.Broadcast()
+		as.mtx.Unlock()
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (as *AsyncStorageStore) signal() {
+	select {
+	case as.notify <- struct{}{}:
+	default:
+	}
+}
+
+// ApplyChangeset appends the changeset to the durable queue, it is applied to
+// the state storage asynchronously.
+func (as *AsyncStorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset) error {
+	as.mtx.Lock()
+	if as.err != nil {
+		as.mtx.Unlock()
+		return as.err
+	}
+	if version < as.queuedVersion {
+		as.mtx.Unlock()
+		return fmt.Errorf("cannot queue version %d, the latest queued version is %d", version, as.queuedVersion)
+	}
+	seq := as.queuedSeq + 1
+	as.mtx.Unlock()
+
+	bz, err := encoding.MarshalChangeset(cs)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	buf.Grow(encoding.EncodeUvarintSize(version) + len(bz))
+	if err := encoding.EncodeUvarint(&buf, version); err != nil {
+		return err
+	}
+	buf.Write(bz)
+
+	batch := as.queue.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(queueKey(seq), buf.Bytes()); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	as.mtx.Lock()
+	as.queuedSeq, as.queuedVersion = seq, version
+	as.mtx.Unlock()
+	as.signal()
+
+	return nil
+}
+
+// Sync blocks until all the queued changesets are applied to the state storage.
+func (as *AsyncStorageStore) Sync() error {
+	return as.wait(math.MaxUint64)
+}
+
+// wait blocks until all the queued changesets of the given version are applied
+// to the state storage. Several changesets may be queued for the same version,
+// e.g. the genesis state followed by the first block.
+func (as *AsyncStorageStore) wait(version uint64) error {
+	as.mtx.Lock()
+	defer as.mtx.Unlock()
+
+	for as.appliedSeq < as.queuedSeq && as.appliedVersion <= version && as.err == nil {
+		as.cond.Wait()
+	}
+
+	return as.err
+}
+
+// pending returns true if the given version is queued but not yet applied.
+func (as *AsyncStorageStore) pending(version uint64) bool {
+	as.mtx.Lock()
+	defer as.mtx.Unlock()
+
+	return version > as.appliedVersion && version <= as.queuedVersion
+}
+
+// GetLatestVersion returns the latest queued version, as it is durable.
+func (as *AsyncStorageStore) GetLatestVersion() (uint64, error) {
+	as.mtx.Lock()
+	queued, applied := as.queuedVersion, as.appliedVersion
+	as.mtx.Unlock()
+
+	if queued > applied {
+		return queued, nil
+	}
+
+	return as.StorageStore.GetLatestVersion()
+}
+
+// Has returns true if the key exists in the store.
+func (as *AsyncStorageStore) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
+	if as.pending(version) {
+		val, err := as.fallback.Get(storeKey, version, key)
+		return val != nil, err
+	}
+
+	return as.StorageStore.Has(storeKey, version, key)
+}
+
+// Get returns the value associated with the given key.
+func (as *AsyncStorageStore) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	if as.pending(version) {
+		return as.fallback.Get(storeKey, version, key)
+	}
+
+	return as.StorageStore.Get(storeKey, version, key)
+}
+
+// Iterator returns an iterator over the specified domain and prefix, once the
+// given version is applied to the state storage.
+func (as *AsyncStorageStore) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	if err := as.wait(version); err != nil {
+		return nil, err
+	}
+
+	return as.StorageStore.Iterator(storeKey, version, start, end)
+}
+
+// ReverseIterator returns an iterator over the specified domain and prefix in
+// reverse, once the given version is applied to the state storage.
+func (as *AsyncStorageStore) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	if err := as.wait(version); err != nil {
+		return nil, err
+	}
+
+	return as.StorageStore.ReverseIterator(storeKey, version, start, end)
+}
+
+// SetLatestVersion sets the latest version of the store once the queue is
+// drained.
+func (as *AsyncStorageStore) SetLatestVersion(version uint64) error {
+	if err := as.Sync(); err != nil {
+		return err
+	}
+
+	return as.StorageStore.SetLatestVersion(version)
+}
+
+// Prune prunes the store up to the given version once the queue is drained, as
+// pruning must not race with the writes of the worker.
+func (as *AsyncStorageStore) Prune(version uint64) error {
+	if err := as.Sync(); err != nil {
+		return err
+	}
+
+	return as.StorageStore.Prune(version)
+}
+
+// PruneStoreKeys prunes the given store keys once the queue is drained.
+func (as *AsyncStorageStore) PruneStoreKeys(storeKeys []string, version uint64) error {
+	if err := as.Sync(); err != nil {
+		return err
+	}
+
+	return as.StorageStore.PruneStoreKeys(storeKeys, version)
+}
+
+// Restore restores the store from the given channel once the queue is drained.
+func (as *AsyncStorageStore) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	if err := as.Sync(); err != nil {
+		return err
+	}
+	if err := as.StorageStore.Restore(version, chStorage); err != nil {
+		return err
+	}
+
+	as.mtx.Lock()
+	as.queuedVersion, as.appliedVersion = version, version
+	as.mtx.Unlock()
+
+	return nil
+}
+
+// Close drains the queue and closes the store.
+func (as *AsyncStorageStore) Close() error {
+	as.mtx.Lock()
+	as.closing = true
+	as.mtx.Unlock()
+	as.signal()
+	<-as.done
+
+	as.mtx.Lock()
+	err := as.err
+	as.mtx.Unlock()
+
+	return errors.Join(err, as.StorageStore.Close())
+}
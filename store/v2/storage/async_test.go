@@ -0,0 +1,143 @@
+package storage_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+var asyncStoreKey = []byte("async_store")
+
+// gatedDB blocks or fails the batches of the wrapped database on demand.
+type gatedDB struct {
+	storage.Database
+	gate chan struct{}
+	fail bool
+}
+
+func (db *gatedDB) NewBatch(version uint64) (store.Batch, error) {
+	if db.gate != nil {
+		<-db.gate
+	}
+	if db.fail {
+		return nil, errors.New("gated")
+	}
+	return db.Database.NewBatch(version)
+}
+
+// commitReader serves the fallback reads from the committed changesets.
+type commitReader map[uint64]map[string][]byte
+
+func (r commitReader) Get(_ []byte, version uint64, key []byte) ([]byte, error) {
+	return r[version][string(key)], nil
+}
+
+func asyncChangeset(version uint64) *corestore.Changeset {
+	cs := corestore.NewChangeset()
+	cs.Add(asyncStoreKey, []byte(fmt.Sprintf("key%d", version)), []byte(fmt.Sprintf("value%d", version)), false)
+	return cs
+}
+
+func newGatedStore(t *testing.T) (*storage.StorageStore, *gatedDB) {
+	t.Helper()
+	db, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+	gated := &gatedDB{Database: db}
+	return storage.NewStorageStore(gated, coretesting.NewNopLogger()), gated
+}
+
+func TestAsyncStorageStore(t *testing.T) {
+	ss, gated := newGatedStore(t)
+	fallback := commitReader{}
+	as, err := storage.NewAsyncStorageStore(ss, dbm.NewMemDB(), fallback, 0, coretesting.NewNopLogger())
+	require.NoError(t, err)
+
+	// block the worker on the first version
+	gated.gate = make(chan struct{})
+	for version := uint64(1); version <= 3; version++ {
+		require.NoError(t, as.ApplyChangeset(version, asyncChangeset(version)))
+		fallback[version] = map[string][]byte{fmt.Sprintf("key%d", version): []byte("fallback")}
+	}
+	latest, err := as.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), latest)
+	require.Error(t, as.ApplyChangeset(2, asyncChangeset(2)))
+
+	// pending versions are served by the fallback reader
+	val, err := as.Get(asyncStoreKey, 3, []byte("key3"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("fallback"), val)
+	has, err := as.Has(asyncStoreKey, 2, []byte("key2"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// iterators wait for the worker to catch up
+	close(gated.gate)
+	iter, err := as.Iterator(asyncStoreKey, 3, nil, nil)
+	require.NoError(t, err)
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, 3, count)
+
+	require.NoError(t, as.Sync())
+	val, err = as.Get(asyncStoreKey, 3, []byte("key3"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value3"), val)
+	latest, err = ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), latest)
+
+	require.NoError(t, as.Close())
+}
+
+func TestAsyncStorageStore_Recover(t *testing.T) {
+	ss, gated := newGatedStore(t)
+	queue := dbm.NewMemDB()
+	as, err := storage.NewAsyncStorageStore(ss, queue, commitReader{}, 0, coretesting.NewNopLogger())
+	require.NoError(t, err)
+
+	// the worker fails, leaving the changesets in the queue
+	gated.fail = true
+	for version := uint64(1); version <= 3; version++ {
+		require.NoError(t, as.ApplyChangeset(version, asyncChangeset(version)))
+	}
+	require.Error(t, as.Sync())
+	require.Error(t, as.ApplyChangeset(4, asyncChangeset(4)))
+	gated.fail = false
+
+	// only the first 2 versions were committed, the third one is dropped
+	as, err = storage.NewAsyncStorageStore(storage.NewStorageStore(gated, coretesting.NewNopLogger()), queue, commitReader{}, 2, coretesting.NewNopLogger())
+	require.NoError(t, err)
+	latest, err := as.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest)
+	for version := uint64(1); version <= 2; version++ {
+		val, err := as.Get(asyncStoreKey, version, []byte(fmt.Sprintf("key%d", version)))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", version)), val)
+	}
+	val, err := as.Get(asyncStoreKey, 3, []byte("key3"))
+	require.NoError(t, err)
+	require.Nil(t, val)
+
+	iter, err := queue.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	// replaying the dropped version resumes the queue
+	require.NoError(t, as.ApplyChangeset(3, asyncChangeset(3)))
+	require.NoError(t, as.Close())
+}
@@ -83,6 +83,13 @@ type UpgradeableStore interface {
 	LoadVersionAndUpgrade(version uint64, upgrades *corestore.StoreUpgrades) error
 }
 
+// ResettableStore defines the interface of the stores which can delete all their
+// versions before a version is loaded, e.g. to recover from a crash.
+type ResettableStore interface {
+	// Reset deletes all the versions of the store, back to the empty store.
+	Reset() error
+}
+
 // Pruner defines the interface for pruning old versions of the store or database.
 type Pruner interface {
 	// Prune prunes the store to the provided version.
@@ -0,0 +1,133 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	lockupaccount "cosmossdk.io/x/accounts/defaults/lockup"
+	"cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *E2ETestSuite) TestMigrateVestingAccounts() {
+	t := s.T()
+	app := setupApp(t)
+	currentTime := time.Now()
+	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger()).WithHeaderInfo(header.Info{
+		Time: currentTime,
+	})
+	ownerAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(accOwner)
+	require.NoError(t, err)
+
+	originalVesting := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(1000)))
+	newVestingAccount := func(newAcc func(*vestingtypes.BaseVestingAccount) sdk.AccountI) sdk.AccountI {
+		privKey := secp256k1.GenPrivKey()
+		baseAcc := app.AuthKeeper.NewAccountWithAddress(ctx, sdk.AccAddress(privKey.PubKey().Address())).(*authtypes.BaseAccount)
+		require.NoError(t, baseAcc.SetPubKey(privKey.PubKey()))
+		require.NoError(t, baseAcc.SetSequence(4))
+		bva, err := vestingtypes.NewBaseVestingAccount(baseAcc, originalVesting, currentTime.Add(time.Hour).Unix())
+		require.NoError(t, err)
+		acc := newAcc(bva)
+		app.AuthKeeper.SetAccount(ctx, acc)
+		s.fundAccount(app, ctx, acc.GetAddress(), originalVesting)
+		return acc
+	}
+
+	requireMigrated := func(t *testing.T, legacyAcc sdk.AccountI, accountType string) {
+		t.Helper()
+		addr := legacyAcc.GetAddress()
+		require.Nil(t, app.AuthKeeper.GetAccount(ctx, addr))
+		require.True(t, app.AccountsKeeper.IsAccountsModuleAccount(ctx, addr))
+		accType, err := app.AccountsKeeper.AccountsByType.Get(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, accountType, accType)
+		accNum, err := app.AccountsKeeper.AccountByNumber.Get(ctx, addr)
+		require.NoError(t, err)
+		require.Equal(t, legacyAcc.GetAccountNumber(), accNum)
+
+		// the coins are still locked
+		info := s.queryLockupAccInfo(ctx, app, addr)
+		require.Equal(t, ownerAddrStr, info.Owner)
+		require.True(t, originalVesting.Equal(info.OriginalLocking))
+		require.True(t, originalVesting.Equal(info.LockedCoins))
+		err = s.executeTx(ctx, &types.MsgSend{
+			Sender:    ownerAddrStr,
+			ToAddress: ownerAddrStr,
+			Amount:    sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))),
+		}, app, addr, accOwner)
+		require.ErrorContains(t, err, "insufficient funds")
+
+		// the x/auth gRPC server still returns the vesting account
+		res, err := authkeeper.NewQueryServer(app.AuthKeeper).Account(ctx, &authtypes.QueryAccountRequest{Address: addr.String()})
+		require.NoError(t, err)
+		var acc sdk.AccountI
+		require.NoError(t, app.InterfaceRegistry().UnpackAny(res.Account, &acc))
+		vestingAcc, ok := acc.(vestingexported.VestingAccount)
+		require.True(t, ok)
+		require.True(t, originalVesting.Equal(vestingAcc.GetOriginalVesting()))
+		require.Equal(t, legacyAcc.(vestingexported.VestingAccount).GetEndTime(), vestingAcc.GetEndTime())
+	}
+
+	t.Run("ok - migrate account with a tx", func(t *testing.T) {
+		legacyAcc := newVestingAccount(func(bva *vestingtypes.BaseVestingAccount) sdk.AccountI {
+			return vestingtypes.NewContinuousVestingAccountRaw(bva, currentTime.Unix())
+		})
+
+		accountType, initMsg, err := lockupaccount.MigrateLegacyVestingAccount(legacyAcc, ownerAddrStr)
+		require.NoError(t, err)
+		initMsgAny, err := codectypes.NewAnyWithValue(initMsg)
+		require.NoError(t, err)
+
+		_, err = authkeeper.NewMsgServerImpl(app.AuthKeeper).MigrateAccount(ctx, &authtypes.MsgMigrateAccount{
+			Signer:         legacyAcc.GetAddress().String(),
+			AccountType:    accountType,
+			AccountInitMsg: initMsgAny,
+		})
+		require.NoError(t, err)
+		requireMigrated(t, legacyAcc, lockupaccount.CONTINUOUS_LOCKING_ACCOUNT)
+	})
+
+	t.Run("ok - migrate accounts in an upgrade", func(t *testing.T) {
+		periodicAcc := newVestingAccount(func(bva *vestingtypes.BaseVestingAccount) sdk.AccountI {
+			return vestingtypes.NewPeriodicVestingAccountRaw(bva, currentTime.Unix(), vestingtypes.Periods{
+				{Length: 1800, Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(500)))},
+				{Length: 1800, Amount: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(500)))},
+			})
+		})
+		permanentAcc := newVestingAccount(func(bva *vestingtypes.BaseVestingAccount) sdk.AccountI {
+			bva.EndTime = 0
+			return &vestingtypes.PermanentLockedAccount{BaseVestingAccount: bva}
+		})
+
+		migrator := func(_ context.Context, acc sdk.AccountI) (string, transaction.Msg, error) {
+			if _, ok := acc.(vestingexported.VestingAccount); !ok {
+				return "", nil, nil
+			}
+			return lockupaccount.MigrateLegacyVestingAccount(acc, ownerAddrStr)
+		}
+
+		var next sdk.AccAddress
+		for {
+			next, err = authkeeper.MigrateLegacyAccounts(ctx, &app.AuthKeeper, migrator, next, 2)
+			require.NoError(t, err)
+			if next == nil {
+				break
+			}
+		}
+		requireMigrated(t, periodicAcc, lockupaccount.PERIODIC_LOCKING_ACCOUNT)
+		requireMigrated(t, permanentAcc, lockupaccount.PERMANENT_LOCKING_ACCOUNT)
+	})
+}
@@ -206,7 +206,7 @@ func (s *E2ETestSuite) TestConfigUpdate() {
 
 	// need to wait until voting period is over because we disabled early execution on the last
 	// config update
-	err = s.executeProposal(ctx, accountAddr, s.members[0], 0)
+	err = s.executeProposal(ctx, accountAddr, s.members[0], 1)
 	s.ErrorContains(err, "voting period has not ended yet, and early execution is not enabled")
 
 	// vote with member 1
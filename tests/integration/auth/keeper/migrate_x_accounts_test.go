@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/transaction"
+	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -58,7 +61,29 @@ func TestMigrateToAccounts(t *testing.T) {
 			AccountInitMsg: nil,
 		})
 		require.Nil(t, resp)
-		require.ErrorContains(t, err, "only BaseAccount can be migrated")
+		require.ErrorContains(t, err, "only BaseAccount and vesting accounts can be migrated")
+	})
+
+	t.Run("sequence not preserved", func(t *testing.T) {
+		privKey := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+		acc := f.authKeeper.NewAccountWithAddress(f.ctx, addr)
+		require.NoError(t, acc.SetPubKey(privKey.PubKey()))
+		require.NoError(t, acc.SetSequence(10))
+		f.authKeeper.SetAccount(f.ctx, acc)
+
+		pk, err := codectypes.NewAnyWithValue(privKey.PubKey())
+		require.NoError(t, err)
+		initMsgAny, err := codectypes.NewAnyWithValue(&basev1.MsgInit{PubKey: pk, InitSequence: 5})
+		require.NoError(t, err)
+
+		resp, err := msgSrv.MigrateAccount(f.ctx, &authtypes.MsgMigrateAccount{
+			Signer:         f.mustAddr(addr),
+			AccountType:    "base",
+			AccountInitMsg: initMsgAny,
+		})
+		require.Nil(t, resp)
+		require.ErrorContains(t, err, "migrated account sequence 5 is lower than the account sequence 10")
 	})
 
 	t.Run("success", func(t *testing.T) {
@@ -98,3 +123,63 @@ func TestMigrateToAccounts(t *testing.T) {
 		require.Equal(t, migrateMsg.PubKey, pkResp.(*basev1.QueryPubKeyResponse).PubKey)
 	})
 }
+
+func TestMigrateLegacyAccounts(t *testing.T) {
+	f := initFixture(t, nil)
+
+	// accounts with a pubkey are migrated, the other ones are skipped
+	var migrated, skipped []sdk.AccAddress
+	for i := 0; i < 5; i++ {
+		privKey := secp256k1.GenPrivKey()
+		addr := sdk.AccAddress(privKey.PubKey().Address())
+		acc := f.authKeeper.NewAccountWithAddress(f.ctx, addr)
+		require.NoError(t, acc.SetPubKey(privKey.PubKey()))
+		require.NoError(t, acc.SetSequence(uint64(i)))
+		f.authKeeper.SetAccount(f.ctx, acc)
+		migrated = append(migrated, addr)
+	}
+	for i := 0; i < 2; i++ {
+		addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		f.authKeeper.SetAccount(f.ctx, f.authKeeper.NewAccountWithAddress(f.ctx, addr))
+		skipped = append(skipped, addr)
+	}
+
+	migrator := func(_ context.Context, acc sdk.AccountI) (string, transaction.Msg, error) {
+		if acc.GetPubKey() == nil {
+			return "", nil, nil
+		}
+		initMsg, err := baseaccount.MigrateLegacyAccount(acc)
+		return "base", initMsg, err
+	}
+
+	_, err := authkeeper.MigrateLegacyAccounts(f.ctx, &f.authKeeper, migrator, nil, 0)
+	require.ErrorContains(t, err, "invalid limit 0")
+
+	// the accounts are migrated in batches
+	var (
+		next    sdk.AccAddress
+		batches int
+	)
+	for {
+		next, err = authkeeper.MigrateLegacyAccounts(f.ctx, &f.authKeeper, migrator, next, 3)
+		require.NoError(t, err)
+		batches++
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, 3, batches)
+
+	for i, addr := range migrated {
+		require.Nil(t, f.authKeeper.GetAccount(f.ctx, addr))
+		require.True(t, f.accountsKeeper.IsAccountsModuleAccount(f.ctx, addr))
+
+		seq, err := f.accountsKeeper.Query(f.ctx, addr, &basev1.QuerySequence{})
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), seq.(*basev1.QuerySequenceResponse).Sequence)
+	}
+	for _, addr := range skipped {
+		require.NotNil(t, f.authKeeper.GetAccount(f.ctx, addr))
+		require.False(t, f.accountsKeeper.IsAccountsModuleAccount(f.ctx, addr))
+	}
+}
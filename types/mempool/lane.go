@@ -0,0 +1,224 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*LanedMempool)(nil)
+
+// ErrNoLane is returned when a tx matches none of the lanes of a LanedMempool.
+var ErrNoLane = errors.New("tx matches no lane")
+
+// Lane is a partition of a LanedMempool holding the transactions of a kind, e.g.
+// governance votes or IBC relaying, which are given a dedicated blockspace.
+type Lane = GenericLane[sdk.Tx, Iterator]
+
+// LanedMempool is a mempool partitioned in lanes. A transaction belongs to the
+// first lane matching it, and the transactions are selected lane by lane, in the
+// order of the lanes and then in the order of the mempool of their lane.
+//
+// The DefaultProposalHandler enforces the lane ordering and the maximum block
+// share of the lanes when building and processing a proposal.
+type LanedMempool = GenericLanedMempool[sdk.Tx, Iterator]
+
+// NewLanedMempool returns a LanedMempool with the given lanes, ordered from the
+// first lane to be included in a block to the last one.
+func NewLanedMempool(lanes ...Lane) (*LanedMempool, error) {
+	return NewGenericLanedMempool(lanes...)
+}
+
+// GenericMempool is a mempool of transactions of type T whose iterators are of
+// type I, e.g. Mempool. It allows to share the GenericLanedMempool with the
+// mempools of other transaction types.
+type GenericMempool[T any, I GenericIterator[T, I]] interface {
+	Insert(context.Context, T) error
+	Select(context.Context, []T) I
+	SelectBy(context.Context, []T, func(T) bool)
+	CountTx() int
+	Remove(T) error
+}
+
+// GenericIterator is an iterator of type I over transactions of type T, e.g.
+// Iterator.
+type GenericIterator[T, I any] interface {
+	Next() I
+	Tx() T
+}
+
+// GenericLane is a lane of a GenericLanedMempool, see Lane.
+type GenericLane[T any, I GenericIterator[T, I]] struct {
+	// Name is the name of the lane.
+	Name string
+
+	// Match reports whether a transaction belongs to the lane. A nil Match matches
+	// all the transactions, which is typically the case of the last, default lane.
+	Match func(tx T) bool
+
+	// Mempool stores the transactions of the lane, it defines their ordering.
+	Mempool GenericMempool[T, I]
+
+	// MaxBlockShare is the maximum percentage, from 1 to 100, of the block bytes
+	// and gas which can be used by the transactions of the lane.
+	MaxBlockShare uint64
+}
+
+// GenericLanedMempool is a mempool of transactions of type T partitioned in
+// lanes, see LanedMempool. The iterator type I must be an interface type, which
+// the iterator over the lanes implements.
+type GenericLanedMempool[T any, I GenericIterator[T, I]] struct {
+	lanes []GenericLane[T, I]
+}
+
+// NewGenericLanedMempool returns a GenericLanedMempool with the given lanes,
+// ordered from the first lane to be included in a block to the last one.
+func NewGenericLanedMempool[T any, I GenericIterator[T, I]](lanes ...GenericLane[T, I]) (*GenericLanedMempool[T, I], error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("laned mempool must have at least one lane")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return nil, errors.New("lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		}
+		if lane.MaxBlockShare == 0 || lane.MaxBlockShare > 100 {
+			return nil, fmt.Errorf("lane %s max block share must be between 1 and 100, got %d", lane.Name, lane.MaxBlockShare)
+		}
+	}
+
+	return &GenericLanedMempool[T, I]{lanes: lanes}, nil
+}
+
+// Lanes returns the lanes of the mempool.
+func (mp *GenericLanedMempool[T, I]) Lanes() []GenericLane[T, I] {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the lane of a transaction, -1 if it matches none.
+func (mp *GenericLanedMempool[T, I]) LaneIndex(tx T) int {
+	for i, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ProposalLaneIndex returns the index of the lane of a transaction of a proposal.
+// A transaction matching no lane, which cannot be inserted in the mempool but can
+// be proposed by another validator, belongs to the last lane.
+func (mp *GenericLanedMempool[T, I]) ProposalLaneIndex(tx T) int {
+	if i := mp.LaneIndex(tx); i >= 0 {
+		return i
+	}
+	return len(mp.lanes) - 1
+}
+
+// Insert inserts a transaction in the mempool of its lane.
+func (mp *GenericLanedMempool[T, I]) Insert(ctx context.Context, tx T) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrNoLane
+	}
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all the lanes, in lane order.
+func (mp *GenericLanedMempool[T, I]) Select(ctx context.Context, txs []T) I {
+	laneTxs := mp.partition(txs)
+	for i := range mp.lanes {
+		if iter := mp.lanes[i].Mempool.Select(ctx, laneTxs[i]); any(iter) != nil {
+			return any(&lanedIterator[T, I]{mp: mp, ctx: ctx, laneTxs: laneTxs, lane: i, iter: iter}).(I)
+		}
+	}
+	var none I
+	return none
+}
+
+// SelectBy iterates over the transactions of all the lanes, in lane order.
+func (mp *GenericLanedMempool[T, I]) SelectBy(ctx context.Context, txs []T, callback func(T) bool) {
+	laneTxs := mp.partition(txs)
+	for i, lane := range mp.lanes {
+		next := true
+		lane.Mempool.SelectBy(ctx, laneTxs[i], func(tx T) bool {
+			next = callback(tx)
+			return next
+		})
+		if !next {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of transactions of all the lanes.
+func (mp *GenericLanedMempool[T, I]) CountTx() int {
+	var count int
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+// Remove removes a transaction from the mempool of its lane.
+func (mp *GenericLanedMempool[T, I]) Remove(tx T) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+	return mp.lanes[i].Mempool.Remove(tx)
+}
+
+// partition returns the given transactions by lane.
+func (mp *GenericLanedMempool[T, I]) partition(txs []T) [][]T {
+	laneTxs := make([][]T, len(mp.lanes))
+	for _, tx := range txs {
+		if i := mp.LaneIndex(tx); i >= 0 {
+			laneTxs[i] = append(laneTxs[i], tx)
+		}
+	}
+	return laneTxs
+}
+
+// lanedIterator iterates over the iterators of the lanes of a GenericLanedMempool.
+type lanedIterator[T any, I GenericIterator[T, I]] struct {
+	mp      *GenericLanedMempool[T, I]
+	ctx     context.Context
+	laneTxs [][]T
+	lane    int
+	iter    I
+}
+
+func (it *lanedIterator[T, I]) Next() I {
+	if it.iter = it.iter.Next(); any(it.iter) != nil {
+		return any(it).(I)
+	}
+
+	for it.lane++; it.lane < len(it.mp.lanes); it.lane++ {
+		if it.iter = it.mp.lanes[it.lane].Mempool.Select(it.ctx, it.laneTxs[it.lane]); any(it.iter) != nil {
+			return any(it).(I)
+		}
+	}
+	var none I
+	return none
+}
+
+func (it *lanedIterator[T, I]) Tx() T {
+	return it.iter.Tx()
+}
+
+// LaneLimit returns the share, in percent, of a block limit.
+func LaneLimit(limit, share uint64) uint64 {
+	return limit/100*share + limit%100*share/100
+}
@@ -0,0 +1,83 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestLanedMempool(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+
+	// the txs with an id lower than 10 are in the first lane, the ones with an id
+	// lower than 100 in the second one
+	idBelow := func(limit int) func(sdk.Tx) bool {
+		return func(tx sdk.Tx) bool { return tx.(testTx).id < limit }
+	}
+	_, err := mempool.NewLanedMempool()
+	require.Error(t, err)
+	_, err = mempool.NewLanedMempool(mempool.Lane{Name: "a", Mempool: mempool.DefaultPriorityMempool(), MaxBlockShare: 101})
+	require.ErrorContains(t, err, "max block share")
+	_, err = mempool.NewLanedMempool(
+		mempool.Lane{Name: "a", Mempool: mempool.DefaultPriorityMempool(), MaxBlockShare: 10},
+		mempool.Lane{Name: "a", Mempool: mempool.DefaultPriorityMempool(), MaxBlockShare: 10},
+	)
+	require.ErrorContains(t, err, "duplicate lane")
+
+	mp, err := mempool.NewLanedMempool(
+		mempool.Lane{Name: "first", Match: idBelow(10), Mempool: mempool.DefaultPriorityMempool(), MaxBlockShare: 10},
+		mempool.Lane{Name: "second", Match: idBelow(100), Mempool: mempool.DefaultPriorityMempool(), MaxBlockShare: 50},
+	)
+	require.NoError(t, err)
+
+	txs := []testTx{
+		{id: 50, priority: 100, nonce: 0, address: accounts[0].Address},
+		{id: 1, priority: 1, nonce: 0, address: accounts[1].Address},
+		{id: 51, priority: 200, nonce: 0, address: accounts[2].Address},
+		{id: 2, priority: 2, nonce: 0, address: accounts[3].Address},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.ErrorIs(t, mp.Insert(ctx, testTx{id: 100, address: accounts[0].Address}), mempool.ErrNoLane)
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 1, mp.LaneIndex(txs[0]))
+	require.Equal(t, -1, mp.LaneIndex(testTx{id: 100}))
+
+	// the txs are selected by lane, then by priority
+	var ids []int
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		ids = append(ids, iter.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{2, 1, 51, 50}, ids)
+
+	ids = nil
+	mp.SelectBy(ctx, nil, func(tx sdk.Tx) bool {
+		ids = append(ids, tx.(testTx).id)
+		return len(ids) < 3
+	})
+	require.Equal(t, []int{2, 1, 51}, ids)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[3]))
+	require.ErrorIs(t, mp.Remove(txs[3]), mempool.ErrTxNotFound)
+	require.ErrorIs(t, mp.Remove(testTx{id: 100}), mempool.ErrTxNotFound)
+
+	ids = nil
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		ids = append(ids, iter.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{51, 50}, ids)
+
+	require.Equal(t, uint64(50), mempool.LaneLimit(100, 50))
+	require.Equal(t, uint64(1_000_000_000), mempool.LaneLimit(10_000_000_000, 10))
+}
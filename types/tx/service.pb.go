@@ -390,6 +390,13 @@ type SimulateRequest struct {
 	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"` // Deprecated: Do not use.
 	// tx_bytes is the raw transaction.
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// height is the height of the state to simulate the transaction against, 0
+	// for the latest state.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// state_overrides are written over the state before the simulation, e.g. to
+	// simulate the transaction with hypothetical balances, account sequences or
+	// params. They are applied in order and never committed.
+	StateOverrides []*StateOverride `protobuf:"bytes,4,rep,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -440,6 +447,94 @@ func (m *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *SimulateRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SimulateRequest) GetStateOverrides() []*StateOverride {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
+}
+
+// StateOverride is a write of a key of a module store, applied to the state a
+// transaction is simulated against.
+type StateOverride struct {
+	// store_key is the name of the store of the key, e.g. "bank".
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// key is the key of the store to override.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value of the key, ignored if the key is deleted.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// delete removes the key from the store instead of setting its value.
+	Delete bool `protobuf:"varint,4,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (m *StateOverride) Reset()         { *m = StateOverride{} }
+func (m *StateOverride) String() string { return proto.CompactTextString(m) }
+func (*StateOverride) ProtoMessage()    {}
+func (*StateOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{5}
+}
+func (m *StateOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateOverride.Merge(m, src)
+}
+func (m *StateOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateOverride proto.InternalMessageInfo
+
+func (m *StateOverride) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StateOverride) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateOverride) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateOverride) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -453,7 +548,7 @@ func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
 func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
 func (*SimulateResponse) ProtoMessage()    {}
 func (*SimulateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{5}
+	return fileDescriptor_e0b00a618705eca7, []int{6}
 }
 func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +602,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{7}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +650,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{8}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +706,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +764,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +832,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +879,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +926,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +973,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1019,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1065,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,7 +1111,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1157,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,6 +1201,7 @@ func init() {
 	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
+	proto.RegisterType((*StateOverride)(nil), "cosmos.tx.v1beta1.StateOverride")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
@@ -1124,90 +1220,96 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x13, 0x47,
-	0x14, 0xc7, 0xb3, 0x9b, 0x5f, 0xce, 0x73, 0x42, 0xcc, 0x24, 0x80, 0x31, 0xe0, 0x98, 0x85, 0x10,
-	0x13, 0x89, 0x5d, 0x62, 0x88, 0xa8, 0x50, 0xab, 0xca, 0x6b, 0x9b, 0x34, 0x50, 0x30, 0x5a, 0xa7,
-	0xaa, 0xa8, 0x2a, 0x59, 0x6b, 0x7b, 0xb0, 0xb7, 0xd8, 0xbb, 0xc6, 0x3b, 0x89, 0xd6, 0xa2, 0xa8,
-	0x55, 0x4f, 0x6d, 0xd5, 0xaa, 0x95, 0xfa, 0x17, 0xf4, 0xde, 0x23, 0x7f, 0x44, 0xc5, 0x09, 0xd1,
-	0x4b, 0xd5, 0x53, 0x05, 0x3d, 0xf5, 0xaf, 0xa8, 0x76, 0x66, 0xd6, 0xde, 0xb5, 0x77, 0x49, 0xe2,
-	0xde, 0x66, 0x76, 0xde, 0xbc, 0xf7, 0x99, 0xef, 0xcc, 0xbc, 0x37, 0x0b, 0x6b, 0x75, 0xcb, 0xee,
-	0x58, 0xb6, 0x42, 0x1c, 0xe5, 0x60, 0xab, 0x86, 0x89, 0xbe, 0xa5, 0xd8, 0xb8, 0x77, 0x60, 0xd4,
-	0xb1, 0xdc, 0xed, 0x59, 0xc4, 0x42, 0x27, 0x99, 0x81, 0x4c, 0x1c, 0x99, 0x1b, 0xa4, 0xce, 0x37,
-	0x2d, 0xab, 0xd9, 0xc6, 0x8a, 0xde, 0x35, 0x14, 0xdd, 0x34, 0x2d, 0xa2, 0x13, 0xc3, 0x32, 0x6d,
-	0x36, 0x21, 0x75, 0x89, 0x7b, 0xac, 0xe9, 0x36, 0x56, 0xf4, 0x5a, 0xdd, 0x18, 0x38, 0x76, 0x3b,
-	0xdc, 0x28, 0x35, 0x1e, 0x96, 0x38, 0x7c, 0x6c, 0xd3, 0xef, 0xe0, 0xe9, 0x3e, 0xee, 0xf5, 0x07,
-	0x36, 0x5d, 0xbd, 0x69, 0x98, 0x34, 0x1a, 0xb7, 0xbd, 0x50, 0xb7, 0x3a, 0x98, 0xd4, 0x1e, 0x13,
-	0x85, 0xf4, 0xbb, 0xd8, 0x56, 0x0e, 0xb6, 0x94, 0x5a, 0xdb, 0xaa, 0x3f, 0x89, 0x1e, 0xa6, 0x0d,
-	0x3e, 0x7c, 0x96, 0x45, 0xaa, 0xd2, 0x9e, 0xc2, 0x17, 0x4a, 0x3b, 0xd2, 0xb7, 0x22, 0xa0, 0x1d,
-	0x4c, 0xf6, 0x1c, 0xbb, 0x74, 0x80, 0x4d, 0xa2, 0xe1, 0xa7, 0xfb, 0xd8, 0x26, 0x28, 0x05, 0x73,
-	0xd8, 0xed, 0xdb, 0x49, 0x21, 0x33, 0x9d, 0x5d, 0x50, 0xc5, 0xa4, 0xa0, 0xf1, 0x2f, 0xe8, 0x2e,
-	0xc0, 0x90, 0x2f, 0x29, 0x66, 0x84, 0x6c, 0x3c, 0x77, 0x45, 0xe6, 0x5e, 0xdd, 0xc5, 0xc8, 0x74,
-	0x31, 0x9e, 0x8c, 0xf2, 0x43, 0xbd, 0x89, 0xb9, 0x5f, 0xea, 0xc7, 0x37, 0x1b, 0x6d, 0x43, 0xcc,
-	0xea, 0x35, 0x70, 0xaf, 0x5a, 0xeb, 0x27, 0xa7, 0x33, 0x42, 0xf6, 0x44, 0x2e, 0x25, 0x8f, 0x6d,
-	0x84, 0x5c, 0x76, 0x4d, 0xd4, 0xbe, 0x36, 0x6f, 0xb1, 0x06, 0x42, 0x30, 0xd3, 0xd5, 0x9b, 0x38,
-	0x39, 0x93, 0x11, 0xb2, 0x33, 0x1a, 0x6d, 0xa3, 0x55, 0x98, 0x6d, 0x1b, 0x1d, 0x83, 0x24, 0x67,
-	0xe9, 0x47, 0xd6, 0x41, 0x57, 0x61, 0x96, 0xd2, 0x24, 0xe7, 0x32, 0x42, 0x76, 0x41, 0x5d, 0xf9,
-	0xeb, 0xc5, 0xb5, 0x65, 0x16, 0xe0, 0x9a, 0xdd, 0x78, 0x92, 0xb9, 0x2e, 0x6f, 0x5f, 0xd7, 0x98,
-	0x85, 0xf4, 0xaf, 0x00, 0x2b, 0x01, 0x29, 0xec, 0xae, 0x65, 0xda, 0x18, 0x6d, 0xc0, 0x34, 0x71,
-	0x98, 0x10, 0xf1, 0xdc, 0xa9, 0x10, 0xbc, 0x3d, 0x47, 0x73, 0x2d, 0xd0, 0x0e, 0x2c, 0x12, 0xa7,
-	0xda, 0xe3, 0xf3, 0xec, 0xa4, 0x48, 0x67, 0x5c, 0x0e, 0x48, 0x43, 0xcf, 0x86, 0x6f, 0x22, 0x37,
-	0xd6, 0xe2, 0x64, 0xd0, 0xb6, 0xd1, 0xbd, 0x80, 0xc2, 0xd3, 0x54, 0xe1, 0x8d, 0x43, 0x15, 0x66,
-	0xb3, 0xc7, 0x24, 0x5e, 0x85, 0x59, 0x62, 0x11, 0xbd, 0xcd, 0xc5, 0x62, 0x1d, 0x09, 0x03, 0x52,
-	0x7b, 0x96, 0xde, 0xa8, 0xeb, 0x36, 0x71, 0x31, 0xd8, 0xb6, 0x9f, 0x85, 0x18, 0x71, 0xaa, 0xb5,
-	0x3e, 0xc1, 0xee, 0x7a, 0x85, 0xec, 0xa2, 0x36, 0x4f, 0x1c, 0xd5, 0xed, 0xa2, 0x9b, 0x30, 0xd3,
-	0xb1, 0x1a, 0x98, 0xee, 0xf7, 0x89, 0x5c, 0x26, 0x44, 0x86, 0x81, 0xbf, 0xfb, 0x56, 0x03, 0x6b,
-	0xd4, 0x5a, 0xfa, 0x1c, 0x56, 0x02, 0x61, 0xb8, 0xa4, 0x25, 0x88, 0xfb, 0x94, 0xa2, 0xa1, 0x8e,
-	0x2a, 0x14, 0x0c, 0x85, 0x92, 0xda, 0xb0, 0x5c, 0x31, 0x3a, 0xfb, 0x6d, 0x9d, 0x78, 0x07, 0x0c,
-	0x5d, 0x05, 0x91, 0x38, 0xdc, 0x61, 0xf8, 0x5e, 0x51, 0x81, 0x44, 0xe2, 0x20, 0xd9, 0xb7, 0x58,
-	0x77, 0x55, 0x8b, 0x21, 0xa7, 0xe3, 0xe6, 0x8d, 0x81, 0x02, 0xd2, 0xf7, 0x02, 0x24, 0x86, 0xe1,
-	0xf8, 0x4a, 0xde, 0x87, 0x58, 0x53, 0xb7, 0xab, 0x86, 0xf9, 0xd8, 0xe2, 0x51, 0x2f, 0x46, 0x2f,
-	0x63, 0x47, 0xb7, 0x77, 0xcd, 0xc7, 0x96, 0x36, 0xdf, 0x64, 0x0d, 0xf4, 0x1e, 0xcc, 0xf5, 0xb0,
-	0xbd, 0xdf, 0x26, 0xfc, 0x1a, 0x65, 0xa2, 0xe7, 0x6a, 0xd4, 0x4e, 0xe3, 0xf6, 0x92, 0x04, 0x8b,
-	0xf4, 0xac, 0x7a, 0xeb, 0x46, 0x30, 0xd3, 0xd2, 0xed, 0x16, 0x65, 0x58, 0xd0, 0x68, 0x5b, 0x7a,
-	0x0e, 0x4b, 0xdc, 0x86, 0xc3, 0xae, 0x1f, 0x2a, 0x0e, 0x15, 0x66, 0x64, 0x77, 0xc4, 0x09, 0x77,
-	0xe7, 0x27, 0x01, 0x4e, 0xef, 0x60, 0xa2, 0xba, 0x79, 0xea, 0x53, 0x83, 0xb4, 0xf6, 0x1c, 0xdb,
-	0xa3, 0x3d, 0x0d, 0x73, 0x2d, 0x6c, 0x34, 0x5b, 0x84, 0xc2, 0x4c, 0x6b, 0xbc, 0x87, 0xee, 0x4c,
-	0x9e, 0x5a, 0xfc, 0x67, 0xfe, 0xf6, 0xa9, 0xd7, 0x2f, 0xae, 0x9d, 0x0c, 0x6e, 0xe4, 0xb6, 0x9c,
-	0x93, 0xbe, 0x13, 0xe1, 0xcc, 0x18, 0xd1, 0x71, 0x6f, 0xf9, 0x36, 0xc4, 0x68, 0xea, 0xad, 0x1a,
-	0x0d, 0x4e, 0xe8, 0xa6, 0x2c, 0x96, 0x7e, 0x65, 0x96, 0x75, 0x0f, 0xb6, 0x64, 0x1a, 0x63, 0xb7,
-	0xa8, 0xcd, 0x53, 0xdb, 0xdd, 0x06, 0x92, 0x61, 0x96, 0x36, 0xf9, 0x75, 0x4e, 0x46, 0xcd, 0xd1,
-	0x98, 0x19, 0xda, 0x09, 0x48, 0x31, 0x73, 0xac, 0x1c, 0x70, 0x14, 0x2d, 0xf2, 0xb0, 0xbc, 0xe7,
-	0x14, 0x71, 0xdd, 0xbd, 0xab, 0x87, 0xde, 0xfe, 0xdb, 0x2b, 0xaf, 0xc7, 0x6e, 0xc6, 0x2d, 0xe9,
-	0x01, 0x24, 0x86, 0x2e, 0x8e, 0x75, 0xc4, 0xc2, 0xfd, 0xdd, 0x77, 0x91, 0x4a, 0xa6, 0x1f, 0xe9,
-	0xff, 0xb8, 0x53, 0x5d, 0x3c, 0xcf, 0x1d, 0xc7, 0x3b, 0xee, 0x12, 0xef, 0xc2, 0xaa, 0xe7, 0x23,
-	0xdf, 0x31, 0x4c, 0xcb, 0xe3, 0xba, 0x00, 0xa0, 0xbb, 0xfd, 0xea, 0x17, 0xb6, 0x65, 0xf2, 0x4b,
-	0xb7, 0x40, 0xbf, 0xdc, 0xb5, 0x2d, 0x33, 0xdc, 0x57, 0x19, 0x4e, 0x8d, 0xf8, 0xe2, 0x50, 0x17,
-	0x61, 0x91, 0x39, 0xab, 0x19, 0xa6, 0xde, 0xeb, 0x73, 0xb0, 0x38, 0xfd, 0xa6, 0xd2, 0x4f, 0x51,
-	0xfa, 0xaf, 0x7a, 0xfa, 0x07, 0xe0, 0x26, 0xf5, 0x77, 0xcf, 0x05, 0x0c, 0xf8, 0xe3, 0x80, 0x13,
-	0xac, 0x76, 0xf3, 0x23, 0x98, 0xe7, 0x65, 0x1b, 0x25, 0x61, 0xb5, 0xac, 0x15, 0x4b, 0x5a, 0x55,
-	0x7d, 0x54, 0xfd, 0xe4, 0x41, 0xe5, 0x61, 0xa9, 0xb0, 0x7b, 0x67, 0xb7, 0x54, 0x4c, 0x4c, 0xa1,
-	0x04, 0x2c, 0x0e, 0x46, 0xf2, 0x95, 0x42, 0x42, 0x40, 0x27, 0x61, 0x69, 0xf0, 0xa5, 0x58, 0xaa,
-	0x14, 0x12, 0xe2, 0xe6, 0xd7, 0x02, 0x2c, 0x05, 0x6a, 0x0b, 0x4a, 0x43, 0x4a, 0xd5, 0xca, 0xf9,
-	0x62, 0x21, 0x5f, 0xd9, 0xab, 0xde, 0x2f, 0x17, 0x4b, 0x23, 0x6e, 0xcf, 0xc3, 0xea, 0xc8, 0xb8,
-	0xfa, 0x71, 0xb9, 0x70, 0x2f, 0x21, 0xa4, 0xc4, 0x98, 0x80, 0xce, 0xc0, 0xca, 0xc8, 0x68, 0xe5,
-	0xd1, 0x83, 0x42, 0x42, 0x74, 0x39, 0x47, 0x06, 0xf2, 0x74, 0x64, 0x3a, 0xf7, 0x23, 0xc0, 0x7c,
-	0x85, 0x3d, 0x17, 0xd1, 0x33, 0x88, 0x79, 0x55, 0x00, 0x49, 0x21, 0x47, 0x72, 0xa4, 0x22, 0xa5,
-	0x2e, 0xbd, 0xd3, 0x86, 0xe7, 0xca, 0x2b, 0xdf, 0xfc, 0xf1, 0xcf, 0x2f, 0x62, 0xe6, 0xb6, 0xb0,
-	0x29, 0x9d, 0x53, 0x42, 0x9e, 0xaa, 0x5e, 0xc0, 0xa7, 0x30, 0x4b, 0x53, 0x3a, 0x5a, 0x0b, 0xf1,
-	0xea, 0x2f, 0x08, 0xa9, 0x4c, 0xb4, 0x01, 0x8f, 0xb9, 0x4e, 0x63, 0xae, 0xa1, 0x0b, 0x4a, 0xd8,
-	0x23, 0xd5, 0x56, 0x9e, 0xb9, 0x45, 0xe4, 0x39, 0xfa, 0x0a, 0xe2, 0xbe, 0x12, 0x8e, 0xd6, 0xdf,
-	0x55, 0xf9, 0x87, 0xe1, 0xaf, 0x1c, 0x66, 0xc6, 0x21, 0x2e, 0x52, 0x88, 0x73, 0xee, 0xc2, 0x4f,
-	0x87, 0x73, 0xa0, 0x2f, 0x21, 0xee, 0x7b, 0x96, 0x85, 0x02, 0x8c, 0xbf, 0x60, 0x43, 0x01, 0x42,
-	0x5e, 0x77, 0x52, 0x9a, 0x02, 0x24, 0x51, 0x54, 0xf4, 0xdf, 0x04, 0x58, 0x1e, 0xa9, 0x19, 0xe8,
-	0x6a, 0xb8, 0xef, 0x90, 0x4a, 0x97, 0xda, 0x3c, 0x8a, 0x29, 0x47, 0x51, 0x5f, 0x86, 0x65, 0x6a,
-	0xca, 0xb7, 0x81, 0xd6, 0x23, 0x76, 0x89, 0x56, 0x0b, 0xe5, 0x19, 0x2b, 0xa0, 0xcf, 0xd1, 0x0f,
-	0x02, 0xc4, 0xbc, 0x4b, 0x1c, 0x7a, 0x3c, 0x47, 0x92, 0x7e, 0xe8, 0xf1, 0x1c, 0xcd, 0xea, 0xd2,
-	0xad, 0x97, 0xe3, 0x37, 0x9c, 0x72, 0xa5, 0xdd, 0x8d, 0x3b, 0x1b, 0x82, 0xd6, 0x60, 0x04, 0x0c,
-	0x87, 0x25, 0xbd, 0x08, 0x9c, 0x40, 0xc2, 0x8f, 0xc0, 0x09, 0x66, 0xf1, 0x09, 0x70, 0x30, 0x23,
-	0xf8, 0x55, 0x80, 0xa5, 0x40, 0x0e, 0x46, 0x1b, 0xef, 0x88, 0xe7, 0x4f, 0xaa, 0xa9, 0xec, 0xe1,
-	0x86, 0x9c, 0xee, 0x83, 0x28, 0xba, 0xcb, 0x2e, 0xdd, 0x5a, 0x24, 0x9d, 0x42, 0x33, 0x2a, 0x67,
-	0xf4, 0xa5, 0xe1, 0x08, 0xc6, 0xf1, 0xc4, 0x1f, 0xc1, 0x18, 0x92, 0xd1, 0x27, 0x60, 0x64, 0x1b,
-	0xca, 0x18, 0xd5, 0x0f, 0x7f, 0x7f, 0x93, 0x16, 0x5e, 0xbd, 0x49, 0x0b, 0x7f, 0xbf, 0x49, 0x0b,
-	0x3f, 0xbf, 0x4d, 0x4f, 0xbd, 0x7a, 0x9b, 0x9e, 0xfa, 0xf3, 0x6d, 0x7a, 0xea, 0xb3, 0xf5, 0xa6,
-	0x41, 0x5a, 0xfb, 0x35, 0xf7, 0x75, 0xe3, 0x39, 0x19, 0x86, 0xe1, 0x7f, 0xa7, 0xc4, 0xa9, 0xcd,
-	0xd1, 0xbf, 0xcf, 0x1b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x1d, 0xd5, 0xa1, 0x97, 0x0f,
-	0x00, 0x00,
+	// 1414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xda, 0x4e, 0xe2, 0xbc, 0x76, 0x1a, 0x77, 0xf2, 0x51, 0x77, 0xdb, 0x3a, 0xee, 0xb6,
+	0x49, 0xdc, 0xa0, 0xee, 0x36, 0x69, 0xa3, 0xa2, 0x0a, 0x84, 0xb2, 0xb1, 0x1b, 0xd2, 0xd0, 0xba,
+	0x5a, 0x07, 0xa1, 0x22, 0x84, 0xb5, 0xb6, 0xa7, 0xce, 0x52, 0x67, 0x27, 0xf5, 0x4c, 0xac, 0xb5,
+	0x4a, 0xc5, 0xc7, 0x09, 0x10, 0x08, 0x24, 0x7e, 0x01, 0x77, 0x8e, 0xfd, 0x11, 0xa8, 0xa7, 0xaa,
+	0x5c, 0x10, 0x27, 0xd4, 0x72, 0xe2, 0x1f, 0x70, 0x43, 0x3b, 0x3b, 0xeb, 0xcf, 0x75, 0x9c, 0xa4,
+	0xdc, 0xe6, 0xe3, 0x99, 0xe7, 0x7d, 0xe6, 0x99, 0x77, 0xdf, 0x99, 0x85, 0xf9, 0x32, 0xa1, 0x7b,
+	0x84, 0x6a, 0xcc, 0xd1, 0x1a, 0x2b, 0x25, 0xcc, 0xcc, 0x15, 0x8d, 0xe2, 0x7a, 0xc3, 0x2a, 0x63,
+	0x75, 0xbf, 0x4e, 0x18, 0x41, 0xa7, 0x3d, 0x80, 0xca, 0x1c, 0x55, 0x00, 0xe4, 0xf3, 0x55, 0x42,
+	0xaa, 0x35, 0xac, 0x99, 0xfb, 0x96, 0x66, 0xda, 0x36, 0x61, 0x26, 0xb3, 0x88, 0x4d, 0xbd, 0x05,
+	0xf2, 0x25, 0xc1, 0x58, 0x32, 0x29, 0xd6, 0xcc, 0x52, 0xd9, 0x6a, 0x11, 0xbb, 0x1d, 0x01, 0x92,
+	0xfb, 0xc3, 0x32, 0x47, 0xcc, 0x2d, 0x77, 0x12, 0x3c, 0x3e, 0xc0, 0xf5, 0x66, 0x0b, 0xb3, 0x6f,
+	0x56, 0x2d, 0x9b, 0x47, 0x13, 0xd8, 0x0b, 0x65, 0xb2, 0x87, 0x59, 0xe9, 0x21, 0xd3, 0x58, 0x73,
+	0x1f, 0x53, 0xad, 0xb1, 0xa2, 0x95, 0x6a, 0xa4, 0xfc, 0x68, 0xf0, 0x34, 0x6f, 0x88, 0xe9, 0xb3,
+	0x5e, 0xa4, 0x22, 0xef, 0x69, 0x5e, 0xc7, 0x9b, 0x52, 0xbe, 0x09, 0x01, 0xda, 0xc4, 0x6c, 0xc7,
+	0xa1, 0xb9, 0x06, 0xb6, 0x99, 0x81, 0x1f, 0x1f, 0x60, 0xca, 0x90, 0x0c, 0x63, 0xd8, 0xed, 0xd3,
+	0xa4, 0x94, 0x0e, 0x67, 0x26, 0xf4, 0x50, 0x52, 0x32, 0xc4, 0x08, 0xba, 0x03, 0xd0, 0xd6, 0x97,
+	0x0c, 0xa5, 0xa5, 0x4c, 0x6c, 0x75, 0x51, 0x15, 0xac, 0xee, 0x66, 0x54, 0xbe, 0x19, 0xdf, 0x46,
+	0xf5, 0xbe, 0x59, 0xc5, 0x82, 0x97, 0xf3, 0x74, 0xac, 0x46, 0x6b, 0x10, 0x25, 0xf5, 0x0a, 0xae,
+	0x17, 0x4b, 0xcd, 0x64, 0x38, 0x2d, 0x65, 0x4e, 0xad, 0xca, 0x6a, 0xdf, 0x41, 0xa8, 0x79, 0x17,
+	0xa2, 0x37, 0x8d, 0x71, 0xe2, 0x35, 0x10, 0x82, 0xc8, 0xbe, 0x59, 0xc5, 0xc9, 0x48, 0x5a, 0xca,
+	0x44, 0x0c, 0xde, 0x46, 0x33, 0x30, 0x5a, 0xb3, 0xf6, 0x2c, 0x96, 0x1c, 0xe5, 0x83, 0x5e, 0x07,
+	0x5d, 0x81, 0x51, 0xae, 0x26, 0x39, 0x96, 0x96, 0x32, 0x13, 0xfa, 0xf4, 0x9f, 0xcf, 0xae, 0x4e,
+	0x79, 0x01, 0xae, 0xd2, 0xca, 0xa3, 0xf4, 0x35, 0x75, 0xed, 0x9a, 0xe1, 0x21, 0x94, 0x7f, 0x24,
+	0x98, 0xee, 0xb2, 0x82, 0xee, 0x13, 0x9b, 0x62, 0xb4, 0x04, 0x61, 0xe6, 0x78, 0x46, 0xc4, 0x56,
+	0x67, 0x03, 0xe4, 0xed, 0x38, 0x86, 0x8b, 0x40, 0x9b, 0x10, 0x67, 0x4e, 0xb1, 0x2e, 0xd6, 0xd1,
+	0x64, 0x88, 0xaf, 0xb8, 0xdc, 0x65, 0x0d, 0xcf, 0x8d, 0x8e, 0x85, 0x02, 0x6c, 0xc4, 0x58, 0xab,
+	0x4d, 0xd1, 0x76, 0x97, 0xc3, 0x61, 0xee, 0xf0, 0xd2, 0x50, 0x87, 0xbd, 0xd5, 0x7d, 0x16, 0xcf,
+	0xc0, 0x28, 0x23, 0xcc, 0xac, 0x09, 0xb3, 0xbc, 0x8e, 0x82, 0x01, 0xe9, 0x75, 0x62, 0x56, 0xca,
+	0x26, 0x65, 0x3b, 0x8e, 0x38, 0x1e, 0x74, 0x16, 0xa2, 0xcc, 0x29, 0x96, 0x9a, 0x0c, 0xbb, 0xfb,
+	0x95, 0x32, 0x71, 0x63, 0x9c, 0x39, 0xba, 0xdb, 0x45, 0x37, 0x20, 0xb2, 0x47, 0x2a, 0x98, 0x9f,
+	0xf7, 0xa9, 0xd5, 0x74, 0x80, 0x0d, 0x2d, 0xbe, 0xbb, 0xa4, 0x82, 0x0d, 0x8e, 0x56, 0x3e, 0x81,
+	0xe9, 0xae, 0x30, 0xc2, 0xd2, 0x1c, 0xc4, 0x3a, 0x9c, 0xe2, 0xa1, 0x8e, 0x6a, 0x14, 0xb4, 0x8d,
+	0x52, 0xfe, 0x95, 0x60, 0xaa, 0x60, 0xed, 0x1d, 0xd4, 0x4c, 0xe6, 0x67, 0x18, 0xba, 0x02, 0x21,
+	0xe6, 0x08, 0xc6, 0xe0, 0xc3, 0xe2, 0x0e, 0x85, 0x98, 0x83, 0xd4, 0x8e, 0xdd, 0xba, 0xdb, 0x8a,
+	0x07, 0xa4, 0xc7, 0x8d, 0xeb, 0x6d, 0x0b, 0xde, 0x82, 0xb1, 0x5d, 0x6c, 0x55, 0x77, 0x19, 0x3f,
+	0x92, 0x70, 0x50, 0x32, 0x5d, 0x37, 0x04, 0x04, 0x7d, 0x0a, 0x53, 0x94, 0x99, 0x0c, 0x17, 0x49,
+	0x03, 0xd7, 0xeb, 0x56, 0x05, 0xd3, 0x64, 0x84, 0xe7, 0x43, 0x90, 0x75, 0x05, 0x17, 0x99, 0x17,
+	0xc0, 0x60, 0xde, 0x53, 0xb4, 0x13, 0x43, 0x95, 0xaf, 0x24, 0x98, 0xec, 0x5a, 0x86, 0xce, 0xc1,
+	0x04, 0x65, 0xa4, 0x8e, 0x8b, 0x8f, 0x70, 0x93, 0x1b, 0x30, 0x61, 0x44, 0xf9, 0xc0, 0x36, 0x6e,
+	0xa2, 0x04, 0x84, 0xdd, 0x61, 0xbe, 0x4d, 0xc3, 0x6d, 0xba, 0x79, 0xd1, 0x30, 0x6b, 0x07, 0x98,
+	0x6f, 0x26, 0x6e, 0x78, 0x1d, 0x34, 0x07, 0x63, 0x15, 0x5c, 0xc3, 0xcc, 0xfb, 0xb6, 0xa2, 0x86,
+	0xe8, 0xdd, 0x9a, 0x7e, 0xd9, 0xaf, 0x49, 0xf9, 0x4e, 0x82, 0x44, 0xdb, 0x7f, 0x71, 0xb6, 0xef,
+	0x40, 0xb4, 0x6a, 0xd2, 0xa2, 0x65, 0x3f, 0x24, 0xe2, 0x18, 0x2e, 0x0e, 0x3e, 0xd8, 0x4d, 0x93,
+	0x6e, 0xd9, 0x0f, 0x89, 0x31, 0x5e, 0xf5, 0x1a, 0xe8, 0x6d, 0x18, 0xab, 0x63, 0x7a, 0x50, 0x63,
+	0xa2, 0xb0, 0xa4, 0x07, 0xaf, 0x35, 0x38, 0xce, 0x10, 0x78, 0x45, 0x81, 0x38, 0xff, 0x7a, 0xfd,
+	0x44, 0x40, 0x10, 0xd9, 0x35, 0xe9, 0xae, 0x70, 0x82, 0xb7, 0x95, 0xa7, 0x30, 0x29, 0x30, 0x42,
+	0xec, 0xc2, 0xd0, 0x6c, 0xe1, 0x99, 0xd2, 0x93, 0xaf, 0xa1, 0x13, 0xe6, 0xeb, 0x8f, 0x12, 0xcc,
+	0x6d, 0x62, 0xa6, 0xbb, 0x95, 0xfb, 0x23, 0x8b, 0xed, 0xee, 0x38, 0xd4, 0x57, 0x3b, 0xd7, 0xca,
+	0x2d, 0x57, 0x4c, 0xb8, 0x95, 0x46, 0xb7, 0x4f, 0x5e, 0x6c, 0x3b, 0xab, 0xc0, 0xad, 0xd9, 0x97,
+	0xcf, 0xae, 0x9e, 0xee, 0xce, 0xec, 0x35, 0x75, 0x55, 0xf9, 0x36, 0x04, 0x67, 0xfa, 0x14, 0x1d,
+	0xb7, 0xee, 0xad, 0x41, 0x94, 0x5f, 0x46, 0x45, 0xab, 0x22, 0x14, 0xca, 0xaa, 0x7f, 0x21, 0xa9,
+	0xde, 0x3d, 0xd4, 0x58, 0x51, 0x79, 0x8c, 0xad, 0xac, 0x31, 0xce, 0xb1, 0x5b, 0x15, 0xa4, 0xc2,
+	0x28, 0x6f, 0x8a, 0x02, 0x97, 0x1c, 0xb4, 0xc6, 0xf0, 0x60, 0x68, 0xb3, 0xcb, 0x8a, 0xc8, 0xb1,
+	0xaa, 0xe2, 0x51, 0xbc, 0x58, 0x87, 0xa9, 0x1d, 0x27, 0x8b, 0xcb, 0xa4, 0xe2, 0x3b, 0x78, 0x48,
+	0x3d, 0x0c, 0xf8, 0x20, 0x6e, 0xdc, 0x54, 0xee, 0x41, 0xa2, 0x4d, 0x71, 0xac, 0x14, 0x0b, 0xe6,
+	0xbb, 0xeb, 0x4a, 0xca, 0xd9, 0x9d, 0x92, 0xde, 0x84, 0x4e, 0x87, 0x44, 0x9b, 0x4e, 0xc8, 0x3b,
+	0xee, 0x16, 0xef, 0xc0, 0x8c, 0xcf, 0xb1, 0xbe, 0x67, 0xd9, 0xc4, 0xd7, 0x75, 0x01, 0xc0, 0x74,
+	0xfb, 0xc5, 0xcf, 0x28, 0xb1, 0xc5, 0x47, 0x37, 0xc1, 0x47, 0xee, 0x50, 0x62, 0x07, 0x73, 0xe5,
+	0x61, 0xb6, 0x87, 0x4b, 0x88, 0xba, 0x08, 0x71, 0x8f, 0xac, 0x64, 0xd9, 0x66, 0xbd, 0x29, 0x84,
+	0xc5, 0xf8, 0x98, 0xce, 0x87, 0x06, 0xf9, 0x3f, 0xe3, 0xfb, 0xdf, 0x25, 0xee, 0xa4, 0x7c, 0xdb,
+	0x30, 0xdb, 0xc3, 0x27, 0x04, 0x9e, 0x60, 0xb7, 0xcb, 0xef, 0xc3, 0xb8, 0x78, 0xc8, 0xa0, 0x24,
+	0xcc, 0xe4, 0x8d, 0x6c, 0xce, 0x28, 0xea, 0x0f, 0x8a, 0x1f, 0xde, 0x2b, 0xdc, 0xcf, 0x6d, 0x6c,
+	0xdd, 0xde, 0xca, 0x65, 0x13, 0x23, 0x28, 0x01, 0xf1, 0xd6, 0xcc, 0x7a, 0x61, 0x23, 0x21, 0xa1,
+	0xd3, 0x30, 0xd9, 0x1a, 0xc9, 0xe6, 0x0a, 0x1b, 0x89, 0xd0, 0xf2, 0x97, 0x12, 0x4c, 0x76, 0xdd,
+	0xb6, 0x28, 0x05, 0xb2, 0x6e, 0xe4, 0xd7, 0xb3, 0x1b, 0xeb, 0x85, 0x9d, 0xe2, 0xdd, 0x7c, 0x36,
+	0xd7, 0x43, 0x7b, 0x1e, 0x66, 0x7a, 0xe6, 0xf5, 0x0f, 0xf2, 0x1b, 0xdb, 0x09, 0x49, 0x0e, 0x45,
+	0x25, 0x74, 0x06, 0xa6, 0x7b, 0x66, 0x0b, 0x0f, 0xee, 0x6d, 0x24, 0x42, 0xae, 0xce, 0x9e, 0x89,
+	0x75, 0x3e, 0x13, 0x5e, 0xfd, 0x01, 0x60, 0xbc, 0xe0, 0x3d, 0xa0, 0xd1, 0x13, 0x88, 0xfa, 0xb7,
+	0x00, 0x52, 0x82, 0x6e, 0xb7, 0xee, 0x2b, 0x5a, 0xbe, 0x74, 0x28, 0x46, 0xd4, 0xca, 0xc5, 0xaf,
+	0x7f, 0xff, 0xfb, 0xe7, 0x50, 0xfa, 0x96, 0xb4, 0xac, 0x9c, 0xd3, 0x02, 0x1e, 0xef, 0x7e, 0xc0,
+	0xc7, 0x30, 0xca, 0x4b, 0x3a, 0x9a, 0x0f, 0x60, 0xed, 0xbc, 0x10, 0xe4, 0xf4, 0x60, 0x80, 0x88,
+	0xb9, 0xc0, 0x63, 0xce, 0xa3, 0x0b, 0x5a, 0xd0, 0xb3, 0x9d, 0x6a, 0x4f, 0xdc, 0x4b, 0xe4, 0x29,
+	0xfa, 0x02, 0x62, 0x1d, 0x8f, 0x1a, 0xb4, 0x70, 0xd8, 0x5b, 0xa8, 0x1d, 0x7e, 0x71, 0x18, 0x4c,
+	0x88, 0xb8, 0xc8, 0x45, 0x9c, 0x73, 0x37, 0x3e, 0x17, 0xac, 0x03, 0x7d, 0x0e, 0xb1, 0x8e, 0x87,
+	0x6a, 0xa0, 0x80, 0xfe, 0x37, 0xbd, 0xbc, 0x38, 0x0c, 0x26, 0x04, 0xa4, 0xb8, 0x80, 0x24, 0x1a,
+	0x14, 0xfd, 0x57, 0x09, 0xa6, 0x7a, 0xee, 0x0c, 0x74, 0x25, 0x98, 0x3b, 0xe0, 0xa6, 0x93, 0x97,
+	0x8f, 0x02, 0x15, 0x52, 0xf4, 0xe7, 0x41, 0x95, 0x9a, 0xeb, 0x5b, 0x42, 0x0b, 0x03, 0x4e, 0x89,
+	0xdf, 0x16, 0xda, 0x13, 0xef, 0x02, 0x7d, 0x8a, 0xbe, 0x97, 0x20, 0xea, 0x7f, 0xc4, 0x81, 0xe9,
+	0xd9, 0x53, 0xf4, 0xe5, 0x4b, 0x87, 0x62, 0x84, 0xb2, 0x9b, 0xcf, 0xfb, 0xbf, 0x70, 0xae, 0x2b,
+	0xe5, 0x1e, 0xdc, 0xd9, 0x00, 0x69, 0x15, 0x4f, 0x81, 0x27, 0x27, 0x67, 0x1f, 0x22, 0x27, 0x67,
+	0x0f, 0x97, 0x93, 0xb3, 0xdf, 0x50, 0x0e, 0xf6, 0x14, 0xfc, 0x22, 0xc1, 0x64, 0x57, 0x0d, 0x46,
+	0x4b, 0x87, 0xc4, 0xeb, 0x2c, 0xaa, 0x72, 0x66, 0x38, 0x50, 0xa8, 0x7b, 0x77, 0x90, 0xba, 0xcb,
+	0xae, 0xba, 0xf9, 0x81, 0xea, 0x34, 0x5e, 0x51, 0x85, 0xc6, 0x2c, 0x1e, 0xa6, 0x31, 0x8b, 0x8f,
+	0xa8, 0x31, 0x8b, 0xff, 0x0f, 0x8d, 0x15, 0xdc, 0xd6, 0xa8, 0xbf, 0xf7, 0xdb, 0xab, 0x94, 0xf4,
+	0xe2, 0x55, 0x4a, 0xfa, 0xeb, 0x55, 0x4a, 0xfa, 0xe9, 0x75, 0x6a, 0xe4, 0xc5, 0xeb, 0xd4, 0xc8,
+	0x1f, 0xaf, 0x53, 0x23, 0x1f, 0x2f, 0x54, 0x2d, 0xb6, 0x7b, 0x50, 0x72, 0x5f, 0x37, 0x3e, 0x49,
+	0x3b, 0x8c, 0xf8, 0x5f, 0x67, 0x4e, 0x69, 0x8c, 0xff, 0x8f, 0x5f, 0xff, 0x6f, 0x00, 0x89, 0x38,
+	0xd3, 0x28, 0xa9, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1821,6 +1923,25 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StateOverrides) > 0 {
+		for iNdEx := len(m.StateOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -1843,6 +1964,60 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StateOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2444,6 +2619,39 @@ func (m *SimulateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if len(m.StateOverrides) > 0 {
+		for _, e := range m.StateOverrides {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
 	return n
 }
 
@@ -3315,6 +3523,229 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides, &StateOverride{})
+			if err := m.StateOverrides[len(m.StateOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
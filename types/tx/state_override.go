@@ -0,0 +1,63 @@
+package tx
+
+import (
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	corestore "cosmossdk.io/core/store"
+)
+
+// NewStateOverride returns the override setting the value of a key of a
+// collections.Map of the given store, e.g. a balance or an account, from the
+// prefix and codecs of the map.
+func NewStateOverride[K, V any](
+	storeKey string,
+	prefix collections.Prefix,
+	kc collcodec.KeyCodec[K],
+	vc collcodec.ValueCodec[V],
+	key K,
+	value V,
+) (*StateOverride, error) {
+	keyBz, err := collections.EncodeKeyWithPrefix(prefix.Bytes(), kc, key)
+	if err != nil {
+		return nil, err
+	}
+	valueBz, err := vc.Encode(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StateOverride{StoreKey: storeKey, Key: keyBz, Value: valueBz}, nil
+}
+
+// NewItemStateOverride returns the override setting the value of a
+// collections.Item of the given store, e.g. the params of a module.
+func NewItemStateOverride[V any](storeKey string, prefix collections.Prefix, vc collcodec.ValueCodec[V], value V) (*StateOverride, error) {
+	valueBz, err := vc.Encode(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StateOverride{StoreKey: storeKey, Key: prefix.Bytes(), Value: valueBz}, nil
+}
+
+// StateOverridesToStateChanges returns the state changes applying the
+// overrides, grouped by store in order of first appearance.
+func StateOverridesToStateChanges(overrides []*StateOverride) []corestore.StateChanges {
+	var changes []corestore.StateChanges
+	indexes := make(map[string]int)
+	for _, o := range overrides {
+		i, ok := indexes[o.StoreKey]
+		if !ok {
+			i = len(changes)
+			indexes[o.StoreKey] = i
+			changes = append(changes, corestore.StateChanges{Actor: []byte(o.StoreKey)})
+		}
+		changes[i].StateChanges = append(changes[i].StateChanges, corestore.KVPair{
+			Key:    o.Key,
+			Value:  o.Value,
+			Remove: o.Delete,
+		})
+	}
+
+	return changes
+}
@@ -0,0 +1,34 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestStateOverrides(t *testing.T) {
+	prefix := collections.NewPrefix(2)
+	balance, err := tx.NewStateOverride("bank", prefix, collections.StringKey, collections.Uint64Value, "alice", 10)
+	require.NoError(t, err)
+	require.Equal(t, append(prefix.Bytes(), "alice"...), balance.Key)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 10}, balance.Value)
+
+	params, err := tx.NewItemStateOverride("auth", collections.NewPrefix(0), collections.StringValue, "params")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, params.Key)
+
+	deleted := &tx.StateOverride{StoreKey: "bank", Key: []byte("bob"), Delete: true}
+
+	require.Equal(t, []corestore.StateChanges{
+		{Actor: []byte("bank"), StateChanges: []corestore.KVPair{
+			{Key: balance.Key, Value: balance.Value},
+			{Key: []byte("bob"), Remove: true},
+		}},
+		{Actor: []byte("auth"), StateChanges: []corestore.KVPair{{Key: []byte{0}, Value: []byte("params")}}},
+	}, tx.StateOverridesToStateChanges([]*tx.StateOverride{balance, params, deleted}))
+}
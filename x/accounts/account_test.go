@@ -10,6 +10,8 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/accounts/accountstd"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	payments_v1 "cosmossdk.io/x/accounts/interfaces/payments/v1"
 	"cosmossdk.io/x/accounts/internal/implementation"
 	banktypes "cosmossdk.io/x/bank/types"
 
@@ -114,3 +116,152 @@ func (t TestAccount) RegisterQueryHandlers(builder *implementation.QueryBuilder)
 		return &types.UInt64Value{Value: v}, nil
 	})
 }
+
+var _ implementation.MigratableAccount = (*TestAccountV2)(nil)
+
+func NewTestAccountV2(d accountstd.Dependencies) (*TestAccountV2, error) {
+	return &TestAccountV2{
+		TestAccount: TestAccount{
+			Counter: collections.NewSequence(d.SchemaBuilder, collections.NewPrefix(0), "counter"),
+		},
+		Migrations: collections.NewSequence(d.SchemaBuilder, collections.NewPrefix(1), "migrations"),
+	}, nil
+}
+
+// TestAccountV2 is a new version of TestAccount, the counter state is kept and
+// the number of migrations is tracked.
+type TestAccountV2 struct {
+	TestAccount
+	Migrations collections.Sequence
+}
+
+func (t TestAccountV2) RegisterMigrationHandlers(builder *implementation.MigrationBuilder) {
+	implementation.RegisterMigrationHandler(builder, "test", func(ctx context.Context, req *types.UInt64Value) (*types.UInt64Value, error) {
+		// only the sender of the tests or governance can migrate the account
+		if string(implementation.Sender(ctx)) != "sender" && !accountstd.SenderIsAccountsModule(ctx) {
+			return nil, fmt.Errorf("unauthorized migration by %s", implementation.Sender(ctx))
+		}
+		counter, err := t.Counter.Peek(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.Counter.Set(ctx, counter+req.Value); err != nil {
+			return nil, err
+		}
+		migrations, err := t.Migrations.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &types.UInt64Value{Value: migrations + 1}, nil
+	})
+}
+
+var _ implementation.MigratableAccount = (*TestIncompatibleAccount)(nil)
+
+func NewTestIncompatibleAccount(d accountstd.Dependencies) (*TestIncompatibleAccount, error) {
+	return &TestIncompatibleAccount{
+		Counter: collections.NewItem(d.SchemaBuilder, collections.NewPrefix(0), "counter", collections.StringValue),
+	}, nil
+}
+
+// TestIncompatibleAccount changes the value type of the TestAccount counter,
+// hence TestAccount accounts cannot be migrated to it.
+type TestIncompatibleAccount struct {
+	TestAccount
+	Counter collections.Item[string]
+}
+
+func (t TestIncompatibleAccount) RegisterMigrationHandlers(builder *implementation.MigrationBuilder) {
+	implementation.RegisterMigrationHandler(builder, "test", func(ctx context.Context, _ *types.Empty) (*types.Empty, error) {
+		return &types.Empty{}, nil
+	})
+}
+
+var _ implementation.MigratableAccount = (*TestIncompatibleKeyAccount)(nil)
+
+func NewTestIncompatibleKeyAccount(d accountstd.Dependencies) (*TestIncompatibleKeyAccount, error) {
+	return &TestIncompatibleKeyAccount{
+		Counter: collections.NewMap(d.SchemaBuilder, collections.NewPrefix(0), "counter", collections.StringKey, collections.Uint64Value),
+	}, nil
+}
+
+// TestIncompatibleKeyAccount keeps the value type of the TestAccount counter
+// but keys it, hence TestAccount accounts cannot be migrated to it.
+type TestIncompatibleKeyAccount struct {
+	TestAccount
+	Counter collections.Map[string, uint64]
+}
+
+func (t TestIncompatibleKeyAccount) RegisterMigrationHandlers(builder *implementation.MigrationBuilder) {
+	implementation.RegisterMigrationHandler(builder, "test", func(ctx context.Context, _ *types.Empty) (*types.Empty, error) {
+		return &types.Empty{}, nil
+	})
+}
+
+var _ implementation.Account = (*TestPaymentsAccount)(nil)
+
+func NewTestPaymentsAccount(d accountstd.Dependencies) (*TestPaymentsAccount, error) {
+	return &TestPaymentsAccount{
+		Claims: collections.NewSequence(d.SchemaBuilder, collections.NewPrefix(0), "claims"),
+	}, nil
+}
+
+// TestPaymentsAccount pays 10 atoms to the merchants claiming all the coins due,
+// and tracks the number of claims.
+type TestPaymentsAccount struct {
+	TestAccount
+	Claims collections.Sequence
+}
+
+func (t TestPaymentsAccount) RegisterExecuteHandlers(builder *implementation.ExecuteBuilder) {
+	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, req *payments_v1.MsgClaimPayments) (*payments_v1.MsgClaimPaymentsResponse, error) {
+		if !accountstd.SenderIsAccountsModule(ctx) {
+			return nil, fmt.Errorf("unauthorized claim by %s", implementation.Sender(ctx))
+		}
+		amount := req.Amount
+		switch {
+		case req.Merchant == "greedy":
+			amount = amount.Add(sdk.NewInt64Coin("atom", 1))
+		case amount.IsZero():
+			amount = sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+		}
+		if _, err := t.Claims.Next(ctx); err != nil {
+			return nil, err
+		}
+		return &payments_v1.MsgClaimPaymentsResponse{Amount: amount}, nil
+	})
+}
+
+var _ implementation.Account = (*TestPaymasterAccount)(nil)
+
+func NewTestPaymasterAccount(d accountstd.Dependencies) (*TestPaymasterAccount, error) {
+	return &TestPaymasterAccount{
+		Sponsored: collections.NewSequence(d.SchemaBuilder, collections.NewPrefix(0), "sponsored"),
+	}, nil
+}
+
+// TestPaymasterAccount sponsors the fees of the fee payers "sponsored" and
+// "insolvent", up to 100 atoms, in exchange for a payment of 1 usdc, and tracks
+// the number of sponsored txs.
+type TestPaymasterAccount struct {
+	TestAccount
+	Sponsored collections.Sequence
+}
+
+func (t TestPaymasterAccount) RegisterExecuteHandlers(builder *implementation.ExecuteBuilder) {
+	implementation.RegisterExecuteHandler(builder, func(ctx context.Context, req *aa_interface_v1.MsgSponsorFees) (*aa_interface_v1.MsgSponsorFeesResponse, error) {
+		if !accountstd.SenderIsAccountsModule(ctx) {
+			return nil, fmt.Errorf("unauthorized sponsor request by %s", implementation.Sender(ctx))
+		}
+		if req.FeePayer != "sponsored" && req.FeePayer != "insolvent" {
+			return nil, fmt.Errorf("fee payer %s is not sponsored", req.FeePayer)
+		}
+		if !sdk.NewCoins(sdk.NewInt64Coin("atom", 100)).IsAllGTE(req.Fee) {
+			return nil, fmt.Errorf("fee %s exceeds the sponsored fee", req.Fee)
+		}
+		if _, err := t.Sponsored.Next(ctx); err != nil {
+			return nil, err
+		}
+		return &aa_interface_v1.MsgSponsorFeesResponse{Payment: sdk.NewCoins(sdk.NewInt64Coin("usdc", 1))}, nil
+	})
+}
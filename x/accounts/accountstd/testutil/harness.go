@@ -0,0 +1,437 @@
+// Package testutil provides a harness to test account implementations of
+// x/accounts without the x/accounts module or an app: the account is
+// instantiated with in-memory state, and the bank and staking messages and
+// queries it sends are routed to mocked modules.
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/x/accounts/accountstd"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var accountsModuleAddress = sdkaddress.Module("accounts")
+
+type (
+	// MsgHandler handles a message sent to a module by the account.
+	MsgHandler = implementation.ModuleExecFunc
+	// QueryHandler handles a query sent to a module by the account.
+	QueryHandler = implementation.ModuleQueryFunc
+)
+
+// SentMsg is a message successfully handled by a module on behalf of the account.
+type SentMsg struct {
+	Sender []byte
+	Msg    transaction.Msg
+}
+
+// Option configures a Harness.
+type Option func(*Harness)
+
+// WithAddressCodec sets the address codec of the account and of the mocked
+// modules, defaults to the bech32 codec with the cosmos prefix.
+func WithAddressCodec(addressCodec address.Codec) Option {
+	return func(h *Harness) { h.addressCodec = addressCodec }
+}
+
+// WithAccount sets the address and the number of the account, the address
+// defaults to an address derived from the account number.
+func WithAccount(addr []byte, accNum uint64) Option {
+	return func(h *Harness) { h.accountAddr, h.accountNumber = addr, accNum }
+}
+
+// WithHeaderInfo sets the initial block header.
+func WithHeaderInfo(info header.Info) Option {
+	return func(h *Harness) { h.headerInfo = info }
+}
+
+// WithStakingParams sets the bond denom and the unbonding time of the mocked
+// staking module, which default to stake and no unbonding time.
+func WithStakingParams(bondDenom string, unbondingTime time.Duration) Option {
+	return func(h *Harness) { h.bondDenom, h.unbondingTime = bondDenom, unbondingTime }
+}
+
+// Harness instantiates an account implementation with in-memory state and
+// runs its init, execute and query handlers as the x/accounts module would.
+//
+// The messages and queries the account sends to modules are routed to the
+// handlers registered with HandleModuleMsg and HandleModuleQuery, which
+// default to mocked bank and staking modules: the bank module keeps balances
+// (SetBalance, Balance) and handles MsgSend, the staking module handles
+// MsgDelegate and MsgUndelegate and completes the unbondings once the
+// unbonding time elapsed. As in x/accounts, the sender of a message must be
+// its signer.
+//
+// As for a tx, the state changes, sent messages and events of a failed init or
+// execution are reverted.
+type Harness struct {
+	ctx           context.Context
+	storeService  store.KVStoreService
+	eventService  coretesting.MemEventsService
+	cdc           codec.Codec
+	addressCodec  address.Codec
+	impl          implementation.Implementation
+	accountAddr   []byte
+	accountNumber uint64
+	headerInfo    header.Info
+	initialized   bool
+
+	msgHandlers   map[string]MsgHandler
+	queryHandlers map[string]QueryHandler
+	sentMsgs      []SentMsg
+
+	balances      map[string]sdk.Coins
+	bondDenom     string
+	unbondingTime time.Duration
+	delegations   map[delegationKey]sdk.Coin
+	unbondings    []unbonding
+}
+
+// NewHarness returns a harness for the account created by the given creator,
+// the account is initialized with Init.
+func NewHarness(creator accountstd.AccountCreatorFunc, options ...Option) (*Harness, error) {
+	ctx := coretesting.Context()
+	h := &Harness{
+		ctx:           ctx,
+		storeService:  coretesting.KVStoreService(ctx, "accounts"),
+		eventService:  coretesting.EventsService(ctx, "accounts"),
+		addressCodec:  addresscodec.NewBech32Codec("cosmos"),
+		headerInfo:    header.Info{ChainID: "test-chain", Height: 1, Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		msgHandlers:   map[string]MsgHandler{},
+		queryHandlers: map[string]QueryHandler{},
+		balances:      map[string]sdk.Coins{},
+		bondDenom:     "stake",
+		delegations:   map[delegationKey]sdk.Coin{},
+	}
+	for _, option := range options {
+		option(h)
+	}
+	if h.accountAddr == nil {
+		h.accountAddr = sdkaddress.Derive(accountsModuleAddress, binary.BigEndian.AppendUint64(nil, h.accountNumber))
+	}
+
+	ir, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: gogoproto.HybridResolver,
+		SigningOptions: signing.Options{
+			FileResolver:          gogoproto.HybridResolver,
+			TypeResolver:          protoregistry.GlobalTypes,
+			AddressCodec:          h.addressCodec,
+			ValidatorAddressCodec: addresscodec.NewBech32Codec("cosmosvaloper"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.cdc = codec.NewProtoCodec(ir)
+
+	env := appmodule.Environment{
+		Logger:         coretesting.NewNopLogger(),
+		EventService:   h.eventService,
+		GasService:     gasService{},
+		HeaderService:  headerService{h},
+		KVStoreService: h.storeService,
+	}
+	accounts, err := implementation.MakeAccountsMap(h.cdc, h.addressCodec, env, []implementation.AccountCreatorFunc{creator})
+	if err != nil {
+		return nil, err
+	}
+	for _, impl := range accounts {
+		h.impl = impl
+	}
+
+	h.registerBankHandlers()
+	h.registerStakingHandlers()
+	h.registerAccountsHandlers()
+	return h, nil
+}
+
+// HandleModuleMsg registers the handler of the messages of the given type sent
+// by the account, replacing the previous one.
+func HandleModuleMsg[
+	Req any, ProtoReq implementation.ProtoMsgG[Req], Resp any, ProtoResp implementation.ProtoMsgG[Resp],
+](h *Harness, handler func(ctx context.Context, sender []byte, req ProtoReq) (ProtoResp, error),
+) {
+	h.msgHandlers[implementation.MessageName(ProtoReq(new(Req)))] = func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+		req, ok := msg.(ProtoReq)
+		if !ok {
+			return nil, fmt.Errorf("%w: wanted %T, got %T", accountstd.ErrInvalidType, req, msg)
+		}
+		resp, err := handler(ctx, sender, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// HandleModuleQuery registers the handler of the queries of the given type sent
+// by the account, replacing the previous one.
+func HandleModuleQuery[
+	Req any, ProtoReq implementation.ProtoMsgG[Req], Resp any, ProtoResp implementation.ProtoMsgG[Resp],
+](h *Harness, handler func(ctx context.Context, req ProtoReq) (ProtoResp, error),
+) {
+	h.queryHandlers[implementation.MessageName(ProtoReq(new(Req)))] = func(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+		req, ok := msg.(ProtoReq)
+		if !ok {
+			return nil, fmt.Errorf("%w: wanted %T, got %T", accountstd.ErrInvalidType, req, msg)
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// Address returns the address of the account.
+func (h *Harness) Address() []byte { return h.accountAddr }
+
+// AccountNumber returns the number of the account.
+func (h *Harness) AccountNumber() uint64 { return h.accountNumber }
+
+// AddressCodec returns the address codec of the account.
+func (h *Harness) AddressCodec() address.Codec { return h.addressCodec }
+
+// Init initializes the account with the init message sent by the sender, the
+// funds are transferred from the sender to the account beforehand.
+func (h *Harness) Init(sender []byte, msg transaction.Msg, funds sdk.Coins) (transaction.Msg, error) {
+	if h.initialized {
+		return nil, errors.New("account is already initialized")
+	}
+	resp, err := h.runTx(func() (transaction.Msg, error) {
+		if err := h.sendCoins(sender, h.accountAddr, funds); err != nil {
+			return nil, fmt.Errorf("unable to transfer funds: %w", err)
+		}
+		return h.impl.Init(h.accountContext(sender, funds, false), msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.initialized = true
+	return resp, nil
+}
+
+// Execute executes the message sent by the sender on the account, the funds are
+// transferred from the sender to the account beforehand.
+func (h *Harness) Execute(sender []byte, msg transaction.Msg, funds sdk.Coins) (transaction.Msg, error) {
+	if !h.initialized {
+		return nil, errors.New("account is not initialized")
+	}
+	return h.runTx(func() (transaction.Msg, error) {
+		if err := h.sendCoins(sender, h.accountAddr, funds); err != nil {
+			return nil, fmt.Errorf("unable to transfer coins to account: %w", err)
+		}
+		return h.impl.Execute(h.accountContext(sender, funds, false), msg)
+	})
+}
+
+// Query queries the account.
+func (h *Harness) Query(req transaction.Msg) (transaction.Msg, error) {
+	if !h.initialized {
+		return nil, errors.New("account is not initialized")
+	}
+	return h.impl.Query(h.accountContext(nil, nil, true), req)
+}
+
+// Authenticate asks the account to authenticate the signer at the given index
+// of the tx, as the x/auth ante handler does through x/accounts.
+func (h *Harness) Authenticate(bundler string, rawTx *tx.TxRaw, protoTx *tx.Tx, signerIndex uint32) error {
+	_, err := h.Execute(accountsModuleAddress, &aa_interface_v1.MsgAuthenticate{
+		Bundler:     bundler,
+		RawTx:       rawTx,
+		Tx:          protoTx,
+		SignerIndex: signerIndex,
+	}, nil)
+	return err
+}
+
+// HeaderInfo returns the current block header.
+func (h *Harness) HeaderInfo() header.Info { return h.headerInfo }
+
+// SetHeaderInfo sets the current block header, completing the unbondings which
+// are due.
+func (h *Harness) SetHeaderInfo(info header.Info) error {
+	h.headerInfo = info
+	return h.completeUnbondings()
+}
+
+// AdvanceTime moves to the next block, the given duration later.
+func (h *Harness) AdvanceTime(d time.Duration) error {
+	info := h.headerInfo
+	info.Height++
+	info.Time = info.Time.Add(d)
+	return h.SetHeaderInfo(info)
+}
+
+// SentMsgs returns the messages sent by the account and successfully handled
+// by the modules, since the harness creation or the last ResetRecords.
+func (h *Harness) SentMsgs() []SentMsg { return h.sentMsgs }
+
+// Events returns the events emitted by the account, since the harness creation
+// or the last ResetRecords.
+func (h *Harness) Events() []event.Event { return h.eventService.GetEvents(h.ctx) }
+
+// ProtoEvents returns the typed events emitted by the account, since the
+// harness creation or the last ResetRecords.
+func (h *Harness) ProtoEvents() []transaction.Msg { return h.eventService.GetProtoEvents(h.ctx) }
+
+// ResetRecords clears the recorded messages and events.
+func (h *Harness) ResetRecords() {
+	h.sentMsgs = nil
+	coretesting.EventsService(h.ctx, "accounts") // resets the events
+}
+
+// runTx runs the init or the execution of the account, reverting the state of
+// the account and of the mocked modules, and the records, if it fails.
+func (h *Harness) runTx(run func() (transaction.Msg, error)) (transaction.Msg, error) {
+	restore, err := h.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := run()
+	if err != nil {
+		if restoreErr := restore(); restoreErr != nil {
+			return nil, errors.Join(err, restoreErr)
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// snapshot returns a function restoring the current state of the account and
+// of the mocked modules, and the records.
+func (h *Harness) snapshot() (func() error, error) {
+	kvStore := h.storeService.OpenKVStore(h.ctx)
+	it, err := kvStore.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var state [][2][]byte
+	for ; it.Valid(); it.Next() {
+		state = append(state, [2][]byte{it.Key(), it.Value()})
+	}
+	if err := it.Close(); err != nil {
+		return nil, err
+	}
+
+	balances, delegations, unbondings := maps.Clone(h.balances), maps.Clone(h.delegations), slices.Clone(h.unbondings)
+	sentMsgs, events, protoEvents := h.sentMsgs, h.Events(), h.ProtoEvents()
+
+	return func() error {
+		it, err := kvStore.Iterator(nil, nil)
+		if err != nil {
+			return err
+		}
+		var keys [][]byte
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		if err := it.Close(); err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := kvStore.Delete(key); err != nil {
+				return err
+			}
+		}
+		for _, kv := range state {
+			if err := kvStore.Set(kv[0], kv[1]); err != nil {
+				return err
+			}
+		}
+
+		h.balances, h.delegations, h.unbondings = balances, delegations, unbondings
+		h.sentMsgs = sentMsgs
+		coretesting.EventsService(h.ctx, "accounts") // resets the events
+		em := h.eventService.EventManager(h.ctx)
+		for _, e := range events {
+			attrs, err := e.Attributes()
+			if err != nil {
+				return err
+			}
+			if err := em.EmitKV(e.Type, attrs...); err != nil {
+				return err
+			}
+		}
+		for _, e := range protoEvents {
+			if err := em.Emit(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+func (h *Harness) accountContext(sender []byte, funds sdk.Coins, isQuery bool) context.Context {
+	if !isQuery {
+		return implementation.MakeAccountContext(
+			h.ctx, h.storeService, h.accountNumber, h.accountAddr, sender, funds, h.execModule, h.queryModule,
+		)
+	}
+	return implementation.MakeAccountContext(
+		h.ctx, h.storeService, h.accountNumber, h.accountAddr, nil, nil,
+		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			return nil, errors.New("cannot execute in query context")
+		},
+		h.queryModule,
+	)
+}
+
+func (h *Harness) execModule(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+	wantSenders, _, err := h.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get signers: %w", err)
+	}
+	if len(wantSenders) != 1 {
+		return nil, fmt.Errorf("expected only one signer, got %d", len(wantSenders))
+	}
+	if !bytes.Equal(sender, wantSenders[0]) {
+		return nil, errors.New("unauthorized: sender does not match expected sender")
+	}
+
+	handler, ok := h.msgHandlers[implementation.MessageName(msg)]
+	if !ok {
+		return nil, fmt.Errorf("no handler for message %s", implementation.MessageName(msg))
+	}
+	resp, err := handler(ctx, sender, msg)
+	if err != nil {
+		return nil, err
+	}
+	h.sentMsgs = append(h.sentMsgs, SentMsg{Sender: sender, Msg: msg})
+	return resp, nil
+}
+
+func (h *Harness) queryModule(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+	handler, ok := h.queryHandlers[implementation.MessageName(req)]
+	if !ok {
+		return nil, fmt.Errorf("no handler for query %s", implementation.MessageName(req))
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,184 @@
+package testutil_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
+	"cosmossdk.io/x/accounts/testing/counter"
+	counterv1 "cosmossdk.io/x/accounts/testing/counter/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// forwarder is an account forwarding the bank and staking messages of its
+// owner to the modules, and emitting an event for each forwarded message.
+type forwarder struct {
+	em func(ctx context.Context) event.Manager
+}
+
+func (f forwarder) Init(context.Context, *counterv1.MsgInit) (*counterv1.MsgInitResponse, error) {
+	return &counterv1.MsgInitResponse{}, nil
+}
+
+func (f forwarder) Send(ctx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	if err := f.em(ctx).EmitKV("forward", event.NewAttribute("to", msg.ToAddress)); err != nil {
+		return nil, err
+	}
+	return accountstd.ExecModule[*banktypes.MsgSendResponse](ctx, msg)
+}
+
+func (f forwarder) Undelegate(ctx context.Context, msg *stakingtypes.MsgUndelegate) (*stakingtypes.MsgUndelegateResponse, error) {
+	return accountstd.ExecModule[*stakingtypes.MsgUndelegateResponse](ctx, msg)
+}
+
+func (f forwarder) Delegate(ctx context.Context, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error) {
+	return accountstd.ExecModule[*stakingtypes.MsgDelegateResponse](ctx, msg)
+}
+
+func (f forwarder) Balance(ctx context.Context, req *banktypes.QueryBalanceRequest) (*banktypes.QueryBalanceResponse, error) {
+	return accountstd.QueryModule[*banktypes.QueryBalanceResponse](ctx, req)
+}
+
+func (f forwarder) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, f.Init)
+}
+
+func (f forwarder) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, f.Send)
+	accountstd.RegisterExecuteHandler(builder, f.Delegate)
+	accountstd.RegisterExecuteHandler(builder, f.Undelegate)
+}
+
+func (f forwarder) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, f.Balance)
+}
+
+func newForwarder(t *testing.T, options ...testutil.Option) *testutil.Harness {
+	t.Helper()
+	h, err := testutil.NewHarness(accountstd.AddAccount("forwarder", func(deps accountstd.Dependencies) (forwarder, error) {
+		return forwarder{em: deps.Environment.EventService.EventManager}, nil
+	}), options...)
+	require.NoError(t, err)
+	_, err = h.Init([]byte("creator"), &counterv1.MsgInit{}, nil)
+	require.NoError(t, err)
+	return h
+}
+
+func TestHarness(t *testing.T) {
+	h, err := testutil.NewHarness(accountstd.AddAccount("counter", counter.NewAccount))
+	require.NoError(t, err)
+
+	owner := []byte("owner")
+	_, err = h.Execute(owner, &counterv1.MsgIncreaseCounter{Amount: 1}, nil)
+	require.ErrorContains(t, err, "account is not initialized")
+
+	// the funds are transferred from the sender to the account
+	funds := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	_, err = h.Init(owner, &counterv1.MsgInit{InitialValue: 1}, funds)
+	require.ErrorContains(t, err, "insufficient funds")
+	h.SetBalance(owner, funds)
+	_, err = h.Init(owner, &counterv1.MsgInit{InitialValue: 1}, funds)
+	require.NoError(t, err)
+	require.True(t, h.Balance(owner).IsZero())
+	require.Equal(t, funds, h.Balance(h.Address()))
+
+	_, err = h.Execute([]byte("other"), &counterv1.MsgIncreaseCounter{Amount: 1}, nil)
+	require.ErrorContains(t, err, "sender is not the owner of the account")
+	resp, err := h.Execute(owner, &counterv1.MsgIncreaseCounter{Amount: 2}, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.(*counterv1.MsgIncreaseCounterResponse).NewAmount)
+
+	qResp, err := h.Query(&counterv1.QueryCounterRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), qResp.(*counterv1.QueryCounterResponse).Value)
+
+	// the dependencies are provided by the harness
+	h.SetBalance(owner, funds)
+	resp, err = h.Execute(owner, &counterv1.MsgTestDependencies{}, funds)
+	require.NoError(t, err)
+	deps := resp.(*counterv1.MsgTestDependenciesResponse)
+	require.Equal(t, h.HeaderInfo().ChainID, deps.ChainId)
+	addr, err := h.AddressCodec().BytesToString(h.Address())
+	require.NoError(t, err)
+	require.Equal(t, addr, deps.Address)
+	require.Equal(t, uint64(10), deps.AfterGas-deps.BeforeGas)
+	require.Equal(t, funds, deps.Funds)
+}
+
+func TestHarnessModuleMsgs(t *testing.T) {
+	h := newForwarder(t)
+	self, err := h.AddressCodec().BytesToString(h.Address())
+	require.NoError(t, err)
+	recipient, err := h.AddressCodec().BytesToString([]byte("recipient"))
+	require.NoError(t, err)
+	h.SetBalance(h.Address(), sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+
+	// the sender of a message must be its signer
+	send := &banktypes.MsgSend{FromAddress: recipient, ToAddress: self, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))}
+	_, err = h.Execute(h.Address(), send, nil)
+	require.ErrorContains(t, err, "sender does not match expected sender")
+
+	send = &banktypes.MsgSend{FromAddress: self, ToAddress: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 4))}
+	_, err = h.Execute(h.Address(), send, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 6)), h.Balance(h.Address()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 4)), h.Balance([]byte("recipient")))
+
+	resp, err := h.Query(&banktypes.QueryBalanceRequest{Address: recipient, Denom: "atom"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("atom", 4), *resp.(*banktypes.QueryBalanceResponse).Balance)
+
+	// the sent messages and the events are recorded
+	require.Equal(t, []testutil.SentMsg{{Sender: h.Address(), Msg: send}}, h.SentMsgs())
+	require.Len(t, h.Events(), 1)
+	require.Equal(t, "forward", h.Events()[0].Type)
+	h.ResetRecords()
+	require.Empty(t, h.SentMsgs())
+	require.Empty(t, h.Events())
+
+	// the default handlers can be replaced
+	testutil.HandleModuleMsg(h, func(_ context.Context, _ []byte, _ *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+		return nil, errors.New("send disabled")
+	})
+	_, err = h.Execute(h.Address(), send, nil)
+	require.ErrorContains(t, err, "send disabled")
+	require.Empty(t, h.SentMsgs())
+}
+
+func TestHarnessStaking(t *testing.T) {
+	h := newForwarder(t, testutil.WithStakingParams("stake", time.Hour))
+	self, err := h.AddressCodec().BytesToString(h.Address())
+	require.NoError(t, err)
+	h.SetBalance(h.Address(), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	_, err = h.Execute(h.Address(), &stakingtypes.MsgDelegate{DelegatorAddress: self, ValidatorAddress: "val", Amount: sdk.NewInt64Coin("atom", 6)}, nil)
+	require.ErrorContains(t, err, "invalid coin denomination")
+	_, err = h.Execute(h.Address(), &stakingtypes.MsgDelegate{DelegatorAddress: self, ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 6)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 6), h.Delegation(h.Address(), "val"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), h.Balance(h.Address()))
+
+	resp, err := h.Execute(h.Address(), &stakingtypes.MsgUndelegate{DelegatorAddress: self, ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 6)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, h.HeaderInfo().Time.Add(time.Hour), resp.(*stakingtypes.MsgUndelegateResponse).CompletionTime)
+	require.True(t, h.Delegation(h.Address(), "val").IsNil())
+
+	// the coins are returned once the unbonding time elapsed
+	require.NoError(t, h.AdvanceTime(59*time.Minute))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), h.Balance(h.Address()))
+	require.NoError(t, h.AdvanceTime(time.Minute))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), h.Balance(h.Address()))
+	require.Equal(t, int64(3), h.HeaderInfo().Height)
+}
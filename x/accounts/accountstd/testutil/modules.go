@@ -0,0 +1,169 @@
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BondedPoolAddress is the address holding the coins delegated through the
+// mocked staking module, until their unbonding completes.
+var BondedPoolAddress = sdkaddress.Module("bonded_tokens_pool")
+
+type delegationKey struct {
+	delegator string
+	validator string
+}
+
+type unbonding struct {
+	delegator      []byte
+	amount         sdk.Coin
+	completionTime time.Time
+}
+
+// SetBalance sets the balance of an address in the mocked bank module.
+func (h *Harness) SetBalance(addr []byte, coins sdk.Coins) {
+	h.balances[string(addr)] = coins
+}
+
+// Balance returns the balance of an address in the mocked bank module.
+func (h *Harness) Balance(addr []byte) sdk.Coins {
+	return h.balances[string(addr)]
+}
+
+// Delegation returns the coins delegated by the delegator to the validator in
+// the mocked staking module.
+func (h *Harness) Delegation(delegator []byte, validator string) sdk.Coin {
+	return h.delegations[delegationKey{delegator: string(delegator), validator: validator}]
+}
+
+func (h *Harness) sendCoins(from, to []byte, amt sdk.Coins) error {
+	if amt.IsZero() {
+		return nil
+	}
+	balance, hasNeg := h.Balance(from).SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("%s is smaller than %s", h.Balance(from), amt)
+	}
+	h.balances[string(from)] = balance
+	h.balances[string(to)] = h.Balance(to).Add(amt...)
+	return nil
+}
+
+func (h *Harness) registerBankHandlers() {
+	HandleModuleMsg(h, func(_ context.Context, _ []byte, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+		from, err := h.addressCodec.StringToBytes(msg.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+		to, err := h.addressCodec.StringToBytes(msg.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+		return &banktypes.MsgSendResponse{}, h.sendCoins(from, to, msg.Amount)
+	})
+	HandleModuleQuery(h, func(_ context.Context, req *banktypes.QueryBalanceRequest) (*banktypes.QueryBalanceResponse, error) {
+		addr, err := h.addressCodec.StringToBytes(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		balance := sdk.NewCoin(req.Denom, h.Balance(addr).AmountOf(req.Denom))
+		return &banktypes.QueryBalanceResponse{Balance: &balance}, nil
+	})
+	HandleModuleQuery(h, func(_ context.Context, req *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error) {
+		addr, err := h.addressCodec.StringToBytes(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		return &banktypes.QueryAllBalancesResponse{Balances: h.Balance(addr)}, nil
+	})
+}
+
+func (h *Harness) registerStakingHandlers() {
+	HandleModuleMsg(h, func(_ context.Context, _ []byte, msg *stakingtypes.MsgDelegate) (*stakingtypes.MsgDelegateResponse, error) {
+		if msg.Amount.Denom != h.bondDenom {
+			return nil, fmt.Errorf("invalid coin denomination: got %s, expected %s", msg.Amount.Denom, h.bondDenom)
+		}
+		delegator, err := h.addressCodec.StringToBytes(msg.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.sendCoins(delegator, BondedPoolAddress, sdk.NewCoins(msg.Amount)); err != nil {
+			return nil, err
+		}
+
+		key := delegationKey{delegator: string(delegator), validator: msg.ValidatorAddress}
+		delegation, ok := h.delegations[key]
+		if !ok {
+			delegation = sdk.NewInt64Coin(h.bondDenom, 0)
+		}
+		h.delegations[key] = delegation.Add(msg.Amount)
+		return &stakingtypes.MsgDelegateResponse{}, nil
+	})
+	HandleModuleMsg(h, func(_ context.Context, _ []byte, msg *stakingtypes.MsgUndelegate) (*stakingtypes.MsgUndelegateResponse, error) {
+		delegator, err := h.addressCodec.StringToBytes(msg.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		key := delegationKey{delegator: string(delegator), validator: msg.ValidatorAddress}
+		delegation, ok := h.delegations[key]
+		if !ok || msg.Amount.Denom != h.bondDenom || !delegation.IsGTE(msg.Amount) {
+			return nil, fmt.Errorf("cannot undelegate %s, delegation is %s", msg.Amount, delegation)
+		}
+		if delegation = delegation.Sub(msg.Amount); delegation.IsZero() {
+			delete(h.delegations, key)
+		} else {
+			h.delegations[key] = delegation
+		}
+
+		completionTime := h.headerInfo.Time.Add(h.unbondingTime)
+		h.unbondings = append(h.unbondings, unbonding{delegator: delegator, amount: msg.Amount, completionTime: completionTime})
+		if err := h.completeUnbondings(); err != nil {
+			return nil, err
+		}
+		return &stakingtypes.MsgUndelegateResponse{CompletionTime: completionTime, Amount: msg.Amount}, nil
+	})
+	HandleModuleQuery(h, func(_ context.Context, _ *stakingtypes.QueryParamsRequest) (*stakingtypes.QueryParamsResponse, error) {
+		params := stakingtypes.DefaultParams()
+		params.BondDenom, params.UnbondingTime = h.bondDenom, h.unbondingTime
+		return &stakingtypes.QueryParamsResponse{Params: params}, nil
+	})
+}
+
+// completeUnbondings returns the unbonded coins to their delegator once the
+// unbonding time elapsed.
+func (h *Harness) completeUnbondings() error {
+	sort.SliceStable(h.unbondings, func(i, j int) bool {
+		return h.unbondings[i].completionTime.Before(h.unbondings[j].completionTime)
+	})
+	for len(h.unbondings) != 0 && !h.unbondings[0].completionTime.After(h.headerInfo.Time) {
+		if err := h.sendCoins(BondedPoolAddress, h.unbondings[0].delegator, sdk.NewCoins(h.unbondings[0].amount)); err != nil {
+			return err
+		}
+		h.unbondings = h.unbondings[1:]
+	}
+	return nil
+}
+
+func (h *Harness) registerAccountsHandlers() {
+	HandleModuleQuery(h, func(_ context.Context, req *accountsv1.AccountNumberRequest) (*accountsv1.AccountNumberResponse, error) {
+		addr, err := h.addressCodec.StringToBytes(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(addr, h.accountAddr) {
+			return nil, fmt.Errorf("account %s not found", req.Address)
+		}
+		return &accountsv1.AccountNumberResponse{Number: h.accountNumber}, nil
+	})
+}
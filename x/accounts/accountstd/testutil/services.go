@@ -0,0 +1,38 @@
+package testutil
+
+import (
+	"context"
+
+	"cosmossdk.io/core/gas"
+	"cosmossdk.io/core/header"
+)
+
+// headerService returns the current block header of the harness.
+type headerService struct{ h *Harness }
+
+func (s headerService) HeaderInfo(context.Context) header.Info { return s.h.headerInfo }
+
+// gasService returns gas meters without limit.
+type gasService struct{}
+
+func (gasService) GasMeter(context.Context) gas.Meter { return &gasMeter{} }
+
+func (gasService) GasConfig(context.Context) gas.GasConfig { return gas.GasConfig{} }
+
+type gasMeter struct{ consumed gas.Gas }
+
+func (m *gasMeter) Consume(amount gas.Gas, _ string) error {
+	m.consumed += amount
+	return nil
+}
+
+func (m *gasMeter) Refund(amount gas.Gas, _ string) error {
+	m.consumed -= min(amount, m.consumed)
+	return nil
+}
+
+func (m *gasMeter) Remaining() gas.Gas { return gas.NoGasLimit - m.consumed }
+
+func (m *gasMeter) Consumed() gas.Gas { return m.consumed }
+
+func (m *gasMeter) Limit() gas.Gas { return gas.NoGasLimit }
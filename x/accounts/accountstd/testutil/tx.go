@@ -0,0 +1,72 @@
+package testutil
+
+import (
+	"cosmossdk.io/core/transaction"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignDirectTx returns a tx of the given messages signed in SIGN_MODE_DIRECT by
+// the private key with the given sequence, for the account on the chain of the
+// current block header, in its raw and decoded forms.
+func (h *Harness) SignDirectTx(privKey cryptotypes.PrivKey, sequence uint64, msgs ...transaction.Msg) (*tx.TxRaw, *tx.Tx, error) {
+	anyMsgs := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		anyMsgs[i] = anyMsg
+	}
+	pubKey, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protoTx := &tx.Tx{
+		Body: &tx.TxBody{Messages: anyMsgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{{
+				PublicKey: pubKey,
+				ModeInfo: &tx.ModeInfo{
+					Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT}},
+				},
+				Sequence: sequence,
+			}},
+			Fee: &tx.Fee{},
+		},
+	}
+	bodyBytes, err := protoTx.Body.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+	authInfoBytes, err := protoTx.AuthInfo.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signDoc := tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       h.headerInfo.ChainID,
+		AccountNumber: h.accountNumber,
+	}
+	signBytes, err := signDoc.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err := privKey.Sign(signBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protoTx.Signatures = [][]byte{sig}
+	return &tx.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    protoTx.Signatures,
+	}, protoTx, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TxCmd(name string) *cobra.Command {
@@ -24,7 +25,7 @@ func TxCmd(name string) *cobra.Command {
 		RunE:               client.ValidateCmd,
 		DisableFlagParsing: true,
 	}
-	cmd.AddCommand(GetTxInitCmd(), GetExecuteCmd())
+	cmd.AddCommand(GetTxInitCmd(), GetExecuteCmd(), GetMigrateCmd(), GetClaimPaymentsCmd())
 	return cmd
 }
 
@@ -130,6 +131,101 @@ func GetExecuteCmd() *cobra.Command {
 	return cmd
 }
 
+func GetMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate <account-address> <account-type> <json-message>",
+		Short: "Migrate an account to another account type",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			// the migration message is the one of the handler registered by the
+			// new account type for the current account type of the account.
+			queryClient := v1.NewQueryClient(clientCtx)
+			accType, err := queryClient.AccountType(cmd.Context(), &v1.AccountTypeRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			schema, err := queryClient.Schema(cmd.Context(), &v1.SchemaRequest{
+				AccountType: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			var handler *v1.SchemaResponse_Handler
+			for _, migrationHandler := range schema.MigrationHandlers {
+				if migrationHandler.FromAccountType == accType.AccountType {
+					handler = migrationHandler.Handler
+					break
+				}
+			}
+			if handler == nil {
+				return fmt.Errorf("account type %s cannot be migrated to %s", accType.AccountType, args[1])
+			}
+
+			msgBytes, err := encodeJSONToProto(handler.Request, args[2])
+			if err != nil {
+				return err
+			}
+			msg := v1.MsgMigrate{
+				Sender:      sender,
+				Target:      args[0],
+				AccountType: args[1],
+				Message:     msgBytes,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetClaimPaymentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-payments <account-address> [amount]",
+		Short: "Claim a payment authorized by an account for the sender merchant",
+		Long:  "Claim a payment authorized by an account for the sender merchant, all the coins due if no amount is provided.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			var amount sdk.Coins
+			if len(args) == 2 {
+				amount, err = sdk.ParseCoinsNormalized(args[1])
+				if err != nil {
+					return err
+				}
+			}
+			msg := v1.MsgClaimPayments{
+				Sender: sender,
+				Target: args[0],
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetQueryAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "query <account-address> <query-request-type-url> <json-message>",
@@ -56,6 +56,24 @@ func NewAccount(name string, handlerMap *signing.HandlerMap, options ...Option)
 	}
 }
 
+// newExtendedAccount returns the creator of an account type extending the base
+// account, accepting the same options as the base account. The extend function
+// returns the account built on top of the base account.
+func newExtendedAccount(
+	name string,
+	handlerMap *signing.HandlerMap,
+	options []Option,
+	extend func(acc Account, deps accountstd.Dependencies) accountstd.Interface,
+) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, acc, err := NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+		return name, extend(acc.(Account), deps), nil
+	}
+}
+
 // Account implements a base account.
 type Account struct {
 	PubKey     collections.Item[[]byte]
@@ -95,38 +113,49 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
 	}
 
-	pubKey, signerData, err := a.computeSignerData(ctx)
+	pubKey, err := a.loadPubKey(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to compute signer data: %w", err)
+		return nil, fmt.Errorf("unable to load pubkey: %w", err)
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.verifySignature(ctx, msg, pubKey)
+}
+
+// verifySignature verifies the signature of the tx signer with the given pubkey,
+// against the account sequence which is increased.
+func (a Account) verifySignature(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate, pubKey PubKey) error {
+	signerData, err := a.computeSignerData(ctx, pubKey)
+	if err != nil {
+		return fmt.Errorf("unable to compute signer data: %w", err)
 	}
 
 	txData, err := a.getTxData(msg)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get tx data: %w", err)
+		return fmt.Errorf("unable to get tx data: %w", err)
 	}
 
 	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
 	if gotSeq != signerData.Sequence {
-		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+		return fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
 	}
 
 	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+		return fmt.Errorf("unable to parse sign mode: %w", err)
 	}
 
 	signature := msg.Tx.Signatures[msg.SignerIndex]
 
 	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get sign bytes: %w", err)
+		return fmt.Errorf("unable to get sign bytes: %w", err)
 	}
 
 	if !pubKey.VerifySignature(signBytes, signature) {
-		return nil, errors.New("signature verification failed")
+		return errors.New("signature verification failed")
 	}
 
-	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+	return nil
 }
 
 func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
@@ -137,35 +166,30 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 	return signingv1beta1.SignMode(single.Single.Mode), nil
 }
 
-// computeSignerData will populate signer data and also increase the sequence.
-func (a Account) computeSignerData(ctx context.Context) (PubKey, signing.SignerData, error) {
+// computeSignerData will populate signer data for the given pubkey and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, pk PubKey) (signing.SignerData, error) {
 	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 	chainID := a.hs.HeaderInfo(ctx).ChainID
 
 	wantSequence, err := a.Sequence.Next(ctx)
 	if err != nil {
-		return nil, signing.SignerData{}, err
-	}
-
-	pk, err := a.loadPubKey(ctx)
-	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
 	pkAny, err := codectypes.NewAnyWithValue(pk)
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
 	accNum, err := a.getNumber(ctx, addrStr)
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
-	return pk, signing.SignerData{
+	return signing.SignerData{
 		Address:       addrStr,
 		ChainID:       chainID,
 		AccountNumber: accNum,
@@ -235,27 +259,38 @@ func (a Account) loadPubKey(ctx context.Context) (PubKey, error) {
 }
 
 func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+	if _, err := a.decodePubKey(anyPk); err != nil {
+		return err
+	}
+
+	// save into state
+	err := a.PubKey.Set(ctx, anyPk.Value)
+	if err != nil {
+		return fmt.Errorf("unable to save pubkey: %w", err)
+	}
+	return a.PubKeyType.Set(ctx, nameFromTypeURL(anyPk.TypeUrl))
+}
+
+// decodePubKey decodes and validates a pubkey of a supported type.
+func (a Account) decodePubKey(anyPk *codectypes.Any) (PubKey, error) {
+	if anyPk == nil {
+		return nil, errors.New("pubkey cannot be empty")
+	}
 	// check if known
 	name := nameFromTypeURL(anyPk.TypeUrl)
 	impl, exists := a.supportedPubKeys[name]
 	if !exists {
-		return fmt.Errorf("unknown pubkey type %s", name)
+		return nil, fmt.Errorf("unknown pubkey type %s", name)
 	}
 	pk, err := impl.decode(anyPk.Value)
 	if err != nil {
-		return fmt.Errorf("unable to decode pubkey: %w", err)
+		return nil, fmt.Errorf("unable to decode pubkey: %w", err)
 	}
 	err = impl.validate(pk)
 	if err != nil {
-		return fmt.Errorf("unable to validate pubkey: %w", err)
-	}
-
-	// save into state
-	err = a.PubKey.Set(ctx, anyPk.Value)
-	if err != nil {
-		return fmt.Errorf("unable to save pubkey: %w", err)
+		return nil, fmt.Errorf("unable to validate pubkey: %w", err)
 	}
-	return a.PubKeyType.Set(ctx, name)
+	return pk, nil
 }
 
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
@@ -12,30 +12,44 @@ import (
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
 	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 func setupBaseAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	return setupAccount[Account](t, ss, "base", NewAccount)
+}
+
+// setupAccount creates an account of type A, the base account or an account
+// type extending it, with the given constructor.
+func setupAccount[A accountstd.Interface](
+	t *testing.T,
+	ss store.KVStoreService,
+	name string,
+	newAccount func(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc,
+) A {
 	t.Helper()
 	deps := makeMockDependencies(ss)
 	handler := directHandler{}
 
-	createAccFn := NewAccount("base", signing.NewHandlerMap(handler), WithPubKeyWithValidationFunc(func(pt *secp256k1.PubKey) error {
+	createAccFn := newAccount(name, signing.NewHandlerMap(handler), WithPubKeyWithValidationFunc(func(pt *secp256k1.PubKey) error {
 		_, err := dcrd_secp256k1.ParsePubKey(pt.Key)
 		return err
 	}))
 	_, acc, err := createAccFn(deps)
-	baseAcc := acc.(Account)
 	require.NoError(t, err)
 
-	return baseAcc
+	return acc.(A)
 }
 
 func TestInit(t *testing.T) {
@@ -258,6 +272,44 @@ func TestAuthenticate(t *testing.T) {
 	require.Equal(t, errors.New("signature verification failed"), err)
 }
 
+func TestAuthenticateHarness(t *testing.T) {
+	h, err := testutil.NewHarness(NewAccount("base", signing.NewHandlerMap(directHandler{}), WithSecp256K1PubKey()))
+	require.NoError(t, err)
+
+	privKey := secp256k1.GenPrivKey()
+	_, err = h.Init([]byte("creator"), &v1.MsgInit{PubKey: toAnyPb(t, privKey.PubKey())}, nil)
+	require.NoError(t, err)
+
+	from, err := h.AddressCodec().BytesToString(h.Address())
+	require.NoError(t, err)
+	send := &banktypes.MsgSend{FromAddress: from, ToAddress: from, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))}
+
+	rawTx, protoTx, err := h.SignDirectTx(privKey, 0, send)
+	require.NoError(t, err)
+	require.NoError(t, h.Authenticate("bundler", rawTx, protoTx, 0))
+
+	// the sequence was increased
+	require.ErrorContains(t, h.Authenticate("bundler", rawTx, protoTx, 0), "unexpected sequence number")
+
+	// the tx must be signed by the account key
+	rawTx, protoTx, err = h.SignDirectTx(secp256k1.GenPrivKey(), 1, send)
+	require.NoError(t, err)
+	require.ErrorContains(t, h.Authenticate("bundler", rawTx, protoTx, 0), "signature verification failed")
+
+	// on the chain of the block header
+	rawTx, protoTx, err = h.SignDirectTx(privKey, 1, send)
+	require.NoError(t, err)
+	info := h.HeaderInfo()
+	info.ChainID = "other-chain"
+	require.NoError(t, h.SetHeaderInfo(info))
+	require.ErrorContains(t, h.Authenticate("bundler", rawTx, protoTx, 0), "signature verification failed")
+
+	// the failed authentications did not increase the sequence
+	rawTx, protoTx, err = h.SignDirectTx(privKey, 1, send)
+	require.NoError(t, err)
+	require.NoError(t, h.Authenticate("bundler", rawTx, protoTx, 0))
+}
+
 func toAnyPb(t *testing.T, pm gogoproto.Message) *codectypes.Any {
 	t.Helper()
 	if gogoproto.MessageName(pm) == gogoproto.MessageName(&types.Any{}) {
@@ -18,6 +18,26 @@ func ProvideAccount(in Inputs) accountstd.DepinjectAccount {
 	return accountstd.DepinjectAccount{MakeAccount: base.NewAccount("base", in.SignHandlersMap, in.Options...)}
 }
 
+func ProvideSessionKeyAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: base.NewSessionKeyAccount("session-key", in.SignHandlersMap, in.Options...)}
+}
+
+func ProvideRecoveryAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: base.NewRecoveryAccount("recovery", in.SignHandlersMap, in.Options...)}
+}
+
+func ProvideSubscriptionAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: base.NewSubscriptionAccount("subscription", in.SignHandlersMap, in.Options...)}
+}
+
+func ProvidePaymasterAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: base.NewPaymasterAccount("paymaster", in.SignHandlersMap, in.Options...)}
+}
+
+func ProvidePolicyAccount(in Inputs) accountstd.DepinjectAccount {
+	return accountstd.DepinjectAccount{MakeAccount: base.NewPolicyAccount("policy", in.SignHandlersMap, in.Options...)}
+}
+
 func ProvideSecp256K1PubKey() base.Option {
 	return base.WithSecp256K1PubKey()
 }
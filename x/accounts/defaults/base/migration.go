@@ -0,0 +1,29 @@
+package base
+
+import (
+	"fmt"
+
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateLegacyAccount returns the init message of a base account equivalent
+// to an x/auth account, to be used to migrate it to x/accounts. The pubkey and
+// the sequence of the account are preserved, so an account which never signed
+// a tx cannot be migrated.
+func MigrateLegacyAccount(acc sdk.AccountI) (*v1.MsgInit, error) {
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		return nil, fmt.Errorf("account %s has no pubkey", acc.GetAddress())
+	}
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgInit{
+		PubKey:       anyPk,
+		InitSequence: acc.GetSequence(),
+	}, nil
+}
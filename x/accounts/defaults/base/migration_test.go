@@ -0,0 +1,26 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrateLegacyAccount(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(privKey.PubKey().Address()))
+
+	_, err := MigrateLegacyAccount(acc)
+	require.ErrorContains(t, err, "has no pubkey")
+
+	require.NoError(t, acc.SetPubKey(privKey.PubKey()))
+	require.NoError(t, acc.SetSequence(5))
+	msg, err := MigrateLegacyAccount(acc)
+	require.NoError(t, err)
+	require.Equal(t, toAnyPb(t, privKey.PubKey()).Value, msg.PubKey.Value)
+	require.Equal(t, uint64(5), msg.InitSequence)
+}
@@ -0,0 +1,192 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	PaymasterConfigPrefix = collections.NewPrefix(7)
+	PaymasterUsagePrefix  = collections.NewPrefix(8)
+)
+
+// NewPaymasterAccount returns the creator of a paymaster account.
+func NewPaymasterAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return newExtendedAccount(name, handlerMap, options, func(acc Account, deps accountstd.Dependencies) accountstd.Interface {
+		return PaymasterAccount{
+			Account: acc,
+			Config:  collections.NewItem(deps.SchemaBuilder, PaymasterConfigPrefix, "paymaster_config", codec.CollValue[v1.PaymasterConfig](deps.LegacyStateCodec)),
+			Usage:   collections.NewMap(deps.SchemaBuilder, PaymasterUsagePrefix, "paymaster_usage", collections.BytesKey, codec.CollValue[v1.PaymasterUsage](deps.LegacyStateCodec)),
+		}
+	})
+}
+
+// PaymasterAccount implements a base account sponsoring the fees of the txs
+// designating it as fee granter, provided they match its config: the fee payer
+// and the messages of the tx can be allowlisted, the fees sponsored per tx and
+// per fee payer during a quota period can be capped, and the fee payer can be
+// required to pay the paymaster in other tokens.
+type PaymasterAccount struct {
+	Account
+
+	Config collections.Item[v1.PaymasterConfig]
+	// Usage maps the fee payer address bytes to the fees sponsored during their
+	// current quota period.
+	Usage collections.Map[[]byte, v1.PaymasterUsage]
+}
+
+func (a PaymasterAccount) Init(ctx context.Context, msg *v1.MsgInitPaymaster) (*v1.MsgInitPaymasterResponse, error) {
+	if err := a.validateConfig(msg.Config); err != nil {
+		return nil, err
+	}
+	if err := a.Config.Set(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	_, err := a.Account.Init(ctx, &v1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	return &v1.MsgInitPaymasterResponse{}, err
+}
+
+// UpdateConfig changes the sponsored txs, only the account itself can call it.
+func (a PaymasterAccount) UpdateConfig(ctx context.Context, msg *v1.MsgUpdatePaymasterConfig) (*v1.MsgUpdatePaymasterConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := a.validateConfig(msg.Config); err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdatePaymasterConfigResponse{}, a.Config.Set(ctx, msg.Config)
+}
+
+func (a PaymasterAccount) validateConfig(config v1.PaymasterConfig) error {
+	for _, payer := range config.AllowedPayers {
+		if _, err := a.addrCodec.StringToBytes(payer); err != nil {
+			return fmt.Errorf("invalid allowed payer %s: %w", payer, err)
+		}
+	}
+	if err := config.MaxFee.Validate(); err != nil {
+		return fmt.Errorf("invalid max fee: %w", err)
+	}
+	if err := config.PayerQuota.Validate(); err != nil {
+		return fmt.Errorf("invalid payer quota: %w", err)
+	}
+	if config.QuotaPeriod < 0 {
+		return errors.New("quota period must not be negative")
+	}
+	if err := config.TokenPayment.Validate(); err != nil {
+		return fmt.Errorf("invalid token payment: %w", err)
+	}
+	return nil
+}
+
+// SponsorFees implements the paymaster interface, it accepts to sponsor the
+// fees of the tx if the tx matches the config, records the fees sponsored for
+// the fee payer and requests the token payment of the config from it. Only the
+// x/accounts module can call it.
+func (a PaymasterAccount) SponsorFees(ctx context.Context, msg *aa_interface_v1.MsgSponsorFees) (*aa_interface_v1.MsgSponsorFeesResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+	if msg.Tx == nil || msg.Tx.Body == nil {
+		return nil, errors.New("tx cannot be empty")
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.AllowedPayers) != 0 && !slices.Contains(config.AllowedPayers, msg.FeePayer) {
+		return nil, fmt.Errorf("fee payer %s is not sponsored", msg.FeePayer)
+	}
+	if len(config.AllowedMsgs) != 0 {
+		for _, txMsg := range msg.Tx.Body.Messages {
+			if !slices.Contains(config.AllowedMsgs, txMsg.TypeUrl) {
+				return nil, fmt.Errorf("message %s is not sponsored", txMsg.TypeUrl)
+			}
+		}
+	}
+	if !config.MaxFee.IsZero() && !config.MaxFee.IsAllGTE(msg.Fee) {
+		return nil, fmt.Errorf("fee %s exceeds the max sponsored fee %s", msg.Fee, config.MaxFee)
+	}
+	feePayer, err := a.addrCodec.StringToBytes(msg.FeePayer)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := a.usage(ctx, config, feePayer)
+	if err != nil {
+		return nil, err
+	}
+	usage.Sponsored = usage.Sponsored.Add(msg.Fee...)
+	if !config.PayerQuota.IsZero() && !config.PayerQuota.IsAllGTE(usage.Sponsored) {
+		return nil, fmt.Errorf("fee payer %s exceeded its quota of %s", msg.FeePayer, config.PayerQuota)
+	}
+
+	return &aa_interface_v1.MsgSponsorFeesResponse{Payment: config.TokenPayment}, a.Usage.Set(ctx, feePayer, usage)
+}
+
+// usage returns the fees sponsored for the fee payer during its current quota
+// period, a new period starts once the quota period elapsed.
+func (a PaymasterAccount) usage(ctx context.Context, config v1.PaymasterConfig, feePayer []byte) (v1.PaymasterUsage, error) {
+	now := a.hs.HeaderInfo(ctx).Time
+	usage, err := a.Usage.Get(ctx, feePayer)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return v1.PaymasterUsage{PeriodStart: now}, nil
+	case err != nil:
+		return v1.PaymasterUsage{}, err
+	case config.QuotaPeriod > 0 && !now.Before(usage.PeriodStart.Add(config.QuotaPeriod)):
+		return v1.PaymasterUsage{PeriodStart: now}, nil
+	}
+	return usage, nil
+}
+
+func (a PaymasterAccount) QueryPaymasterConfig(ctx context.Context, _ *v1.QueryPaymasterConfig) (*v1.QueryPaymasterConfigResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPaymasterConfigResponse{Config: config}, nil
+}
+
+func (a PaymasterAccount) QueryPaymasterUsage(ctx context.Context, msg *v1.QueryPaymasterUsage) (*v1.QueryPaymasterUsageResponse, error) {
+	feePayer, err := a.addrCodec.StringToBytes(msg.FeePayer)
+	if err != nil {
+		return nil, err
+	}
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := a.usage(ctx, config, feePayer)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPaymasterUsageResponse{Usage: usage}, nil
+}
+
+func (a PaymasterAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a PaymasterAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.SponsorFees) // paymaster
+}
+
+func (a PaymasterAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryPaymasterConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryPaymasterUsage)
+}
@@ -0,0 +1,244 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func newPaymasterTx(t *testing.T, msgs ...gogoproto.Message) *tx.Tx {
+	t.Helper()
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = anyMsg
+	}
+	return &tx.Tx{Body: &tx.TxBody{Messages: anys}}
+}
+
+func TestPaymasterInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[PaymasterAccount](t, ss, "paymaster", NewPaymasterAccount)
+
+	_, err := acc.Init(ctx, &v1.MsgInitPaymaster{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: v1.PaymasterConfig{QuotaPeriod: -time.Hour},
+	})
+	require.ErrorContains(t, err, "quota period must not be negative")
+
+	config := v1.PaymasterConfig{
+		AllowedPayers: []string{"payer"},
+		MaxFee:        sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+	}
+	_, err = acc.Init(ctx, &v1.MsgInitPaymaster{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: config,
+	})
+	require.NoError(t, err)
+
+	res, err := acc.QueryPaymasterConfig(ctx, &v1.QueryPaymasterConfig{})
+	require.NoError(t, err)
+	require.Equal(t, config, res.Config)
+
+	// only the account itself can update the config
+	config.MaxFee = sdk.NewCoins(sdk.NewInt64Coin("atom", 20))
+	_, err = acc.UpdateConfig(ctx, &v1.MsgUpdatePaymasterConfig{Config: config})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.UpdateConfig(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgUpdatePaymasterConfig{Config: config})
+	require.NoError(t, err)
+
+	res, err = acc.QueryPaymasterConfig(ctx, &v1.QueryPaymasterConfig{})
+	require.NoError(t, err)
+	require.Equal(t, config, res.Config)
+}
+
+func TestSponsorFees(t *testing.T) {
+	atoms := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("atom", amount))
+	}
+	send := &banktypes.MsgSend{FromAddress: "payer", ToAddress: "mock_base_account", Amount: sdk.NewCoins(sdk.NewInt64Coin("usdc", 5))}
+
+	testcases := []struct {
+		name   string
+		config v1.PaymasterConfig
+		sender []byte
+		msg    *aa_interface_v1.MsgSponsorFees
+		expErr string
+	}{
+		{
+			"sender is not the accounts module",
+			v1.PaymasterConfig{},
+			[]byte("payer"),
+			&aa_interface_v1.MsgSponsorFees{FeePayer: "payer", Fee: atoms(1), Tx: newPaymasterTx(t, send)},
+			"unauthorized",
+		},
+		{
+			"fee payer not allowed",
+			v1.PaymasterConfig{AllowedPayers: []string{"other"}},
+			address.Module("accounts"),
+			&aa_interface_v1.MsgSponsorFees{FeePayer: "payer", Fee: atoms(1), Tx: newPaymasterTx(t, send)},
+			"fee payer payer is not sponsored",
+		},
+		{
+			"message not allowed",
+			v1.PaymasterConfig{AllowedMsgs: []string{"/cosmos.bank.v1beta1.MsgMultiSend"}},
+			address.Module("accounts"),
+			&aa_interface_v1.MsgSponsorFees{FeePayer: "payer", Fee: atoms(1), Tx: newPaymasterTx(t, send)},
+			"message /cosmos.bank.v1beta1.MsgSend is not sponsored",
+		},
+		{
+			"fee exceeds max fee",
+			v1.PaymasterConfig{MaxFee: atoms(10)},
+			address.Module("accounts"),
+			&aa_interface_v1.MsgSponsorFees{FeePayer: "payer", Fee: atoms(11), Tx: newPaymasterTx(t, send)},
+			"fee 11atom exceeds the max sponsored fee 10atom",
+		},
+		{
+			"quota exceeded",
+			v1.PaymasterConfig{PayerQuota: atoms(10)},
+			address.Module("accounts"),
+			&aa_interface_v1.MsgSponsorFees{FeePayer: "payer", Fee: atoms(11), Tx: newPaymasterTx(t, send)},
+			"fee payer payer exceeded its quota of 10atom",
+		},
+		{
+			"valid",
+			v1.PaymasterConfig{
+				AllowedPayers: []string{"payer"},
+				AllowedMsgs:   []string{"/cosmos.bank.v1beta1.MsgSend"},
+				MaxFee:        atoms(10),
+				PayerQuota:    atoms(10),
+				TokenPayment:  sdk.NewCoins(sdk.NewInt64Coin("usdc", 5)),
+			},
+			address.Module("accounts"),
+			&aa_interface_v1.MsgSponsorFees{FeePayer: "payer", Fee: atoms(10), Tx: newPaymasterTx(t, send)},
+			"",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupAccount[PaymasterAccount](t, ss, "paymaster", NewPaymasterAccount)
+			_, err := acc.Init(ctx, &v1.MsgInitPaymaster{
+				PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+				Config: tc.config,
+			})
+			require.NoError(t, err)
+
+			resp, err := acc.SponsorFees(accountstd.SetSender(ctx, tc.sender), tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.config.TokenPayment, resp.Payment)
+
+			res, err := acc.QueryPaymasterUsage(ctx, &v1.QueryPaymasterUsage{FeePayer: tc.msg.FeePayer})
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Fee, res.Usage.Sponsored)
+		})
+	}
+}
+
+func TestSponsorFeesQuotaPeriod(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[PaymasterAccount](t, ss, "paymaster", NewPaymasterAccount)
+	_, err := acc.Init(ctx, &v1.MsgInitPaymaster{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: v1.PaymasterConfig{
+			PayerQuota:  sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			QuotaPeriod: time.Hour,
+		},
+	})
+	require.NoError(t, err)
+
+	now := headerService{}.HeaderInfo(ctx).Time
+	moduleCtx := accountstd.SetSender(ctx, address.Module("accounts"))
+	sponsor := func(d time.Duration, amount int64) error {
+		_, err := acc.SponsorFees(context.WithValue(moduleCtx, headerTimeKey{}, now.Add(d)), &aa_interface_v1.MsgSponsorFees{
+			FeePayer: "payer",
+			Fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", amount)),
+			Tx:       newPaymasterTx(t),
+		})
+		return err
+	}
+
+	require.NoError(t, sponsor(0, 6))
+	require.NoError(t, sponsor(30*time.Minute, 4))
+	require.ErrorContains(t, sponsor(59*time.Minute, 1), "exceeded its quota")
+
+	// the quota is renewed once the period elapsed
+	require.NoError(t, sponsor(time.Hour, 10))
+
+	res, err := acc.QueryPaymasterUsage(context.WithValue(ctx, headerTimeKey{}, now.Add(time.Hour)), &v1.QueryPaymasterUsage{FeePayer: "payer"})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour), res.Usage.PeriodStart)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), res.Usage.Sponsored)
+
+	// a fee payer has its own quota
+	_, err = acc.SponsorFees(moduleCtx, &aa_interface_v1.MsgSponsorFees{
+		FeePayer: "other",
+		Fee:      sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+		Tx:       newPaymasterTx(t),
+	})
+	require.NoError(t, err)
+}
+
+func TestPaymasterHarness(t *testing.T) {
+	h, err := testutil.NewHarness(NewPaymasterAccount("paymaster", signing.NewHandlerMap(directHandler{}), WithSecp256K1PubKey()))
+	require.NoError(t, err)
+
+	payer, err := h.AddressCodec().BytesToString(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, err)
+	config := v1.PaymasterConfig{
+		PayerQuota:   sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		QuotaPeriod:  time.Hour,
+		TokenPayment: sdk.NewCoins(sdk.NewInt64Coin("usdc", 1)),
+	}
+	_, err = h.Init([]byte("creator"), &v1.MsgInitPaymaster{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()), Config: config}, nil)
+	require.NoError(t, err)
+
+	sponsor := &aa_interface_v1.MsgSponsorFees{
+		FeePayer: payer,
+		Fee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 6)),
+		Tx:       newPaymasterTx(t, &banktypes.MsgSend{FromAddress: payer, ToAddress: payer}),
+	}
+	usage := func() sdk.Coins {
+		res, err := h.Query(&v1.QueryPaymasterUsage{FeePayer: payer})
+		require.NoError(t, err)
+		return res.(*v1.QueryPaymasterUsageResponse).Usage.Sponsored
+	}
+
+	_, err = h.Execute([]byte("creator"), sponsor, nil)
+	require.ErrorContains(t, err, "unauthorized")
+	res, err := h.Execute(address.Module("accounts"), sponsor, nil)
+	require.NoError(t, err)
+	require.Equal(t, config.TokenPayment, res.(*aa_interface_v1.MsgSponsorFeesResponse).Payment)
+
+	// the rejected fees are not accounted in the quota
+	_, err = h.Execute(address.Module("accounts"), sponsor, nil)
+	require.ErrorContains(t, err, "exceeded its quota")
+	require.Equal(t, sponsor.Fee, usage())
+
+	// the quota is renewed after the quota period
+	require.NoError(t, h.AdvanceTime(time.Hour))
+	require.True(t, usage().IsZero())
+	_, err = h.Execute(address.Module("accounts"), sponsor, nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,435 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	PolicyPrefix        = collections.NewPrefix(9)
+	PendingPolicyPrefix = collections.NewPrefix(10)
+	SpendWindowPrefix   = collections.NewPrefix(11)
+)
+
+// NewPolicyAccount returns the creator of a policy account.
+func NewPolicyAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return newExtendedAccount(name, handlerMap, options, func(acc Account, deps accountstd.Dependencies) accountstd.Interface {
+		return PolicyAccount{
+			Account:       acc,
+			Policy:        collections.NewItem(deps.SchemaBuilder, PolicyPrefix, "policy", codec.CollValue[v1.Policy](deps.LegacyStateCodec)),
+			PendingPolicy: collections.NewItem(deps.SchemaBuilder, PendingPolicyPrefix, "pending_policy", codec.CollValue[v1.PendingPolicy](deps.LegacyStateCodec)),
+			SpendWindow:   collections.NewItem(deps.SchemaBuilder, SpendWindowPrefix, "spend_window", codec.CollValue[v1.SpendWindow](deps.LegacyStateCodec)),
+			eventService:  deps.Environment.EventService,
+		}
+	})
+}
+
+// PolicyAccount implements a base account whose txs are constrained by a
+// policy: a daily spend limit, an allowlist of recipients and an allowlist of
+// messages. The policy can only be relaxed once its update delay elapsed, which
+// bounds the damage done with a compromised key.
+//
+// Like for the session keys, the spend limit and the recipients account for the
+// coins sent by the bank MsgSend and MsgMultiSend messages and the funds of the
+// x/accounts messages. Without allowed messages, a policy with a spend limit or
+// recipients only allows the bank messages, as an executed account can move more
+// coins than its funds. The other messages moving funds must not be listed in the
+// allowed messages. The messages executed on the account itself, which manage the
+// policy, are always allowed.
+//
+// The base accounts can opt in by migrating to a policy account.
+type PolicyAccount struct {
+	Account
+
+	Policy        collections.Item[v1.Policy]
+	PendingPolicy collections.Item[v1.PendingPolicy]
+	SpendWindow   collections.Item[v1.SpendWindow]
+
+	eventService event.Service
+}
+
+func (a PolicyAccount) Init(ctx context.Context, msg *v1.MsgInitPolicyAccount) (*v1.MsgInitPolicyAccountResponse, error) {
+	if err := a.validatePolicy(msg.Policy); err != nil {
+		return nil, err
+	}
+	if err := a.Policy.Set(ctx, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	_, err := a.Account.Init(ctx, &v1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	return &v1.MsgInitPolicyAccountResponse{}, err
+}
+
+// MigrateFromBase migrates a base account to a policy account enforcing the
+// given policy, only the account itself can call it.
+func (a PolicyAccount) MigrateFromBase(ctx context.Context, msg *v1.MsgMigrateToPolicyAccount) (*v1.MsgMigrateToPolicyAccountResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := a.validatePolicy(msg.Policy); err != nil {
+		return nil, err
+	}
+	return &v1.MsgMigrateToPolicyAccountResponse{}, a.Policy.Set(ctx, msg.Policy)
+}
+
+// UpdatePolicy updates the policy, only the account itself can call it. A policy
+// at least as strict as the one in effect takes effect immediately, otherwise the
+// update is scheduled after the update delay of the policy in effect.
+func (a PolicyAccount) UpdatePolicy(ctx context.Context, msg *v1.MsgUpdatePolicy) (*v1.MsgUpdatePolicyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := a.validatePolicy(msg.Policy); err != nil {
+		return nil, err
+	}
+	policy, err := a.applyPendingPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := a.hs.HeaderInfo(ctx).Time
+	if policy.UpdateDelay == 0 || isStricter(msg.Policy, policy) {
+		if err := a.PendingPolicy.Remove(ctx); err != nil {
+			return nil, err
+		}
+		return &v1.MsgUpdatePolicyResponse{EffectiveTime: now}, a.Policy.Set(ctx, msg.Policy)
+	}
+
+	effectiveTime := now.Add(policy.UpdateDelay)
+	if err := a.PendingPolicy.Set(ctx, v1.PendingPolicy{Policy: msg.Policy, EffectiveTime: effectiveTime}); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("policy_update_scheduled",
+		event.NewAttribute("effective_time", effectiveTime.Format(time.RFC3339)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdatePolicyResponse{EffectiveTime: effectiveTime}, nil
+}
+
+// CancelPolicyUpdate cancels the pending policy update, only the account itself
+// can call it.
+func (a PolicyAccount) CancelPolicyUpdate(ctx context.Context, _ *v1.MsgCancelPolicyUpdate) (*v1.MsgCancelPolicyUpdateResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if _, err := a.applyPendingPolicy(ctx); err != nil {
+		return nil, err
+	}
+	has, err := a.PendingPolicy.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("no pending policy update")
+	}
+	if err := a.PendingPolicy.Remove(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("policy_update_cancelled"); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelPolicyUpdateResponse{}, nil
+}
+
+// Authenticate implements the authentication flow of an abstracted policy
+// account, the tx must be signed by the owner and comply with the policy.
+func (a PolicyAccount) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	policy, err := a.applyPendingPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	window, err := a.spendWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	spent, err := checkPolicy(self, policy, window.Spent, msg.Tx.Body.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := a.Account.Authenticate(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if spent.Empty() {
+		return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+	}
+	window.Spent = window.Spent.Add(spent...)
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.SpendWindow.Set(ctx, window)
+}
+
+// applyPendingPolicy returns the policy in effect, after replacing it with the
+// pending update once its delay elapsed.
+func (a PolicyAccount) applyPendingPolicy(ctx context.Context) (v1.Policy, error) {
+	pending, err := a.PendingPolicy.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return a.Policy.Get(ctx)
+	case err != nil:
+		return v1.Policy{}, err
+	case a.hs.HeaderInfo(ctx).Time.Before(pending.EffectiveTime):
+		return a.Policy.Get(ctx)
+	}
+
+	if err := a.Policy.Set(ctx, pending.Policy); err != nil {
+		return v1.Policy{}, err
+	}
+	return pending.Policy, a.PendingPolicy.Remove(ctx)
+}
+
+// spendWindow returns the coins spent during the current day, a new window
+// starts every day at midnight UTC.
+func (a PolicyAccount) spendWindow(ctx context.Context) (v1.SpendWindow, error) {
+	today := a.hs.HeaderInfo(ctx).Time.UTC().Truncate(24 * time.Hour)
+	window, err := a.SpendWindow.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return v1.SpendWindow{Start: today}, nil
+	case err != nil:
+		return v1.SpendWindow{}, err
+	case !window.Start.Equal(today):
+		return v1.SpendWindow{Start: today}, nil
+	}
+	return window, nil
+}
+
+func (a PolicyAccount) validatePolicy(policy v1.Policy) error {
+	if err := policy.DailySpendLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid daily spend limit: %w", err)
+	}
+	for _, recipient := range policy.AllowedRecipients {
+		if _, err := a.addrCodec.StringToBytes(recipient); err != nil {
+			return fmt.Errorf("invalid allowed recipient %s: %w", recipient, err)
+		}
+	}
+	if policy.UpdateDelay < 0 {
+		return errors.New("update delay cannot be negative")
+	}
+	return nil
+}
+
+// isStricter reports whether the policy allows at most what the current policy
+// allows, and keeps at least its update delay.
+func isStricter(policy, current v1.Policy) bool {
+	if policy.UpdateDelay < current.UpdateDelay {
+		return false
+	}
+	if len(current.DailySpendLimit) > 0 && (len(policy.DailySpendLimit) == 0 || !policy.DailySpendLimit.IsAllLTE(current.DailySpendLimit)) {
+		return false
+	}
+	if len(current.AllowedRecipients) > 0 && (len(policy.AllowedRecipients) == 0 || !isSubset(policy.AllowedRecipients, current.AllowedRecipients)) {
+		return false
+	}
+
+	switch {
+	case len(policy.AllowedMsgs) > 0:
+		return allowsMsgs(current, policy.AllowedMsgs)
+	case restrictsSpending(policy):
+		return allowsMsgs(current, []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&banktypes.MsgMultiSend{})})
+	default:
+		return len(current.AllowedMsgs) == 0 && !restrictsSpending(current)
+	}
+}
+
+// allowsMsgs reports whether the policy allows the messages of these types.
+func allowsMsgs(policy v1.Policy, typeURLs []string) bool {
+	for _, typeURL := range typeURLs {
+		switch {
+		case len(policy.AllowedMsgs) > 0:
+			if !slices.Contains(policy.AllowedMsgs, typeURL) {
+				return false
+			}
+		case restrictsSpending(policy):
+			if !isSpendAccounted(typeURL) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isSubset reports whether all the elements of s are in set.
+func isSubset(s, set []string) bool {
+	for _, e := range s {
+		if !slices.Contains(set, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// restrictsSpending reports whether the policy restricts the coins sent by the account.
+func restrictsSpending(policy v1.Policy) bool {
+	return len(policy.DailySpendLimit) > 0 || len(policy.AllowedRecipients) > 0
+}
+
+// checkPolicy checks the messages of a tx comply with the policy, given the
+// coins already spent during the day, and returns the coins they spend.
+func checkPolicy(self string, policy v1.Policy, spentToday sdk.Coins, msgs []*codectypes.Any) (sdk.Coins, error) {
+	spent := sdk.NewCoins()
+	for _, msg := range msgs {
+		onSelf, err := executesOnSelf(self, msg)
+		if err != nil {
+			return nil, err
+		}
+		if onSelf {
+			continue
+		}
+
+		switch {
+		case len(policy.AllowedMsgs) > 0 && !slices.Contains(policy.AllowedMsgs, msg.TypeUrl):
+			return nil, fmt.Errorf("message %s is not allowed by the policy", msg.TypeUrl)
+		case len(policy.AllowedMsgs) == 0 && restrictsSpending(policy) && !isSpendAccounted(msg.TypeUrl):
+			return nil, fmt.Errorf("message %s is not allowed by the policy, its spending is not accounted by the policy", msg.TypeUrl)
+		}
+
+		coins, err := spentCoins(self, msg)
+		if err != nil {
+			return nil, err
+		}
+		if coins.IsZero() {
+			continue
+		}
+		if len(policy.AllowedRecipients) > 0 {
+			recipients, err := coinRecipients(msg)
+			if err != nil {
+				return nil, err
+			}
+			for _, recipient := range recipients {
+				if !slices.Contains(policy.AllowedRecipients, recipient) {
+					return nil, fmt.Errorf("recipient %s is not allowed by the policy", recipient)
+				}
+			}
+		}
+		spent = spent.Add(coins...)
+	}
+
+	if len(policy.DailySpendLimit) > 0 && !spentToday.Add(spent...).IsAllLTE(policy.DailySpendLimit) {
+		return nil, fmt.Errorf("daily spend limit exceeded: spent %s, limit %s", spentToday.Add(spent...), policy.DailySpendLimit)
+	}
+	return spent, nil
+}
+
+// executesOnSelf reports whether the message is an x/accounts execution on the
+// account itself.
+func executesOnSelf(self string, msg *codectypes.Any) (bool, error) {
+	if msg.TypeUrl != sdk.MsgTypeURL(&accountsv1.MsgExecute{}) {
+		return false, nil
+	}
+	execute := new(accountsv1.MsgExecute)
+	if err := gogoproto.Unmarshal(msg.Value, execute); err != nil {
+		return false, err
+	}
+	return execute.Target == self, nil
+}
+
+// coinRecipients returns the addresses receiving the coins sent with a message.
+func coinRecipients(msg *codectypes.Any) ([]string, error) {
+	switch msg.TypeUrl {
+	case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+		send := new(banktypes.MsgSend)
+		if err := gogoproto.Unmarshal(msg.Value, send); err != nil {
+			return nil, err
+		}
+		return []string{send.ToAddress}, nil
+	case sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):
+		multiSend := new(banktypes.MsgMultiSend)
+		if err := gogoproto.Unmarshal(msg.Value, multiSend); err != nil {
+			return nil, err
+		}
+		recipients := make([]string, len(multiSend.Outputs))
+		for i, output := range multiSend.Outputs {
+			recipients[i] = output.Address
+		}
+		return recipients, nil
+	case sdk.MsgTypeURL(&accountsv1.MsgExecute{}):
+		execute := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(msg.Value, execute); err != nil {
+			return nil, err
+		}
+		return []string{execute.Target}, nil
+	case sdk.MsgTypeURL(&accountsv1.MsgInit{}):
+		// the address of the new account is not known before its creation.
+		return nil, errors.New("the policy does not allow funding new accounts")
+	}
+	return nil, nil
+}
+
+func (a PolicyAccount) QueryPolicy(ctx context.Context, _ *v1.QueryPolicy) (*v1.QueryPolicyResponse, error) {
+	policy, err := a.Policy.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending *v1.PendingPolicy
+	pendingPolicy, err := a.PendingPolicy.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return nil, err
+	case a.hs.HeaderInfo(ctx).Time.Before(pendingPolicy.EffectiveTime):
+		pending = &pendingPolicy
+	default:
+		policy = pendingPolicy.Policy
+	}
+
+	window, err := a.spendWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPolicyResponse{Policy: policy, Pending: pending, SpentToday: window.Spent}, nil
+}
+
+func (a PolicyAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a PolicyAccount) RegisterMigrationHandlers(builder *accountstd.MigrationBuilder) {
+	// the account type of the base accounts registered by the depinject module
+	accountstd.RegisterMigrationHandler(builder, "base", a.MigrateFromBase)
+}
+
+func (a PolicyAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.UpdatePolicy)
+	accountstd.RegisterExecuteHandler(builder, a.CancelPolicyUpdate)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a PolicyAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryPolicy)
+}
@@ -0,0 +1,295 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestPolicyAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[PolicyAccount](t, ss, "policy", NewPolicyAccount)
+	owner := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInitPolicyAccount{
+		PubKey: toAnyPb(t, owner.PubKey()),
+		Policy: v1.Policy{
+			DailySpendLimit:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			AllowedRecipients: []string{"recipient", "other"},
+			AllowedMsgs:       []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&accountsv1.MsgExecute{}), sdk.MsgTypeURL(&accountsv1.MsgInit{})},
+			UpdateDelay:       time.Hour,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	send := func(to string, coins ...sdk.Coin) gogoproto.Message {
+		return &banktypes.MsgSend{FromAddress: "mock_base_account", ToAddress: to, Amount: sdk.NewCoins(coins...)}
+	}
+
+	sequence := uint64(0)
+	testcases := []struct {
+		name   string
+		msgs   []gogoproto.Message
+		expErr string
+	}{
+		{
+			"message not allowed",
+			[]gogoproto.Message{&banktypes.MsgBurn{FromAddress: "mock_base_account"}},
+			"message /cosmos.bank.v1beta1.MsgBurn is not allowed by the policy",
+		},
+		{
+			"recipient not allowed",
+			[]gogoproto.Message{send("attacker", sdk.NewInt64Coin("stake", 1))},
+			"recipient attacker is not allowed by the policy",
+		},
+		{
+			"funding a new account",
+			[]gogoproto.Message{&accountsv1.MsgInit{Sender: "mock_base_account", Funds: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}},
+			"the policy does not allow funding new accounts",
+		},
+		{
+			"denom without limit",
+			[]gogoproto.Message{send("recipient", sdk.NewInt64Coin("atom", 1))},
+			"daily spend limit exceeded",
+		},
+		{
+			"within the policy",
+			[]gogoproto.Message{send("recipient", sdk.NewInt64Coin("stake", 60))},
+			"",
+		},
+		{
+			"execute with funds",
+			[]gogoproto.Message{&accountsv1.MsgExecute{Sender: "mock_base_account", Target: "other", Funds: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))}},
+			"",
+		},
+		{
+			"daily spend limit exceeded",
+			[]gogoproto.Message{send("recipient", sdk.NewInt64Coin("stake", 11))},
+			"daily spend limit exceeded",
+		},
+		{
+			"execute on self",
+			[]gogoproto.Message{&accountsv1.MsgExecute{Sender: "mock_base_account", Target: "mock_base_account"}},
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Authenticate(ctx, signTx(t, owner, sequence, tc.msgs...))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			sequence++
+		})
+	}
+
+	res, err := acc.QueryPolicy(ctx, &v1.QueryPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), res.SpentToday)
+
+	// the spend limit is renewed the next day
+	nextDay := headerService{}.HeaderInfo(ctx).Time.Truncate(24 * time.Hour).Add(24 * time.Hour)
+	ctx = context.WithValue(ctx, headerTimeKey{}, nextDay)
+	res, err = acc.QueryPolicy(ctx, &v1.QueryPolicy{})
+	require.NoError(t, err)
+	require.True(t, res.SpentToday.IsZero())
+	_, err = acc.Authenticate(ctx, signTx(t, owner, sequence, send("recipient", sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, err)
+}
+
+func TestPolicyUpdate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[PolicyAccount](t, ss, "policy", NewPolicyAccount)
+	owner := secp256k1.GenPrivKey()
+	policy := v1.Policy{
+		DailySpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		UpdateDelay:     time.Hour,
+	}
+
+	_, err := acc.Init(ctx, &v1.MsgInitPolicyAccount{
+		PubKey: toAnyPb(t, owner.PubKey()),
+		Policy: v1.Policy{UpdateDelay: -time.Hour},
+	})
+	require.ErrorContains(t, err, "update delay cannot be negative")
+	_, err = acc.Init(ctx, &v1.MsgInitPolicyAccount{PubKey: toAnyPb(t, owner.PubKey()), Policy: policy})
+	require.NoError(t, err)
+
+	now := headerService{}.HeaderInfo(ctx).Time
+	relaxed := v1.Policy{UpdateDelay: time.Minute}
+
+	// only the account itself can update its policy
+	_, err = acc.UpdatePolicy(ctx, &v1.MsgUpdatePolicy{Policy: relaxed})
+	require.ErrorContains(t, err, "unauthorized")
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	_, err = acc.CancelPolicyUpdate(selfCtx, &v1.MsgCancelPolicyUpdate{})
+	require.ErrorContains(t, err, "no pending policy update")
+
+	// the update takes effect after the delay of the policy in effect
+	res, err := acc.UpdatePolicy(selfCtx, &v1.MsgUpdatePolicy{Policy: relaxed})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour), res.EffectiveTime)
+
+	authCtx := accountstd.SetSender(ctx, address.Module("accounts"))
+	send := &banktypes.MsgSend{FromAddress: "mock_base_account", ToAddress: "recipient", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))}
+	_, err = acc.Authenticate(authCtx, signTx(t, owner, 0, send))
+	require.ErrorContains(t, err, "daily spend limit exceeded")
+	// without allowed messages, the messages whose spending is not accounted are
+	// not allowed while spending is restricted
+	_, err = acc.Authenticate(authCtx, signTx(t, owner, 0, &banktypes.MsgBurn{FromAddress: "mock_base_account"}))
+	require.ErrorContains(t, err, "its spending is not accounted by the policy")
+	// an owned account could send the coins of the account, only the funds would be accounted
+	_, err = acc.Authenticate(authCtx, signTx(t, owner, 0, &accountsv1.MsgExecute{Sender: "mock_base_account", Target: "owned"}))
+	require.ErrorContains(t, err, "its spending is not accounted by the policy")
+
+	queryRes, err := acc.QueryPolicy(ctx, &v1.QueryPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, policy, queryRes.Policy)
+	require.Equal(t, &v1.PendingPolicy{Policy: relaxed, EffectiveTime: now.Add(time.Hour)}, queryRes.Pending)
+
+	// a pending update can be cancelled
+	_, err = acc.CancelPolicyUpdate(selfCtx, &v1.MsgCancelPolicyUpdate{})
+	require.NoError(t, err)
+	_, err = acc.UpdatePolicy(selfCtx, &v1.MsgUpdatePolicy{Policy: relaxed})
+	require.NoError(t, err)
+
+	authCtx = context.WithValue(authCtx, headerTimeKey{}, now.Add(time.Hour))
+	queryRes, err = acc.QueryPolicy(authCtx, &v1.QueryPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, relaxed, queryRes.Policy)
+	require.Nil(t, queryRes.Pending)
+	_, err = acc.Authenticate(authCtx, signTx(t, owner, 0, send))
+	require.NoError(t, err)
+	_, err = acc.Authenticate(authCtx, signTx(t, owner, 1, &banktypes.MsgBurn{FromAddress: "mock_base_account"}))
+	require.NoError(t, err)
+	updated, err := acc.Policy.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, relaxed, updated)
+
+	// an elapsed update cannot be cancelled anymore
+	_, err = acc.CancelPolicyUpdate(context.WithValue(selfCtx, headerTimeKey{}, now.Add(time.Hour)), &v1.MsgCancelPolicyUpdate{})
+	require.ErrorContains(t, err, "no pending policy update")
+
+	// a stricter policy takes effect immediately, replacing the pending update
+	laterCtx := context.WithValue(selfCtx, headerTimeKey{}, now.Add(time.Hour))
+	_, err = acc.UpdatePolicy(laterCtx, &v1.MsgUpdatePolicy{Policy: v1.Policy{UpdateDelay: 0}})
+	require.NoError(t, err)
+	stricter := v1.Policy{DailySpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), UpdateDelay: time.Minute}
+	res, err = acc.UpdatePolicy(laterCtx, &v1.MsgUpdatePolicy{Policy: stricter})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Hour), res.EffectiveTime)
+	queryRes, err = acc.QueryPolicy(laterCtx, &v1.QueryPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, stricter, queryRes.Policy)
+	require.Nil(t, queryRes.Pending)
+
+	// raising the limit, allowing other messages or shortening the delay is delayed
+	for _, relaxing := range []v1.Policy{
+		{DailySpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 6)), UpdateDelay: time.Minute},
+		{DailySpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("atom", 1)), UpdateDelay: time.Minute},
+		{DailySpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), AllowedMsgs: []string{sdk.MsgTypeURL(&banktypes.MsgBurn{})}, UpdateDelay: time.Minute},
+		{DailySpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))},
+	} {
+		res, err = acc.UpdatePolicy(laterCtx, &v1.MsgUpdatePolicy{Policy: relaxing})
+		require.NoError(t, err)
+		require.Equal(t, now.Add(time.Hour+time.Minute), res.EffectiveTime)
+	}
+	queryRes, err = acc.QueryPolicy(laterCtx, &v1.QueryPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, stricter, queryRes.Policy)
+}
+
+func TestPolicyMigrateFromBase(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss)
+	owner := secp256k1.GenPrivKey()
+	_, err := baseAcc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, owner.PubKey()), InitSequence: 5})
+	require.NoError(t, err)
+
+	// only the account itself can migrate to a policy account
+	acc := setupAccount[PolicyAccount](t, ss, "policy", NewPolicyAccount)
+	policy := v1.Policy{DailySpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	_, err = acc.MigrateFromBase(ctx, &v1.MsgMigrateToPolicyAccount{Policy: policy})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.MigrateFromBase(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgMigrateToPolicyAccount{Policy: policy})
+	require.NoError(t, err)
+
+	// the pubkey and the sequence of the base account are kept
+	authCtx := accountstd.SetSender(ctx, address.Module("accounts"))
+	send := &banktypes.MsgSend{FromAddress: "mock_base_account", ToAddress: "recipient", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	_, err = acc.Authenticate(authCtx, signTx(t, owner, 5, send))
+	require.NoError(t, err)
+	_, err = acc.Authenticate(authCtx, signTx(t, owner, 6, send))
+	require.ErrorContains(t, err, "daily spend limit exceeded")
+}
+
+func TestPolicyHarness(t *testing.T) {
+	h, err := testutil.NewHarness(NewPolicyAccount("policy", signing.NewHandlerMap(directHandler{}), WithSecp256K1PubKey()))
+	require.NoError(t, err)
+
+	from, err := h.AddressCodec().BytesToString(h.Address())
+	require.NoError(t, err)
+	recipient, err := h.AddressCodec().BytesToString(secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, err)
+
+	owner := secp256k1.GenPrivKey()
+	policy := v1.Policy{
+		DailySpendLimit:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		AllowedRecipients: []string{recipient},
+		UpdateDelay:       time.Hour,
+	}
+	_, err = h.Init([]byte("creator"), &v1.MsgInitPolicyAccount{PubKey: toAnyPb(t, owner.PubKey()), Policy: policy}, nil)
+	require.NoError(t, err)
+
+	sequence := uint64(0)
+	authenticate := func(msgs ...gogoproto.Message) error {
+		rawTx, protoTx, err := h.SignDirectTx(owner, sequence, msgs...)
+		require.NoError(t, err)
+		if err := h.Authenticate("bundler", rawTx, protoTx, 0); err != nil {
+			return err
+		}
+		sequence++
+		return nil
+	}
+	send := func(to string, amount int64) gogoproto.Message {
+		return &banktypes.MsgSend{FromAddress: from, ToAddress: to, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", amount))}
+	}
+
+	require.NoError(t, authenticate(send(recipient, 60)))
+	require.ErrorContains(t, authenticate(send(recipient, 60)), "daily spend limit exceeded")
+	require.ErrorContains(t, authenticate(send(from, 1)), "is not allowed by the policy")
+
+	// relaxing the policy takes effect after the update delay
+	relaxed := policy
+	relaxed.DailySpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	_, err = h.Execute([]byte("creator"), &v1.MsgUpdatePolicy{Policy: relaxed}, nil)
+	require.ErrorContains(t, err, "unauthorized")
+	res, err := h.Execute(h.Address(), &v1.MsgUpdatePolicy{Policy: relaxed}, nil)
+	require.NoError(t, err)
+	require.Equal(t, h.HeaderInfo().Time.Add(time.Hour), res.(*v1.MsgUpdatePolicyResponse).EffectiveTime)
+	require.ErrorContains(t, authenticate(send(recipient, 60)), "daily spend limit exceeded")
+
+	require.NoError(t, h.AdvanceTime(time.Hour))
+	require.NoError(t, authenticate(send(recipient, 60)))
+
+	query, err := h.Query(&v1.QueryPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, relaxed.DailySpendLimit, query.(*v1.QueryPolicyResponse).Policy.DailySpendLimit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 120)), query.(*v1.QueryPolicyResponse).SpentToday)
+}
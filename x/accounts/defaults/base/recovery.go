@@ -0,0 +1,260 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	RecoveryConfigPrefix = collections.NewPrefix(4)
+	RecoveriesPrefix     = collections.NewPrefix(5)
+)
+
+// NewRecoveryAccount returns the creator of a social recovery account.
+func NewRecoveryAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return newExtendedAccount(name, handlerMap, options, func(acc Account, deps accountstd.Dependencies) accountstd.Interface {
+		return RecoveryAccount{
+			Account:      acc,
+			Config:       collections.NewItem(deps.SchemaBuilder, RecoveryConfigPrefix, "recovery_config", codec.CollValue[v1.RecoveryConfig](deps.LegacyStateCodec)),
+			Recoveries:   collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.BytesKey, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			eventService: deps.Environment.EventService,
+		}
+	})
+}
+
+// RecoveryAccount implements a base account whose pubkey can be swapped by a
+// threshold of guardians, e.g. when the owner lost its key. Once the threshold
+// is reached, the owner can cancel the recovery during a delay, after which it
+// can be executed.
+type RecoveryAccount struct {
+	Account
+
+	Config collections.Item[v1.RecoveryConfig]
+	// Recoveries maps the new pubkey bytes of the pending recoveries to their approvals.
+	Recoveries collections.Map[[]byte, v1.Recovery]
+
+	eventService event.Service
+}
+
+func (a RecoveryAccount) Init(ctx context.Context, msg *v1.MsgInitRecovery) (*v1.MsgInitRecoveryResponse, error) {
+	if err := a.validateConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+	if err := a.Config.Set(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+
+	_, err := a.Account.Init(ctx, &v1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence})
+	return &v1.MsgInitRecoveryResponse{}, err
+}
+
+// UpdateConfig changes the guardians and cancels the pending recoveries, only
+// the account itself can call it.
+func (a RecoveryAccount) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateRecoveryConfig) (*v1.MsgUpdateRecoveryConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := a.validateConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+	if err := a.Config.Set(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateRecoveryConfigResponse{}, a.Recoveries.Clear(ctx, nil)
+}
+
+// ApproveRecovery approves the recovery to a new pubkey, only the guardians can
+// call it.
+func (a RecoveryAccount) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	guardian, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(config.Guardians, guardian) {
+		return nil, errors.New("unauthorized: sender is not a guardian")
+	}
+
+	if _, err := a.decodePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+	recovery, err := a.Recoveries.Get(ctx, msg.NewPubKey.Value)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		recovery = v1.Recovery{NewPubKey: msg.NewPubKey}
+	case err != nil:
+		return nil, err
+	case recovery.NewPubKey.TypeUrl != msg.NewPubKey.TypeUrl:
+		return nil, fmt.Errorf("unexpected pubkey type, wanted: %s, got: %s", recovery.NewPubKey.TypeUrl, msg.NewPubKey.TypeUrl)
+	}
+
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, errors.New("recovery already approved by the guardian")
+	}
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	if recovery.ExecutableAfter == nil && uint64(len(recovery.Approvals)) >= config.Threshold {
+		executableAfter := a.hs.HeaderInfo(ctx).Time.Add(config.Delay)
+		recovery.ExecutableAfter = &executableAfter
+	}
+	if err := a.Recoveries.Set(ctx, msg.NewPubKey.Value, recovery); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_approved",
+		event.NewAttribute("new_pub_key", hex.EncodeToString(msg.NewPubKey.Value)),
+		event.NewAttribute("guardian", guardian),
+		event.NewAttribute("approvals", fmt.Sprint(len(recovery.Approvals))),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgApproveRecoveryResponse{Recovery: recovery}, nil
+}
+
+// CancelRecovery vetoes a pending recovery, only the account itself can call it.
+func (a RecoveryAccount) CancelRecovery(ctx context.Context, msg *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if _, err := a.pendingRecovery(ctx, msg.NewPubKey); err != nil {
+		return nil, err
+	}
+	if err := a.Recoveries.Remove(ctx, msg.NewPubKey.Value); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_cancelled",
+		event.NewAttribute("new_pub_key", hex.EncodeToString(msg.NewPubKey.Value)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelRecoveryResponse{}, nil
+}
+
+// ExecuteRecovery swaps the pubkey of the account with the one of a recovery
+// approved by the guardians once its delay elapsed, anyone can call it.
+func (a RecoveryAccount) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	recovery, err := a.pendingRecovery(ctx, msg.NewPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if recovery.ExecutableAfter == nil {
+		return nil, errors.New("recovery threshold not reached")
+	}
+	if a.hs.HeaderInfo(ctx).Time.Before(*recovery.ExecutableAfter) {
+		return nil, fmt.Errorf("recovery cannot be executed before %s", recovery.ExecutableAfter)
+	}
+
+	if err := a.savePubKey(ctx, recovery.NewPubKey); err != nil {
+		return nil, err
+	}
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("new_pub_key", hex.EncodeToString(msg.NewPubKey.Value)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteRecoveryResponse{}, nil
+}
+
+func (a RecoveryAccount) pendingRecovery(ctx context.Context, newPubKey *codectypes.Any) (v1.Recovery, error) {
+	if newPubKey == nil {
+		return v1.Recovery{}, errors.New("pubkey cannot be empty")
+	}
+	recovery, err := a.Recoveries.Get(ctx, newPubKey.Value)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.Recovery{}, errors.New("recovery not found")
+	}
+	return recovery, err
+}
+
+func (a RecoveryAccount) validateConfig(ctx context.Context, config v1.RecoveryConfig) error {
+	if len(config.Guardians) == 0 {
+		return errors.New("guardians must be specified")
+	}
+
+	guardians := map[string]struct{}{} // to check for duplicates
+	for _, guardian := range config.Guardians {
+		if _, ok := guardians[guardian]; ok {
+			return fmt.Errorf("duplicate guardian %s", guardian)
+		}
+		guardians[guardian] = struct{}{}
+
+		addr, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+		}
+		if bytes.Equal(addr, accountstd.Whoami(ctx)) {
+			return errors.New("the account cannot be its own guardian")
+		}
+	}
+
+	if config.Threshold == 0 || config.Threshold > uint64(len(config.Guardians)) {
+		return fmt.Errorf("threshold must be between 1 and the number of guardians, got: %d", config.Threshold)
+	}
+	if config.Delay < 0 {
+		return errors.New("delay cannot be negative")
+	}
+	return nil
+}
+
+func (a RecoveryAccount) QueryRecoveryConfig(ctx context.Context, _ *v1.QueryRecoveryConfig) (*v1.QueryRecoveryConfigResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryRecoveryConfigResponse{Config: config}, nil
+}
+
+func (a RecoveryAccount) QueryPendingRecoveries(ctx context.Context, _ *v1.QueryPendingRecoveries) (*v1.QueryPendingRecoveriesResponse, error) {
+	var recoveries []v1.Recovery
+	err := a.Recoveries.Walk(ctx, nil, func(_ []byte, recovery v1.Recovery) (stop bool, err error) {
+		recoveries = append(recoveries, recovery)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPendingRecoveriesResponse{Recoveries: recoveries}, nil
+}
+
+func (a RecoveryAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a RecoveryAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+}
+
+func (a RecoveryAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecoveryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryPendingRecoveries)
+}
@@ -0,0 +1,228 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRecoveryInit(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[RecoveryAccount](t, ss, "recovery", NewRecoveryAccount)
+	pubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+
+	testcases := []struct {
+		name   string
+		config v1.RecoveryConfig
+		expErr string
+	}{
+		{
+			"no guardians",
+			v1.RecoveryConfig{Threshold: 1},
+			"guardians must be specified",
+		},
+		{
+			"duplicate guardian",
+			v1.RecoveryConfig{Guardians: []string{"alice", "alice"}, Threshold: 1},
+			"duplicate guardian alice",
+		},
+		{
+			"self guardian",
+			v1.RecoveryConfig{Guardians: []string{"alice", "mock_base_account"}, Threshold: 1},
+			"the account cannot be its own guardian",
+		},
+		{
+			"threshold too high",
+			v1.RecoveryConfig{Guardians: []string{"alice", "bob"}, Threshold: 3},
+			"threshold must be between 1 and the number of guardians",
+		},
+		{
+			"zero threshold",
+			v1.RecoveryConfig{Guardians: []string{"alice", "bob"}},
+			"threshold must be between 1 and the number of guardians",
+		},
+		{
+			"negative delay",
+			v1.RecoveryConfig{Guardians: []string{"alice", "bob"}, Threshold: 2, Delay: -time.Hour},
+			"delay cannot be negative",
+		},
+		{
+			"valid config",
+			v1.RecoveryConfig{Guardians: []string{"alice", "bob"}, Threshold: 2, Delay: time.Hour},
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Init(ctx, &v1.MsgInitRecovery{PubKey: pubKey, Config: tc.config})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := acc.QueryRecoveryConfig(ctx, &v1.QueryRecoveryConfig{})
+			require.NoError(t, err)
+			require.Equal(t, tc.config, res.Config)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[RecoveryAccount](t, ss, "recovery", NewRecoveryAccount)
+	_, err := acc.Init(ctx, &v1.MsgInitRecovery{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: v1.RecoveryConfig{Guardians: []string{"alice", "bob", "carol"}, Threshold: 2, Delay: time.Hour},
+	})
+	require.NoError(t, err)
+
+	now := headerService{}.HeaderInfo(ctx).Time
+	newPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+	otherPubKey := toAnyPb(t, secp256k1.GenPrivKey().PubKey())
+	as := func(sender string) context.Context {
+		return accountstd.SetSender(ctx, []byte(sender))
+	}
+
+	// only guardians can approve a recovery, once
+	_, err = acc.ApproveRecovery(as("mallory"), &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "unauthorized: sender is not a guardian")
+	res, err := acc.ApproveRecovery(as("alice"), &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice"}, res.Recovery.Approvals)
+	require.Nil(t, res.Recovery.ExecutableAfter)
+	_, err = acc.ApproveRecovery(as("alice"), &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "recovery already approved by the guardian")
+	_, err = acc.ApproveRecovery(as("carol"), &v1.MsgApproveRecovery{NewPubKey: otherPubKey})
+	require.NoError(t, err)
+
+	// the recovery cannot be executed before the threshold is reached and the delay elapsed
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "recovery threshold not reached")
+	res, err = acc.ApproveRecovery(as("bob"), &v1.MsgApproveRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob"}, res.Recovery.Approvals)
+	require.True(t, now.Add(time.Hour).Equal(*res.Recovery.ExecutableAfter))
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{NewPubKey: newPubKey})
+	require.ErrorContains(t, err, "recovery cannot be executed before")
+
+	pending, err := acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Len(t, pending.Recoveries, 2)
+
+	// the owner can veto a recovery
+	_, err = acc.CancelRecovery(as("alice"), &v1.MsgCancelRecovery{NewPubKey: otherPubKey})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.CancelRecovery(as("mock_base_account"), &v1.MsgCancelRecovery{NewPubKey: otherPubKey})
+	require.NoError(t, err)
+	_, err = acc.CancelRecovery(as("mock_base_account"), &v1.MsgCancelRecovery{NewPubKey: otherPubKey})
+	require.ErrorContains(t, err, "recovery not found")
+
+	// once the delay elapsed, anyone can execute the recovery
+	later := context.WithValue(ctx, headerTimeKey{}, now.Add(time.Hour))
+	_, err = acc.ExecuteRecovery(later, &v1.MsgExecuteRecovery{NewPubKey: newPubKey})
+	require.NoError(t, err)
+
+	pubKey, err := acc.QueryPubKey(ctx, &v1.QueryPubKey{})
+	require.NoError(t, err)
+	require.Equal(t, newPubKey.Value, pubKey.PubKey.Value)
+	pending, err = acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Recoveries)
+}
+
+func TestRecoveryUpdateConfig(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[RecoveryAccount](t, ss, "recovery", NewRecoveryAccount)
+	_, err := acc.Init(ctx, &v1.MsgInitRecovery{
+		PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		Config: v1.RecoveryConfig{Guardians: []string{"alice", "bob"}, Threshold: 1},
+	})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(accountstd.SetSender(ctx, []byte("alice")), &v1.MsgApproveRecovery{
+		NewPubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+	})
+	require.NoError(t, err)
+
+	config := v1.RecoveryConfig{Guardians: []string{"carol"}, Threshold: 1}
+	_, err = acc.UpdateConfig(accountstd.SetSender(ctx, []byte("alice")), &v1.MsgUpdateRecoveryConfig{Config: config})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.UpdateConfig(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgUpdateRecoveryConfig{Config: config})
+	require.NoError(t, err)
+
+	// the pending recoveries are cancelled
+	res, err := acc.QueryRecoveryConfig(ctx, &v1.QueryRecoveryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, config, res.Config)
+	pending, err := acc.QueryPendingRecoveries(ctx, &v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, pending.Recoveries)
+}
+
+func TestRecoveryHarness(t *testing.T) {
+	h, err := testutil.NewHarness(NewRecoveryAccount("recovery", signing.NewHandlerMap(directHandler{}), WithSecp256K1PubKey()))
+	require.NoError(t, err)
+
+	alice, bob := secp256k1.GenPrivKey().PubKey().Address(), secp256k1.GenPrivKey().PubKey().Address()
+	guardians := make([]string, 2)
+	for i, guardian := range [][]byte{alice, bob} {
+		guardians[i], err = h.AddressCodec().BytesToString(guardian)
+		require.NoError(t, err)
+	}
+
+	owner := secp256k1.GenPrivKey()
+	_, err = h.Init([]byte("creator"), &v1.MsgInitRecovery{
+		PubKey: toAnyPb(t, owner.PubKey()),
+		Config: v1.RecoveryConfig{Guardians: guardians, Threshold: 2, Delay: time.Hour},
+	}, nil)
+	require.NoError(t, err)
+
+	// the guardians approve the recovery to a new key
+	newKey := secp256k1.GenPrivKey()
+	approve := &v1.MsgApproveRecovery{NewPubKey: toAnyPb(t, newKey.PubKey())}
+	_, err = h.Execute([]byte("creator"), approve, nil)
+	require.ErrorContains(t, err, "unauthorized: sender is not a guardian")
+	_, err = h.Execute(alice, approve, nil)
+	require.NoError(t, err)
+
+	execute := &v1.MsgExecuteRecovery{NewPubKey: approve.NewPubKey}
+	_, err = h.Execute([]byte("creator"), execute, nil)
+	require.ErrorContains(t, err, "recovery threshold not reached")
+
+	_, err = h.Execute(bob, approve, nil)
+	require.NoError(t, err)
+	_, err = h.Execute([]byte("creator"), execute, nil)
+	require.ErrorContains(t, err, "recovery cannot be executed before")
+
+	// once the delay elapsed, anyone can execute the recovery
+	require.NoError(t, h.AdvanceTime(time.Hour))
+	_, err = h.Execute([]byte("creator"), execute, nil)
+	require.NoError(t, err)
+
+	res, err := h.Query(&v1.QueryPendingRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, res.(*v1.QueryPendingRecoveriesResponse).Recoveries)
+
+	// the new key signs the txs of the account
+	from, err := h.AddressCodec().BytesToString(h.Address())
+	require.NoError(t, err)
+	send := &banktypes.MsgSend{FromAddress: from, ToAddress: from, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}
+	rawTx, protoTx, err := h.SignDirectTx(owner, 0, send)
+	require.NoError(t, err)
+	require.ErrorContains(t, h.Authenticate("bundler", rawTx, protoTx, 0), "signature verification failed")
+	rawTx, protoTx, err = h.SignDirectTx(newKey, 0, send)
+	require.NoError(t, err)
+	require.NoError(t, h.Authenticate("bundler", rawTx, protoTx, 0))
+}
@@ -0,0 +1,272 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var SessionKeysPrefix = collections.NewPrefix(3)
+
+// NewSessionKeyAccount returns the creator of a session-key account.
+func NewSessionKeyAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return newExtendedAccount(name, handlerMap, options, func(acc Account, deps accountstd.Dependencies) accountstd.Interface {
+		return SessionKeyAccount{
+			Account:     acc,
+			SessionKeys: collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+		}
+	})
+}
+
+// SessionKeyAccount implements a base account whose owner can delegate the
+// authentication of txs to short-lived session keys. A session key is scoped
+// to a set of message types and to a spend limit.
+//
+// The spend limit accounts for the coins sent by the bank MsgSend and
+// MsgMultiSend messages and the funds of the x/accounts messages. Without allowed
+// messages, a session key with a spend limit can only send the bank messages, as
+// an executed account can move more coins than its funds. The session keys
+// authenticating other messages moving funds should be scoped with their allowed
+// messages, knowing that only the funds of the x/accounts messages are accounted.
+type SessionKeyAccount struct {
+	Account
+
+	// SessionKeys maps the pubkey bytes of the session keys to their scope.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+}
+
+// AddSessionKey registers a session key, only the account itself can call it.
+func (a SessionKeyAccount) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if _, err := a.decodePubKey(msg.PubKey); err != nil {
+		return nil, err
+	}
+	ownerPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(msg.PubKey.Value, ownerPubKey) {
+		return nil, errors.New("session key cannot be the owner pubkey")
+	}
+	if !msg.Expiration.After(a.hs.HeaderInfo(ctx).Time) {
+		return nil, errors.New("session key expiration must be in the future")
+	}
+	if err := msg.SpendLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid spend limit: %w", err)
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, msg.PubKey.Value, v1.SessionKey{
+		PubKey:      msg.PubKey,
+		Expiration:  msg.Expiration,
+		AllowedMsgs: msg.AllowedMsgs,
+		SpendLimit:  msg.SpendLimit,
+	})
+}
+
+// RevokeSessionKey removes a session key, only the account itself can call it.
+func (a SessionKeyAccount) RevokeSessionKey(ctx context.Context, msg *v1.MsgRevokeSessionKey) (*v1.MsgRevokeSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+	if msg.PubKey == nil {
+		return nil, errors.New("pubkey cannot be empty")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRevokeSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey.Value)
+}
+
+// Authenticate implements the authentication flow of an abstracted session-key
+// account, the signer is either the owner or a session key in its scope.
+func (a SessionKeyAccount) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerPubKey := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].PublicKey
+	ownerPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load pubkey: %w", err)
+	}
+	if signerPubKey == nil || bytes.Equal(signerPubKey.Value, ownerPubKey) {
+		return a.Account.Authenticate(ctx, msg)
+	}
+
+	sessionKey, err := a.SessionKeys.Get(ctx, signerPubKey.Value)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("unknown session key")
+		}
+		return nil, err
+	}
+	if sessionKey.PubKey.TypeUrl != signerPubKey.TypeUrl {
+		return nil, fmt.Errorf("unexpected session key type, wanted: %s, got: %s", sessionKey.PubKey.TypeUrl, signerPubKey.TypeUrl)
+	}
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.Expiration) {
+		return nil, errors.New("session key expired")
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	spent, err := checkSessionScope(self, sessionKey, msg.Tx.Body.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := a.decodePubKey(sessionKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.verifySignature(ctx, msg, pubKey); err != nil {
+		return nil, err
+	}
+
+	if spent.Empty() {
+		return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+	}
+	sessionKey.Spent = sessionKey.Spent.Add(spent...)
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.SessionKeys.Set(ctx, signerPubKey.Value, sessionKey)
+}
+
+// checkSessionScope checks the messages of a tx are in the scope of a session
+// key, and returns the coins they spend. Without allowed messages, a session
+// key with a spend limit can only send the messages accounted by spentCoins.
+func checkSessionScope(self string, sessionKey v1.SessionKey, msgs []*codectypes.Any) (sdk.Coins, error) {
+	spent := sdk.NewCoins()
+	for _, msg := range msgs {
+		switch {
+		case len(sessionKey.AllowedMsgs) > 0 && !slices.Contains(sessionKey.AllowedMsgs, msg.TypeUrl):
+			return nil, fmt.Errorf("message %s is not allowed for the session key", msg.TypeUrl)
+		case len(sessionKey.AllowedMsgs) == 0 && len(sessionKey.SpendLimit) > 0 && !isSpendAccounted(msg.TypeUrl):
+			return nil, fmt.Errorf("message %s is not allowed for the session key, its spending is not accounted by the spend limit", msg.TypeUrl)
+		}
+
+		coins, err := spentCoins(self, msg)
+		if err != nil {
+			return nil, err
+		}
+		spent = spent.Add(coins...)
+	}
+
+	if len(sessionKey.SpendLimit) > 0 && !sessionKey.Spent.Add(spent...).IsAllLTE(sessionKey.SpendLimit) {
+		return nil, fmt.Errorf("spend limit exceeded: spent %s, limit %s", sessionKey.Spent.Add(spent...), sessionKey.SpendLimit)
+	}
+	return spent, nil
+}
+
+// isSpendAccounted reports whether the coins sent by the account with a message
+// of this type are accounted by spentCoins. The x/accounts messages are not, only
+// their funds are accounted while the executed account can move the coins of the
+// account, e.g. an account it owns executing a MsgSend.
+func isSpendAccounted(typeURL string) bool {
+	switch typeURL {
+	case sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):
+		return true
+	}
+	return false
+}
+
+// spentCoins returns the coins sent by the account with a message. The session
+// keys cannot execute messages on the account itself, as they would be able to
+// manage the session keys.
+func spentCoins(self string, msg *codectypes.Any) (sdk.Coins, error) {
+	switch msg.TypeUrl {
+	case sdk.MsgTypeURL(&banktypes.MsgSend{}):
+		send := new(banktypes.MsgSend)
+		if err := gogoproto.Unmarshal(msg.Value, send); err != nil {
+			return nil, err
+		}
+		if send.FromAddress == self {
+			return send.Amount, nil
+		}
+	case sdk.MsgTypeURL(&banktypes.MsgMultiSend{}):
+		multiSend := new(banktypes.MsgMultiSend)
+		if err := gogoproto.Unmarshal(msg.Value, multiSend); err != nil {
+			return nil, err
+		}
+		spent := sdk.NewCoins()
+		for _, input := range multiSend.Inputs {
+			if input.Address == self {
+				spent = spent.Add(input.Coins...)
+			}
+		}
+		return spent, nil
+	case sdk.MsgTypeURL(&accountsv1.MsgExecute{}):
+		execute := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(msg.Value, execute); err != nil {
+			return nil, err
+		}
+		if execute.Target == self {
+			return nil, errors.New("session key cannot execute messages on the account itself")
+		}
+		if execute.Sender == self {
+			return execute.Funds, nil
+		}
+	case sdk.MsgTypeURL(&accountsv1.MsgInit{}):
+		msgInit := new(accountsv1.MsgInit)
+		if err := gogoproto.Unmarshal(msg.Value, msgInit); err != nil {
+			return nil, err
+		}
+		if msgInit.Sender == self {
+			return msgInit.Funds, nil
+		}
+	}
+	return nil, nil
+}
+
+func (a SessionKeyAccount) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	var sessionKeys []v1.SessionKey
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sessionKey v1.SessionKey) (stop bool, err error) {
+		sessionKeys = append(sessionKeys, sessionKey)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (a SessionKeyAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a SessionKeyAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RevokeSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a SessionKeyAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+}
@@ -0,0 +1,344 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestAddAndRevokeSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[SessionKeyAccount](t, ss, "session-key", NewSessionKeyAccount)
+	owner := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, owner.PubKey())})
+	require.NoError(t, err)
+
+	now := headerService{}.HeaderInfo(ctx).Time
+	sessionKey := secp256k1.GenPrivKey()
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+
+	testcases := []struct {
+		name   string
+		ctx    context.Context
+		msg    *v1.MsgAddSessionKey
+		expErr string
+	}{
+		{
+			"sender is not self",
+			accountstd.SetSender(ctx, []byte("sender")),
+			&v1.MsgAddSessionKey{PubKey: toAnyPb(t, sessionKey.PubKey()), Expiration: now.Add(time.Hour)},
+			"unauthorized",
+		},
+		{
+			"invalid pubkey",
+			selfCtx,
+			&v1.MsgAddSessionKey{PubKey: toAnyPb(t, &secp256k1.PubKey{Key: []byte("invalid")}), Expiration: now.Add(time.Hour)},
+			"unable to validate pubkey",
+		},
+		{
+			"owner pubkey",
+			selfCtx,
+			&v1.MsgAddSessionKey{PubKey: toAnyPb(t, owner.PubKey()), Expiration: now.Add(time.Hour)},
+			"session key cannot be the owner pubkey",
+		},
+		{
+			"expired",
+			selfCtx,
+			&v1.MsgAddSessionKey{PubKey: toAnyPb(t, sessionKey.PubKey()), Expiration: now},
+			"session key expiration must be in the future",
+		},
+		{
+			"invalid spend limit",
+			selfCtx,
+			&v1.MsgAddSessionKey{PubKey: toAnyPb(t, sessionKey.PubKey()), Expiration: now.Add(time.Hour), SpendLimit: sdk.Coins{sdk.NewInt64Coin("stake", 0)}},
+			"invalid spend limit",
+		},
+		{
+			"valid session key",
+			selfCtx,
+			&v1.MsgAddSessionKey{
+				PubKey:      toAnyPb(t, sessionKey.PubKey()),
+				Expiration:  now.Add(time.Hour),
+				AllowedMsgs: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+				SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.AddSessionKey(tc.ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, res.SessionKeys, 1)
+	require.Equal(t, toAnyPb(t, sessionKey.PubKey()).Value, res.SessionKeys[0].PubKey.Value)
+	require.True(t, now.Add(time.Hour).Equal(res.SessionKeys[0].Expiration))
+	require.Equal(t, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, res.SessionKeys[0].AllowedMsgs)
+
+	_, err = acc.RevokeSessionKey(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgRevokeSessionKey{PubKey: toAnyPb(t, sessionKey.PubKey())})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.RevokeSessionKey(selfCtx, &v1.MsgRevokeSessionKey{PubKey: toAnyPb(t, sessionKey.PubKey())})
+	require.NoError(t, err)
+	_, err = acc.RevokeSessionKey(selfCtx, &v1.MsgRevokeSessionKey{PubKey: toAnyPb(t, sessionKey.PubKey())})
+	require.ErrorContains(t, err, "session key not found")
+
+	res, err = acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Empty(t, res.SessionKeys)
+}
+
+func TestSessionKeyAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[SessionKeyAccount](t, ss, "session-key", NewSessionKeyAccount)
+	owner := secp256k1.GenPrivKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, owner.PubKey())})
+	require.NoError(t, err)
+
+	now := headerService{}.HeaderInfo(ctx).Time
+	sessionKey := secp256k1.GenPrivKey()
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgAddSessionKey{
+		PubKey:      toAnyPb(t, sessionKey.PubKey()),
+		Expiration:  now.Add(time.Hour),
+		AllowedMsgs: []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&accountsv1.MsgExecute{})},
+		SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	send := func(amount int64) gogoproto.Message {
+		return &banktypes.MsgSend{FromAddress: "mock_base_account", ToAddress: "recipient", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", amount))}
+	}
+
+	sequence := uint64(0)
+	testcases := []struct {
+		name   string
+		key    cryptotypes.PrivKey
+		msgs   []gogoproto.Message
+		expErr string
+	}{
+		{
+			"owner, any message",
+			owner,
+			[]gogoproto.Message{&banktypes.MsgBurn{FromAddress: "mock_base_account"}},
+			"",
+		},
+		{
+			"session key, in scope",
+			sessionKey,
+			[]gogoproto.Message{send(60)},
+			"",
+		},
+		{
+			"session key, message not allowed",
+			sessionKey,
+			[]gogoproto.Message{&banktypes.MsgBurn{FromAddress: "mock_base_account"}},
+			"is not allowed for the session key",
+		},
+		{
+			"session key, spend limit exceeded",
+			sessionKey,
+			[]gogoproto.Message{send(30), send(30)},
+			"spend limit exceeded",
+		},
+		{
+			"session key, execute on self",
+			sessionKey,
+			[]gogoproto.Message{&accountsv1.MsgExecute{Sender: "mock_base_account", Target: "mock_base_account"}},
+			"session key cannot execute messages on the account itself",
+		},
+		{
+			"session key, execute with funds",
+			sessionKey,
+			[]gogoproto.Message{&accountsv1.MsgExecute{Sender: "mock_base_account", Target: "other", Funds: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))}},
+			"",
+		},
+		{
+			"session key, limit reached",
+			sessionKey,
+			[]gogoproto.Message{send(1)},
+			"spend limit exceeded",
+		},
+		{
+			"unknown session key",
+			secp256k1.GenPrivKey(),
+			[]gogoproto.Message{send(0)},
+			"unknown session key",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Authenticate(ctx, signTx(t, tc.key, sequence, tc.msgs...))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			sequence++
+		})
+	}
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), res.SessionKeys[0].Spent)
+
+	// without allowed messages, a session key with a spend limit can only send
+	// the messages whose spending is accounted
+	limitedKey := secp256k1.GenPrivKey()
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte("mock_base_account")), &v1.MsgAddSessionKey{
+		PubKey:     toAnyPb(t, limitedKey.PubKey()),
+		Expiration: now.Add(time.Hour),
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	})
+	require.NoError(t, err)
+	_, err = acc.Authenticate(ctx, signTx(t, limitedKey, sequence, &banktypes.MsgBurn{FromAddress: "mock_base_account"}))
+	require.ErrorContains(t, err, "its spending is not accounted by the spend limit")
+	// an owned account could send the coins of the account, only the funds would be accounted
+	_, err = acc.Authenticate(ctx, signTx(t, limitedKey, sequence, &accountsv1.MsgExecute{Sender: "mock_base_account", Target: "owned"}))
+	require.ErrorContains(t, err, "its spending is not accounted by the spend limit")
+	_, err = acc.Authenticate(ctx, signTx(t, limitedKey, sequence, &accountsv1.MsgInit{Sender: "mock_base_account"}))
+	require.ErrorContains(t, err, "its spending is not accounted by the spend limit")
+	_, err = acc.Authenticate(ctx, signTx(t, limitedKey, sequence, send(100)))
+	require.NoError(t, err)
+	sequence++
+
+	// an expired session key cannot authenticate txs
+	sessionKeyState, err := acc.SessionKeys.Get(ctx, toAnyPb(t, sessionKey.PubKey()).Value)
+	require.NoError(t, err)
+	sessionKeyState.Expiration = now
+	sessionKeyState.SpendLimit = nil
+	require.NoError(t, acc.SessionKeys.Set(ctx, toAnyPb(t, sessionKey.PubKey()).Value, sessionKeyState))
+	_, err = acc.Authenticate(ctx, signTx(t, sessionKey, sequence, send(1)))
+	require.ErrorContains(t, err, "session key expired")
+}
+
+// signTx returns the authentication of a tx with the messages signed by the key.
+func signTx(t *testing.T, key cryptotypes.PrivKey, sequence uint64, msgs ...gogoproto.Message) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+
+	body := &tx.TxBody{}
+	for _, msg := range msgs {
+		body.Messages = append(body.Messages, toAnyPb(t, msg))
+	}
+	transaction := tx.Tx{
+		Body: body,
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: toAnyPb(t, key.PubKey()),
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: sequence,
+				},
+			},
+		},
+	}
+
+	bodyBytes, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	signBytes, err := (&tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}).Marshal()
+	require.NoError(t, err)
+	sig, err := key.Sign(signBytes)
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyBytes,
+			AuthInfoBytes: authInfoBytes,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
+
+func TestSessionKeyHarness(t *testing.T) {
+	h, err := testutil.NewHarness(NewSessionKeyAccount("session-key", signing.NewHandlerMap(directHandler{}), WithSecp256K1PubKey()))
+	require.NoError(t, err)
+
+	owner := secp256k1.GenPrivKey()
+	_, err = h.Init([]byte("creator"), &v1.MsgInit{PubKey: toAnyPb(t, owner.PubKey())}, nil)
+	require.NoError(t, err)
+
+	sessionKey := secp256k1.GenPrivKey()
+	addSessionKey := &v1.MsgAddSessionKey{
+		PubKey:     toAnyPb(t, sessionKey.PubKey()),
+		Expiration: h.HeaderInfo().Time.Add(time.Hour),
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+	_, err = h.Execute([]byte("creator"), addSessionKey, nil)
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = h.Execute(h.Address(), addSessionKey, nil)
+	require.NoError(t, err)
+
+	from, err := h.AddressCodec().BytesToString(h.Address())
+	require.NoError(t, err)
+	send := &banktypes.MsgSend{FromAddress: from, ToAddress: from, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))}
+
+	rawTx, protoTx, err := h.SignDirectTx(sessionKey, 0, send)
+	require.NoError(t, err)
+	require.NoError(t, h.Authenticate("bundler", rawTx, protoTx, 0))
+
+	res, err := h.Query(&v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), res.(*v1.QuerySessionKeysResponse).SessionKeys[0].Spent)
+
+	// the failed authentication does not account the spending
+	rawTx, protoTx, err = h.SignDirectTx(sessionKey, 1, send)
+	require.NoError(t, err)
+	require.ErrorContains(t, h.Authenticate("bundler", rawTx, protoTx, 0), "spend limit exceeded")
+
+	res, err = h.Query(&v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), res.(*v1.QuerySessionKeysResponse).SessionKeys[0].Spent)
+
+	// the session key expires, the owner can still sign
+	require.NoError(t, h.AdvanceTime(time.Hour))
+	send.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	rawTx, protoTx, err = h.SignDirectTx(sessionKey, 1, send)
+	require.NoError(t, err)
+	require.ErrorContains(t, h.Authenticate("bundler", rawTx, protoTx, 0), "session key expired")
+	rawTx, protoTx, err = h.SignDirectTx(owner, 1, send)
+	require.NoError(t, err)
+	require.NoError(t, h.Authenticate("bundler", rawTx, protoTx, 0))
+}
@@ -0,0 +1,280 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	payments_v1 "cosmossdk.io/x/accounts/interfaces/payments/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var SubscriptionsPrefix = collections.NewPrefix(6)
+
+// NewSubscriptionAccount returns the creator of a subscription account.
+func NewSubscriptionAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
+	return newExtendedAccount(name, handlerMap, options, func(acc Account, deps accountstd.Dependencies) accountstd.Interface {
+		return SubscriptionAccount{
+			Account:       acc,
+			Subscriptions: collections.NewMap(deps.SchemaBuilder, SubscriptionsPrefix, "subscriptions", collections.BytesKey, codec.CollValue[v1.Subscription](deps.LegacyStateCodec)),
+		}
+	})
+}
+
+// SubscriptionAccount implements a base account whose owner can authorize
+// merchants to claim recurring payments: each merchant can claim up to its
+// period limit per period, the coins not claimed during a period are not
+// carried over to the next one. The owner can pause, resume and cancel the
+// subscriptions, the merchants can cancel their own subscription.
+//
+// Merchants claim their payments through the x/accounts ClaimPayments message,
+// which transfers the claimed coins from the account to the merchant.
+type SubscriptionAccount struct {
+	Account
+
+	// Subscriptions maps the merchant address bytes to their subscription.
+	Subscriptions collections.Map[[]byte, v1.Subscription]
+}
+
+// Subscribe authorizes a merchant to claim recurring payments, replacing its
+// subscription if any. Only the account itself can call it.
+func (a SubscriptionAccount) Subscribe(ctx context.Context, msg *v1.MsgSubscribe) (*v1.MsgSubscribeResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	merchant, err := a.addrCodec.StringToBytes(msg.Merchant)
+	if err != nil {
+		return nil, fmt.Errorf("invalid merchant address: %w", err)
+	}
+	if bytes.Equal(merchant, accountstd.Whoami(ctx)) {
+		return nil, errors.New("merchant cannot be the account itself")
+	}
+	if msg.PeriodLimit.IsZero() {
+		return nil, errors.New("period limit cannot be empty")
+	}
+	if err := msg.PeriodLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid period limit: %w", err)
+	}
+	if msg.Period <= 0 {
+		return nil, errors.New("period must be positive")
+	}
+
+	now := a.hs.HeaderInfo(ctx).Time
+	sub := v1.Subscription{
+		Merchant:    msg.Merchant,
+		PeriodLimit: msg.PeriodLimit,
+		Period:      msg.Period,
+		StartTime:   now,
+		MaxPeriods:  msg.MaxPeriods,
+	}
+	if msg.StartTime != nil {
+		sub.StartTime = *msg.StartTime
+	}
+
+	// the payments claimed during the current period of a replaced subscription
+	// are accounted in the current period of the new one, so that the merchant
+	// cannot claim twice in a period.
+	prev, err := a.Subscriptions.Get(ctx, merchant)
+	switch {
+	case err == nil:
+		prevPeriod, err := currentPeriod(prev, now)
+		if err == nil && prev.CurrentPeriod == prevPeriod && !now.Before(sub.StartTime) {
+			sub.CurrentPeriod = uint64(now.Sub(sub.StartTime) / sub.Period)
+			sub.PeriodClaimed = prev.PeriodClaimed
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, err
+	}
+
+	return &v1.MsgSubscribeResponse{}, a.Subscriptions.Set(ctx, merchant, sub)
+}
+
+// PauseSubscription pauses the subscription of a merchant, only the account
+// itself can call it.
+func (a SubscriptionAccount) PauseSubscription(ctx context.Context, msg *v1.MsgPauseSubscription) (*v1.MsgPauseSubscriptionResponse, error) {
+	if err := a.setPaused(ctx, msg.Merchant, true); err != nil {
+		return nil, err
+	}
+	return &v1.MsgPauseSubscriptionResponse{}, nil
+}
+
+// ResumeSubscription resumes the paused subscription of a merchant, only the
+// account itself can call it.
+func (a SubscriptionAccount) ResumeSubscription(ctx context.Context, msg *v1.MsgResumeSubscription) (*v1.MsgResumeSubscriptionResponse, error) {
+	if err := a.setPaused(ctx, msg.Merchant, false); err != nil {
+		return nil, err
+	}
+	return &v1.MsgResumeSubscriptionResponse{}, nil
+}
+
+func (a SubscriptionAccount) setPaused(ctx context.Context, merchantAddr string, paused bool) error {
+	if !accountstd.SenderIsSelf(ctx) {
+		return errors.New("unauthorized")
+	}
+
+	merchant, sub, err := a.getSubscription(ctx, merchantAddr)
+	if err != nil {
+		return err
+	}
+	switch {
+	case paused && sub.Paused:
+		return errors.New("subscription is already paused")
+	case !paused && !sub.Paused:
+		return errors.New("subscription is not paused")
+	}
+
+	sub.Paused = paused
+	return a.Subscriptions.Set(ctx, merchant, sub)
+}
+
+// CancelSubscription removes the subscription of a merchant, only the account
+// itself or the merchant can call it.
+func (a SubscriptionAccount) CancelSubscription(ctx context.Context, msg *v1.MsgCancelSubscription) (*v1.MsgCancelSubscriptionResponse, error) {
+	merchant, _, err := a.getSubscription(ctx, msg.Merchant)
+	if err != nil {
+		return nil, err
+	}
+	if !accountstd.SenderIsSelf(ctx) && !bytes.Equal(accountstd.Sender(ctx), merchant) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgCancelSubscriptionResponse{}, a.Subscriptions.Remove(ctx, merchant)
+}
+
+// ClaimPayments implements the payments interface, it records the payment
+// claimed by a merchant within the limit of the current period. Only the
+// x/accounts module can call it, which then transfers the coins to the merchant.
+func (a SubscriptionAccount) ClaimPayments(ctx context.Context, msg *payments_v1.MsgClaimPayments) (*payments_v1.MsgClaimPaymentsResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	merchant, sub, err := a.getSubscription(ctx, msg.Merchant)
+	if err != nil {
+		return nil, err
+	}
+	if sub.Paused {
+		return nil, errors.New("subscription is paused")
+	}
+	period, err := currentPeriod(sub, a.hs.HeaderInfo(ctx).Time)
+	if err != nil {
+		return nil, err
+	}
+	remaining := remainingPayments(sub, period)
+
+	amount := msg.Amount
+	if amount.IsZero() {
+		amount = remaining
+		if amount.IsZero() {
+			return nil, errors.New("no payment due for the current period")
+		}
+	}
+	if err := amount.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	if !remaining.IsAllGTE(amount) {
+		return nil, fmt.Errorf("claimed amount %s exceeds the remaining %s of the current period", amount, remaining)
+	}
+
+	if sub.CurrentPeriod != period {
+		sub.CurrentPeriod, sub.PeriodClaimed = period, nil
+	}
+	sub.PeriodClaimed = sub.PeriodClaimed.Add(amount...)
+	if err := a.Subscriptions.Set(ctx, merchant, sub); err != nil {
+		return nil, err
+	}
+	return &payments_v1.MsgClaimPaymentsResponse{Amount: amount}, nil
+}
+
+func (a SubscriptionAccount) getSubscription(ctx context.Context, merchantAddr string) ([]byte, v1.Subscription, error) {
+	merchant, err := a.addrCodec.StringToBytes(merchantAddr)
+	if err != nil {
+		return nil, v1.Subscription{}, fmt.Errorf("invalid merchant address: %w", err)
+	}
+	sub, err := a.Subscriptions.Get(ctx, merchant)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, v1.Subscription{}, fmt.Errorf("no subscription found for merchant %s", merchantAddr)
+	}
+	return merchant, sub, err
+}
+
+// currentPeriod returns the index of the period of the subscription at the
+// given time, or an error if the subscription has not started or has ended.
+func currentPeriod(sub v1.Subscription, now time.Time) (uint64, error) {
+	if now.Before(sub.StartTime) {
+		return 0, fmt.Errorf("subscription starts at %s", sub.StartTime)
+	}
+	period := uint64(now.Sub(sub.StartTime) / sub.Period)
+	if sub.MaxPeriods != 0 && period >= sub.MaxPeriods {
+		return 0, errors.New("subscription has ended")
+	}
+	return period, nil
+}
+
+// remainingPayments returns the coins the merchant can still claim during the
+// given period.
+func remainingPayments(sub v1.Subscription, period uint64) sdk.Coins {
+	if sub.CurrentPeriod != period {
+		return sub.PeriodLimit
+	}
+	// the claimed coins can exceed a lower limit set when replacing the subscription
+	var remaining sdk.Coins
+	for _, coin := range sub.PeriodLimit {
+		if claimed := sub.PeriodClaimed.AmountOf(coin.Denom); claimed.LT(coin.Amount) {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(claimed)))
+		}
+	}
+	return remaining
+}
+
+func (a SubscriptionAccount) QuerySubscriptions(ctx context.Context, _ *v1.QuerySubscriptions) (*v1.QuerySubscriptionsResponse, error) {
+	var subscriptions []v1.Subscription
+	err := a.Subscriptions.Walk(ctx, nil, func(_ []byte, sub v1.Subscription) (stop bool, err error) {
+		subscriptions = append(subscriptions, sub)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySubscriptionsResponse{Subscriptions: subscriptions}, nil
+}
+
+func (a SubscriptionAccount) QueryDuePayments(ctx context.Context, msg *v1.QueryDuePayments) (*v1.QueryDuePaymentsResponse, error) {
+	_, sub, err := a.getSubscription(ctx, msg.Merchant)
+	if err != nil {
+		return nil, err
+	}
+	if sub.Paused {
+		return &v1.QueryDuePaymentsResponse{}, nil
+	}
+	period, err := currentPeriod(sub, a.hs.HeaderInfo(ctx).Time)
+	if err != nil {
+		// nothing is due before the start or after the end of the subscription
+		return &v1.QueryDuePaymentsResponse{}, nil
+	}
+	return &v1.QueryDuePaymentsResponse{Amount: remainingPayments(sub, period)}, nil
+}
+
+func (a SubscriptionAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.Subscribe)
+	accountstd.RegisterExecuteHandler(builder, a.PauseSubscription)
+	accountstd.RegisterExecuteHandler(builder, a.ResumeSubscription)
+	accountstd.RegisterExecuteHandler(builder, a.CancelSubscription)
+	accountstd.RegisterExecuteHandler(builder, a.ClaimPayments) // payments
+}
+
+func (a SubscriptionAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QuerySubscriptions)
+	accountstd.RegisterQueryHandler(builder, a.QueryDuePayments)
+}
@@ -0,0 +1,293 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	payments_v1 "cosmossdk.io/x/accounts/interfaces/payments/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[SubscriptionAccount](t, ss, "subscription", NewSubscriptionAccount)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	testcases := []struct {
+		name   string
+		ctx    context.Context
+		msg    *v1.MsgSubscribe
+		expErr string
+	}{
+		{
+			"sender is not self",
+			accountstd.SetSender(ctx, []byte("merchant")),
+			&v1.MsgSubscribe{Merchant: "merchant", PeriodLimit: limit, Period: time.Hour},
+			"unauthorized",
+		},
+		{
+			"merchant is self",
+			selfCtx,
+			&v1.MsgSubscribe{Merchant: "mock_base_account", PeriodLimit: limit, Period: time.Hour},
+			"merchant cannot be the account itself",
+		},
+		{
+			"empty period limit",
+			selfCtx,
+			&v1.MsgSubscribe{Merchant: "merchant", Period: time.Hour},
+			"period limit cannot be empty",
+		},
+		{
+			"zero period",
+			selfCtx,
+			&v1.MsgSubscribe{Merchant: "merchant", PeriodLimit: limit},
+			"period must be positive",
+		},
+		{
+			"valid",
+			selfCtx,
+			&v1.MsgSubscribe{Merchant: "merchant", PeriodLimit: limit, Period: time.Hour},
+			"",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.Subscribe(tc.ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	res, err := acc.QuerySubscriptions(ctx, &v1.QuerySubscriptions{})
+	require.NoError(t, err)
+	require.Len(t, res.Subscriptions, 1)
+	require.Equal(t, headerService{}.HeaderInfo(ctx).Time, res.Subscriptions[0].StartTime)
+}
+
+func TestClaimPayments(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[SubscriptionAccount](t, ss, "subscription", NewSubscriptionAccount)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	now := headerService{}.HeaderInfo(ctx).Time
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	moduleCtx := accountstd.SetSender(ctx, address.Module("accounts"))
+	at := func(ctx context.Context, d time.Duration) context.Context {
+		return context.WithValue(ctx, headerTimeKey{}, now.Add(d))
+	}
+	atoms := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("atom", amount))
+	}
+	claim := func(ctx context.Context, amount sdk.Coins) (sdk.Coins, error) {
+		res, err := acc.ClaimPayments(ctx, &payments_v1.MsgClaimPayments{Merchant: "merchant", Amount: amount})
+		if err != nil {
+			return nil, err
+		}
+		return res.Amount, nil
+	}
+	due := func(ctx context.Context) sdk.Coins {
+		res, err := acc.QueryDuePayments(ctx, &v1.QueryDuePayments{Merchant: "merchant"})
+		require.NoError(t, err)
+		return res.Amount
+	}
+
+	startTime := now.Add(time.Hour)
+	_, err = acc.Subscribe(selfCtx, &v1.MsgSubscribe{
+		Merchant:    "merchant",
+		PeriodLimit: atoms(100),
+		Period:      24 * time.Hour,
+		StartTime:   &startTime,
+		MaxPeriods:  3,
+	})
+	require.NoError(t, err)
+
+	// only the accounts module can claim payments
+	_, err = claim(accountstd.SetSender(ctx, []byte("merchant")), nil)
+	require.ErrorContains(t, err, "unauthorized")
+
+	// the subscription has not started yet
+	_, err = claim(moduleCtx, nil)
+	require.ErrorContains(t, err, "subscription starts at")
+	require.True(t, due(ctx).IsZero())
+
+	// first period: the merchant claims up to the period limit
+	amount, err := claim(at(moduleCtx, time.Hour), atoms(30))
+	require.NoError(t, err)
+	require.Equal(t, atoms(30), amount)
+	require.Equal(t, atoms(70), due(at(ctx, time.Hour)))
+
+	_, err = claim(at(moduleCtx, 2*time.Hour), atoms(71))
+	require.ErrorContains(t, err, "exceeds the remaining 70atom of the current period")
+
+	amount, err = claim(at(moduleCtx, 2*time.Hour), nil)
+	require.NoError(t, err)
+	require.Equal(t, atoms(70), amount)
+
+	_, err = claim(at(moduleCtx, 2*time.Hour), nil)
+	require.ErrorContains(t, err, "no payment due for the current period")
+
+	// second period: the limit is renewed
+	amount, err = claim(at(moduleCtx, 25*time.Hour), nil)
+	require.NoError(t, err)
+	require.Equal(t, atoms(100), amount)
+
+	// the merchant cannot claim while paused
+	_, err = acc.PauseSubscription(ctx, &v1.MsgPauseSubscription{Merchant: "merchant"})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.PauseSubscription(selfCtx, &v1.MsgPauseSubscription{Merchant: "merchant"})
+	require.NoError(t, err)
+	_, err = acc.PauseSubscription(selfCtx, &v1.MsgPauseSubscription{Merchant: "merchant"})
+	require.ErrorContains(t, err, "subscription is already paused")
+
+	_, err = claim(at(moduleCtx, 49*time.Hour), nil)
+	require.ErrorContains(t, err, "subscription is paused")
+	require.True(t, due(at(ctx, 49*time.Hour)).IsZero())
+
+	_, err = acc.ResumeSubscription(selfCtx, &v1.MsgResumeSubscription{Merchant: "merchant"})
+	require.NoError(t, err)
+
+	// third and last period
+	amount, err = claim(at(moduleCtx, 49*time.Hour), atoms(10))
+	require.NoError(t, err)
+	require.Equal(t, atoms(10), amount)
+
+	_, err = claim(at(moduleCtx, 73*time.Hour), nil)
+	require.ErrorContains(t, err, "subscription has ended")
+	require.True(t, due(at(ctx, 73*time.Hour)).IsZero())
+}
+
+func TestResubscribe(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[SubscriptionAccount](t, ss, "subscription", NewSubscriptionAccount)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	now := headerService{}.HeaderInfo(ctx).Time
+	at := func(ctx context.Context, d time.Duration) context.Context {
+		return context.WithValue(ctx, headerTimeKey{}, now.Add(d))
+	}
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	moduleCtx := accountstd.SetSender(ctx, address.Module("accounts"))
+	subscribe := func(ctx context.Context, limit sdk.Coins, startTime *time.Time) {
+		_, err := acc.Subscribe(ctx, &v1.MsgSubscribe{Merchant: "merchant", PeriodLimit: limit, Period: 24 * time.Hour, StartTime: startTime})
+		require.NoError(t, err)
+	}
+	due := func(ctx context.Context) sdk.Coins {
+		res, err := acc.QueryDuePayments(ctx, &v1.QueryDuePayments{Merchant: "merchant"})
+		require.NoError(t, err)
+		return res.Amount
+	}
+
+	subscribe(selfCtx, sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 50)), nil)
+	_, err = acc.ClaimPayments(at(moduleCtx, time.Hour), &payments_v1.MsgClaimPayments{Merchant: "merchant", Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 60))})
+	require.NoError(t, err)
+
+	// the payments claimed during the current period are kept when replacing the subscription
+	subscribe(at(selfCtx, 2*time.Hour), sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 50)), nil)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 40), sdk.NewInt64Coin("stake", 50)), due(at(ctx, 2*time.Hour)))
+
+	// including when lowering the limit below them
+	subscribe(at(selfCtx, 3*time.Hour), sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 50)), nil)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), due(at(ctx, 3*time.Hour)))
+	_, err = acc.ClaimPayments(at(moduleCtx, 3*time.Hour), &payments_v1.MsgClaimPayments{Merchant: "merchant", Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))})
+	require.ErrorContains(t, err, "exceeds the remaining 50stake of the current period")
+
+	// the limit is renewed in the next period
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 50)), due(at(ctx, 27*time.Hour)))
+
+	// a subscription starting later starts with a fresh period
+	startTime := now.Add(4 * time.Hour)
+	subscribe(at(selfCtx, 3*time.Hour), sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), &startTime)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), due(at(ctx, 4*time.Hour)))
+}
+
+func TestCancelSubscription(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupAccount[SubscriptionAccount](t, ss, "subscription", NewSubscriptionAccount)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	selfCtx := accountstd.SetSender(ctx, []byte("mock_base_account"))
+	for _, merchant := range []string{"merchant1", "merchant2"} {
+		_, err = acc.Subscribe(selfCtx, &v1.MsgSubscribe{
+			Merchant:    merchant,
+			PeriodLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+			Period:      time.Hour,
+		})
+		require.NoError(t, err)
+	}
+
+	// a merchant cannot cancel the subscription of another merchant
+	_, err = acc.CancelSubscription(accountstd.SetSender(ctx, []byte("merchant1")), &v1.MsgCancelSubscription{Merchant: "merchant2"})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// the merchant and the owner can cancel a subscription
+	_, err = acc.CancelSubscription(accountstd.SetSender(ctx, []byte("merchant1")), &v1.MsgCancelSubscription{Merchant: "merchant1"})
+	require.NoError(t, err)
+	_, err = acc.CancelSubscription(selfCtx, &v1.MsgCancelSubscription{Merchant: "merchant2"})
+	require.NoError(t, err)
+
+	_, err = acc.CancelSubscription(selfCtx, &v1.MsgCancelSubscription{Merchant: "merchant2"})
+	require.ErrorContains(t, err, "no subscription found for merchant merchant2")
+
+	res, err := acc.QuerySubscriptions(ctx, &v1.QuerySubscriptions{})
+	require.NoError(t, err)
+	require.Empty(t, res.Subscriptions)
+}
+
+func TestSubscriptionHarness(t *testing.T) {
+	h, err := testutil.NewHarness(NewSubscriptionAccount("subscription", signing.NewHandlerMap(directHandler{}), WithSecp256K1PubKey()))
+	require.NoError(t, err)
+	_, err = h.Init([]byte("creator"), &v1.MsgInit{PubKey: toAnyPb(t, secp256k1.GenPrivKey().PubKey())}, nil)
+	require.NoError(t, err)
+
+	merchantAddr := secp256k1.GenPrivKey().PubKey().Address()
+	merchant, err := h.AddressCodec().BytesToString(merchantAddr)
+	require.NoError(t, err)
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	subscribe := &v1.MsgSubscribe{Merchant: merchant, PeriodLimit: limit, Period: 24 * time.Hour}
+	_, err = h.Execute(merchantAddr, subscribe, nil)
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = h.Execute(h.Address(), subscribe, nil)
+	require.NoError(t, err)
+
+	// the merchant claims its payments through the accounts module
+	claim := &payments_v1.MsgClaimPayments{Merchant: merchant}
+	_, err = h.Execute(merchantAddr, claim, nil)
+	require.ErrorContains(t, err, "unauthorized")
+	res, err := h.Execute(address.Module("accounts"), claim, nil)
+	require.NoError(t, err)
+	require.Equal(t, limit, res.(*payments_v1.MsgClaimPaymentsResponse).Amount)
+	_, err = h.Execute(address.Module("accounts"), claim, nil)
+	require.ErrorContains(t, err, "no payment due for the current period")
+
+	require.NoError(t, h.AdvanceTime(24*time.Hour))
+	due, err := h.Query(&v1.QueryDuePayments{Merchant: merchant})
+	require.NoError(t, err)
+	require.Equal(t, limit, due.(*v1.QueryDuePaymentsResponse).Amount)
+
+	// the merchant can cancel its subscription
+	_, err = h.Execute(merchantAddr, &v1.MsgCancelSubscription{Merchant: merchant}, nil)
+	require.NoError(t, err)
+	_, err = h.Execute(address.Module("accounts"), claim, nil)
+	require.ErrorContains(t, err, "no subscription found for merchant")
+}
@@ -3,6 +3,7 @@ package base
 import (
 	"context"
 	"testing"
+	"time"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
 
@@ -82,9 +83,17 @@ func makeMockDependencies(storeservice store.KVStoreService) accountstd.Dependen
 
 type headerService struct{}
 
-func (h headerService) HeaderInfo(context.Context) header.Info {
+// headerTimeKey overrides the block time of the headerService in a context.
+type headerTimeKey struct{}
+
+func (h headerService) HeaderInfo(ctx context.Context) header.Info {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	if t, ok := ctx.Value(headerTimeKey{}).(time.Time); ok {
+		blockTime = t
+	}
 	return header.Info{
 		ChainID: "test",
+		Time:    blockTime,
 	}
 }
 
@@ -0,0 +1,2232 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/defaults/base/v1/paymaster.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PaymasterConfig defines the txs whose fees are sponsored by a paymaster account.
+type PaymasterConfig struct {
+	// allowed_payers defines the addresses of the fee payers whose txs are
+	// sponsored, any fee payer if empty.
+	AllowedPayers []string `protobuf:"bytes,1,rep,name=allowed_payers,json=allowedPayers,proto3" json:"allowed_payers,omitempty"`
+	// allowed_msgs defines the type URLs of the messages the sponsored txs can
+	// contain, any message if empty.
+	AllowedMsgs []string `protobuf:"bytes,2,rep,name=allowed_msgs,json=allowedMsgs,proto3" json:"allowed_msgs,omitempty"`
+	// max_fee defines the maximum fee sponsored per tx, unlimited if empty.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_fee,json=maxFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee"`
+	// payer_quota defines the maximum fees sponsored per fee payer during a quota
+	// period, unlimited if empty.
+	PayerQuota github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=payer_quota,json=payerQuota,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payer_quota"`
+	// quota_period defines the duration after which the quota of a fee payer is
+	// renewed, the quota is never renewed if zero.
+	QuotaPeriod time.Duration `protobuf:"bytes,5,opt,name=quota_period,json=quotaPeriod,proto3,stdduration" json:"quota_period"`
+	// token_payment defines the coins the fee payer pays to the paymaster for each
+	// sponsored tx, they are transferred along with the fees, no payment if empty.
+	TokenPayment github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=token_payment,json=tokenPayment,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"token_payment"`
+}
+
+func (m *PaymasterConfig) Reset()         { *m = PaymasterConfig{} }
+func (m *PaymasterConfig) String() string { return proto.CompactTextString(m) }
+func (*PaymasterConfig) ProtoMessage()    {}
+func (*PaymasterConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{0}
+}
+func (m *PaymasterConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymasterConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymasterConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymasterConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymasterConfig.Merge(m, src)
+}
+func (m *PaymasterConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymasterConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymasterConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymasterConfig proto.InternalMessageInfo
+
+func (m *PaymasterConfig) GetAllowedPayers() []string {
+	if m != nil {
+		return m.AllowedPayers
+	}
+	return nil
+}
+
+func (m *PaymasterConfig) GetAllowedMsgs() []string {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+func (m *PaymasterConfig) GetMaxFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxFee
+	}
+	return nil
+}
+
+func (m *PaymasterConfig) GetPayerQuota() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PayerQuota
+	}
+	return nil
+}
+
+func (m *PaymasterConfig) GetQuotaPeriod() time.Duration {
+	if m != nil {
+		return m.QuotaPeriod
+	}
+	return 0
+}
+
+func (m *PaymasterConfig) GetTokenPayment() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokenPayment
+	}
+	return nil
+}
+
+// PaymasterUsage defines the fees sponsored for a fee payer during the current
+// quota period.
+type PaymasterUsage struct {
+	// period_start defines the start of the current quota period.
+	PeriodStart time.Time `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+	// sponsored defines the fees sponsored during the current quota period.
+	Sponsored github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=sponsored,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sponsored"`
+}
+
+func (m *PaymasterUsage) Reset()         { *m = PaymasterUsage{} }
+func (m *PaymasterUsage) String() string { return proto.CompactTextString(m) }
+func (*PaymasterUsage) ProtoMessage()    {}
+func (*PaymasterUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{1}
+}
+func (m *PaymasterUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymasterUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymasterUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymasterUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymasterUsage.Merge(m, src)
+}
+func (m *PaymasterUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymasterUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymasterUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymasterUsage proto.InternalMessageInfo
+
+func (m *PaymasterUsage) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return time.Time{}
+}
+
+func (m *PaymasterUsage) GetSponsored() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Sponsored
+	}
+	return nil
+}
+
+// MsgInitPaymaster is used to initialize a paymaster account.
+type MsgInitPaymaster struct {
+	// pub_key defines a pubkey for the account arbitrary encapsulated.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// init_sequence defines the initial sequence of the account.
+	// Defaults to zero if not set.
+	InitSequence uint64 `protobuf:"varint,2,opt,name=init_sequence,json=initSequence,proto3" json:"init_sequence,omitempty"`
+	// config defines the txs sponsored by the account.
+	Config PaymasterConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgInitPaymaster) Reset()         { *m = MsgInitPaymaster{} }
+func (m *MsgInitPaymaster) String() string { return proto.CompactTextString(m) }
+func (*MsgInitPaymaster) ProtoMessage()    {}
+func (*MsgInitPaymaster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{2}
+}
+func (m *MsgInitPaymaster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitPaymaster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitPaymaster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitPaymaster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitPaymaster.Merge(m, src)
+}
+func (m *MsgInitPaymaster) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitPaymaster) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitPaymaster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitPaymaster proto.InternalMessageInfo
+
+func (m *MsgInitPaymaster) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *MsgInitPaymaster) GetInitSequence() uint64 {
+	if m != nil {
+		return m.InitSequence
+	}
+	return 0
+}
+
+func (m *MsgInitPaymaster) GetConfig() PaymasterConfig {
+	if m != nil {
+		return m.Config
+	}
+	return PaymasterConfig{}
+}
+
+// MsgInitPaymasterResponse is the response returned after paymaster account
+// initialization. This is empty.
+type MsgInitPaymasterResponse struct {
+}
+
+func (m *MsgInitPaymasterResponse) Reset()         { *m = MsgInitPaymasterResponse{} }
+func (m *MsgInitPaymasterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitPaymasterResponse) ProtoMessage()    {}
+func (*MsgInitPaymasterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{3}
+}
+func (m *MsgInitPaymasterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitPaymasterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitPaymasterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitPaymasterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitPaymasterResponse.Merge(m, src)
+}
+func (m *MsgInitPaymasterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitPaymasterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitPaymasterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitPaymasterResponse proto.InternalMessageInfo
+
+// MsgUpdatePaymasterConfig is used by the owner to change the sponsored txs,
+// the usage of the fee payers is kept.
+type MsgUpdatePaymasterConfig struct {
+	Config PaymasterConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdatePaymasterConfig) Reset()         { *m = MsgUpdatePaymasterConfig{} }
+func (m *MsgUpdatePaymasterConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePaymasterConfig) ProtoMessage()    {}
+func (*MsgUpdatePaymasterConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{4}
+}
+func (m *MsgUpdatePaymasterConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePaymasterConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePaymasterConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePaymasterConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePaymasterConfig.Merge(m, src)
+}
+func (m *MsgUpdatePaymasterConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePaymasterConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePaymasterConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePaymasterConfig proto.InternalMessageInfo
+
+func (m *MsgUpdatePaymasterConfig) GetConfig() PaymasterConfig {
+	if m != nil {
+		return m.Config
+	}
+	return PaymasterConfig{}
+}
+
+// MsgUpdatePaymasterConfigResponse is the response for the MsgUpdatePaymasterConfig message.
+// This is empty.
+type MsgUpdatePaymasterConfigResponse struct {
+}
+
+func (m *MsgUpdatePaymasterConfigResponse) Reset()         { *m = MsgUpdatePaymasterConfigResponse{} }
+func (m *MsgUpdatePaymasterConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePaymasterConfigResponse) ProtoMessage()    {}
+func (*MsgUpdatePaymasterConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{5}
+}
+func (m *MsgUpdatePaymasterConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePaymasterConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePaymasterConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePaymasterConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePaymasterConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdatePaymasterConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePaymasterConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePaymasterConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePaymasterConfigResponse proto.InternalMessageInfo
+
+// QueryPaymasterConfig is the request used to query the paymaster config of the account.
+type QueryPaymasterConfig struct {
+}
+
+func (m *QueryPaymasterConfig) Reset()         { *m = QueryPaymasterConfig{} }
+func (m *QueryPaymasterConfig) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymasterConfig) ProtoMessage()    {}
+func (*QueryPaymasterConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{6}
+}
+func (m *QueryPaymasterConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymasterConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymasterConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymasterConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymasterConfig.Merge(m, src)
+}
+func (m *QueryPaymasterConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymasterConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymasterConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymasterConfig proto.InternalMessageInfo
+
+// QueryPaymasterConfigResponse is the response for the QueryPaymasterConfig message.
+type QueryPaymasterConfigResponse struct {
+	Config PaymasterConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (m *QueryPaymasterConfigResponse) Reset()         { *m = QueryPaymasterConfigResponse{} }
+func (m *QueryPaymasterConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymasterConfigResponse) ProtoMessage()    {}
+func (*QueryPaymasterConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{7}
+}
+func (m *QueryPaymasterConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymasterConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymasterConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymasterConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymasterConfigResponse.Merge(m, src)
+}
+func (m *QueryPaymasterConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymasterConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymasterConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymasterConfigResponse proto.InternalMessageInfo
+
+func (m *QueryPaymasterConfigResponse) GetConfig() PaymasterConfig {
+	if m != nil {
+		return m.Config
+	}
+	return PaymasterConfig{}
+}
+
+// QueryPaymasterUsage is the request used to query the fees sponsored for a fee payer.
+type QueryPaymasterUsage struct {
+	// fee_payer defines the address of the fee payer.
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+}
+
+func (m *QueryPaymasterUsage) Reset()         { *m = QueryPaymasterUsage{} }
+func (m *QueryPaymasterUsage) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymasterUsage) ProtoMessage()    {}
+func (*QueryPaymasterUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{8}
+}
+func (m *QueryPaymasterUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymasterUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymasterUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymasterUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymasterUsage.Merge(m, src)
+}
+func (m *QueryPaymasterUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymasterUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymasterUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymasterUsage proto.InternalMessageInfo
+
+func (m *QueryPaymasterUsage) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+// QueryPaymasterUsageResponse is the response for the QueryPaymasterUsage message.
+type QueryPaymasterUsageResponse struct {
+	// usage defines the fees sponsored for the fee payer during the current quota
+	// period, empty if none.
+	Usage PaymasterUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QueryPaymasterUsageResponse) Reset()         { *m = QueryPaymasterUsageResponse{} }
+func (m *QueryPaymasterUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymasterUsageResponse) ProtoMessage()    {}
+func (*QueryPaymasterUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35df31e448b2f2d4, []int{9}
+}
+func (m *QueryPaymasterUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymasterUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymasterUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymasterUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymasterUsageResponse.Merge(m, src)
+}
+func (m *QueryPaymasterUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymasterUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymasterUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymasterUsageResponse proto.InternalMessageInfo
+
+func (m *QueryPaymasterUsageResponse) GetUsage() PaymasterUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return PaymasterUsage{}
+}
+
+func init() {
+	proto.RegisterType((*PaymasterConfig)(nil), "cosmos.accounts.defaults.base.v1.PaymasterConfig")
+	proto.RegisterType((*PaymasterUsage)(nil), "cosmos.accounts.defaults.base.v1.PaymasterUsage")
+	proto.RegisterType((*MsgInitPaymaster)(nil), "cosmos.accounts.defaults.base.v1.MsgInitPaymaster")
+	proto.RegisterType((*MsgInitPaymasterResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgInitPaymasterResponse")
+	proto.RegisterType((*MsgUpdatePaymasterConfig)(nil), "cosmos.accounts.defaults.base.v1.MsgUpdatePaymasterConfig")
+	proto.RegisterType((*MsgUpdatePaymasterConfigResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgUpdatePaymasterConfigResponse")
+	proto.RegisterType((*QueryPaymasterConfig)(nil), "cosmos.accounts.defaults.base.v1.QueryPaymasterConfig")
+	proto.RegisterType((*QueryPaymasterConfigResponse)(nil), "cosmos.accounts.defaults.base.v1.QueryPaymasterConfigResponse")
+	proto.RegisterType((*QueryPaymasterUsage)(nil), "cosmos.accounts.defaults.base.v1.QueryPaymasterUsage")
+	proto.RegisterType((*QueryPaymasterUsageResponse)(nil), "cosmos.accounts.defaults.base.v1.QueryPaymasterUsageResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/defaults/base/v1/paymaster.proto", fileDescriptor_35df31e448b2f2d4)
+}
+
+var fileDescriptor_35df31e448b2f2d4 = []byte{
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x9b, 0x34, 0x6d, 0x27, 0x69, 0xef, 0x95, 0x6f, 0x74, 0xe5, 0x06, 0xe4, 0x04, 0xb3,
+	0xc9, 0xa6, 0x76, 0x03, 0x62, 0x8d, 0x9a, 0xa2, 0x22, 0x44, 0x2b, 0x52, 0x97, 0x6e, 0xd8, 0x58,
+	0x63, 0xfb, 0xc4, 0x58, 0x89, 0x3d, 0xae, 0x67, 0x5c, 0xea, 0x35, 0x2f, 0xd0, 0x25, 0xcf, 0xc0,
+	0x1a, 0x89, 0x17, 0x60, 0xd1, 0x65, 0xc5, 0x8a, 0x15, 0x45, 0xed, 0x8b, 0x20, 0xcf, 0x8c, 0x53,
+	0x91, 0xb6, 0x20, 0xa4, 0xac, 0x92, 0x39, 0x67, 0xbe, 0xbf, 0xf9, 0x33, 0xda, 0xf4, 0x08, 0x8d,
+	0x08, 0xb5, 0xb0, 0xe7, 0x91, 0x2c, 0x66, 0xd4, 0xf2, 0x61, 0x84, 0xb3, 0x09, 0xa3, 0x96, 0x8b,
+	0x29, 0x58, 0xc7, 0x7d, 0x2b, 0xc1, 0x79, 0x84, 0x29, 0x83, 0xd4, 0x4c, 0x52, 0xc2, 0x88, 0xda,
+	0x15, 0x08, 0xb3, 0x44, 0x98, 0x25, 0xc2, 0x2c, 0x10, 0xe6, 0x71, 0xbf, 0xad, 0x4b, 0x4e, 0xc9,
+	0xe0, 0x02, 0xc3, 0x7d, 0xcb, 0x23, 0x61, 0x2c, 0x18, 0xda, 0xeb, 0xa2, 0xef, 0xf0, 0x91, 0x25,
+	0xe9, 0x44, 0xab, 0x15, 0x90, 0x80, 0x88, 0x7a, 0xf1, 0xaf, 0x04, 0x04, 0x84, 0x04, 0x13, 0xb0,
+	0xf8, 0xc8, 0xcd, 0x46, 0x16, 0x8e, 0x73, 0xd9, 0xd2, 0x67, 0x5b, 0x7e, 0x96, 0x62, 0x16, 0x92,
+	0x52, 0xab, 0x33, 0xdb, 0x67, 0x61, 0x04, 0x94, 0xe1, 0x28, 0x11, 0x13, 0x8c, 0xf7, 0x35, 0xf4,
+	0xcf, 0xb0, 0x8c, 0xb8, 0x4d, 0xe2, 0x51, 0x18, 0xa8, 0x4f, 0xd1, 0x1a, 0x9e, 0x4c, 0xc8, 0x3b,
+	0xf0, 0x9d, 0x04, 0xe7, 0x90, 0x52, 0x4d, 0xe9, 0x56, 0x7b, 0x2b, 0x03, 0xed, 0xeb, 0xa7, 0x8d,
+	0x96, 0xf4, 0xbb, 0xe5, 0xfb, 0x29, 0x50, 0x7a, 0xc0, 0xd2, 0x30, 0x0e, 0xec, 0x55, 0x39, 0x7f,
+	0xc8, 0xa7, 0xab, 0x0f, 0x50, 0xb3, 0x24, 0x88, 0x68, 0x40, 0xb5, 0x85, 0x02, 0x6e, 0x37, 0x64,
+	0x6d, 0x8f, 0x06, 0x54, 0xf5, 0xd1, 0x52, 0x84, 0x4f, 0x9c, 0x11, 0x80, 0x56, 0xed, 0x56, 0x7b,
+	0x8d, 0x47, 0xeb, 0xa6, 0x64, 0x96, 0xcb, 0xc8, 0x97, 0xcd, 0xdc, 0x26, 0x61, 0x3c, 0xd8, 0x3c,
+	0xfb, 0xde, 0xa9, 0x7c, 0xbc, 0xe8, 0xf4, 0x82, 0x90, 0xbd, 0xcd, 0x5c, 0xd3, 0x23, 0x91, 0x5c,
+	0x36, 0xf9, 0xb3, 0x41, 0xfd, 0xb1, 0xc5, 0xf2, 0x04, 0x28, 0x07, 0x50, 0xbb, 0x1e, 0xe1, 0x93,
+	0x1d, 0x00, 0x75, 0x82, 0x1a, 0x3c, 0x81, 0x73, 0x94, 0x11, 0x86, 0xb5, 0xda, 0xfc, 0x95, 0x10,
+	0xe7, 0xdf, 0x2f, 0xe8, 0xd5, 0x1d, 0xd4, 0xe4, 0x3a, 0x4e, 0x02, 0x69, 0x48, 0x7c, 0x6d, 0xb1,
+	0xab, 0x70, 0x39, 0xb1, 0x07, 0x66, 0xb9, 0x07, 0xe6, 0x33, 0xb9, 0x47, 0x83, 0xe5, 0x42, 0xee,
+	0xc3, 0x45, 0x47, 0xb1, 0x1b, 0x1c, 0x38, 0xe4, 0x38, 0x35, 0x41, 0xab, 0x8c, 0x8c, 0x21, 0x2e,
+	0x56, 0x3f, 0x82, 0x98, 0x69, 0xf5, 0xf9, 0xfb, 0x6e, 0x72, 0x85, 0xa1, 0x10, 0x30, 0xbe, 0x28,
+	0x68, 0x6d, 0x7a, 0x0a, 0x0e, 0x29, 0x0e, 0x40, 0x7d, 0x8e, 0x9a, 0x22, 0x86, 0x43, 0x19, 0x4e,
+	0x99, 0xa6, 0xf0, 0x30, 0xed, 0x1b, 0x61, 0x5e, 0x97, 0x07, 0x4a, 0xa4, 0x39, 0xe5, 0x69, 0x04,
+	0xf2, 0xa0, 0x00, 0xaa, 0x21, 0x5a, 0xa1, 0x09, 0x89, 0x29, 0x49, 0xc1, 0xd7, 0x16, 0xe6, 0x9f,
+	0xe4, 0x9a, 0xdd, 0xf8, 0xac, 0xa0, 0x7f, 0xf7, 0x68, 0xf0, 0x22, 0x0e, 0xd9, 0x34, 0x8d, 0xba,
+	0x81, 0x96, 0x92, 0xcc, 0x75, 0xc6, 0x90, 0xcb, 0x0c, 0xad, 0x1b, 0x19, 0xb6, 0xe2, 0xdc, 0xae,
+	0x27, 0x99, 0xfb, 0x12, 0x72, 0xf5, 0x21, 0x5a, 0x0d, 0xe3, 0x90, 0x39, 0x14, 0x8e, 0x32, 0x88,
+	0x3d, 0xd0, 0x16, 0xba, 0x4a, 0xaf, 0x66, 0x37, 0x8b, 0xe2, 0x81, 0xac, 0xa9, 0xaf, 0x50, 0xdd,
+	0xe3, 0x77, 0x45, 0xab, 0x72, 0xca, 0xbe, 0xf9, 0xa7, 0x57, 0xc1, 0x9c, 0xb9, 0x64, 0x83, 0x5a,
+	0x11, 0xd4, 0x96, 0x34, 0x46, 0x1b, 0x69, 0xb3, 0xc6, 0x6d, 0xe0, 0xc1, 0xc0, 0x18, 0xf3, 0xde,
+	0x61, 0xe2, 0x63, 0x06, 0xb3, 0x57, 0xf5, 0xda, 0x88, 0x32, 0x1f, 0x23, 0x06, 0xea, 0xde, 0x25,
+	0x36, 0x35, 0xf4, 0x3f, 0x6a, 0xed, 0x67, 0x90, 0xe6, 0x33, 0x7d, 0x83, 0xa0, 0xfb, 0xb7, 0xd5,
+	0x4b, 0xdc, 0xfc, 0xcd, 0xee, 0xa2, 0xff, 0x7e, 0x15, 0x14, 0x47, 0xf7, 0x09, 0x5a, 0x19, 0x01,
+	0x88, 0xb7, 0x8b, 0x4b, 0xfd, 0xee, 0xe9, 0x5a, 0x1e, 0x01, 0xf0, 0x67, 0xcb, 0x18, 0xa3, 0x7b,
+	0xb7, 0xb0, 0x4d, 0xdd, 0xef, 0xa2, 0xc5, 0xac, 0x28, 0x48, 0xf3, 0x9b, 0x7f, 0x61, 0x9e, 0x13,
+	0x49, 0xef, 0x82, 0x64, 0x30, 0x38, 0xbb, 0xd4, 0x95, 0xf3, 0x4b, 0x5d, 0xf9, 0x71, 0xa9, 0x2b,
+	0xa7, 0x57, 0x7a, 0xe5, 0xfc, 0x4a, 0xaf, 0x7c, 0xbb, 0xd2, 0x2b, 0x6f, 0x7a, 0x82, 0x97, 0xfa,
+	0x63, 0x33, 0x24, 0xd6, 0xc9, 0xdd, 0x9f, 0x26, 0xb7, 0xce, 0x0f, 0xf0, 0xe3, 0x9f, 0x03, 0x00,
+	0xa5, 0x1b, 0xef, 0xf7, 0xc5, 0x06, 0x00, 0x00,
+}
+
+func (m *PaymasterConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymasterConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymasterConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenPayment) > 0 {
+		for iNdEx := len(m.TokenPayment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPayment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaymaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.QuotaPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.QuotaPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPaymaster(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PayerQuota) > 0 {
+		for iNdEx := len(m.PayerQuota) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayerQuota[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaymaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MaxFee) > 0 {
+		for iNdEx := len(m.MaxFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaymaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgs[iNdEx])
+			i = encodeVarintPaymaster(dAtA, i, uint64(len(m.AllowedMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedPayers) > 0 {
+		for iNdEx := len(m.AllowedPayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPayers[iNdEx])
+			copy(dAtA[i:], m.AllowedPayers[iNdEx])
+			i = encodeVarintPaymaster(dAtA, i, uint64(len(m.AllowedPayers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymasterUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymasterUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymasterUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsored) > 0 {
+		for iNdEx := len(m.Sponsored) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsored[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPaymaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPaymaster(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitPaymaster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitPaymaster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitPaymaster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPaymaster(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.InitSequence != 0 {
+		i = encodeVarintPaymaster(dAtA, i, uint64(m.InitSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPaymaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitPaymasterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitPaymasterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitPaymasterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePaymasterConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePaymasterConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePaymasterConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPaymaster(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePaymasterConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePaymasterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePaymasterConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymasterConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymasterConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymasterConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymasterConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymasterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymasterConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPaymaster(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymasterUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymasterUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymasterUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintPaymaster(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymasterUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymasterUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymasterUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPaymaster(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPaymaster(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPaymaster(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PaymasterConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedPayers) > 0 {
+		for _, s := range m.AllowedPayers {
+			l = len(s)
+			n += 1 + l + sovPaymaster(uint64(l))
+		}
+	}
+	if len(m.AllowedMsgs) > 0 {
+		for _, s := range m.AllowedMsgs {
+			l = len(s)
+			n += 1 + l + sovPaymaster(uint64(l))
+		}
+	}
+	if len(m.MaxFee) > 0 {
+		for _, e := range m.MaxFee {
+			l = e.Size()
+			n += 1 + l + sovPaymaster(uint64(l))
+		}
+	}
+	if len(m.PayerQuota) > 0 {
+		for _, e := range m.PayerQuota {
+			l = e.Size()
+			n += 1 + l + sovPaymaster(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.QuotaPeriod)
+	n += 1 + l + sovPaymaster(uint64(l))
+	if len(m.TokenPayment) > 0 {
+		for _, e := range m.TokenPayment {
+			l = e.Size()
+			n += 1 + l + sovPaymaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PaymasterUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovPaymaster(uint64(l))
+	if len(m.Sponsored) > 0 {
+		for _, e := range m.Sponsored {
+			l = e.Size()
+			n += 1 + l + sovPaymaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInitPaymaster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovPaymaster(uint64(l))
+	}
+	if m.InitSequence != 0 {
+		n += 1 + sovPaymaster(uint64(m.InitSequence))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovPaymaster(uint64(l))
+	return n
+}
+
+func (m *MsgInitPaymasterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePaymasterConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovPaymaster(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePaymasterConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPaymasterConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPaymasterConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovPaymaster(uint64(l))
+	return n
+}
+
+func (m *QueryPaymasterUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovPaymaster(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymasterUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovPaymaster(uint64(l))
+	return n
+}
+
+func sovPaymaster(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPaymaster(x uint64) (n int) {
+	return sovPaymaster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PaymasterConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymasterConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymasterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPayers = append(m.AllowedPayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = append(m.MaxFee, types.Coin{})
+			if err := m.MaxFee[len(m.MaxFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayerQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayerQuota = append(m.PayerQuota, types.Coin{})
+			if err := m.PayerQuota[len(m.PayerQuota)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.QuotaPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPayment = append(m.TokenPayment, types.Coin{})
+			if err := m.TokenPayment[len(m.TokenPayment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymasterUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymasterUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymasterUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsored", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsored = append(m.Sponsored, types.Coin{})
+			if err := m.Sponsored[len(m.Sponsored)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitPaymaster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitPaymaster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitPaymaster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitSequence", wireType)
+			}
+			m.InitSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitPaymasterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitPaymasterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitPaymasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePaymasterConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePaymasterConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePaymasterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePaymasterConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePaymasterConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePaymasterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymasterConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymasterConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymasterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymasterConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymasterConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymasterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymasterUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymasterUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymasterUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymasterUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymasterUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymasterUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPaymaster(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPaymaster
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaymaster
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPaymaster
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPaymaster
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPaymaster
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPaymaster        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPaymaster          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPaymaster = fmt.Errorf("proto: unexpected end of group")
+)
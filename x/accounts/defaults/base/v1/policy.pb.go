@@ -0,0 +1,2702 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/defaults/base/v1/policy.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Policy defines the constraints enforced on the txs authenticated by a policy
+// account.
+type Policy struct {
+	// daily_spend_limit defines the maximum coins the account can spend per day,
+	// the denoms which are not listed cannot be spent. Unlimited if empty.
+	DailySpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=daily_spend_limit,json=dailySpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_spend_limit"`
+	// allowed_recipients defines the addresses the account can send coins to,
+	// any address if empty.
+	AllowedRecipients []string `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+	// allowed_msgs defines the type URLs of the messages the account can
+	// authenticate. If empty, any message, or only the messages whose spending is
+	// accounted (bank MsgSend and MsgMultiSend) if daily_spend_limit or
+	// allowed_recipients is set.
+	AllowedMsgs []string `protobuf:"bytes,3,rep,name=allowed_msgs,json=allowedMsgs,proto3" json:"allowed_msgs,omitempty"`
+	// update_delay defines the delay after which an update of the policy takes
+	// effect.
+	UpdateDelay time.Duration `protobuf:"bytes,4,opt,name=update_delay,json=updateDelay,proto3,stdduration" json:"update_delay"`
+}
+
+func (m *Policy) Reset()         { *m = Policy{} }
+func (m *Policy) String() string { return proto.CompactTextString(m) }
+func (*Policy) ProtoMessage()    {}
+func (*Policy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{0}
+}
+func (m *Policy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Policy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Policy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Policy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Policy.Merge(m, src)
+}
+func (m *Policy) XXX_Size() int {
+	return m.Size()
+}
+func (m *Policy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Policy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Policy proto.InternalMessageInfo
+
+func (m *Policy) GetDailySpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailySpendLimit
+	}
+	return nil
+}
+
+func (m *Policy) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+func (m *Policy) GetAllowedMsgs() []string {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+func (m *Policy) GetUpdateDelay() time.Duration {
+	if m != nil {
+		return m.UpdateDelay
+	}
+	return 0
+}
+
+// PendingPolicy defines a policy update waiting for its delay to elapse.
+type PendingPolicy struct {
+	// policy defines the policy replacing the current one.
+	Policy Policy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// effective_time defines the time from which the policy takes effect.
+	EffectiveTime time.Time `protobuf:"bytes,2,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingPolicy) Reset()         { *m = PendingPolicy{} }
+func (m *PendingPolicy) String() string { return proto.CompactTextString(m) }
+func (*PendingPolicy) ProtoMessage()    {}
+func (*PendingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{1}
+}
+func (m *PendingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPolicy.Merge(m, src)
+}
+func (m *PendingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPolicy proto.InternalMessageInfo
+
+func (m *PendingPolicy) GetPolicy() Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return Policy{}
+}
+
+func (m *PendingPolicy) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// SpendWindow defines the coins spent by a policy account during a day.
+type SpendWindow struct {
+	// start defines the start of the day, at midnight UTC.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// spent defines the coins spent during the day.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *SpendWindow) Reset()         { *m = SpendWindow{} }
+func (m *SpendWindow) String() string { return proto.CompactTextString(m) }
+func (*SpendWindow) ProtoMessage()    {}
+func (*SpendWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{2}
+}
+func (m *SpendWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendWindow.Merge(m, src)
+}
+func (m *SpendWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendWindow proto.InternalMessageInfo
+
+func (m *SpendWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *SpendWindow) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// MsgInitPolicyAccount is used to initialize a policy account.
+type MsgInitPolicyAccount struct {
+	// pub_key defines a pubkey for the account arbitrary encapsulated.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// init_sequence defines the initial sequence of the account.
+	// Defaults to zero if not set.
+	InitSequence uint64 `protobuf:"varint,2,opt,name=init_sequence,json=initSequence,proto3" json:"init_sequence,omitempty"`
+	// policy defines the policy enforced on the account from its creation.
+	Policy Policy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgInitPolicyAccount) Reset()         { *m = MsgInitPolicyAccount{} }
+func (m *MsgInitPolicyAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgInitPolicyAccount) ProtoMessage()    {}
+func (*MsgInitPolicyAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{3}
+}
+func (m *MsgInitPolicyAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitPolicyAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitPolicyAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitPolicyAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitPolicyAccount.Merge(m, src)
+}
+func (m *MsgInitPolicyAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitPolicyAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitPolicyAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitPolicyAccount proto.InternalMessageInfo
+
+func (m *MsgInitPolicyAccount) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *MsgInitPolicyAccount) GetInitSequence() uint64 {
+	if m != nil {
+		return m.InitSequence
+	}
+	return 0
+}
+
+func (m *MsgInitPolicyAccount) GetPolicy() Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return Policy{}
+}
+
+// MsgInitPolicyAccountResponse is the response returned after policy account
+// initialization. This is empty.
+type MsgInitPolicyAccountResponse struct {
+}
+
+func (m *MsgInitPolicyAccountResponse) Reset()         { *m = MsgInitPolicyAccountResponse{} }
+func (m *MsgInitPolicyAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitPolicyAccountResponse) ProtoMessage()    {}
+func (*MsgInitPolicyAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{4}
+}
+func (m *MsgInitPolicyAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitPolicyAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitPolicyAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitPolicyAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitPolicyAccountResponse.Merge(m, src)
+}
+func (m *MsgInitPolicyAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitPolicyAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitPolicyAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitPolicyAccountResponse proto.InternalMessageInfo
+
+// MsgMigrateToPolicyAccount is used by the owner of a base account to migrate
+// it to a policy account, keeping its pubkey and sequence.
+type MsgMigrateToPolicyAccount struct {
+	// policy defines the policy enforced on the account from its migration.
+	Policy Policy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgMigrateToPolicyAccount) Reset()         { *m = MsgMigrateToPolicyAccount{} }
+func (m *MsgMigrateToPolicyAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateToPolicyAccount) ProtoMessage()    {}
+func (*MsgMigrateToPolicyAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{5}
+}
+func (m *MsgMigrateToPolicyAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateToPolicyAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateToPolicyAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateToPolicyAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateToPolicyAccount.Merge(m, src)
+}
+func (m *MsgMigrateToPolicyAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateToPolicyAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateToPolicyAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateToPolicyAccount proto.InternalMessageInfo
+
+func (m *MsgMigrateToPolicyAccount) GetPolicy() Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return Policy{}
+}
+
+// MsgMigrateToPolicyAccountResponse is the response for the
+// MsgMigrateToPolicyAccount message. This is empty.
+type MsgMigrateToPolicyAccountResponse struct {
+}
+
+func (m *MsgMigrateToPolicyAccountResponse) Reset()         { *m = MsgMigrateToPolicyAccountResponse{} }
+func (m *MsgMigrateToPolicyAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateToPolicyAccountResponse) ProtoMessage()    {}
+func (*MsgMigrateToPolicyAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{6}
+}
+func (m *MsgMigrateToPolicyAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateToPolicyAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateToPolicyAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateToPolicyAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateToPolicyAccountResponse.Merge(m, src)
+}
+func (m *MsgMigrateToPolicyAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateToPolicyAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateToPolicyAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateToPolicyAccountResponse proto.InternalMessageInfo
+
+// MsgUpdatePolicy is used by the owner to schedule a policy update, which takes
+// effect once the update delay of the current policy elapsed. It replaces the
+// pending update if any.
+type MsgUpdatePolicy struct {
+	Policy Policy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgUpdatePolicy) Reset()         { *m = MsgUpdatePolicy{} }
+func (m *MsgUpdatePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePolicy) ProtoMessage()    {}
+func (*MsgUpdatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{7}
+}
+func (m *MsgUpdatePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePolicy.Merge(m, src)
+}
+func (m *MsgUpdatePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePolicy proto.InternalMessageInfo
+
+func (m *MsgUpdatePolicy) GetPolicy() Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return Policy{}
+}
+
+// MsgUpdatePolicyResponse is the response for the MsgUpdatePolicy message.
+type MsgUpdatePolicyResponse struct {
+	// effective_time defines the time from which the policy takes effect.
+	EffectiveTime time.Time `protobuf:"bytes,1,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *MsgUpdatePolicyResponse) Reset()         { *m = MsgUpdatePolicyResponse{} }
+func (m *MsgUpdatePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePolicyResponse) ProtoMessage()    {}
+func (*MsgUpdatePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{8}
+}
+func (m *MsgUpdatePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdatePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePolicyResponse proto.InternalMessageInfo
+
+func (m *MsgUpdatePolicyResponse) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// MsgCancelPolicyUpdate is used by the owner to cancel the pending policy update.
+type MsgCancelPolicyUpdate struct {
+}
+
+func (m *MsgCancelPolicyUpdate) Reset()         { *m = MsgCancelPolicyUpdate{} }
+func (m *MsgCancelPolicyUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPolicyUpdate) ProtoMessage()    {}
+func (*MsgCancelPolicyUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{9}
+}
+func (m *MsgCancelPolicyUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPolicyUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPolicyUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPolicyUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPolicyUpdate.Merge(m, src)
+}
+func (m *MsgCancelPolicyUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPolicyUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPolicyUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPolicyUpdate proto.InternalMessageInfo
+
+// MsgCancelPolicyUpdateResponse is the response for the MsgCancelPolicyUpdate message.
+// This is empty.
+type MsgCancelPolicyUpdateResponse struct {
+}
+
+func (m *MsgCancelPolicyUpdateResponse) Reset()         { *m = MsgCancelPolicyUpdateResponse{} }
+func (m *MsgCancelPolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPolicyUpdateResponse) ProtoMessage()    {}
+func (*MsgCancelPolicyUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{10}
+}
+func (m *MsgCancelPolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPolicyUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPolicyUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPolicyUpdateResponse.Merge(m, src)
+}
+func (m *MsgCancelPolicyUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPolicyUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPolicyUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPolicyUpdateResponse proto.InternalMessageInfo
+
+// QueryPolicy is the request used to query the policy of the account.
+type QueryPolicy struct {
+}
+
+func (m *QueryPolicy) Reset()         { *m = QueryPolicy{} }
+func (m *QueryPolicy) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicy) ProtoMessage()    {}
+func (*QueryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{11}
+}
+func (m *QueryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicy.Merge(m, src)
+}
+func (m *QueryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPolicy proto.InternalMessageInfo
+
+// QueryPolicyResponse is the response for the QueryPolicy message.
+type QueryPolicyResponse struct {
+	// policy defines the policy in effect.
+	Policy Policy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// pending defines the pending policy update, if any.
+	Pending *PendingPolicy `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// spent_today defines the coins spent by the account during the current day.
+	SpentToday github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent_today,json=spentToday,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent_today"`
+}
+
+func (m *QueryPolicyResponse) Reset()         { *m = QueryPolicyResponse{} }
+func (m *QueryPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicyResponse) ProtoMessage()    {}
+func (*QueryPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bdad853674543ee, []int{12}
+}
+func (m *QueryPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicyResponse.Merge(m, src)
+}
+func (m *QueryPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryPolicyResponse) GetPolicy() Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return Policy{}
+}
+
+func (m *QueryPolicyResponse) GetPending() *PendingPolicy {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *QueryPolicyResponse) GetSpentToday() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpentToday
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Policy)(nil), "cosmos.accounts.defaults.base.v1.Policy")
+	proto.RegisterType((*PendingPolicy)(nil), "cosmos.accounts.defaults.base.v1.PendingPolicy")
+	proto.RegisterType((*SpendWindow)(nil), "cosmos.accounts.defaults.base.v1.SpendWindow")
+	proto.RegisterType((*MsgInitPolicyAccount)(nil), "cosmos.accounts.defaults.base.v1.MsgInitPolicyAccount")
+	proto.RegisterType((*MsgInitPolicyAccountResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgInitPolicyAccountResponse")
+	proto.RegisterType((*MsgMigrateToPolicyAccount)(nil), "cosmos.accounts.defaults.base.v1.MsgMigrateToPolicyAccount")
+	proto.RegisterType((*MsgMigrateToPolicyAccountResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgMigrateToPolicyAccountResponse")
+	proto.RegisterType((*MsgUpdatePolicy)(nil), "cosmos.accounts.defaults.base.v1.MsgUpdatePolicy")
+	proto.RegisterType((*MsgUpdatePolicyResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgUpdatePolicyResponse")
+	proto.RegisterType((*MsgCancelPolicyUpdate)(nil), "cosmos.accounts.defaults.base.v1.MsgCancelPolicyUpdate")
+	proto.RegisterType((*MsgCancelPolicyUpdateResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgCancelPolicyUpdateResponse")
+	proto.RegisterType((*QueryPolicy)(nil), "cosmos.accounts.defaults.base.v1.QueryPolicy")
+	proto.RegisterType((*QueryPolicyResponse)(nil), "cosmos.accounts.defaults.base.v1.QueryPolicyResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/defaults/base/v1/policy.proto", fileDescriptor_1bdad853674543ee)
+}
+
+var fileDescriptor_1bdad853674543ee = []byte{
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0xda, 0xad, 0x03, 0x77, 0x65, 0x5a, 0x28, 0x5a, 0x56, 0x41, 0xda, 0x65, 0x97, 0x5e,
+	0x9a, 0x30, 0xb8, 0x71, 0x5b, 0x37, 0x0d, 0x4d, 0xa3, 0xd2, 0xc8, 0x86, 0x10, 0x5c, 0x22, 0x37,
+	0x71, 0x83, 0xb5, 0xd4, 0x0e, 0xb1, 0xb3, 0x91, 0x7f, 0xb1, 0x13, 0xe2, 0x07, 0x70, 0x42, 0x9c,
+	0x10, 0xfc, 0x87, 0x1d, 0x27, 0x4e, 0x9c, 0x18, 0xda, 0xfe, 0x08, 0x8a, 0xed, 0x54, 0x63, 0x1f,
+	0xe2, 0xab, 0xa7, 0xcd, 0x7e, 0xdf, 0xe7, 0x79, 0xde, 0x8f, 0xc7, 0x29, 0xe8, 0xfa, 0x94, 0x8d,
+	0x28, 0x73, 0xa0, 0xef, 0xd3, 0x94, 0x70, 0xe6, 0x04, 0x68, 0x08, 0xd3, 0x88, 0x33, 0x67, 0x00,
+	0x19, 0x72, 0xf6, 0x57, 0x9c, 0x98, 0x46, 0xd8, 0xcf, 0xec, 0x38, 0xa1, 0x9c, 0xea, 0x6d, 0x99,
+	0x6e, 0x17, 0xe9, 0x76, 0x91, 0x6e, 0xe7, 0xe9, 0xf6, 0xfe, 0x4a, 0xd3, 0x54, 0x84, 0x0a, 0x3e,
+	0x40, 0x1c, 0xae, 0x38, 0x3e, 0xc5, 0x44, 0x32, 0x34, 0x17, 0x65, 0xdc, 0x13, 0x27, 0x47, 0xd1,
+	0xc9, 0x50, 0x23, 0xa4, 0x21, 0x95, 0xf7, 0xf9, 0x7f, 0x05, 0x20, 0xa4, 0x34, 0x8c, 0x90, 0x23,
+	0x4e, 0x83, 0x74, 0xe8, 0x40, 0xa2, 0xaa, 0x69, 0x9a, 0x17, 0x43, 0x41, 0x9a, 0x40, 0x8e, 0x69,
+	0xa1, 0xd5, 0xba, 0x18, 0xe7, 0x78, 0x84, 0x18, 0x87, 0xa3, 0x58, 0x26, 0x58, 0x5f, 0xca, 0xa0,
+	0xba, 0x2d, 0xfa, 0xd3, 0x0f, 0xc0, 0x7c, 0x00, 0x71, 0x94, 0x79, 0x2c, 0x46, 0x24, 0xf0, 0x22,
+	0x3c, 0xc2, 0xdc, 0xd0, 0xda, 0x95, 0x4e, 0xed, 0xc1, 0xa2, 0xad, 0xca, 0x54, 0x3d, 0x8a, 0x9e,
+	0xec, 0x35, 0x8a, 0x49, 0xef, 0xfe, 0xd1, 0xf7, 0x56, 0xe9, 0xc3, 0x49, 0xab, 0x13, 0x62, 0xfe,
+	0x2a, 0x1d, 0xd8, 0x3e, 0x1d, 0xa9, 0x9e, 0xd4, 0x9f, 0x2e, 0x0b, 0xf6, 0x1c, 0x9e, 0xc5, 0x88,
+	0x09, 0x00, 0x73, 0xe7, 0x84, 0xca, 0x4e, 0x2e, 0xf2, 0x24, 0xd7, 0xd0, 0x1f, 0x03, 0x1d, 0x46,
+	0x11, 0x3d, 0x40, 0x81, 0x97, 0x20, 0x1f, 0xc7, 0x18, 0x11, 0xce, 0x8c, 0x72, 0xbb, 0xd2, 0xb9,
+	0xd9, 0x33, 0xbe, 0x7e, 0xee, 0x36, 0x94, 0xf8, 0x6a, 0x10, 0x24, 0x88, 0xb1, 0x1d, 0x9e, 0x60,
+	0x12, 0xba, 0xf3, 0x0a, 0xe3, 0x8e, 0x21, 0xfa, 0x12, 0x98, 0x2d, 0x88, 0x46, 0x2c, 0x64, 0x46,
+	0x25, 0xa7, 0x70, 0x6b, 0xea, 0xae, 0xcf, 0x42, 0xa6, 0x6f, 0x80, 0xd9, 0x34, 0x0e, 0x20, 0x47,
+	0x5e, 0x80, 0x22, 0x98, 0x19, 0x53, 0x6d, 0x4d, 0xf4, 0x27, 0xe7, 0x64, 0x17, 0x73, 0xb2, 0xd7,
+	0xd5, 0x1c, 0x7b, 0x37, 0xf2, 0xfe, 0xde, 0x9d, 0xb4, 0x34, 0xb7, 0x26, 0x81, 0xeb, 0x39, 0xce,
+	0x7a, 0xaf, 0x81, 0xfa, 0x36, 0x22, 0x01, 0x26, 0xa1, 0x1a, 0xdf, 0x06, 0xa8, 0x4a, 0xa3, 0x18,
+	0x9a, 0xe0, 0xec, 0xd8, 0xbf, 0x73, 0x8a, 0x2d, 0x91, 0xbd, 0xa9, 0x5c, 0xc2, 0x55, 0x68, 0x7d,
+	0x0b, 0xdc, 0x42, 0xc3, 0x21, 0xf2, 0x39, 0xde, 0x47, 0x5e, 0xbe, 0x2e, 0xa3, 0x2c, 0xf8, 0x9a,
+	0x97, 0x6a, 0xdc, 0x2d, 0x76, 0x29, 0x8b, 0x3c, 0xcc, 0x8b, 0xac, 0x8f, 0xb1, 0x79, 0xd4, 0xfa,
+	0xa8, 0x81, 0x9a, 0x98, 0xf4, 0x73, 0x4c, 0x02, 0x7a, 0xa0, 0x3f, 0x02, 0xd3, 0x8c, 0xc3, 0x84,
+	0x1b, 0xda, 0x5f, 0x70, 0x4a, 0x88, 0x0e, 0xc1, 0x74, 0xee, 0x0c, 0x2e, 0x36, 0x33, 0x61, 0x4f,
+	0x48, 0x66, 0xeb, 0x93, 0x06, 0x1a, 0x7d, 0x16, 0x6e, 0x12, 0xcc, 0xe5, 0x6c, 0x56, 0xe5, 0xec,
+	0xf4, 0x2e, 0x98, 0x89, 0xd3, 0x81, 0xb7, 0x87, 0x8a, 0xe9, 0x36, 0x2e, 0x55, 0xbe, 0x4a, 0x32,
+	0xb7, 0x1a, 0xa7, 0x83, 0x2d, 0x94, 0xe9, 0xcb, 0xa0, 0x8e, 0x09, 0xe6, 0x1e, 0x43, 0xaf, 0x53,
+	0x44, 0x7c, 0x39, 0xc2, 0x29, 0x77, 0x36, 0xbf, 0xdc, 0x51, 0x77, 0xe7, 0x16, 0x56, 0xf9, 0x9f,
+	0x85, 0x59, 0x26, 0xb8, 0x7b, 0x55, 0xcd, 0x2e, 0x62, 0x31, 0x25, 0x0c, 0x59, 0x3e, 0x58, 0xec,
+	0xb3, 0xb0, 0x8f, 0xc3, 0x04, 0x72, 0xb4, 0x4b, 0x7f, 0x6d, 0x6c, 0x42, 0xae, 0xb1, 0x96, 0xc1,
+	0xd2, 0xb5, 0x22, 0xe3, 0x4a, 0x5e, 0x80, 0xb9, 0x3e, 0x0b, 0x9f, 0x09, 0x1b, 0x4f, 0xd6, 0xb5,
+	0xd6, 0x10, 0x2c, 0x5c, 0xa0, 0x2e, 0x54, 0xaf, 0x30, 0xb4, 0xf6, 0xef, 0x86, 0x5e, 0x00, 0x77,
+	0xfa, 0x2c, 0x5c, 0x83, 0xc4, 0x47, 0x91, 0xd4, 0x91, 0x9a, 0x56, 0x0b, 0xdc, 0xbb, 0x32, 0x30,
+	0x6e, 0xbe, 0x0e, 0x6a, 0x4f, 0x53, 0x94, 0x64, 0x32, 0x68, 0xbd, 0x2d, 0x83, 0xdb, 0xe7, 0xce,
+	0xe3, 0x6a, 0x27, 0xf5, 0x8c, 0x37, 0xc1, 0x4c, 0x2c, 0xbf, 0x0f, 0xea, 0xfd, 0x3a, 0x7f, 0x40,
+	0x74, 0xfe, 0x83, 0xe2, 0x16, 0x78, 0x3d, 0x02, 0x35, 0xf1, 0x3c, 0x3c, 0x4e, 0x03, 0x98, 0x19,
+	0x95, 0xc9, 0x3f, 0x3f, 0x20, 0xf8, 0x77, 0x73, 0xfa, 0x5e, 0xef, 0xe8, 0xd4, 0xd4, 0x8e, 0x4f,
+	0x4d, 0xed, 0xc7, 0xa9, 0xa9, 0x1d, 0x9e, 0x99, 0xa5, 0xe3, 0x33, 0xb3, 0xf4, 0xed, 0xcc, 0x2c,
+	0xbd, 0xec, 0x48, 0x34, 0x0b, 0xf6, 0x6c, 0x4c, 0x9d, 0x37, 0xd7, 0xff, 0x62, 0x0e, 0xaa, 0x62,
+	0xa5, 0x0f, 0x7f, 0x0e, 0x00, 0x74, 0x5a, 0x49, 0x11, 0x5c, 0x07, 0x00, 0x00,
+}
+
+func (m *Policy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UpdateDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UpdateDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPolicy(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgs[iNdEx])
+			i = encodeVarintPolicy(dAtA, i, uint64(len(m.AllowedMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintPolicy(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DailySpendLimit) > 0 {
+		for iNdEx := len(m.DailySpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailySpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPolicy(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintPolicy(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitPolicyAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitPolicyAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitPolicyAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.InitSequence != 0 {
+		i = encodeVarintPolicy(dAtA, i, uint64(m.InitSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPolicy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitPolicyAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitPolicyAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitPolicyAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateToPolicyAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateToPolicyAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateToPolicyAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateToPolicyAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateToPolicyAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateToPolicyAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintPolicy(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPolicyUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPolicyUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPolicyUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPolicyUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPolicyUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpentToday) > 0 {
+		for iNdEx := len(m.SpentToday) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentToday[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPolicy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Policy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DailySpendLimit) > 0 {
+		for _, e := range m.DailySpendLimit {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	if len(m.AllowedMsgs) > 0 {
+		for _, s := range m.AllowedMsgs {
+			l = len(s)
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UpdateDelay)
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func (m *PendingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func (m *SpendWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovPolicy(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgInitPolicyAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if m.InitSequence != 0 {
+		n += 1 + sovPolicy(uint64(m.InitSequence))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func (m *MsgInitPolicyAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateToPolicyAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateToPolicyAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func (m *MsgCancelPolicyUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPolicyUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	if len(m.SpentToday) > 0 {
+		for _, e := range m.SpentToday {
+			l = e.Size()
+			n += 1 + l + sovPolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPolicy(x uint64) (n int) {
+	return sovPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Policy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailySpendLimit = append(m.DailySpendLimit, types.Coin{})
+			if err := m.DailySpendLimit[len(m.DailySpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UpdateDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitPolicyAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitPolicyAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitPolicyAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitSequence", wireType)
+			}
+			m.InitSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitPolicyAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitPolicyAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitPolicyAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateToPolicyAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateToPolicyAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateToPolicyAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateToPolicyAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateToPolicyAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateToPolicyAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPolicyUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPolicyUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPolicyUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPolicyUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPolicyUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPolicyUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pending == nil {
+				m.Pending = &PendingPolicy{}
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentToday", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentToday = append(m.SpentToday, types.Coin{})
+			if err := m.SpentToday[len(m.SpentToday)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1675 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/defaults/base/v1/session.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SessionKey defines a key delegated by the owner of a session-key account,
+// which can authenticate txs in its scope until it expires.
+type SessionKey struct {
+	// pub_key defines the pubkey of the session key arbitrary encapsulated.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// expiration defines the time after which the session key cannot authenticate txs.
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// allowed_msgs defines the type URLs of the messages the session key can
+	// authenticate. If empty, any message, or only the messages whose spending is
+	// accounted (bank MsgSend and MsgMultiSend) if spend_limit is set.
+	AllowedMsgs []string `protobuf:"bytes,3,rep,name=allowed_msgs,json=allowedMsgs,proto3" json:"allowed_msgs,omitempty"`
+	// spend_limit defines the maximum coins the session key can spend, unlimited
+	// if empty.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spent defines the coins already spent by the session key.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *SessionKey) Reset()         { *m = SessionKey{} }
+func (m *SessionKey) String() string { return proto.CompactTextString(m) }
+func (*SessionKey) ProtoMessage()    {}
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd7c00f3ffc8a9b6, []int{0}
+}
+func (m *SessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKey.Merge(m, src)
+}
+func (m *SessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKey proto.InternalMessageInfo
+
+func (m *SessionKey) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SessionKey) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func (m *SessionKey) GetAllowedMsgs() []string {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+func (m *SessionKey) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *SessionKey) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// MsgAddSessionKey is used by the owner to register a session key, replacing
+// the session key with the same pubkey if any.
+type MsgAddSessionKey struct {
+	// pub_key defines the pubkey of the session key arbitrary encapsulated.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// expiration defines the time after which the session key cannot authenticate txs.
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// allowed_msgs defines the type URLs of the messages the session key can
+	// authenticate. If empty, any message, or only the messages whose spending is
+	// accounted (bank MsgSend and MsgMultiSend) if spend_limit is set.
+	AllowedMsgs []string `protobuf:"bytes,3,rep,name=allowed_msgs,json=allowedMsgs,proto3" json:"allowed_msgs,omitempty"`
+	// spend_limit defines the maximum coins the session key can spend, unlimited
+	// if empty.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *MsgAddSessionKey) Reset()         { *m = MsgAddSessionKey{} }
+func (m *MsgAddSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSessionKey) ProtoMessage()    {}
+func (*MsgAddSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd7c00f3ffc8a9b6, []int{1}
+}
+func (m *MsgAddSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSessionKey.Merge(m, src)
+}
+func (m *MsgAddSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSessionKey proto.InternalMessageInfo
+
+func (m *MsgAddSessionKey) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *MsgAddSessionKey) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func (m *MsgAddSessionKey) GetAllowedMsgs() []string {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+func (m *MsgAddSessionKey) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// MsgAddSessionKeyResponse is the response for the MsgAddSessionKey message.
+// This is empty.
+type MsgAddSessionKeyResponse struct {
+}
+
+func (m *MsgAddSessionKeyResponse) Reset()         { *m = MsgAddSessionKeyResponse{} }
+func (m *MsgAddSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSessionKeyResponse) ProtoMessage()    {}
+func (*MsgAddSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd7c00f3ffc8a9b6, []int{2}
+}
+func (m *MsgAddSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgAddSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSessionKeyResponse proto.InternalMessageInfo
+
+// MsgRevokeSessionKey is used by the owner to revoke a session key.
+type MsgRevokeSessionKey struct {
+	// pub_key defines the pubkey of the session key arbitrary encapsulated.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *MsgRevokeSessionKey) Reset()         { *m = MsgRevokeSessionKey{} }
+func (m *MsgRevokeSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKey) ProtoMessage()    {}
+func (*MsgRevokeSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd7c00f3ffc8a9b6, []int{3}
+}
+func (m *MsgRevokeSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKey.Merge(m, src)
+}
+func (m *MsgRevokeSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSessionKey proto.InternalMessageInfo
+
+func (m *MsgRevokeSessionKey) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// MsgRevokeSessionKeyResponse is the response for the MsgRevokeSessionKey message.
+// This is empty.
+type MsgRevokeSessionKeyResponse struct {
+}
+
+func (m *MsgRevokeSessionKeyResponse) Reset()         { *m = MsgRevokeSessionKeyResponse{} }
+func (m *MsgRevokeSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd7c00f3ffc8a9b6, []int{4}
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSessionKeyResponse proto.InternalMessageInfo
+
+// QuerySessionKeys is the request used to query the session keys of an account.
+type QuerySessionKeys struct {
+}
+
+func (m *QuerySessionKeys) Reset()         { *m = QuerySessionKeys{} }
+func (m *QuerySessionKeys) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeys) ProtoMessage()    {}
+func (*QuerySessionKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd7c00f3ffc8a9b6, []int{5}
+}
+func (m *QuerySessionKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeys.Merge(m, src)
+}
+func (m *QuerySessionKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeys proto.InternalMessageInfo
+
+// QuerySessionKeysResponse returns the session keys of the account, including
+// the expired ones which were not revoked.
+type QuerySessionKeysResponse struct {
+	SessionKeys []SessionKey `protobuf:"bytes,1,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
+}
+
+func (m *QuerySessionKeysResponse) Reset()         { *m = QuerySessionKeysResponse{} }
+func (m *QuerySessionKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeysResponse) ProtoMessage()    {}
+func (*QuerySessionKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd7c00f3ffc8a9b6, []int{6}
+}
+func (m *QuerySessionKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeysResponse.Merge(m, src)
+}
+func (m *QuerySessionKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeysResponse proto.InternalMessageInfo
+
+func (m *QuerySessionKeysResponse) GetSessionKeys() []SessionKey {
+	if m != nil {
+		return m.SessionKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SessionKey)(nil), "cosmos.accounts.defaults.base.v1.SessionKey")
+	proto.RegisterType((*MsgAddSessionKey)(nil), "cosmos.accounts.defaults.base.v1.MsgAddSessionKey")
+	proto.RegisterType((*MsgAddSessionKeyResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgAddSessionKeyResponse")
+	proto.RegisterType((*MsgRevokeSessionKey)(nil), "cosmos.accounts.defaults.base.v1.MsgRevokeSessionKey")
+	proto.RegisterType((*MsgRevokeSessionKeyResponse)(nil), "cosmos.accounts.defaults.base.v1.MsgRevokeSessionKeyResponse")
+	proto.RegisterType((*QuerySessionKeys)(nil), "cosmos.accounts.defaults.base.v1.QuerySessionKeys")
+	proto.RegisterType((*QuerySessionKeysResponse)(nil), "cosmos.accounts.defaults.base.v1.QuerySessionKeysResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/defaults/base/v1/session.proto", fileDescriptor_cd7c00f3ffc8a9b6)
+}
+
+var fileDescriptor_cd7c00f3ffc8a9b6 = []byte{
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x3f, 0x72, 0xd3, 0x40,
+	0x14, 0xc6, 0x2d, 0x3b, 0x09, 0xb0, 0x4a, 0x91, 0x11, 0x29, 0x14, 0x33, 0xc8, 0x46, 0x95, 0x0a,
+	0xb2, 0x8b, 0xc3, 0x09, 0x22, 0xd2, 0x81, 0x0b, 0x04, 0x34, 0x34, 0x1e, 0xfd, 0xd9, 0x2c, 0x3b,
+	0x96, 0xf4, 0x84, 0xdf, 0xca, 0x44, 0xb7, 0x48, 0xc9, 0x19, 0x38, 0x49, 0xca, 0x94, 0x54, 0x84,
+	0xb1, 0xcf, 0x40, 0xcf, 0x48, 0x5a, 0x61, 0x30, 0x30, 0xcc, 0x30, 0x94, 0x54, 0xda, 0x7d, 0xef,
+	0xfb, 0x7e, 0x6f, 0xf5, 0x69, 0x56, 0x84, 0xc6, 0x80, 0x19, 0x20, 0x0b, 0xe3, 0x18, 0xca, 0x5c,
+	0x21, 0x4b, 0xf8, 0x79, 0x58, 0xa6, 0x0a, 0x59, 0x14, 0x22, 0x67, 0xcb, 0x09, 0x43, 0x8e, 0x28,
+	0x21, 0xa7, 0xc5, 0x02, 0x14, 0x58, 0xe3, 0x56, 0x4f, 0x3b, 0x3d, 0xed, 0xf4, 0xb4, 0xd6, 0xd3,
+	0xe5, 0x64, 0xe8, 0x68, 0xa2, 0xf6, 0x47, 0x5c, 0x85, 0x13, 0x16, 0x83, 0xd4, 0x84, 0xe1, 0xa1,
+	0x00, 0x01, 0xcd, 0x92, 0xd5, 0x2b, 0x5d, 0x3d, 0x12, 0x00, 0x22, 0xe5, 0xac, 0xd9, 0x45, 0xe5,
+	0x39, 0x0b, 0xf3, 0x4a, 0xb7, 0x46, 0xdb, 0x2d, 0x25, 0x33, 0x8e, 0x2a, 0xcc, 0x8a, 0x56, 0xe0,
+	0x7e, 0xe9, 0x13, 0xf2, 0xa2, 0x3d, 0xe5, 0x53, 0x5e, 0x59, 0xc7, 0xe4, 0x56, 0x51, 0x46, 0xb3,
+	0x39, 0xaf, 0x6c, 0x63, 0x6c, 0x78, 0xe6, 0xc9, 0x21, 0x6d, 0x09, 0xb4, 0x23, 0xd0, 0xd3, 0xbc,
+	0x0a, 0xf6, 0x8a, 0x32, 0xaa, 0xe5, 0x67, 0x84, 0xf0, 0x8b, 0x42, 0x2e, 0x42, 0x25, 0x21, 0xb7,
+	0xfb, 0x8d, 0x63, 0xf8, 0x93, 0xe3, 0x65, 0x37, 0xd3, 0xbf, 0x7d, 0xf5, 0x69, 0xd4, 0xbb, 0xbc,
+	0x19, 0x19, 0xc1, 0x77, 0x3e, 0xeb, 0x01, 0xd9, 0x0f, 0xd3, 0x14, 0xde, 0xf1, 0x64, 0x96, 0xa1,
+	0x40, 0x7b, 0x30, 0x1e, 0x78, 0x77, 0x02, 0x53, 0xd7, 0xa6, 0x28, 0xd0, 0x4a, 0x89, 0x89, 0x05,
+	0xcf, 0x93, 0x59, 0x2a, 0x33, 0xa9, 0xec, 0x9d, 0xf1, 0xc0, 0x33, 0x4f, 0x8e, 0xf4, 0x07, 0xe8,
+	0xe2, 0x6b, 0xe2, 0xa2, 0x4f, 0x40, 0xe6, 0xfe, 0xa3, 0x7a, 0xd0, 0x87, 0x9b, 0x91, 0x27, 0xa4,
+	0x7a, 0x53, 0x46, 0x34, 0x86, 0x8c, 0xe9, 0x6c, 0xdb, 0xc7, 0x31, 0x26, 0x73, 0xa6, 0xaa, 0x82,
+	0x63, 0x63, 0xc0, 0x80, 0x34, 0xfc, 0x67, 0x35, 0xde, 0x0a, 0xc9, 0x6e, 0xbd, 0x53, 0xf6, 0xee,
+	0xbf, 0x9f, 0xd3, 0x92, 0xdd, 0xf7, 0x7d, 0x72, 0x30, 0x45, 0x71, 0x9a, 0x24, 0xff, 0xd3, 0xff,
+	0x31, 0x7d, 0x77, 0x48, 0xec, 0xed, 0x64, 0x02, 0x8e, 0x05, 0xe4, 0xc8, 0xdd, 0x33, 0x72, 0x77,
+	0x8a, 0x22, 0xe0, 0x4b, 0x98, 0xf3, 0xbf, 0x0e, 0xce, 0xbd, 0x4f, 0xee, 0xfd, 0x82, 0xf2, 0x6d,
+	0x88, 0x45, 0x0e, 0x9e, 0x97, 0x7c, 0x51, 0x6d, 0x5a, 0xe8, 0xbe, 0x25, 0xf6, 0x76, 0xad, 0xd3,
+	0x5b, 0xaf, 0xc8, 0xbe, 0xbe, 0xe8, 0xf5, 0x09, 0xd0, 0x36, 0x9a, 0x7c, 0x1e, 0xd2, 0x3f, 0x5d,
+	0x77, 0xba, 0x81, 0xf9, 0x3b, 0x75, 0x64, 0x81, 0x89, 0x1b, 0xbc, 0xef, 0x5f, 0xad, 0x1c, 0xe3,
+	0x7a, 0xe5, 0x18, 0x9f, 0x57, 0x8e, 0x71, 0xb9, 0x76, 0x7a, 0xd7, 0x6b, 0xa7, 0xf7, 0x71, 0xed,
+	0xf4, 0x5e, 0x7b, 0x2d, 0x19, 0x93, 0x39, 0x95, 0xc0, 0x2e, 0x7e, 0xff, 0x03, 0x8a, 0xf6, 0x9a,
+	0xf7, 0x7f, 0xfc, 0x75, 0x00, 0xc9, 0x2c, 0x96, 0x6e, 0xab, 0x04, 0x00, 0x00,
+}
+
+func (m *SessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSession(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSession(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgs[iNdEx])
+			i = encodeVarintSession(dAtA, i, uint64(len(m.AllowedMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSession(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSession(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSession(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgs[iNdEx])
+			i = encodeVarintSession(dAtA, i, uint64(len(m.AllowedMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSession(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSession(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSession(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeys) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeys) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSession(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSession(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovSession(uint64(l))
+	if len(m.AllowedMsgs) > 0 {
+		for _, s := range m.AllowedMsgs {
+			l = len(s)
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovSession(uint64(l))
+	if len(m.AllowedMsgs) > 0 {
+		for _, s := range m.AllowedMsgs {
+			l = len(s)
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovSession(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySessionKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySessionKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSession(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSession(x uint64) (n int) {
+	return sovSession(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSessionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSessionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSessionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSessionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySessionKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySessionKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySessionKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySessionKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySessionKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySessionKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeys = append(m.SessionKeys, SessionKey{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSession(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSession
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSession
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSession
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSession        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSession          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSession = fmt.Errorf("proto: unexpected end of group")
+)
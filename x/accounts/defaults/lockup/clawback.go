@@ -0,0 +1,206 @@
+package lockup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// newClawbackLockup creates a new ClawbackLockup object.
+func newClawbackLockup(d accountstd.Dependencies) *ClawbackLockup {
+	return &ClawbackLockup{
+		Funder:       collections.NewItem(d.SchemaBuilder, FunderPrefix, "funder", collections.BytesValue),
+		ClawbackTime: collections.NewItem(d.SchemaBuilder, ClawbackTimePrefix, "clawback_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		ClawedBack:   collections.NewMap(d.SchemaBuilder, ClawedBackPrefix, "clawed_back", collections.StringKey, sdk.IntValue),
+	}
+}
+
+// ClawbackLockup lets the funder of a lockup account take back its locked coins.
+// The lockup schedule is frozen at the first clawback, the coins unlocked
+// before it stay with the owner, and the locked coins are sent back to the
+// funder. The locked coins which are delegated cannot be sent, they are clawed
+// back by the next clawbacks once the owner undelegates them.
+type ClawbackLockup struct {
+	// Funder is the address which can claw back the locked coins, clawback is
+	// disabled if not set.
+	Funder collections.Item[[]byte]
+	// ClawbackTime is the time of the first clawback.
+	ClawbackTime collections.Item[time.Time]
+	ClawedBack   collections.Map[string, math.Int]
+}
+
+func (c ClawbackLockup) initFunder(ctx context.Context, bva *BaseLockup, funder string) error {
+	if funder == "" {
+		return nil
+	}
+	funderAddr, err := bva.addressCodec.StringToBytes(funder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'funder' address: %s", err)
+	}
+	return c.Funder.Set(ctx, funderAddr)
+}
+
+// scheduleTime returns the time at which the lockup schedule is evaluated for
+// the given block time, which is frozen by the first clawback.
+func (c ClawbackLockup) scheduleTime(ctx context.Context, blockTime time.Time) (time.Time, error) {
+	clawbackTime, err := c.ClawbackTime.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return blockTime, nil
+	case err != nil:
+		return time.Time{}, err
+	case clawbackTime.Before(blockTime):
+		return clawbackTime, nil
+	}
+	return blockTime, nil
+}
+
+// clawedBackCoin returns the coin of the denom already clawed back.
+func (c ClawbackLockup) clawedBackCoin(ctx context.Context, denom string) (sdk.Coin, error) {
+	amount, err := c.ClawedBack.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	}
+	return sdk.NewCoin(denom, amount), err
+}
+
+// clawback sends the locked coins which are not delegated back to the funder.
+func (c ClawbackLockup) clawback(
+	ctx context.Context, bva *BaseLockup, msg *lockuptypes.MsgClawback, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	funder, err := c.Funder.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errors.New("clawback is disabled for this lockup account")
+	}
+	if err != nil {
+		return nil, err
+	}
+	sender, err := bva.addressCodec.StringToBytes(msg.Funder)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err.Error())
+	}
+	if !bytes.Equal(funder, accountstd.Sender(ctx)) || !bytes.Equal(funder, sender) {
+		return nil, errors.New("sender is not the funder of this lockup account")
+	}
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := bva.headerService.HeaderInfo(ctx)
+	has, err := c.ClawbackTime.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		if err := c.ClawbackTime.Set(ctx, hs.Time); err != nil {
+			return nil, err
+		}
+	}
+
+	var denoms []string
+	err = bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(denom string, _ math.Int) (stop bool, err error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.Coins{}
+	for _, denom := range denoms {
+		notBondedLockedCoin, err := bva.GetNotBondedLockedCoin(ctx, sdk.NewCoin(denom, lockedCoins.AmountOf(denom)), denom)
+		if err != nil {
+			return nil, err
+		}
+		balance, err := bva.getBalance(ctx, fromAddress, denom)
+		if err != nil {
+			return nil, err
+		}
+		clawbackAmt := math.MinInt(notBondedLockedCoin.Amount, balance.Amount)
+		if !clawbackAmt.IsPositive() {
+			continue
+		}
+		amount = append(amount, sdk.NewCoin(denom, clawbackAmt))
+
+		clawedBack, err := c.clawedBackCoin(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		err = c.ClawedBack.Set(ctx, denom, clawedBack.Amount.Add(clawbackAmt))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(amount) == 0 {
+		return nil, errors.New("no locked tokens available for clawback")
+	}
+
+	funderAddress, err := bva.addressCodec.BytesToString(funder)
+	if err != nil {
+		return nil, err
+	}
+	msgSend := &banktypes.MsgSend{
+		FromAddress: fromAddress,
+		ToAddress:   funderAddress,
+		Amount:      amount,
+	}
+	_, err = sendMessage(ctx, msgSend)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgClawbackResponse{AmountClawedBack: amount}, nil
+}
+
+// queryClawbackInfo returns the funder of the account and the coins clawed back.
+func (c ClawbackLockup) queryClawbackInfo(ctx context.Context, bva *BaseLockup) (*lockuptypes.QueryClawbackInfoResponse, error) {
+	resp := &lockuptypes.QueryClawbackInfoResponse{ClawedBack: sdk.Coins{}}
+	funder, err := c.Funder.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		resp.Funder, err = bva.addressCodec.BytesToString(funder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	clawbackTime, err := c.ClawbackTime.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		resp.ClawbackTime = &clawbackTime
+	}
+
+	err = c.ClawedBack.Walk(ctx, nil, func(denom string, amount math.Int) (stop bool, err error) {
+		resp.ClawedBack = append(resp.ClawedBack, sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
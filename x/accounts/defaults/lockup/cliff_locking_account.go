@@ -0,0 +1,292 @@
+package lockup
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Compile-time type assertions
+var _ accountstd.Interface = (*CliffLockingAccount)(nil)
+
+// NewCliffLockingAccount creates a new CliffLockingAccount object.
+func NewCliffLockingAccount(d accountstd.Dependencies) (*CliffLockingAccount, error) {
+	baseLockup := newBaseLockup(d)
+
+	cliffLockingAccount := CliffLockingAccount{
+		BaseLockup:     baseLockup,
+		ClawbackLockup: newClawbackLockup(d),
+		StartTime:      collections.NewItem(d.SchemaBuilder, StartTimePrefix, "start_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		CliffTime:      collections.NewItem(d.SchemaBuilder, CliffTimePrefix, "cliff_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+	}
+
+	return &cliffLockingAccount, nil
+}
+
+// CliffLockingAccount unlocks no coins before its cliff time, then unlocks its
+// coins linearly from its start time until its end time: the coins which would
+// have been unlocked since the start time are unlocked at once at the cliff.
+type CliffLockingAccount struct {
+	*BaseLockup
+	*ClawbackLockup
+	StartTime collections.Item[time.Time]
+	CliffTime collections.Item[time.Time]
+}
+
+func (cla CliffLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitCliffLockingAccount) (*lockuptypes.MsgInitCliffLockingAccountResponse, error) {
+	if msg.EndTime.IsZero() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid end time %s", msg.EndTime.String())
+	}
+
+	hs := cla.headerService.HeaderInfo(ctx)
+
+	start := msg.StartTime
+	if msg.StartTime.IsZero() {
+		start = hs.Time
+	}
+
+	if !msg.EndTime.After(start) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid start and end time (must be start before end)")
+	}
+	if msg.CliffTime.Before(start) || msg.CliffTime.After(msg.EndTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid cliff time (must be between start and end)")
+	}
+
+	err := cla.StartTime.Set(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+	err = cla.CliffTime.Set(ctx, msg.CliffTime)
+	if err != nil {
+		return nil, err
+	}
+	err = cla.initFunder(ctx, cla.BaseLockup, msg.Funder)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = cla.BaseLockup.Init(ctx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     msg.Owner,
+		EndTime:   msg.EndTime,
+		StartTime: start,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitCliffLockingAccountResponse{}, nil
+}
+
+func (cla *CliffLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.Delegate(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CliffLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return cla.BaseLockup.SendCoins(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CliffLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (
+	*lockuptypes.MsgWithdrawResponse, error,
+) {
+	return cla.BaseLockup.WithdrawUnlockedCoins(ctx, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+func (cla *CliffLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return cla.ClawbackLockup.clawback(ctx, cla.BaseLockup, msg, cla.GetLockedCoinsWithDenoms)
+}
+
+// GetLockCoinsInfo returns the total number of unlocked and locked coins.
+func (cla CliffLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
+	unlockedCoins = sdk.NewCoins()
+	lockedCoins = sdk.NewCoins()
+
+	err = cla.IterateCoinEntries(ctx, cla.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
+		unlockedCoin, lockedCoin, err := cla.GetLockCoinInfoWithDenom(ctx, blockTime, key)
+		if err != nil {
+			return true, err
+		}
+		unlockedCoins = unlockedCoins.Add(*unlockedCoin)
+		lockedCoins = lockedCoins.Add(*lockedCoin)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unlockedCoins, lockedCoins, nil
+}
+
+// GetLockCoinInfoWithDenom returns the number of unlocked and locked coins for
+// a specific denom, the coins clawed back being neither unlocked nor locked.
+func (cla CliffLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	blockTime, err = cla.scheduleTime(ctx, blockTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	originalLockingAmt, err := cla.OriginalLocking.Get(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+	clawedBack, err := cla.clawedBackCoin(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	originalLocking := sdk.NewCoin(denom, originalLockingAmt)
+	unlocked, err := cla.unlockedCoin(ctx, blockTime, originalLocking)
+	if err != nil {
+		return nil, nil, err
+	}
+	locked := originalLocking.Sub(unlocked).Sub(clawedBack)
+
+	return &unlocked, &locked, nil
+}
+
+// unlockedCoin returns the coin unlocked at the given time by the schedule.
+func (cla CliffLockingAccount) unlockedCoin(ctx context.Context, blockTime time.Time, originalLocking sdk.Coin) (sdk.Coin, error) {
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	cliffTime, err := cla.CliffTime.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	endTime, err := cla.EndTime.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if blockTime.Before(cliffTime) {
+		return sdk.NewCoin(originalLocking.Denom, math.ZeroInt()), nil
+	} else if !blockTime.Before(endTime) {
+		return originalLocking, nil
+	}
+
+	// calculate the locking scalar
+	x := blockTime.Unix() - startTime.Unix()
+	y := endTime.Unix() - startTime.Unix()
+	s := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	unlockedAmt := math.LegacyNewDecFromInt(originalLocking.Amount).Mul(s).RoundInt()
+	return sdk.NewCoin(originalLocking.Denom, unlockedAmt), nil
+}
+
+// GetLockedCoinsWithDenoms returns the number of locked coin for a specific denom.
+func (cla CliffLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+	lockedCoins := sdk.Coins{}
+	for _, denom := range denoms {
+		_, lockedCoin, err := cla.GetLockCoinInfoWithDenom(ctx, blockTime, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = append(lockedCoins, *lockedCoin)
+	}
+
+	return lockedCoins, nil
+}
+
+func (cla CliffLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
+	*lockuptypes.QueryLockupAccountInfoResponse, error,
+) {
+	resp, err := cla.BaseLockup.QueryLockupAccountBaseInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hs := cla.headerService.HeaderInfo(ctx)
+	unlockedCoins, lockedCoins, err := cla.GetLockCoinsInfo(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+	resp.StartTime = &startTime
+	resp.LockedCoins = lockedCoins
+	resp.UnlockedCoins = unlockedCoins
+	return resp, nil
+}
+
+func (cla CliffLockingAccount) QueryCliffSchedule(ctx context.Context, _ *lockuptypes.QueryCliffScheduleRequest) (
+	*lockuptypes.QueryCliffScheduleResponse, error,
+) {
+	startTime, err := cla.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cliffTime, err := cla.CliffTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := cla.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cliffAmount := sdk.NewCoins()
+	err = cla.IterateCoinEntries(ctx, cla.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
+		unlocked, err := cla.unlockedCoin(ctx, cliffTime, sdk.NewCoin(key, value))
+		if err != nil {
+			return true, err
+		}
+		cliffAmount = cliffAmount.Add(unlocked)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hs := cla.headerService.HeaderInfo(ctx)
+	unlockedCoins, lockedCoins, err := cla.GetLockCoinsInfo(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+	return &lockuptypes.QueryCliffScheduleResponse{
+		StartTime:     startTime,
+		CliffTime:     cliffTime,
+		EndTime:       endTime,
+		CliffAmount:   cliffAmount,
+		LockedCoins:   lockedCoins,
+		UnlockedCoins: unlockedCoins,
+	}, nil
+}
+
+func (cla CliffLockingAccount) QueryClawbackInfo(ctx context.Context, _ *lockuptypes.QueryClawbackInfoRequest) (
+	*lockuptypes.QueryClawbackInfoResponse, error,
+) {
+	return cla.ClawbackLockup.queryClawbackInfo(ctx, cla.BaseLockup)
+}
+
+// Implement smart account interface
+func (cla CliffLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, cla.Init)
+}
+
+func (cla CliffLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, cla.Delegate)
+	accountstd.RegisterExecuteHandler(builder, cla.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, cla.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, cla.Clawback)
+	cla.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (cla CliffLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, cla.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, cla.QueryCliffSchedule)
+	accountstd.RegisterQueryHandler(builder, cla.QueryClawbackInfo)
+}
@@ -0,0 +1,127 @@
+package lockup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCliffAccountInit(t *testing.T) {
+	h, err := testutil.NewHarness(accountstd.AddAccount(CLIFF_LOCKING_ACCOUNT, NewCliffLockingAccount))
+	require.NoError(t, err)
+	owner, err := h.AddressCodec().BytesToString([]byte("owner"))
+	require.NoError(t, err)
+	startTime := h.HeaderInfo().Time
+
+	_, err = h.Init([]byte("owner"), &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     owner,
+		StartTime: startTime,
+		CliffTime: startTime.Add(-time.Hour),
+		EndTime:   startTime.Add(time.Hour),
+	}, nil)
+	require.ErrorContains(t, err, "invalid cliff time")
+
+	_, err = h.Init([]byte("owner"), &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     owner,
+		StartTime: startTime,
+		CliffTime: startTime.Add(2 * time.Hour),
+		EndTime:   startTime.Add(time.Hour),
+	}, nil)
+	require.ErrorContains(t, err, "invalid cliff time")
+}
+
+func TestCliffAccountHarness(t *testing.T) {
+	h, err := testutil.NewHarness(
+		accountstd.AddAccount(CLIFF_LOCKING_ACCOUNT, NewCliffLockingAccount),
+		testutil.WithStakingParams("stake", time.Hour),
+	)
+	require.NoError(t, err)
+
+	ownerAddr, funderAddr := []byte("owner"), []byte("funder")
+	owner, err := h.AddressCodec().BytesToString(ownerAddr)
+	require.NoError(t, err)
+	funder, err := h.AddressCodec().BytesToString(funderAddr)
+	require.NoError(t, err)
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	startTime := h.HeaderInfo().Time
+	h.SetBalance(funderAddr, stake(100))
+	_, err = h.Init(funderAddr, &lockuptypes.MsgInitCliffLockingAccount{
+		Owner:     owner,
+		StartTime: startTime,
+		CliffTime: startTime.Add(25 * time.Hour),
+		EndTime:   startTime.Add(100 * time.Hour),
+		Funder:    funder,
+	}, stake(100))
+	require.NoError(t, err)
+
+	// no coins are unlocked before the cliff
+	require.NoError(t, h.AdvanceTime(24*time.Hour))
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stake(1)}, nil)
+	require.ErrorContains(t, err, "spendable balance")
+
+	// the coins unlocked since the start time are unlocked at the cliff
+	require.NoError(t, h.AdvanceTime(time.Hour))
+	resp, err := h.Query(&lockuptypes.QueryCliffScheduleRequest{})
+	require.NoError(t, err)
+	schedule := resp.(*lockuptypes.QueryCliffScheduleResponse)
+	require.Equal(t, stake(25), schedule.CliffAmount)
+	require.Equal(t, stake(25), schedule.UnlockedCoins)
+	require.Equal(t, stake(75), schedule.LockedCoins)
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stake(25)}, nil)
+	require.NoError(t, err)
+
+	// then linearly until the end time
+	require.NoError(t, h.AdvanceTime(25*time.Hour))
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgDelegate{Sender: owner, ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 40)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, stake(35), h.Balance(h.Address()))
+
+	// only the funder can claw back the locked coins, the delegated ones stay
+	// locked until they are undelegated
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgClawback{Funder: owner}, nil)
+	require.ErrorContains(t, err, "sender is not the funder of this lockup account")
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgClawback{Funder: funder}, nil)
+	require.ErrorContains(t, err, "sender is not the funder of this lockup account")
+	_, err = h.Execute([]byte("mallory"), &lockuptypes.MsgClawback{Funder: funder}, nil)
+	require.ErrorContains(t, err, "sender is not the funder of this lockup account")
+	clawbackResp, err := h.Execute(funderAddr, &lockuptypes.MsgClawback{Funder: funder}, nil)
+	require.NoError(t, err)
+	require.Equal(t, stake(10), clawbackResp.(*lockuptypes.MsgClawbackResponse).AmountClawedBack)
+	require.Equal(t, stake(10), h.Balance(funderAddr))
+
+	// the schedule is frozen by the clawback
+	require.NoError(t, h.AdvanceTime(50*time.Hour))
+	resp, err = h.Query(&lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, stake(40), resp.(*lockuptypes.QueryLockupAccountInfoResponse).LockedCoins)
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgUndelegate{Sender: owner, ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 40)}, nil)
+	require.NoError(t, err)
+	require.NoError(t, h.AdvanceTime(time.Hour))
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stake(26)}, nil)
+	require.ErrorContains(t, err, "spendable balance")
+
+	clawbackResp, err = h.Execute(funderAddr, &lockuptypes.MsgClawback{Funder: funder}, nil)
+	require.NoError(t, err)
+	require.Equal(t, stake(40), clawbackResp.(*lockuptypes.MsgClawbackResponse).AmountClawedBack)
+	_, err = h.Execute(funderAddr, &lockuptypes.MsgClawback{Funder: funder}, nil)
+	require.ErrorContains(t, err, "no locked tokens available for clawback")
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stake(25)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, stake(50), h.Balance(ownerAddr))
+	require.Equal(t, stake(50), h.Balance(funderAddr))
+
+	resp, err = h.Query(&lockuptypes.QueryClawbackInfoRequest{})
+	require.NoError(t, err)
+	info := resp.(*lockuptypes.QueryClawbackInfoResponse)
+	require.Equal(t, funder, info.Funder)
+	require.Equal(t, startTime.Add(50*time.Hour), *info.ClawbackTime)
+	require.Equal(t, stake(50), info.ClawedBack)
+}
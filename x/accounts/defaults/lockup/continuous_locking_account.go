@@ -12,6 +12,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // Compile-time type assertions
@@ -186,6 +188,19 @@ func (cva ContinuousLockingAccount) QueryLockupAccountInfo(ctx context.Context,
 	return resp, nil
 }
 
+// AuthRetroCompatibility returns the account as an x/auth continuous vesting account.
+func (cva ContinuousLockingAccount) AuthRetroCompatibility(ctx context.Context, _ *authtypes.QueryLegacyAccount) (*authtypes.QueryLegacyAccountResponse, error) {
+	baseVestingAccount, err := cva.legacyBaseVestingAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := cva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newLegacyAccountResponse(vestingtypes.NewContinuousVestingAccountRaw(baseVestingAccount, startTime.Unix()), baseVestingAccount.BaseAccount)
+}
+
 // Implement smart account interface
 func (cva ContinuousLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, cva.Init)
@@ -200,4 +215,5 @@ func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 
 func (cva ContinuousLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, cva.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, cva.AuthRetroCompatibility)
 }
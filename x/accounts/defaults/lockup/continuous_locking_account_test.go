@@ -11,7 +11,10 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -234,3 +237,49 @@ func TestContinousAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestContinuousAccountHarness(t *testing.T) {
+	h, err := testutil.NewHarness(
+		accountstd.AddAccount(CONTINUOUS_LOCKING_ACCOUNT, NewContinuousLockingAccount),
+		testutil.WithStakingParams("stake", time.Hour),
+	)
+	require.NoError(t, err)
+
+	ownerAddr := []byte("owner")
+	owner, err := h.AddressCodec().BytesToString(ownerAddr)
+	require.NoError(t, err)
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	startTime := h.HeaderInfo().Time
+	h.SetBalance(ownerAddr, stake(100))
+	_, err = h.Init(ownerAddr, &lockuptypes.MsgInitLockupAccount{
+		Owner:     owner,
+		StartTime: startTime,
+		EndTime:   startTime.Add(100 * time.Hour),
+	}, stake(100))
+	require.NoError(t, err)
+	require.Equal(t, stake(100), h.Balance(h.Address()))
+
+	// the locked coins can be delegated
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgDelegate{Sender: owner, ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 60)}, nil)
+	require.NoError(t, err)
+	require.Len(t, h.SentMsgs(), 1)
+	require.IsType(t, &stakingtypes.MsgDelegate{}, h.SentMsgs()[0].Msg)
+	require.Equal(t, sdk.NewInt64Coin("stake", 60), h.Delegation(h.Address(), "val"))
+	require.Equal(t, stake(40), h.Balance(h.Address()))
+
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgUndelegate{Sender: owner, ValidatorAddress: "val", Amount: sdk.NewInt64Coin("stake", 60)}, nil)
+	require.NoError(t, err)
+	require.NoError(t, h.AdvanceTime(time.Hour))
+	require.Equal(t, stake(100), h.Balance(h.Address()))
+
+	// but not sent before they are unlocked
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stake(100)}, nil)
+	require.Error(t, err)
+	require.Equal(t, stake(100), h.Balance(h.Address()))
+
+	require.NoError(t, h.AdvanceTime(99*time.Hour))
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stake(100)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, stake(100), h.Balance(ownerAddr))
+}
@@ -10,6 +10,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // Compile-time type assertions
@@ -135,6 +137,15 @@ func (dva DelayedLockingAccount) QueryVestingAccountInfo(ctx context.Context, re
 	return resp, nil
 }
 
+// AuthRetroCompatibility returns the account as an x/auth delayed vesting account.
+func (dva DelayedLockingAccount) AuthRetroCompatibility(ctx context.Context, _ *authtypes.QueryLegacyAccount) (*authtypes.QueryLegacyAccountResponse, error) {
+	baseVestingAccount, err := dva.legacyBaseVestingAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newLegacyAccountResponse(vestingtypes.NewDelayedVestingAccountRaw(baseVestingAccount), baseVestingAccount.BaseAccount)
+}
+
 // Implement smart account interface
 func (dva DelayedLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, dva.Init)
@@ -149,4 +160,5 @@ func (dva DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.Exe
 
 func (dva DelayedLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, dva.QueryVestingAccountInfo)
+	accountstd.RegisterQueryHandler(builder, dva.AuthRetroCompatibility)
 }
@@ -11,6 +11,8 @@ func ProvideAllLockupAccounts() []accountstd.DepinjectAccount {
 		ProvideContinuousLockingAccount(),
 		ProvidePermanentLockingAccount(),
 		ProvideDelayedLockingAccount(),
+		ProvideCliffLockingAccount(),
+		ProvideMilestoneLockingAccount(),
 	}
 }
 
@@ -29,3 +31,11 @@ func ProvideDelayedLockingAccount() accountstd.DepinjectAccount {
 func ProvidePermanentLockingAccount() accountstd.DepinjectAccount {
 	return accountstd.DIAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount)
 }
+
+func ProvideCliffLockingAccount() accountstd.DepinjectAccount {
+	return accountstd.DIAccount(lockup.CLIFF_LOCKING_ACCOUNT, lockup.NewCliffLockingAccount)
+}
+
+func ProvideMilestoneLockingAccount() accountstd.DepinjectAccount {
+	return accountstd.DIAccount(lockup.MILESTONE_LOCKING_ACCOUNT, lockup.NewMilestoneLockingAccount)
+}
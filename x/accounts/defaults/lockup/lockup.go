@@ -36,6 +36,12 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	FunderPrefix           = collections.NewPrefix(8)
+	ClawbackTimePrefix     = collections.NewPrefix(9)
+	ClawedBackPrefix       = collections.NewPrefix(10)
+	CliffTimePrefix        = collections.NewPrefix(11)
+	MilestonesPrefix       = collections.NewPrefix(12)
+	UnlockerPrefix         = collections.NewPrefix(13)
 )
 
 var (
@@ -43,6 +49,8 @@ var (
 	DELAYED_LOCKING_ACCOUNT    = "delayed-locking-account"
 	PERIODIC_LOCKING_ACCOUNT   = "periodic-locking-account"
 	PERMANENT_LOCKING_ACCOUNT  = "permanent-locking-account"
+	CLIFF_LOCKING_ACCOUNT      = "cliff-locking-account"
+	MILESTONE_LOCKING_ACCOUNT  = "milestone-locking-account"
 )
 
 type getLockedCoinsFunc = func(ctx context.Context, time time.Time, denoms ...string) (sdk.Coins, error)
@@ -88,45 +96,61 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
-	funds := accountstd.Funds(ctx)
+	err = bva.initLockedCoins(ctx, accountstd.Funds(ctx))
+	if err != nil {
+		return nil, err
+	}
 
-	sortedAmt := funds.Sort()
+	err = bva.EndTime.Set(ctx, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
+}
+
+// initLockedCoins sets the original locking coins of the account, and the
+// initial value of its withdrawed and delegated coins. When the account is
+// migrated from an x/auth vesting account, they are instead taken from the
+// legacy account, as its coins are already held by the account.
+func (bva *BaseLockup) initLockedCoins(ctx context.Context, originalLocking sdk.Coins) error {
+	delegatedFree, delegatedLocking := sdk.Coins{}, sdk.Coins{}
+	if isMigration(ctx) {
+		legacyAcc, err := bva.getLegacyVestingAccount(ctx)
+		if err != nil {
+			return err
+		}
+		originalLocking = legacyAcc.GetOriginalVesting()
+		delegatedFree, delegatedLocking = legacyAcc.GetDelegatedFree(), legacyAcc.GetDelegatedVesting()
+	}
+
+	sortedAmt := originalLocking.Sort()
 	for _, coin := range sortedAmt {
-		err = bva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
+		err := bva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		// Set initial value for all locked token
+		// Set initial value for all withdrawed token
 		err = bva.WithdrawedCoins.Set(ctx, coin.Denom, math.ZeroInt())
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	bondDenom, err := getStakingDenom(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Set initial value for all locked token
-	err = bva.DelegatedFree.Set(ctx, bondDenom, math.ZeroInt())
+	err = bva.DelegatedFree.Set(ctx, bondDenom, delegatedFree.AmountOf(bondDenom))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Set initial value for all locked token
-	err = bva.DelegatedLocking.Set(ctx, bondDenom, math.ZeroInt())
-	if err != nil {
-		return nil, err
-	}
-
-	err = bva.EndTime.Set(ctx, msg.EndTime)
-	if err != nil {
-		return nil, err
-	}
-
-	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
+	return bva.DelegatedLocking.Set(ctx, bondDenom, delegatedLocking.AmountOf(bondDenom))
 }
 
 func (bva *BaseLockup) Delegate(
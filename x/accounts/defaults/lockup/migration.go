@@ -0,0 +1,174 @@
+package lockup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// MigrateLegacyVestingAccount returns the lockup account type and the init
+// message equivalent to an x/auth vesting account, to be used to migrate it
+// to x/accounts. As lockup accounts are not signing txs, the owner is the
+// address which will control the lockup account once migrated.
+func MigrateLegacyVestingAccount(acc sdk.AccountI, owner string) (string, transaction.Msg, error) {
+	switch acc := acc.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		return CONTINUOUS_LOCKING_ACCOUNT, &lockuptypes.MsgInitLockupAccount{
+			Owner:     owner,
+			StartTime: time.Unix(acc.StartTime, 0),
+			EndTime:   time.Unix(acc.EndTime, 0),
+		}, nil
+	case *vestingtypes.DelayedVestingAccount:
+		return DELAYED_LOCKING_ACCOUNT, &lockuptypes.MsgInitLockupAccount{
+			Owner:   owner,
+			EndTime: time.Unix(acc.EndTime, 0),
+		}, nil
+	case *vestingtypes.PeriodicVestingAccount:
+		periods := make([]lockuptypes.Period, len(acc.VestingPeriods))
+		for i, period := range acc.VestingPeriods {
+			periods[i] = lockuptypes.Period{
+				Length: time.Duration(period.Length) * time.Second,
+				Amount: period.Amount,
+			}
+		}
+		return PERIODIC_LOCKING_ACCOUNT, &lockuptypes.MsgInitPeriodicLockingAccount{
+			Owner:          owner,
+			StartTime:      time.Unix(acc.StartTime, 0),
+			LockingPeriods: periods,
+		}, nil
+	case *vestingtypes.PermanentLockedAccount:
+		return PERMANENT_LOCKING_ACCOUNT, &lockuptypes.MsgInitLockupAccount{
+			Owner: owner,
+		}, nil
+	default:
+		return "", nil, fmt.Errorf("cannot migrate account of type %T to a lockup account", acc)
+	}
+}
+
+// isMigration returns true when the account is initialized by the migration of
+// an x/auth account, the only case in which an account is its own sender.
+func isMigration(ctx context.Context) bool {
+	return accountstd.SenderIsSelf(ctx)
+}
+
+// getLegacyVestingAccount returns the x/auth vesting account being migrated to
+// the lockup account.
+func (bva *BaseLockup) getLegacyVestingAccount(ctx context.Context) (vestingexported.VestingAccount, error) {
+	addr, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := accountstd.QueryModule[*authtypes.QueryAccountResponse](ctx, &authtypes.QueryAccountRequest{Address: addr})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Account == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", addr)
+	}
+
+	var acc vestingexported.VestingAccount
+	switch resp.Account.TypeUrl {
+	case sdk.MsgTypeURL(&vestingtypes.ContinuousVestingAccount{}):
+		acc = &vestingtypes.ContinuousVestingAccount{}
+	case sdk.MsgTypeURL(&vestingtypes.DelayedVestingAccount{}):
+		acc = &vestingtypes.DelayedVestingAccount{}
+	case sdk.MsgTypeURL(&vestingtypes.PeriodicVestingAccount{}):
+		acc = &vestingtypes.PeriodicVestingAccount{}
+	case sdk.MsgTypeURL(&vestingtypes.PermanentLockedAccount{}):
+		acc = &vestingtypes.PermanentLockedAccount{}
+	default:
+		return nil, sdkerrors.ErrInvalidType.Wrapf("cannot migrate account of type %s to a lockup account", resp.Account.TypeUrl)
+	}
+	if err := proto.Unmarshal(resp.Account.Value, acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+// legacyBaseVestingAccount returns the x/auth representation of the state
+// shared by the lockup accounts.
+func (bva BaseLockup) legacyBaseVestingAccount(ctx context.Context) (*vestingtypes.BaseVestingAccount, error) {
+	addr, err := bva.addressCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+	accNumber, err := accountstd.QueryModule[*accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addr})
+	if err != nil {
+		return nil, err
+	}
+
+	originalLocking, err := bva.coinsFromEntries(ctx, bva.OriginalLocking)
+	if err != nil {
+		return nil, err
+	}
+	delegatedFree, err := bva.coinsFromEntries(ctx, bva.DelegatedFree)
+	if err != nil {
+		return nil, err
+	}
+	delegatedLocking, err := bva.coinsFromEntries(ctx, bva.DelegatedLocking)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := bva.EndTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vestingtypes.BaseVestingAccount{
+		BaseAccount: &authtypes.BaseAccount{
+			Address:       addr,
+			AccountNumber: accNumber.Number,
+		},
+		OriginalVesting:  originalLocking,
+		DelegatedFree:    delegatedFree,
+		DelegatedVesting: delegatedLocking,
+		EndTime:          legacyTime(endTime),
+	}, nil
+}
+
+// coinsFromEntries returns the coins of the entries, omitting the zero amounts.
+func (bva BaseLockup) coinsFromEntries(ctx context.Context, entries collections.Map[string, math.Int]) (sdk.Coins, error) {
+	coins := sdk.Coins{}
+	err := bva.IterateCoinEntries(ctx, entries, func(denom string, value math.Int) (bool, error) {
+		coins = coins.Add(sdk.NewCoin(denom, value))
+		return false, nil
+	})
+	return coins, err
+}
+
+// legacyTime returns the unix time of t, the zero time being represented as
+// zero by the x/auth vesting accounts.
+func legacyTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func newLegacyAccountResponse(acc sdk.AccountI, base *authtypes.BaseAccount) (*authtypes.QueryLegacyAccountResponse, error) {
+	accAny, err := codectypes.NewAnyWithValue(acc)
+	if err != nil {
+		return nil, err
+	}
+	return &authtypes.QueryLegacyAccountResponse{
+		Account: accAny,
+		Base:    base,
+	}, nil
+}
@@ -0,0 +1,182 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// newMockMigrationContext returns a context in which the account is migrated
+// from the legacy x/auth account.
+func newMockMigrationContext(t *testing.T, legacyAcc sdk.AccountI) (context.Context, store.KVStoreService) {
+	t.Helper()
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("lockup_account"), []byte("lockup_account"), nil,
+		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			switch req := req.(type) {
+			case *authtypes.QueryAccountRequest:
+				require.Equal(t, "lockup_account", req.Address)
+				accAny, err := codectypes.NewAnyWithValue(legacyAcc)
+				require.NoError(t, err)
+				return &authtypes.QueryAccountResponse{Account: accAny}, nil
+			case *accountsv1.AccountNumberRequest:
+				return &accountsv1.AccountNumberResponse{Number: legacyAcc.GetAccountNumber()}, nil
+			case *stakingtypes.QueryParamsRequest:
+				return &stakingtypes.QueryParamsResponse{
+					Params: stakingtypes.Params{
+						BondDenom: "test",
+					},
+				}, nil
+			}
+			t.Fatalf("unexpected query %T", req)
+			return nil, nil
+		},
+	)
+	return sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: time.Unix(2000, 0),
+	}), ss
+}
+
+func TestMigrateLegacyVestingAccount(t *testing.T) {
+	newBaseVestingAccount := func(endTime int64) *vestingtypes.BaseVestingAccount {
+		return &vestingtypes.BaseVestingAccount{
+			BaseAccount: &authtypes.BaseAccount{
+				Address:       "lockup_account",
+				AccountNumber: 7,
+				Sequence:      3,
+			},
+			OriginalVesting:  sdk.NewCoins(sdk.NewInt64Coin("test", 100), sdk.NewInt64Coin("other", 10)),
+			DelegatedFree:    sdk.NewCoins(sdk.NewInt64Coin("test", 20)),
+			DelegatedVesting: sdk.NewCoins(sdk.NewInt64Coin("test", 30)),
+			EndTime:          endTime,
+		}
+	}
+
+	testcases := []struct {
+		name        string
+		legacyAcc   sdk.AccountI
+		accountType string
+		newAccount  func(accountstd.Dependencies) (accountstd.Interface, error)
+	}{
+		{
+			"continuous",
+			vestingtypes.NewContinuousVestingAccountRaw(newBaseVestingAccount(3000), 1000),
+			CONTINUOUS_LOCKING_ACCOUNT,
+			func(d accountstd.Dependencies) (accountstd.Interface, error) { return NewContinuousLockingAccount(d) },
+		},
+		{
+			"delayed",
+			vestingtypes.NewDelayedVestingAccountRaw(newBaseVestingAccount(3000)),
+			DELAYED_LOCKING_ACCOUNT,
+			func(d accountstd.Dependencies) (accountstd.Interface, error) { return NewDelayedLockingAccount(d) },
+		},
+		{
+			"periodic",
+			vestingtypes.NewPeriodicVestingAccountRaw(newBaseVestingAccount(3000), 1000, vestingtypes.Periods{
+				{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+				{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50), sdk.NewInt64Coin("other", 10))},
+			}),
+			PERIODIC_LOCKING_ACCOUNT,
+			func(d accountstd.Dependencies) (accountstd.Interface, error) { return NewPeriodicLockingAccount(d) },
+		},
+		{
+			"permanent",
+			&vestingtypes.PermanentLockedAccount{BaseVestingAccount: newBaseVestingAccount(0)},
+			PERMANENT_LOCKING_ACCOUNT,
+			func(d accountstd.Dependencies) (accountstd.Interface, error) { return NewPermanentLockingAccount(d) },
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockMigrationContext(t, tc.legacyAcc)
+
+			accountType, initMsg, err := MigrateLegacyVestingAccount(tc.legacyAcc, "owner")
+			require.NoError(t, err)
+			require.Equal(t, tc.accountType, accountType)
+
+			acc, err := tc.newAccount(makeMockDependencies(ss))
+			require.NoError(t, err)
+			var base *BaseLockup
+			switch acc := acc.(type) {
+			case *ContinuousLockingAccount:
+				base = acc.BaseLockup
+				_, err = acc.Init(ctx, initMsg.(*lockuptypes.MsgInitLockupAccount))
+			case *DelayedLockingAccount:
+				base = acc.BaseLockup
+				_, err = acc.Init(ctx, initMsg.(*lockuptypes.MsgInitLockupAccount))
+			case *PeriodicLockingAccount:
+				base = acc.BaseLockup
+				_, err = acc.Init(ctx, initMsg.(*lockuptypes.MsgInitPeriodicLockingAccount))
+			case *PermanentLockingAccount:
+				base = acc.BaseLockup
+				_, err = acc.Init(ctx, initMsg.(*lockuptypes.MsgInitLockupAccount))
+			}
+			require.NoError(t, err)
+
+			// the locked and delegated coins are the ones of the legacy account
+			requireLockedCoins(t, ctx, base)
+
+			// the legacy representation matches the legacy account, apart from the
+			// sequence as lockup accounts are not signing txs
+			res, err := acc.(interface {
+				AuthRetroCompatibility(context.Context, *authtypes.QueryLegacyAccount) (*authtypes.QueryLegacyAccountResponse, error)
+			}).AuthRetroCompatibility(ctx, &authtypes.QueryLegacyAccount{})
+			require.NoError(t, err)
+			require.NoError(t, tc.legacyAcc.SetSequence(0))
+			expected, err := gogoproto.Marshal(tc.legacyAcc)
+			require.NoError(t, err)
+			require.Equal(t, sdk.MsgTypeURL(tc.legacyAcc), res.Account.TypeUrl)
+			require.Equal(t, expected, res.Account.Value)
+			require.Equal(t, uint64(7), res.Base.AccountNumber)
+		})
+	}
+}
+
+func requireLockedCoins(t *testing.T, ctx context.Context, bva *BaseLockup) {
+	t.Helper()
+	for denom, amount := range map[string]int64{"test": 100, "other": 10} {
+		originalLocking, err := bva.OriginalLocking.Get(ctx, denom)
+		require.NoError(t, err)
+		require.True(t, originalLocking.Equal(math.NewInt(amount)))
+	}
+	delegatedFree, err := bva.DelegatedFree.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, delegatedFree.Equal(math.NewInt(20)))
+	delegatedLocking, err := bva.DelegatedLocking.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, delegatedLocking.Equal(math.NewInt(30)))
+}
+
+func TestMigrateLegacyVestingAccountInvalid(t *testing.T) {
+	_, _, err := MigrateLegacyVestingAccount(&authtypes.BaseAccount{}, "owner")
+	require.ErrorContains(t, err, "cannot migrate account of type *types.BaseAccount to a lockup account")
+
+	// a lockup account cannot be migrated from an account which is not vesting
+	legacyAcc := &authtypes.BaseAccount{Address: "lockup_account"}
+	ctx, ss := newMockMigrationContext(t, legacyAcc)
+	acc, err := NewDelayedLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitLockupAccount{Owner: "owner", EndTime: time.Unix(3000, 0)})
+	require.ErrorContains(t, err, "cannot migrate account of type /cosmos.auth.v1beta1.BaseAccount to a lockup account")
+}
@@ -0,0 +1,345 @@
+package lockup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Compile-time type assertions
+var _ accountstd.Interface = (*MilestoneLockingAccount)(nil)
+
+// NewMilestoneLockingAccount creates a new MilestoneLockingAccount object.
+func NewMilestoneLockingAccount(d accountstd.Dependencies) (*MilestoneLockingAccount, error) {
+	baseLockup := newBaseLockup(d)
+
+	milestoneLockingAccount := MilestoneLockingAccount{
+		BaseLockup:     baseLockup,
+		ClawbackLockup: newClawbackLockup(d),
+		Unlocker:       collections.NewItem(d.SchemaBuilder, UnlockerPrefix, "unlocker", collections.BytesValue),
+		Milestones:     collections.NewMap(d.SchemaBuilder, MilestonesPrefix, "milestones", collections.StringKey, codec.CollValue[lockuptypes.Milestone](d.LegacyStateCodec)),
+	}
+
+	return &milestoneLockingAccount, nil
+}
+
+// MilestoneLockingAccount unlocks the coins of each milestone when its unlocker,
+// e.g. the governance module account, marks it as reached. The end time of the
+// account is the time at which the last milestone was reached.
+type MilestoneLockingAccount struct {
+	*BaseLockup
+	*ClawbackLockup
+	// Unlocker is the address which can mark the milestones as reached.
+	Unlocker collections.Item[[]byte]
+	// Milestones maps the name of the milestones to their amount and unlock time.
+	Milestones collections.Map[string, lockuptypes.Milestone]
+}
+
+func (mla MilestoneLockingAccount) Init(ctx context.Context, msg *lockuptypes.MsgInitMilestoneLockingAccount) (*lockuptypes.MsgInitMilestoneLockingAccountResponse, error) {
+	owner, err := mla.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'owner' address: %s", err)
+	}
+	unlocker, err := mla.addressCodec.StringToBytes(msg.Unlocker)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'unlocker' address: %s", err)
+	}
+	if len(msg.Milestones) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("milestones must be specified")
+	}
+
+	totalCoins := sdk.Coins{}
+	for _, milestone := range msg.Milestones {
+		if milestone.Name == "" {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("milestone name cannot be empty")
+		}
+		if milestone.UnlockTime != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "milestone %s cannot be already unlocked", milestone.Name)
+		}
+		if err := validateAmount(milestone.Amount); err != nil {
+			return nil, err
+		}
+		has, err := mla.Milestones.Has(ctx, milestone.Name)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate milestone %s", milestone.Name)
+		}
+
+		totalCoins = totalCoins.Add(milestone.Amount...)
+		err = mla.Milestones.Set(ctx, milestone.Name, milestone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	funds := accountstd.Funds(ctx)
+	if !funds.Equal(totalCoins) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid funding amount, should be equal to total coins lockup")
+	}
+
+	err = mla.initLockedCoins(ctx, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+	err = mla.initFunder(ctx, mla.BaseLockup, msg.Funder)
+	if err != nil {
+		return nil, err
+	}
+
+	// the end time is set once all the milestones are reached
+	err = mla.EndTime.Set(ctx, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	err = mla.Unlocker.Set(ctx, unlocker)
+	if err != nil {
+		return nil, err
+	}
+	err = mla.Owner.Set(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitMilestoneLockingAccountResponse{}, nil
+}
+
+// UnlockMilestone marks a milestone as reached, which unlocks its coins. Only
+// the unlocker can call it, before any clawback.
+func (mla MilestoneLockingAccount) UnlockMilestone(ctx context.Context, msg *lockuptypes.MsgUnlockMilestone) (
+	*lockuptypes.MsgUnlockMilestoneResponse, error,
+) {
+	unlocker, err := mla.Unlocker.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := mla.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+	if !bytes.Equal(unlocker, accountstd.Sender(ctx)) || !bytes.Equal(unlocker, sender) {
+		return nil, errors.New("sender is not the unlocker of this lockup account")
+	}
+
+	clawedBack, err := mla.ClawbackTime.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if clawedBack {
+		return nil, errors.New("the lockup schedule is frozen by a clawback")
+	}
+
+	milestone, err := mla.Milestones.Get(ctx, msg.Name)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "milestone %s", msg.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if milestone.UnlockTime != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "milestone %s is already unlocked", msg.Name)
+	}
+
+	hs := mla.headerService.HeaderInfo(ctx)
+	milestone.UnlockTime = &hs.Time
+	err = mla.Milestones.Set(ctx, msg.Name, milestone)
+	if err != nil {
+		return nil, err
+	}
+
+	allUnlocked := true
+	err = mla.Milestones.Walk(ctx, nil, func(_ string, milestone lockuptypes.Milestone) (stop bool, err error) {
+		allUnlocked = milestone.UnlockTime != nil
+		return !allUnlocked, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if allUnlocked {
+		err = mla.EndTime.Set(ctx, hs.Time)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgUnlockMilestoneResponse{}, nil
+}
+
+func (mla *MilestoneLockingAccount) Delegate(ctx context.Context, msg *lockuptypes.MsgDelegate) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return mla.BaseLockup.Delegate(ctx, msg, mla.GetLockedCoinsWithDenoms)
+}
+
+func (mla *MilestoneLockingAccount) SendCoins(ctx context.Context, msg *lockuptypes.MsgSend) (
+	*lockuptypes.MsgExecuteMessagesResponse, error,
+) {
+	return mla.BaseLockup.SendCoins(ctx, msg, mla.GetLockedCoinsWithDenoms)
+}
+
+func (mla *MilestoneLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (
+	*lockuptypes.MsgWithdrawResponse, error,
+) {
+	return mla.BaseLockup.WithdrawUnlockedCoins(ctx, msg, mla.GetLockedCoinsWithDenoms)
+}
+
+func (mla *MilestoneLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return mla.ClawbackLockup.clawback(ctx, mla.BaseLockup, msg, mla.GetLockedCoinsWithDenoms)
+}
+
+// GetLockCoinsInfo returns the total number of unlocked and locked coins.
+func (mla MilestoneLockingAccount) GetLockCoinsInfo(ctx context.Context, blockTime time.Time) (unlockedCoins, lockedCoins sdk.Coins, err error) {
+	unlockedCoins = sdk.NewCoins()
+	lockedCoins = sdk.NewCoins()
+
+	err = mla.IterateCoinEntries(ctx, mla.OriginalLocking, func(key string, value math.Int) (stop bool, err error) {
+		unlockedCoin, lockedCoin, err := mla.GetLockCoinInfoWithDenom(ctx, blockTime, key)
+		if err != nil {
+			return true, err
+		}
+		unlockedCoins = unlockedCoins.Add(*unlockedCoin)
+		lockedCoins = lockedCoins.Add(*lockedCoin)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return unlockedCoins, lockedCoins, nil
+}
+
+// GetLockCoinInfoWithDenom returns the number of unlocked and locked coins for
+// a specific denom, the coins clawed back being neither unlocked nor locked.
+func (mla MilestoneLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, blockTime time.Time, denom string) (unlockedCoin, lockedCoin *sdk.Coin, err error) {
+	blockTime, err = mla.scheduleTime(ctx, blockTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	originalLockingAmt, err := mla.OriginalLocking.Get(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+	clawedBack, err := mla.clawedBackCoin(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unlocked := sdk.NewCoin(denom, math.ZeroInt())
+	err = mla.Milestones.Walk(ctx, nil, func(_ string, milestone lockuptypes.Milestone) (stop bool, err error) {
+		if milestone.UnlockTime != nil && !milestone.UnlockTime.After(blockTime) {
+			unlocked = unlocked.Add(sdk.NewCoin(denom, milestone.Amount.AmountOf(denom)))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	locked := sdk.NewCoin(denom, originalLockingAmt).Sub(unlocked).Sub(clawedBack)
+
+	return &unlocked, &locked, nil
+}
+
+// GetLockedCoinsWithDenoms returns the number of locked coin for a specific denom.
+func (mla MilestoneLockingAccount) GetLockedCoinsWithDenoms(ctx context.Context, blockTime time.Time, denoms ...string) (sdk.Coins, error) {
+	lockedCoins := sdk.Coins{}
+	for _, denom := range denoms {
+		_, lockedCoin, err := mla.GetLockCoinInfoWithDenom(ctx, blockTime, denom)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = append(lockedCoins, *lockedCoin)
+	}
+
+	return lockedCoins, nil
+}
+
+func (mla MilestoneLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
+	*lockuptypes.QueryLockupAccountInfoResponse, error,
+) {
+	resp, err := mla.BaseLockup.QueryLockupAccountBaseInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	hs := mla.headerService.HeaderInfo(ctx)
+	unlockedCoins, lockedCoins, err := mla.GetLockCoinsInfo(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+	resp.LockedCoins = lockedCoins
+	resp.UnlockedCoins = unlockedCoins
+	return resp, nil
+}
+
+func (mla MilestoneLockingAccount) QueryMilestones(ctx context.Context, _ *lockuptypes.QueryMilestonesRequest) (
+	*lockuptypes.QueryMilestonesResponse, error,
+) {
+	unlocker, err := mla.Unlocker.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	unlockerAddress, err := mla.addressCodec.BytesToString(unlocker)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones := []lockuptypes.Milestone{}
+	err = mla.Milestones.Walk(ctx, nil, func(_ string, milestone lockuptypes.Milestone) (stop bool, err error) {
+		milestones = append(milestones, milestone)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hs := mla.headerService.HeaderInfo(ctx)
+	unlockedCoins, lockedCoins, err := mla.GetLockCoinsInfo(ctx, hs.Time)
+	if err != nil {
+		return nil, err
+	}
+	return &lockuptypes.QueryMilestonesResponse{
+		Milestones:    milestones,
+		Unlocker:      unlockerAddress,
+		LockedCoins:   lockedCoins,
+		UnlockedCoins: unlockedCoins,
+	}, nil
+}
+
+func (mla MilestoneLockingAccount) QueryClawbackInfo(ctx context.Context, _ *lockuptypes.QueryClawbackInfoRequest) (
+	*lockuptypes.QueryClawbackInfoResponse, error,
+) {
+	return mla.ClawbackLockup.queryClawbackInfo(ctx, mla.BaseLockup)
+}
+
+// Implement smart account interface
+func (mla MilestoneLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, mla.Init)
+}
+
+func (mla MilestoneLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, mla.Delegate)
+	accountstd.RegisterExecuteHandler(builder, mla.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, mla.WithdrawUnlockedCoins)
+	accountstd.RegisterExecuteHandler(builder, mla.UnlockMilestone)
+	accountstd.RegisterExecuteHandler(builder, mla.Clawback)
+	mla.BaseLockup.RegisterExecuteHandlers(builder)
+}
+
+func (mla MilestoneLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, mla.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, mla.QueryMilestones)
+	accountstd.RegisterQueryHandler(builder, mla.QueryClawbackInfo)
+}
@@ -0,0 +1,138 @@
+package lockup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/accountstd/testutil"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func stakeCoins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+// setupMilestoneHarness initializes a milestone locking account created by
+// "creator", which is its funder if withFunder is set.
+func setupMilestoneHarness(t *testing.T, withFunder bool) (h *testutil.Harness, owner, gov, creator string) {
+	t.Helper()
+	h, err := testutil.NewHarness(
+		accountstd.AddAccount(MILESTONE_LOCKING_ACCOUNT, NewMilestoneLockingAccount),
+		testutil.WithStakingParams("stake", time.Hour),
+	)
+	require.NoError(t, err)
+	owner, err = h.AddressCodec().BytesToString([]byte("owner"))
+	require.NoError(t, err)
+	gov, err = h.AddressCodec().BytesToString([]byte("gov"))
+	require.NoError(t, err)
+	creator, err = h.AddressCodec().BytesToString([]byte("creator"))
+	require.NoError(t, err)
+	funder := ""
+	if withFunder {
+		funder = creator
+	}
+
+	milestones := []lockuptypes.Milestone{
+		{Name: "testnet", Amount: stakeCoins(30)},
+		{Name: "mainnet", Amount: stakeCoins(70)},
+	}
+	h.SetBalance([]byte("creator"), stakeCoins(200))
+	_, err = h.Init([]byte("creator"), &lockuptypes.MsgInitMilestoneLockingAccount{
+		Owner: owner, Unlocker: gov, Funder: funder, Milestones: milestones,
+	}, stakeCoins(90))
+	require.ErrorContains(t, err, "invalid funding amount")
+	_, err = h.Init([]byte("creator"), &lockuptypes.MsgInitMilestoneLockingAccount{
+		Owner: owner, Unlocker: gov, Funder: funder, Milestones: append(milestones, lockuptypes.Milestone{Name: "testnet", Amount: stakeCoins(10)}),
+	}, stakeCoins(110))
+	require.ErrorContains(t, err, "duplicate milestone testnet")
+	_, err = h.Init([]byte("creator"), &lockuptypes.MsgInitMilestoneLockingAccount{
+		Owner: owner, Unlocker: gov, Funder: funder, Milestones: milestones,
+	}, stakeCoins(100))
+	require.NoError(t, err)
+
+	return h, owner, gov, creator
+}
+
+func TestMilestoneAccountUnlock(t *testing.T) {
+	h, owner, gov, creator := setupMilestoneHarness(t, false)
+	ownerAddr, govAddr := []byte("owner"), []byte("gov")
+
+	_, err := h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stakeCoins(1)}, nil)
+	require.ErrorContains(t, err, "spendable balance")
+
+	// only the unlocker can mark the milestones as reached
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgUnlockMilestone{Sender: owner, Name: "testnet"}, nil)
+	require.ErrorContains(t, err, "sender is not the unlocker of this lockup account")
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgUnlockMilestone{Sender: gov, Name: "testnet"}, nil)
+	require.ErrorContains(t, err, "sender is not the unlocker of this lockup account")
+	_, err = h.Execute(govAddr, &lockuptypes.MsgUnlockMilestone{Sender: gov, Name: "devnet"}, nil)
+	require.ErrorContains(t, err, "milestone devnet")
+	_, err = h.Execute(govAddr, &lockuptypes.MsgUnlockMilestone{Sender: gov, Name: "testnet"}, nil)
+	require.NoError(t, err)
+	_, err = h.Execute(govAddr, &lockuptypes.MsgUnlockMilestone{Sender: gov, Name: "testnet"}, nil)
+	require.ErrorContains(t, err, "milestone testnet is already unlocked")
+
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stakeCoins(31)}, nil)
+	require.ErrorContains(t, err, "spendable balance")
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stakeCoins(30)}, nil)
+	require.NoError(t, err)
+
+	resp, err := h.Query(&lockuptypes.QueryMilestonesRequest{})
+	require.NoError(t, err)
+	milestones := resp.(*lockuptypes.QueryMilestonesResponse)
+	require.Equal(t, gov, milestones.Unlocker)
+	require.Len(t, milestones.Milestones, 2)
+	require.Equal(t, "mainnet", milestones.Milestones[0].Name)
+	require.Nil(t, milestones.Milestones[0].UnlockTime)
+	require.Equal(t, h.HeaderInfo().Time, *milestones.Milestones[1].UnlockTime)
+	require.Equal(t, stakeCoins(30), milestones.UnlockedCoins)
+	require.Equal(t, stakeCoins(70), milestones.LockedCoins)
+
+	// the end time is set once all the milestones are reached
+	require.NoError(t, h.AdvanceTime(time.Hour))
+	_, err = h.Execute(govAddr, &lockuptypes.MsgUnlockMilestone{Sender: gov, Name: "mainnet"}, nil)
+	require.NoError(t, err)
+	resp, err = h.Query(&lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	info := resp.(*lockuptypes.QueryLockupAccountInfoResponse)
+	require.Equal(t, h.HeaderInfo().Time, *info.EndTime)
+	require.True(t, info.LockedCoins.IsZero())
+
+	// clawback is disabled without funder
+	_, err = h.Execute([]byte("creator"), &lockuptypes.MsgClawback{Funder: creator}, nil)
+	require.ErrorContains(t, err, "clawback is disabled for this lockup account")
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stakeCoins(70)}, nil)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(100), h.Balance(ownerAddr))
+}
+
+func TestMilestoneAccountClawback(t *testing.T) {
+	h, owner, gov, creator := setupMilestoneHarness(t, true)
+	ownerAddr, govAddr := []byte("owner"), []byte("gov")
+
+	_, err := h.Execute(govAddr, &lockuptypes.MsgUnlockMilestone{Sender: gov, Name: "testnet"}, nil)
+	require.NoError(t, err)
+	_, err = h.Execute([]byte("mallory"), &lockuptypes.MsgClawback{Funder: creator}, nil)
+	require.ErrorContains(t, err, "sender is not the funder of this lockup account")
+	resp, err := h.Execute([]byte("creator"), &lockuptypes.MsgClawback{Funder: creator}, nil)
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(70), resp.(*lockuptypes.MsgClawbackResponse).AmountClawedBack)
+	require.Equal(t, stakeCoins(170), h.Balance([]byte("creator")))
+
+	// the milestones cannot be reached anymore
+	_, err = h.Execute(govAddr, &lockuptypes.MsgUnlockMilestone{Sender: gov, Name: "mainnet"}, nil)
+	require.ErrorContains(t, err, "the lockup schedule is frozen by a clawback")
+
+	qResp, err := h.Query(&lockuptypes.QueryMilestonesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, stakeCoins(30), qResp.(*lockuptypes.QueryMilestonesResponse).UnlockedCoins)
+	require.True(t, qResp.(*lockuptypes.QueryMilestonesResponse).LockedCoins.IsZero())
+	_, err = h.Execute(ownerAddr, &lockuptypes.MsgSend{Sender: owner, ToAddress: owner, Amount: stakeCoins(30)}, nil)
+	require.NoError(t, err)
+	require.True(t, h.Balance(h.Address()).IsZero())
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // Compile-time type assertions
@@ -46,7 +48,9 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 
 	hs := pva.headerService.HeaderInfo(ctx)
 
-	if msg.StartTime.Before(hs.Time) {
+	// a migrated account keeps the schedule and the coins of the legacy account
+	migration := isMigration(ctx)
+	if !migration && msg.StartTime.Before(hs.Time) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time %s should be after block time")
 	}
 
@@ -71,37 +75,11 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 	}
 
 	funds := accountstd.Funds(ctx)
-	if !funds.Equal(totalCoins) {
+	if !migration && !funds.Equal(totalCoins) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid funding amount, should be equal to total coins lockup")
 	}
 
-	sortedAmt := totalCoins.Sort()
-	for _, coin := range sortedAmt {
-		err := pva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
-		if err != nil {
-			return nil, err
-		}
-
-		// Set initial value for all withdrawed token
-		err = pva.WithdrawedCoins.Set(ctx, coin.Denom, math.ZeroInt())
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	bondDenom, err := getStakingDenom(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Set initial value for all locked token
-	err = pva.DelegatedFree.Set(ctx, bondDenom, math.ZeroInt())
-	if err != nil {
-		return nil, err
-	}
-
-	// Set initial value for all locked token
-	err = pva.DelegatedLocking.Set(ctx, bondDenom, math.ZeroInt())
+	err = pva.initLockedCoins(ctx, totalCoins)
 	if err != nil {
 		return nil, err
 	}
@@ -328,6 +306,30 @@ func (pva PeriodicLockingAccount) QueryLockingPeriods(ctx context.Context, msg *
 	}, nil
 }
 
+// AuthRetroCompatibility returns the account as an x/auth periodic vesting account.
+func (pva PeriodicLockingAccount) AuthRetroCompatibility(ctx context.Context, _ *authtypes.QueryLegacyAccount) (*authtypes.QueryLegacyAccountResponse, error) {
+	baseVestingAccount, err := pva.legacyBaseVestingAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := pva.StartTime.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	periods := vestingtypes.Periods{}
+	err = pva.IteratePeriods(ctx, func(period lockuptypes.Period) (stop bool, err error) {
+		periods = append(periods, vestingtypes.Period{
+			Length: int64(period.Length / time.Second),
+			Amount: period.Amount,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newLegacyAccountResponse(vestingtypes.NewPeriodicVestingAccountRaw(baseVestingAccount, startTime.Unix(), periods), baseVestingAccount.BaseAccount)
+}
+
 // Implement smart account interface
 func (pva PeriodicLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, pva.Init)
@@ -343,4 +345,5 @@ func (pva PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.Ex
 func (pva PeriodicLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, pva.QueryLockupAccountInfo)
 	accountstd.RegisterQueryHandler(builder, pva.QueryLockingPeriods)
+	accountstd.RegisterQueryHandler(builder, pva.AuthRetroCompatibility)
 }
@@ -9,6 +9,8 @@ import (
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // Compile-time type assertions
@@ -93,6 +95,15 @@ func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context,
 	return resp, nil
 }
 
+// AuthRetroCompatibility returns the account as an x/auth permanent locked account.
+func (plva PermanentLockingAccount) AuthRetroCompatibility(ctx context.Context, _ *authtypes.QueryLegacyAccount) (*authtypes.QueryLegacyAccountResponse, error) {
+	baseVestingAccount, err := plva.legacyBaseVestingAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newLegacyAccountResponse(&vestingtypes.PermanentLockedAccount{BaseVestingAccount: baseVestingAccount}, baseVestingAccount.BaseAccount)
+}
+
 // Implement smart account interface
 func (plva PermanentLockingAccount) RegisterInitHandler(builder *accountstd.InitBuilder) {
 	accountstd.RegisterInitHandler(builder, plva.Init)
@@ -107,4 +118,5 @@ func (plva PermanentLockingAccount) RegisterExecuteHandlers(builder *accountstd.
 
 func (plva PermanentLockingAccount) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, plva.QueryLockupAccountInfo)
+	accountstd.RegisterQueryHandler(builder, plva.AuthRetroCompatibility)
 }
@@ -12,6 +12,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -84,8 +85,73 @@ func (m *Period) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// Milestone defines an amount of coins unlocked when a milestone is reached.
+type Milestone struct {
+	// name identifies the milestone.
+	Name   string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// unlock_time defines the time at which the milestone was reached, nil if
+	// it is not reached yet.
+	UnlockTime *time.Time `protobuf:"bytes,3,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time,omitempty"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b466256e1a079c, []int{1}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Milestone) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Milestone) GetUnlockTime() *time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.accounts.defaults.lockup.Period")
+	proto.RegisterType((*Milestone)(nil), "cosmos.accounts.defaults.lockup.Milestone")
 }
 
 func init() {
@@ -93,28 +159,32 @@ func init() {
 }
 
 var fileDescriptor_79b466256e1a079c = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x90, 0x32, 0x04, 0x18, 0xa8, 0x18, 0x4a, 0x07, 0xa7, 0x62, 0xaa, 0x2a, 0x6a,
-	0xab, 0x70, 0x01, 0x54, 0x10, 0xac, 0x88, 0x91, 0x05, 0x39, 0x8e, 0xeb, 0x5a, 0x4d, 0xf2, 0xaa,
-	0xda, 0x41, 0xf4, 0x16, 0x8c, 0x88, 0x13, 0x20, 0xa6, 0x5e, 0x02, 0xa9, 0x63, 0x47, 0x26, 0x8a,
-	0x9a, 0xa1, 0xd7, 0x40, 0xb1, 0x9d, 0x91, 0xc5, 0xef, 0x59, 0xfe, 0xbf, 0xf7, 0xbf, 0x5f, 0x8e,
-	0xce, 0x39, 0xe8, 0x1c, 0x34, 0x65, 0x9c, 0x43, 0x59, 0x18, 0x4d, 0x53, 0x31, 0x66, 0x65, 0x66,
-	0x34, 0xcd, 0x80, 0x4f, 0xcb, 0x99, 0x2f, 0x64, 0x36, 0x07, 0x03, 0xad, 0xd8, 0xa9, 0x49, 0xa3,
-	0x26, 0x8d, 0x9a, 0x38, 0x59, 0xe7, 0x98, 0xe5, 0xaa, 0x00, 0x6a, 0x4f, 0xc7, 0x74, 0xb0, 0x77,
-	0x48, 0x98, 0x16, 0xf4, 0x79, 0x98, 0x08, 0xc3, 0x86, 0x94, 0x83, 0x2a, 0xfc, 0xfb, 0x89, 0x04,
-	0x09, 0xb6, 0xa5, 0x75, 0xd7, 0x50, 0x12, 0x40, 0x66, 0x82, 0xda, 0x5b, 0x52, 0x8e, 0x69, 0x5a,
-	0xce, 0x99, 0x51, 0xe0, 0xa9, 0xb3, 0x2f, 0x14, 0x85, 0xf7, 0x62, 0xae, 0x20, 0x6d, 0x5d, 0x45,
-	0x61, 0x26, 0x0a, 0x69, 0x26, 0x6d, 0xd4, 0x45, 0xbd, 0x83, 0x8b, 0x53, 0xe2, 0x58, 0xd2, 0xb0,
-	0xe4, 0xc6, 0xb3, 0xa3, 0xa3, 0xd5, 0x4f, 0x1c, 0xbc, 0x6d, 0x62, 0xf4, 0xb1, 0x5b, 0xf6, 0xd1,
-	0x83, 0xe7, 0x5a, 0x8b, 0x28, 0x64, 0x79, 0x1d, 0xa8, 0xbd, 0xd7, 0xdd, 0xb7, 0x13, 0x7c, 0xce,
-	0x7a, 0x67, 0xe2, 0x77, 0x26, 0xd7, 0xa0, 0x8a, 0xd1, 0x6d, 0x3d, 0xe1, 0x73, 0x13, 0xf7, 0xa4,
-	0x32, 0x93, 0x32, 0x21, 0x1c, 0x72, 0xea, 0x03, 0xba, 0x32, 0xd0, 0xe9, 0x94, 0x9a, 0xc5, 0x4c,
-	0x68, 0x0b, 0xe8, 0xf7, 0xdd, 0xb2, 0x7f, 0x98, 0x09, 0xc9, 0xf8, 0xe2, 0xa9, 0x4e, 0xad, 0xbd,
-	0xb5, 0x33, 0x1c, 0xdd, 0xad, 0xb6, 0x18, 0xad, 0xb7, 0x18, 0xfd, 0x6e, 0x31, 0x7a, 0xad, 0x70,
-	0xb0, 0xae, 0x70, 0xf0, 0x5d, 0xe1, 0xe0, 0x71, 0xe0, 0xe6, 0xe9, 0x74, 0x4a, 0x14, 0xd0, 0x97,
-	0xff, 0x7f, 0xc8, 0x9a, 0x25, 0xa1, 0x4d, 0x7b, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xa5,
-	0x33, 0xd0, 0xd1, 0x01, 0x00, 0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0xc6, 0x63, 0xee, 0x55, 0xa4, 0xba, 0x30, 0x10, 0x31, 0x94, 0x0e, 0x49, 0xd5, 0xa9, 0xaa,
+	0xa8, 0xad, 0xc2, 0x0b, 0x40, 0x40, 0x30, 0x21, 0xa1, 0x8a, 0x89, 0xa5, 0x72, 0x12, 0x37, 0xb5,
+	0x9a, 0xf8, 0x54, 0xb5, 0x83, 0xe8, 0x5b, 0x74, 0x44, 0x3c, 0x01, 0x62, 0xea, 0x4b, 0x20, 0x75,
+	0xec, 0xc8, 0x02, 0x45, 0xed, 0xd0, 0xd7, 0x40, 0xfe, 0x93, 0x05, 0xc4, 0x7c, 0x97, 0xf8, 0x24,
+	0x3e, 0xbf, 0x73, 0xce, 0xf7, 0x9d, 0xe0, 0x27, 0x39, 0xa8, 0x1a, 0x14, 0x65, 0x79, 0x0e, 0x8d,
+	0xd4, 0x8a, 0x16, 0x7c, 0xc1, 0x9a, 0x4a, 0x2b, 0x5a, 0x41, 0xbe, 0x6a, 0xd6, 0xfe, 0x20, 0xeb,
+	0x0d, 0x68, 0x88, 0x12, 0x97, 0x4d, 0xda, 0x6c, 0xd2, 0x66, 0x13, 0x97, 0xd6, 0x7f, 0xc8, 0x6a,
+	0x21, 0x81, 0xda, 0xa7, 0x63, 0xfa, 0xb1, 0xef, 0x90, 0x31, 0xc5, 0xe9, 0xc7, 0x69, 0xc6, 0x35,
+	0x9b, 0xd2, 0x1c, 0x84, 0xf4, 0xf7, 0x8f, 0x4a, 0x28, 0xc1, 0x86, 0xd4, 0x44, 0x2d, 0x55, 0x02,
+	0x94, 0x15, 0xa7, 0xf6, 0x2d, 0x6b, 0x16, 0xb4, 0x68, 0x36, 0x4c, 0x0b, 0x68, 0xa9, 0xe4, 0xef,
+	0x7b, 0x2d, 0x6a, 0xae, 0x34, 0xab, 0xfd, 0xa8, 0xc3, 0xef, 0x08, 0x87, 0xef, 0xf8, 0x46, 0x40,
+	0x11, 0x3d, 0xc7, 0x61, 0xc5, 0x65, 0xa9, 0x97, 0x3d, 0x34, 0x40, 0xa3, 0xee, 0xd3, 0xc7, 0xc4,
+	0xc1, 0xa4, 0x85, 0xc9, 0x2b, 0x5f, 0x3c, 0x7d, 0x70, 0xf8, 0x95, 0x04, 0x9f, 0x4f, 0x09, 0xfa,
+	0x7a, 0xdd, 0x8f, 0xd1, 0xcc, 0x73, 0xd1, 0x16, 0x87, 0xac, 0x36, 0x8a, 0x7b, 0xf7, 0x06, 0x37,
+	0xb6, 0x82, 0x37, 0xc2, 0x88, 0x22, 0x5e, 0x14, 0x79, 0x09, 0x42, 0xa6, 0xaf, 0x4d, 0x85, 0x6f,
+	0xa7, 0x64, 0x54, 0x0a, 0xbd, 0x6c, 0x32, 0x92, 0x43, 0x4d, 0xbd, 0x03, 0xee, 0x98, 0xa8, 0x62,
+	0x45, 0xf5, 0x76, 0xcd, 0x95, 0x05, 0xd4, 0x97, 0xeb, 0x7e, 0x7c, 0xbf, 0xe2, 0x25, 0xcb, 0xb7,
+	0x73, 0x63, 0x8b, 0xf2, 0xad, 0x5d, 0xc3, 0xe1, 0x4f, 0x84, 0x3b, 0x6f, 0x45, 0xc5, 0x95, 0x06,
+	0xc9, 0xa3, 0x08, 0xdf, 0x4a, 0x56, 0x73, 0x2b, 0xa4, 0x33, 0xb3, 0xf1, 0x1d, 0x0e, 0x17, 0xbd,
+	0xc0, 0xdd, 0x46, 0x9a, 0xd5, 0xcf, 0x8d, 0xfd, 0xbd, 0x1b, 0x6b, 0x6f, 0xff, 0x1f, 0x7b, 0xdf,
+	0xb7, 0xbb, 0x49, 0x6f, 0x77, 0xa7, 0x04, 0xcd, 0xb0, 0x83, 0xcc, 0xe7, 0xf4, 0xcd, 0xe1, 0x1c,
+	0xa3, 0xe3, 0x39, 0x46, 0xbf, 0xcf, 0x31, 0xda, 0x5d, 0xe2, 0xe0, 0x78, 0x89, 0x83, 0x1f, 0x97,
+	0x38, 0xf8, 0x30, 0x71, 0x23, 0xa9, 0x62, 0x45, 0x04, 0xd0, 0x4f, 0xff, 0xff, 0x45, 0xed, 0xbc,
+	0x59, 0x68, 0xdb, 0x3d, 0xfb, 0x33, 0x00, 0xff, 0x8e, 0xe4, 0xe9, 0xd2, 0x02, 0x00, 0x00,
 }
 
 func (m *Period) Marshal() (dAtA []byte, err error) {
@@ -162,6 +232,60 @@ func (m *Period) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnlockTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintLockup(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintLockup(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLockup(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLockup(v)
 	base := offset
@@ -190,6 +314,29 @@ func (m *Period) Size() (n int) {
 	return n
 }
 
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	if m.UnlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnlockTime)
+		n += 1 + l + sovLockup(uint64(l))
+	}
+	return n
+}
+
 func sovLockup(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -313,6 +460,158 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnlockTime == nil {
+				m.UnlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLockup(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
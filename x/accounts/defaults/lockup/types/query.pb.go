@@ -258,11 +258,358 @@ func (m *QueryLockingPeriodsResponse) GetLockingPeriods() []*Period {
 	return nil
 }
 
+// QueryCliffScheduleRequest is used to query the cliff lockup account schedule.
+type QueryCliffScheduleRequest struct {
+}
+
+func (m *QueryCliffScheduleRequest) Reset()         { *m = QueryCliffScheduleRequest{} }
+func (m *QueryCliffScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCliffScheduleRequest) ProtoMessage()    {}
+func (*QueryCliffScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{4}
+}
+func (m *QueryCliffScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCliffScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCliffScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCliffScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCliffScheduleRequest.Merge(m, src)
+}
+func (m *QueryCliffScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCliffScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCliffScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCliffScheduleRequest proto.InternalMessageInfo
+
+// QueryCliffScheduleResponse returns the cliff lockup account schedule.
+type QueryCliffScheduleResponse struct {
+	// start_time defines the time from which the coins unlock linearly.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// cliff_time defines the time before which no coins are unlocked.
+	CliffTime time.Time `protobuf:"bytes,2,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time"`
+	// end_time defines the time at which all the coins are unlocked.
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// cliff_amount defines the coins unlocked at the cliff.
+	CliffAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=cliff_amount,json=cliffAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cliff_amount"`
+	// locked_coins defines the coins still locked.
+	LockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=locked_coins,json=lockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked_coins"`
+	// unlocked_coins defines the coins already unlocked.
+	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
+}
+
+func (m *QueryCliffScheduleResponse) Reset()         { *m = QueryCliffScheduleResponse{} }
+func (m *QueryCliffScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCliffScheduleResponse) ProtoMessage()    {}
+func (*QueryCliffScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{5}
+}
+func (m *QueryCliffScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCliffScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCliffScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCliffScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCliffScheduleResponse.Merge(m, src)
+}
+func (m *QueryCliffScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCliffScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCliffScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCliffScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryCliffScheduleResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryCliffScheduleResponse) GetCliffTime() time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryCliffScheduleResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryCliffScheduleResponse) GetCliffAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CliffAmount
+	}
+	return nil
+}
+
+func (m *QueryCliffScheduleResponse) GetLockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LockedCoins
+	}
+	return nil
+}
+
+func (m *QueryCliffScheduleResponse) GetUnlockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockedCoins
+	}
+	return nil
+}
+
+// QueryMilestonesRequest is used to query the milestone lockup account milestones.
+type QueryMilestonesRequest struct {
+}
+
+func (m *QueryMilestonesRequest) Reset()         { *m = QueryMilestonesRequest{} }
+func (m *QueryMilestonesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMilestonesRequest) ProtoMessage()    {}
+func (*QueryMilestonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{6}
+}
+func (m *QueryMilestonesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMilestonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMilestonesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMilestonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMilestonesRequest.Merge(m, src)
+}
+func (m *QueryMilestonesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMilestonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMilestonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMilestonesRequest proto.InternalMessageInfo
+
+// QueryMilestonesResponse returns the milestone lockup account milestones.
+type QueryMilestonesResponse struct {
+	// milestones defines the milestones, with the unlock time of the reached ones.
+	Milestones []Milestone `protobuf:"bytes,1,rep,name=milestones,proto3" json:"milestones"`
+	// unlocker defines the address which can mark the milestones as reached.
+	Unlocker string `protobuf:"bytes,2,opt,name=unlocker,proto3" json:"unlocker,omitempty"`
+	// locked_coins defines the coins still locked.
+	LockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=locked_coins,json=lockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked_coins"`
+	// unlocked_coins defines the coins already unlocked.
+	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
+}
+
+func (m *QueryMilestonesResponse) Reset()         { *m = QueryMilestonesResponse{} }
+func (m *QueryMilestonesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMilestonesResponse) ProtoMessage()    {}
+func (*QueryMilestonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{7}
+}
+func (m *QueryMilestonesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMilestonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMilestonesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMilestonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMilestonesResponse.Merge(m, src)
+}
+func (m *QueryMilestonesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMilestonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMilestonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMilestonesResponse proto.InternalMessageInfo
+
+func (m *QueryMilestonesResponse) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *QueryMilestonesResponse) GetUnlocker() string {
+	if m != nil {
+		return m.Unlocker
+	}
+	return ""
+}
+
+func (m *QueryMilestonesResponse) GetLockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LockedCoins
+	}
+	return nil
+}
+
+func (m *QueryMilestonesResponse) GetUnlockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockedCoins
+	}
+	return nil
+}
+
+// QueryClawbackInfoRequest is used to query the clawback state of a lockup account.
+type QueryClawbackInfoRequest struct {
+}
+
+func (m *QueryClawbackInfoRequest) Reset()         { *m = QueryClawbackInfoRequest{} }
+func (m *QueryClawbackInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackInfoRequest) ProtoMessage()    {}
+func (*QueryClawbackInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{8}
+}
+func (m *QueryClawbackInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClawbackInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackInfoRequest.Merge(m, src)
+}
+func (m *QueryClawbackInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClawbackInfoRequest proto.InternalMessageInfo
+
+// QueryClawbackInfoResponse returns the clawback state of a lockup account.
+type QueryClawbackInfoResponse struct {
+	// funder defines the address which can claw back the locked coins, empty if
+	// clawback is disabled.
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	// clawback_time defines the time of the first clawback, from which the
+	// lockup schedule is frozen, nil if the coins were never clawed back.
+	ClawbackTime *time.Time `protobuf:"bytes,2,opt,name=clawback_time,json=clawbackTime,proto3,stdtime" json:"clawback_time,omitempty"`
+	// clawed_back defines the coins already clawed back.
+	ClawedBack github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=clawed_back,json=clawedBack,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back"`
+}
+
+func (m *QueryClawbackInfoResponse) Reset()         { *m = QueryClawbackInfoResponse{} }
+func (m *QueryClawbackInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackInfoResponse) ProtoMessage()    {}
+func (*QueryClawbackInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f06fad50e16c8e9b, []int{9}
+}
+func (m *QueryClawbackInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClawbackInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackInfoResponse.Merge(m, src)
+}
+func (m *QueryClawbackInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClawbackInfoResponse proto.InternalMessageInfo
+
+func (m *QueryClawbackInfoResponse) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *QueryClawbackInfoResponse) GetClawbackTime() *time.Time {
+	if m != nil {
+		return m.ClawbackTime
+	}
+	return nil
+}
+
+func (m *QueryClawbackInfoResponse) GetClawedBack() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryLockupAccountInfoRequest)(nil), "cosmos.accounts.defaults.lockup.QueryLockupAccountInfoRequest")
 	proto.RegisterType((*QueryLockupAccountInfoResponse)(nil), "cosmos.accounts.defaults.lockup.QueryLockupAccountInfoResponse")
 	proto.RegisterType((*QueryLockingPeriodsRequest)(nil), "cosmos.accounts.defaults.lockup.QueryLockingPeriodsRequest")
 	proto.RegisterType((*QueryLockingPeriodsResponse)(nil), "cosmos.accounts.defaults.lockup.QueryLockingPeriodsResponse")
+	proto.RegisterType((*QueryCliffScheduleRequest)(nil), "cosmos.accounts.defaults.lockup.QueryCliffScheduleRequest")
+	proto.RegisterType((*QueryCliffScheduleResponse)(nil), "cosmos.accounts.defaults.lockup.QueryCliffScheduleResponse")
+	proto.RegisterType((*QueryMilestonesRequest)(nil), "cosmos.accounts.defaults.lockup.QueryMilestonesRequest")
+	proto.RegisterType((*QueryMilestonesResponse)(nil), "cosmos.accounts.defaults.lockup.QueryMilestonesResponse")
+	proto.RegisterType((*QueryClawbackInfoRequest)(nil), "cosmos.accounts.defaults.lockup.QueryClawbackInfoRequest")
+	proto.RegisterType((*QueryClawbackInfoResponse)(nil), "cosmos.accounts.defaults.lockup.QueryClawbackInfoResponse")
 }
 
 func init() {
@@ -270,39 +617,53 @@ func init() {
 }
 
 var fileDescriptor_f06fad50e16c8e9b = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0xda, 0xf4, 0x63, 0x03, 0x6d, 0x89, 0x7a, 0x30, 0x01, 0xec, 0x28, 0x17, 0x22,
-	0x41, 0x77, 0x69, 0x39, 0x72, 0x40, 0x04, 0x09, 0x84, 0xd4, 0x43, 0xb1, 0x38, 0x71, 0xb1, 0xfc,
-	0x31, 0x31, 0xab, 0x38, 0x3b, 0xae, 0x77, 0x5d, 0xda, 0xb7, 0xe8, 0x73, 0xf0, 0x24, 0x3d, 0xf6,
-	0xc8, 0x89, 0xa2, 0xe4, 0x3d, 0x10, 0xb2, 0x77, 0x37, 0xa8, 0x12, 0x55, 0x39, 0xa4, 0xa7, 0xfd,
-	0x9a, 0x99, 0xdf, 0xcc, 0xfe, 0x77, 0x96, 0x3c, 0x4f, 0x50, 0x4e, 0x51, 0xb2, 0x28, 0x49, 0xb0,
-	0x12, 0x4a, 0xb2, 0x14, 0xc6, 0x51, 0x95, 0x2b, 0xc9, 0x72, 0x4c, 0x26, 0x55, 0xc1, 0x8e, 0x2b,
-	0x28, 0xcf, 0x68, 0x51, 0xa2, 0xc2, 0xae, 0xaf, 0x8d, 0xa9, 0x35, 0xa6, 0xd6, 0x98, 0x6a, 0xe3,
-	0xde, 0x8b, 0xdb, 0xa2, 0xe9, 0x41, 0x87, 0xeb, 0x79, 0xc6, 0x3a, 0x8e, 0x24, 0xb0, 0x93, 0xfd,
-	0x18, 0x54, 0xb4, 0xcf, 0x12, 0xe4, 0xc2, 0x9c, 0xef, 0x66, 0x98, 0x61, 0x33, 0x65, 0xf5, 0xcc,
-	0xec, 0xfa, 0x19, 0x62, 0x96, 0x03, 0x6b, 0x56, 0x71, 0x35, 0x66, 0x8a, 0x4f, 0x41, 0xaa, 0x68,
-	0x6a, 0xc2, 0x0e, 0x7c, 0xf2, 0xf4, 0x53, 0x9d, 0xf4, 0x61, 0xc3, 0x7a, 0xab, 0x53, 0xf9, 0x28,
-	0xc6, 0x18, 0xc0, 0x71, 0x05, 0x52, 0x0d, 0x7e, 0xb7, 0x89, 0x77, 0x93, 0x85, 0x2c, 0x50, 0x48,
-	0xe8, 0x9e, 0x90, 0x1d, 0x2c, 0x79, 0xc6, 0x45, 0x94, 0x87, 0x75, 0xce, 0x5c, 0x64, 0xae, 0xd3,
-	0x5f, 0x19, 0x76, 0x0e, 0x1e, 0x51, 0x73, 0x09, 0x75, 0xd6, 0xd4, 0x64, 0x4d, 0xdf, 0x21, 0x17,
-	0xa3, 0x97, 0x17, 0x3f, 0xfd, 0xd6, 0xf7, 0x2b, 0x7f, 0x98, 0x71, 0xf5, 0xb5, 0x8a, 0x69, 0x82,
-	0x53, 0x66, 0x4a, 0xd4, 0xc3, 0x9e, 0x4c, 0x27, 0x4c, 0x9d, 0x15, 0x20, 0x1b, 0x07, 0x19, 0x6c,
-	0x5b, 0xc8, 0xa1, 0x66, 0x74, 0x4b, 0xb2, 0x95, 0x42, 0x0e, 0x59, 0xa4, 0x20, 0x0d, 0xc7, 0x25,
-	0x80, 0x7b, 0x6f, 0xf9, 0xd4, 0x07, 0x0b, 0xc4, 0xfb, 0x12, 0xa0, 0x7b, 0x4a, 0x1e, 0xfe, 0x65,
-	0xda, 0x62, 0x57, 0x96, 0x8f, 0xdd, 0x59, 0x50, 0x6c, 0xb5, 0x6f, 0x08, 0x91, 0x2a, 0x2a, 0x55,
-	0x58, 0x4b, 0xe8, 0xae, 0xf6, 0x9d, 0x61, 0xe7, 0xa0, 0x47, 0xb5, 0xbe, 0xd4, 0xea, 0x4b, 0x3f,
-	0x5b, 0x7d, 0x47, 0xab, 0xe7, 0x57, 0xbe, 0x13, 0x6c, 0x36, 0x3e, 0xf5, 0x6e, 0xf7, 0x35, 0xd9,
-	0x00, 0x91, 0x6a, 0xf7, 0xf6, 0x7f, 0xba, 0xaf, 0x83, 0x48, 0x1b, 0x67, 0x41, 0xee, 0xd7, 0xd5,
-	0x42, 0x1a, 0xd6, 0x6f, 0x4e, 0xba, 0x6b, 0xcb, 0x2f, 0xb9, 0xa3, 0x01, 0xcd, 0xa2, 0xd6, 0xb6,
-	0x12, 0xd7, 0x88, 0xeb, 0x77, 0xa0, 0xad, 0x45, 0x68, 0xe6, 0x2e, 0x69, 0xe3, 0x37, 0x01, 0xa5,
-	0xbb, 0xd1, 0x77, 0x86, 0x9b, 0x81, 0x5e, 0x0c, 0x9e, 0x90, 0xde, 0xe2, 0xfd, 0x73, 0x91, 0x1d,
-	0x41, 0xc9, 0x31, 0x95, 0xb6, 0x3d, 0x90, 0x3c, 0xfe, 0xe7, 0xa9, 0x69, 0x8d, 0x23, 0xb2, 0x6d,
-	0x1e, 0x49, 0x58, 0xe8, 0x23, 0xd3, 0x19, 0xcf, 0xe8, 0x2d, 0xdf, 0x03, 0xd5, 0xa1, 0x82, 0xad,
-	0xfc, 0x5a, 0xe4, 0xd1, 0x87, 0x8b, 0x99, 0xe7, 0x5c, 0xce, 0x3c, 0xe7, 0xd7, 0xcc, 0x73, 0xce,
-	0xe7, 0x5e, 0xeb, 0x72, 0xee, 0xb5, 0x7e, 0xcc, 0xbd, 0xd6, 0x97, 0x3d, 0x1d, 0x51, 0xa6, 0x13,
-	0xca, 0x91, 0x9d, 0xde, 0xfc, 0xaf, 0x34, 0x57, 0x10, 0xaf, 0x35, 0xa2, 0xbf, 0xfa, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x8d, 0xa3, 0x8b, 0xf7, 0xd5, 0x04, 0x00, 0x00,
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcb, 0x4e, 0xdb, 0x5c,
+	0x10, 0x8e, 0xc9, 0x85, 0xe4, 0x84, 0xdb, 0x1f, 0x21, 0x7e, 0x13, 0x5a, 0x07, 0x65, 0xd3, 0xa8,
+	0x2d, 0xc7, 0x85, 0x2e, 0xbb, 0x40, 0x24, 0x6a, 0xab, 0x4a, 0x54, 0xa2, 0x6e, 0x57, 0xdd, 0x44,
+	0x8e, 0x3d, 0x36, 0x56, 0x9c, 0x73, 0x82, 0x8f, 0xcd, 0xe5, 0x2d, 0x78, 0x8e, 0xbe, 0x42, 0x5f,
+	0x80, 0x25, 0xcb, 0xae, 0x4a, 0x05, 0x9b, 0x4a, 0x7d, 0x87, 0xaa, 0x3a, 0x17, 0xbb, 0xa1, 0x80,
+	0x02, 0x12, 0x61, 0x65, 0xcf, 0x99, 0x99, 0xef, 0x9b, 0x39, 0xf3, 0x8d, 0x13, 0xf4, 0xcc, 0xa1,
+	0x6c, 0x40, 0x99, 0x69, 0x3b, 0x0e, 0x4d, 0x48, 0xcc, 0x4c, 0x17, 0x3c, 0x3b, 0x09, 0x63, 0x66,
+	0x86, 0xd4, 0xe9, 0x27, 0x43, 0x73, 0x2f, 0x81, 0xe8, 0x08, 0x0f, 0x23, 0x1a, 0xd3, 0x5a, 0x43,
+	0x06, 0xe3, 0x34, 0x18, 0xa7, 0xc1, 0x58, 0x06, 0xd7, 0x9f, 0x8f, 0x43, 0x93, 0x0f, 0x09, 0x57,
+	0x37, 0x54, 0x74, 0xcf, 0x66, 0x60, 0xee, 0xaf, 0xf7, 0x20, 0xb6, 0xd7, 0x4d, 0x87, 0x06, 0x44,
+	0xf9, 0x17, 0x7d, 0xea, 0x53, 0xf1, 0x6a, 0xf2, 0x37, 0x75, 0xda, 0xf0, 0x29, 0xf5, 0x43, 0x30,
+	0x85, 0xd5, 0x4b, 0x3c, 0x33, 0x0e, 0x06, 0xc0, 0x62, 0x7b, 0xa0, 0x60, 0x9b, 0x0d, 0xf4, 0xf8,
+	0x03, 0x2f, 0x7a, 0x5b, 0x70, 0x6d, 0xc9, 0x52, 0xde, 0x11, 0x8f, 0x5a, 0xb0, 0x97, 0x00, 0x8b,
+	0x9b, 0xbf, 0x8b, 0xc8, 0xb8, 0x29, 0x82, 0x0d, 0x29, 0x61, 0x50, 0xdb, 0x47, 0x0b, 0x34, 0x0a,
+	0xfc, 0x80, 0xd8, 0x61, 0x97, 0xd7, 0x1c, 0x10, 0x5f, 0xd7, 0x56, 0xf3, 0xad, 0xea, 0xc6, 0x32,
+	0x56, 0x97, 0xc0, 0xab, 0xc6, 0xaa, 0x6a, 0xdc, 0xa1, 0x01, 0x69, 0xbf, 0x38, 0xf9, 0xde, 0xc8,
+	0x7d, 0x39, 0x6b, 0xb4, 0xfc, 0x20, 0xde, 0x4d, 0x7a, 0xd8, 0xa1, 0x03, 0x53, 0xb5, 0x28, 0x1f,
+	0x6b, 0xcc, 0xed, 0x9b, 0xf1, 0xd1, 0x10, 0x98, 0x48, 0x60, 0xd6, 0x7c, 0x4a, 0xb2, 0x2d, 0x39,
+	0x6a, 0x11, 0x9a, 0x73, 0x21, 0x04, 0xdf, 0x8e, 0xc1, 0xed, 0x7a, 0x11, 0x80, 0x3e, 0x75, 0xff,
+	0xac, 0xb3, 0x19, 0xc5, 0x9b, 0x08, 0xa0, 0x76, 0x88, 0xfe, 0xfb, 0xcb, 0x99, 0x36, 0x9b, 0xbf,
+	0x7f, 0xda, 0x85, 0x8c, 0x25, 0xed, 0x76, 0x13, 0x21, 0x16, 0xdb, 0x51, 0xdc, 0xe5, 0x23, 0xd4,
+	0x0b, 0xab, 0x5a, 0xab, 0xba, 0x51, 0xc7, 0x72, 0xbe, 0x38, 0x9d, 0x2f, 0xfe, 0x94, 0xce, 0xb7,
+	0x5d, 0x38, 0x3e, 0x6b, 0x68, 0x56, 0x45, 0xe4, 0xf0, 0xd3, 0xda, 0x2b, 0x54, 0x06, 0xe2, 0xca,
+	0xf4, 0xe2, 0x2d, 0xd3, 0xa7, 0x81, 0xb8, 0x22, 0x99, 0xa0, 0x19, 0xde, 0x2d, 0xb8, 0x5d, 0xae,
+	0x39, 0xa6, 0x97, 0xee, 0xbf, 0xe5, 0xaa, 0x24, 0x10, 0x06, 0x9f, 0x6d, 0x42, 0x2e, 0x31, 0x4e,
+	0x4f, 0x60, 0xb6, 0x29, 0x85, 0xe4, 0x5c, 0x44, 0x45, 0x7a, 0x40, 0x20, 0xd2, 0xcb, 0xab, 0x5a,
+	0xab, 0x62, 0x49, 0xa3, 0xf9, 0x08, 0xd5, 0x33, 0xfd, 0x07, 0xc4, 0xdf, 0x81, 0x28, 0xa0, 0x2e,
+	0x4b, 0xd7, 0x83, 0xa2, 0x95, 0x6b, 0xbd, 0x6a, 0x35, 0x76, 0xd0, 0xbc, 0x12, 0x49, 0x77, 0x28,
+	0x5d, 0x6a, 0x33, 0x9e, 0xe0, 0x31, 0x9f, 0x07, 0x2c, 0xa1, 0xac, 0xb9, 0xf0, 0x12, 0x72, 0x73,
+	0x05, 0x2d, 0x0b, 0xc2, 0x4e, 0x18, 0x78, 0xde, 0x47, 0x67, 0x17, 0xdc, 0x24, 0x84, 0xb4, 0x9a,
+	0xaf, 0x05, 0x54, 0xbf, 0xce, 0xab, 0xaa, 0xe9, 0x5c, 0x92, 0x90, 0x36, 0x56, 0x03, 0x65, 0x7e,
+	0xa3, 0xff, 0xca, 0xa8, 0x83, 0x90, 0xc3, 0xd1, 0x25, 0xc8, 0xd4, 0x5d, 0x40, 0x44, 0x9e, 0x00,
+	0xd9, 0x1c, 0xd1, 0x62, 0xfe, 0x0e, 0x10, 0xa3, 0x7a, 0x94, 0x55, 0xd8, 0x03, 0x7e, 0x7d, 0x7a,
+	0x61, 0x02, 0x7a, 0x14, 0x04, 0x5b, 0x02, 0xff, 0x8a, 0xfe, 0x8b, 0x0f, 0xae, 0xff, 0xd2, 0xa4,
+	0xf5, 0xdf, 0xd4, 0xd1, 0x92, 0x10, 0xcf, 0xfb, 0x20, 0x04, 0x16, 0x53, 0x02, 0x99, 0xca, 0x7f,
+	0x4d, 0xa1, 0xff, 0xaf, 0xb8, 0x32, 0x89, 0xa3, 0x41, 0x76, 0xaa, 0xd4, 0xfd, 0x74, 0xac, 0xba,
+	0x33, 0xa0, 0x76, 0x81, 0x97, 0x6d, 0x8d, 0x60, 0xd4, 0xea, 0xa8, 0xac, 0x0a, 0x8b, 0x84, 0xbe,
+	0x2a, 0x56, 0x66, 0x5f, 0x99, 0x43, 0xfe, 0xc1, 0xe7, 0x50, 0x98, 0xf8, 0x1c, 0xea, 0x48, 0x57,
+	0x4b, 0x6c, 0x1f, 0xf4, 0x6c, 0xa7, 0x3f, 0xfa, 0x73, 0xfc, 0x53, 0x43, 0xcb, 0xd7, 0x38, 0xd5,
+	0x2c, 0x96, 0x50, 0xc9, 0x4b, 0x88, 0x0b, 0x91, 0x58, 0xee, 0x8a, 0xa5, 0xac, 0xda, 0x6b, 0x34,
+	0xeb, 0xa8, 0xf8, 0xdb, 0xae, 0xad, 0xfc, 0xfe, 0xcf, 0xa4, 0x69, 0x62, 0xe9, 0x42, 0x54, 0xe5,
+	0x36, 0xb8, 0x5d, 0x7e, 0x34, 0x89, 0xbb, 0x47, 0x12, 0xbf, 0x6d, 0x3b, 0xfd, 0xf6, 0xdb, 0x93,
+	0x73, 0x43, 0x3b, 0x3d, 0x37, 0xb4, 0x1f, 0xe7, 0x86, 0x76, 0x7c, 0x61, 0xe4, 0x4e, 0x2f, 0x8c,
+	0xdc, 0xb7, 0x0b, 0x23, 0xf7, 0x79, 0x4d, 0x66, 0x33, 0xb7, 0x8f, 0x03, 0x6a, 0x1e, 0xde, 0xfc,
+	0x0f, 0x4a, 0x40, 0xf7, 0x4a, 0xa2, 0xbd, 0x97, 0x7f, 0x06, 0x00, 0x49, 0x42, 0x41, 0xdd, 0xbf,
+	0x09, 0x00, 0x00,
 }
 
 func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
@@ -508,39 +869,323 @@ func (m *QueryLockingPeriodsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCliffScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryLockupAccountInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryCliffScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCliffScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockupAccountInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCliffScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCliffScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCliffScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OriginalLocking) > 0 {
-		for _, e := range m.OriginalLocking {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnlockedCoins) > 0 {
+		for iNdEx := len(m.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.DelegatedFree) > 0 {
+	if len(m.LockedCoins) > 0 {
+		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CliffAmount) > 0 {
+		for iNdEx := len(m.CliffAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CliffAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMilestonesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMilestonesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMilestonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMilestonesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMilestonesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMilestonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnlockedCoins) > 0 {
+		for iNdEx := len(m.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockedCoins) > 0 {
+		for iNdEx := len(m.LockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unlocker) > 0 {
+		i -= len(m.Unlocker)
+		copy(dAtA[i:], m.Unlocker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Unlocker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClawbackInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClawbackInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClawedBack) > 0 {
+		for iNdEx := len(m.ClawedBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ClawbackTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ClawbackTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLockupAccountInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockupAccountInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OriginalLocking) > 0 {
+		for _, e := range m.OriginalLocking {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
 		for _, e := range m.DelegatedFree {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
@@ -552,64 +1197,686 @@ func (m *QueryLockupAccountInfoResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.StartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.EndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnlockedCoins) > 0 {
+		for _, e := range m.UnlockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockingPeriodsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockingPeriodsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockingPeriods) > 0 {
+		for _, e := range m.LockingPeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCliffScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCliffScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.CliffAmount) > 0 {
+		for _, e := range m.CliffAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnlockedCoins) > 0 {
+		for _, e := range m.UnlockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMilestonesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMilestonesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Unlocker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.LockedCoins) > 0 {
+		for _, e := range m.LockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnlockedCoins) > 0 {
+		for _, e := range m.UnlockedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClawbackInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryClawbackInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ClawbackTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ClawbackTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ClawedBack) > 0 {
+		for _, e := range m.ClawedBack {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockupAccountInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockupAccountInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockupAccountInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockupAccountInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalLocking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalLocking = append(m.OriginalLocking, types.Coin{})
+			if err := m.OriginalLocking[len(m.OriginalLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedLocking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedLocking = append(m.DelegatedLocking, types.Coin{})
+			if err := m.DelegatedLocking[len(m.DelegatedLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedCoins = append(m.LockedCoins, types.Coin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
+			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.LockedCoins) > 0 {
-		for _, e := range m.LockedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryLockingPeriodsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.UnlockedCoins) > 0 {
-		for _, e := range m.UnlockedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockingPeriodsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockingPeriodsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryLockingPeriodsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryLockingPeriodsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LockingPeriods) > 0 {
-		for _, e := range m.LockingPeriods {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockingPeriodsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockingPeriodsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockingPeriods = append(m.LockingPeriods, &Period{})
+			if err := m.LockingPeriods[len(m.LockingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCliffScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -632,10 +1899,10 @@ func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockupAccountInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCliffScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockupAccountInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCliffScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -659,7 +1926,7 @@ func (m *QueryLockupAccountInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCliffScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -678,19 +1945,85 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockupAccountInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockupAccountInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCliffScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCliffScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalLocking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -717,14 +2050,13 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalLocking = append(m.OriginalLocking, types.Coin{})
-			if err := m.OriginalLocking[len(m.OriginalLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -751,14 +2083,14 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
-			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CliffAmount = append(m.CliffAmount, types.Coin{})
+			if err := m.CliffAmount[len(m.CliffAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedLocking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -785,14 +2117,14 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedLocking = append(m.DelegatedLocking, types.Coin{})
-			if err := m.DelegatedLocking[len(m.DelegatedLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockedCoins = append(m.LockedCoins, types.Coin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -819,16 +2151,114 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartTime == nil {
-				m.StartTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
+			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMilestonesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMilestonesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMilestonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMilestonesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMilestonesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMilestonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -855,18 +2285,16 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTime == nil {
-				m.EndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -876,29 +2304,27 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockedCoins = append(m.LockedCoins, types.Coin{})
-			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Unlocker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -925,16 +2351,16 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
-			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockedCoins = append(m.LockedCoins, types.Coin{})
+			if err := m.LockedCoins[len(m.LockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -944,23 +2370,25 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.UnlockedCoins = append(m.UnlockedCoins, types.Coin{})
+			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -983,7 +2411,7 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockingPeriodsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClawbackInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,10 +2434,10 @@ func (m *QueryLockingPeriodsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockingPeriodsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClawbackInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockingPeriodsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClawbackInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1033,7 +2461,7 @@ func (m *QueryLockingPeriodsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClawbackInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1056,15 +2484,47 @@ func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockingPeriodsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClawbackInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockingPeriodsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClawbackInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1091,8 +2551,44 @@ func (m *QueryLockingPeriodsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockingPeriods = append(m.LockingPeriods, &Period{})
-			if err := m.LockingPeriods[len(m.LockingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ClawbackTime == nil {
+				m.ClawbackTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ClawbackTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = append(m.ClawedBack, types.Coin{})
+			if err := m.ClawedBack[len(m.ClawedBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
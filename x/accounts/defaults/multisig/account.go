@@ -22,6 +22,7 @@ var (
 	ConfigPrefix    = collections.NewPrefix(2)
 	ProposalsPrefix = collections.NewPrefix(3)
 	VotesPrefix     = collections.NewPrefix(4)
+	VetoesPrefix    = collections.NewPrefix(5)
 )
 
 // Compile-time type assertions
@@ -40,6 +41,7 @@ type Account struct {
 
 	Proposals collections.Map[uint64, v1.Proposal]
 	Votes     collections.Map[collections.Pair[uint64, []byte], int32] // key: proposalID + voter address
+	Vetoes    collections.KeySet[collections.Pair[uint64, []byte]]     // key: proposalID + vetoer address
 }
 
 // NewAccount returns a new multisig account creator function.
@@ -50,6 +52,7 @@ func NewAccount(deps accountstd.Dependencies) (*Account, error) {
 		Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
 		Proposals:     collections.NewMap(deps.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](deps.LegacyStateCodec)),
 		Votes:         collections.NewMap(deps.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
+		Vetoes:        collections.NewKeySet(deps.SchemaBuilder, VetoesPrefix, "vetoes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
 		addrCodec:     deps.AddressCodec,
 		headerService: deps.Environment.HeaderService,
 		eventService:  deps.Environment.EventService,
@@ -186,12 +189,18 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		return nil, err
 	}
 
+	addr, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return nil, err
+	}
+
 	// create the proposal
 	proposal := v1.Proposal{
 		Title:    msg.Proposal.Title,
 		Summary:  msg.Proposal.Summary,
 		Messages: msg.Proposal.Messages,
 		Status:   v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD,
+		Proposer: addr,
 	}
 
 	// set the voting period, if not specified, use the default
@@ -200,16 +209,12 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 	} else {
 		proposal.VotingPeriodEnd = a.headerService.HeaderInfo(ctx).Time.Add(time.Second * time.Duration(config.VotingPeriod)).Unix()
 	}
+	proposal.ExpiresAt = expiresAt(proposal.VotingPeriodEnd, config)
 
 	if err = a.Proposals.Set(ctx, seq, proposal); err != nil {
 		return nil, err
 	}
 
-	addr, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
-	if err != nil {
-		return nil, err
-	}
-
 	if err = a.eventService.EventManager(ctx).EmitKV("proposal_created",
 		event.NewAttribute("proposal_id", fmt.Sprint(seq)),
 		event.NewAttribute("proposer", addr),
@@ -220,7 +225,7 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 	return &v1.MsgCreateProposalResponse{ProposalId: seq}, nil
 }
 
-// deleteProposalAndVotes deletes a proposal and its votes, pruning the state.
+// deleteProposalAndVotes deletes a proposal and its votes and vetoes, pruning the state.
 func (a Account) deleteProposalAndVotes(ctx context.Context, proposalID uint64) error {
 	// delete the proposal
 	if err := a.Proposals.Remove(ctx, proposalID); err != nil {
@@ -229,24 +234,44 @@ func (a Account) deleteProposalAndVotes(ctx context.Context, proposalID uint64)
 
 	// delete the votes
 	rng := collections.NewPrefixedPairRange[uint64, []byte](proposalID)
-	return a.Votes.Clear(ctx, rng)
+	if err := a.Votes.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	// delete the vetoes
+	return a.Vetoes.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](proposalID))
 }
 
 // ExecuteProposal tallies the votes for a proposal and executes it if it passes. If early execution is enabled, it will
 // ignore the voting period and tally the votes without deleting them if the proposal has not passed.
+// If an execution delay is configured, a passed proposal is queued instead, and executed by calling ExecuteProposal
+// again once the delay ended. A proposal which was not executed before its expiry is marked as expired.
 func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
 	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
 		return nil, err
 	}
 
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	if isExpired(prop, now) {
+		return &v1.MsgExecuteProposalResponse{}, a.expireProposal(ctx, msg.ProposalId, prop)
+	}
+
+	switch prop.Status {
+	case v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD:
+	case v1.ProposalStatus_PROPOSAL_STATUS_QUEUED:
+		return a.executeQueuedProposal(ctx, msg.ProposalId, prop, now)
+	default:
+		return nil, fmt.Errorf("proposal cannot be executed, status: %s", prop.Status)
+	}
+
 	config, err := a.Config.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// check if voting period is still active and early execution is disabled
-	votingPeriodEnded := a.headerService.HeaderInfo(ctx).Time.Unix() > prop.VotingPeriodEnd
+	votingPeriodEnded := now > prop.VotingPeriodEnd
 	if !votingPeriodEnded && !config.EarlyExecution {
 		return nil, errors.New("voting period has not ended yet, and early execution is not enabled")
 	}
@@ -294,6 +319,11 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	} else if yesVotes < uint64(config.Threshold) {
 		rejectErr = errors.New("threshold not reached")
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+	} else if config.ExecutionDelay > 0 {
+		// we have quorum and threshold, queue the proposal until the execution delay ends
+		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_QUEUED
+		prop.ExecutableAfter = now + config.ExecutionDelay
+		prop.ExpiresAt = expiresAt(prop.ExecutableAfter, config)
 	} else {
 		// we have quorum and threshold, execute the proposal
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
@@ -301,7 +331,7 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	}
 
 	// if early execution is enabled, we return early if the proposal has NOT passed
-	if config.EarlyExecution && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_PASSED {
+	if config.EarlyExecution && prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_REJECTED {
 		return nil, errors.New("early execution attempted and proposal has not passed")
 	}
 
@@ -344,9 +374,39 @@ func (a Account) QueryProposal(ctx context.Context, q *v1.QueryProposal) (*v1.Qu
 	if err != nil {
 		return nil, err
 	}
+	proposal.Status = proposalStatus(proposal, a.headerService.HeaderInfo(ctx).Time.Unix())
 	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
 }
 
+// QueryProposals returns the proposals with the given status, or all the proposals if no status is
+// specified, if they haven't been pruned yet.
+func (a Account) QueryProposals(ctx context.Context, q *v1.QueryProposals) (*v1.QueryProposalsResponse, error) {
+	limit := q.Limit
+	if limit == 0 {
+		limit = defaultProposalsLimit
+	}
+
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	resp := &v1.QueryProposalsResponse{}
+	rng := new(collections.Range[uint64]).StartInclusive(q.StartId)
+	err := a.Proposals.Walk(ctx, rng, func(id uint64, proposal v1.Proposal) (stop bool, err error) {
+		proposal.Status = proposalStatus(proposal, now)
+		if q.Status != v1.ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED && q.Status != proposal.Status {
+			return false, nil
+		}
+		if uint64(len(resp.Proposals)) == limit {
+			resp.NextId = id
+			return true, nil
+		}
+		resp.Proposals = append(resp.Proposals, &v1.ProposalEntry{ProposalId: id, Proposal: &proposal})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // QueryConfig returns the current multisig configuration.
 func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
 	cfg, err := a.Config.Get(ctx)
@@ -376,6 +436,8 @@ func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
 	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
 	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.CancelProposal)
+	accountstd.RegisterExecuteHandler(builder, a.VetoProposal)
 }
 
 // RegisterInitHandler implements implementation.Account.
@@ -387,6 +449,7 @@ func (a *Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
 func (a *Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
+	accountstd.RegisterQueryHandler(builder, a.QueryProposals)
 	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
 }
 
@@ -0,0 +1,210 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+)
+
+// defaultProposalsLimit is the maximum number of proposals returned by QueryProposals if no limit is provided.
+const defaultProposalsLimit = 100
+
+// CancelProposal cancels a proposal which was not executed yet, either during its voting period or while queued.
+// Only the proposer or the account itself (through a proposal) can cancel a proposal.
+func (a Account) CancelProposal(ctx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if sender != prop.Proposer && !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("only the proposer or the account itself can cancel a proposal")
+	}
+
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	if !isPending(prop) || isExpired(prop, now) {
+		return nil, fmt.Errorf("proposal cannot be cancelled, status: %s", proposalStatus(prop, now))
+	}
+
+	if err = a.deleteProposalAndVotes(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("proposal_cancelled",
+		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute("sender", sender),
+	); err != nil {
+		return nil, err
+	}
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_CANCELLED
+	return &v1.MsgCancelProposalResponse{}, a.Proposals.Set(ctx, msg.ProposalId, prop)
+}
+
+// VetoProposal vetoes a queued proposal on behalf of a member. The proposal is vetoed, and cannot be executed anymore,
+// once the weight of the members who vetoed it reaches the veto threshold.
+func (a Account) VetoProposal(ctx context.Context, msg *v1.MsgVetoProposal) (*v1.MsgVetoProposalResponse, error) {
+	cfg, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.VetoThreshold <= 0 {
+		return nil, errors.New("vetoes are disabled per config")
+	}
+
+	sender := accountstd.Sender(ctx)
+
+	// check if the vetoer is a member
+	_, err = a.Members.Get(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_QUEUED || isExpired(prop, now) {
+		return nil, fmt.Errorf("only queued proposals can be vetoed, status: %s", proposalStatus(prop, now))
+	}
+
+	// check if the vetoer has already vetoed
+	has, err := a.Vetoes.Has(ctx, collections.Join(msg.ProposalId, sender))
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.New("member has already vetoed the proposal")
+	}
+	if err = a.Vetoes.Set(ctx, collections.Join(msg.ProposalId, sender)); err != nil {
+		return nil, err
+	}
+
+	addr, err := a.addrCodec.BytesToString(sender)
+	if err != nil {
+		return nil, err
+	}
+	if err = a.eventService.EventManager(ctx).EmitKV("veto",
+		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute("vetoer", addr),
+	); err != nil {
+		return nil, err
+	}
+
+	// tally the vetoes
+	vetoWeight := uint64(0)
+	rng := collections.NewPrefixedPairRange[uint64, []byte](msg.ProposalId)
+	err = a.Vetoes.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+		weight, err := a.Members.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			// edge case: if a member has been removed after vetoing, we should ignore their veto
+			return false, nil
+		} else if err != nil {
+			return true, err
+		}
+		vetoWeight, err = safeAdd(vetoWeight, weight)
+		return err != nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if vetoWeight < uint64(cfg.VetoThreshold) {
+		return &v1.MsgVetoProposalResponse{}, nil
+	}
+
+	if err = a.deleteProposalAndVotes(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("proposal_vetoed",
+		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute("veto_weight", fmt.Sprint(vetoWeight)),
+	); err != nil {
+		return nil, err
+	}
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_VETOED
+	return &v1.MsgVetoProposalResponse{Vetoed: true}, a.Proposals.Set(ctx, msg.ProposalId, prop)
+}
+
+// executeQueuedProposal executes a queued proposal once its execution delay ended.
+func (a Account) executeQueuedProposal(ctx context.Context, proposalID uint64, prop v1.Proposal, now int64) (*v1.MsgExecuteProposalResponse, error) {
+	if now < prop.ExecutableAfter {
+		return nil, fmt.Errorf("execution delay has not ended yet, proposal can be executed from %d", prop.ExecutableAfter)
+	}
+
+	resp := &v1.MsgExecuteProposalResponse{}
+	var execErr error
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	resp.Responses, execErr = accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
+
+	if err := a.deleteProposalAndVotes(ctx, proposalID); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("proposal_execution",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, a.Proposals.Set(ctx, proposalID, prop)
+}
+
+// expireProposal marks a proposal which was not executed before its expiry as expired.
+func (a Account) expireProposal(ctx context.Context, proposalID uint64, prop v1.Proposal) error {
+	if err := a.deleteProposalAndVotes(ctx, proposalID); err != nil {
+		return err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("proposal_expired",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+	); err != nil {
+		return err
+	}
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED
+	return a.Proposals.Set(ctx, proposalID, prop)
+}
+
+// expiresAt returns the expiry of a proposal which can be executed from the given time, or zero if proposals never
+// expire per config.
+func expiresAt(executableFrom int64, cfg v1.Config) int64 {
+	if cfg.ExecutionPeriod <= 0 {
+		return 0
+	}
+	return executableFrom + cfg.ExecutionPeriod
+}
+
+// isPending returns true if the proposal is being voted on or is queued.
+func isPending(prop v1.Proposal) bool {
+	return prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD ||
+		prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_QUEUED
+}
+
+// isExpired returns true if the proposal is pending and its expiry has passed.
+func isExpired(prop v1.Proposal, now int64) bool {
+	return isPending(prop) && prop.ExpiresAt != 0 && now > prop.ExpiresAt
+}
+
+// proposalStatus returns the status of the proposal, taking into account its expiry as proposals are only marked
+// as expired once an execution is attempted.
+func proposalStatus(prop v1.Proposal, now int64) v1.ProposalStatus {
+	if isExpired(prop, now) {
+		return v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED
+	}
+	return prop.Status
+}
@@ -0,0 +1,216 @@
+package multisig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/cosmos/gogoproto/types/any"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+)
+
+func TestProposalTimelock(t *testing.T) {
+	executions := 0
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig_acc"), []byte("addr1"), TestFunds,
+		func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			executions++
+			return nil, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		},
+	)
+
+	currentTime := time.Now()
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:       2000,
+			Quorum:          2000,
+			VotingPeriod:    60,
+			ExecutionDelay:  100,
+			ExecutionPeriod: 50,
+			VetoThreshold:   2000,
+		},
+		Members: []*v1.Member{
+			{Address: "addr1", Weight: 1000},
+			{Address: "addr2", Weight: 1000},
+			{Address: "addr3", Weight: 1000},
+			{Address: "addr4", Weight: 1000},
+		},
+	})
+	require.NoError(t, err)
+
+	as := func(addr string) context.Context {
+		return accountstd.SetSender(ctx, []byte(addr))
+	}
+	requireStatus := func(t *testing.T, propID uint64, status v1.ProposalStatus) {
+		t.Helper()
+		res, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propID})
+		require.NoError(t, err)
+		require.Equal(t, status, res.Proposal.Status)
+	}
+	msgAny, err := accountstd.PackAny(&v1.MsgVote{})
+	require.NoError(t, err)
+	createProposal := func(t *testing.T) uint64 {
+		t.Helper()
+		createRes, err := acc.CreateProposal(as("addr1"), &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{Title: "test", Summary: "test", Messages: []*types.Any{msgAny}},
+		})
+		require.NoError(t, err)
+		return createRes.ProposalId
+	}
+	// queueProposal creates a proposal which passes and is queued
+	queueProposal := func(t *testing.T) uint64 {
+		t.Helper()
+		propID := createProposal(t)
+		for _, voter := range []string{"addr1", "addr2"} {
+			_, err := acc.Vote(as(voter), &v1.MsgVote{ProposalId: propID, Vote: v1.VoteOption_VOTE_OPTION_YES})
+			require.NoError(t, err)
+		}
+		currentTime = currentTime.Add(61 * time.Second)
+		res, err := acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propID})
+		require.NoError(t, err)
+		require.Empty(t, res.Responses)
+		requireStatus(t, propID, v1.ProposalStatus_PROPOSAL_STATUS_QUEUED)
+		return propID
+	}
+
+	t.Run("queued proposal is executed after the delay", func(t *testing.T) {
+		propID := queueProposal(t)
+		require.Equal(t, 0, executions)
+
+		_, err := acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propID})
+		require.ErrorContains(t, err, "execution delay has not ended yet")
+
+		currentTime = currentTime.Add(100 * time.Second)
+		_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propID})
+		require.NoError(t, err)
+		require.Equal(t, 1, executions)
+		requireStatus(t, propID, v1.ProposalStatus_PROPOSAL_STATUS_PASSED)
+
+		// a proposal cannot be executed twice
+		_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propID})
+		require.ErrorContains(t, err, "proposal cannot be executed, status: PROPOSAL_STATUS_PASSED")
+	})
+
+	t.Run("queued proposal is vetoed", func(t *testing.T) {
+		propID := queueProposal(t)
+
+		_, err := acc.VetoProposal(as("not_member"), &v1.MsgVetoProposal{ProposalId: propID})
+		require.Error(t, err)
+
+		res, err := acc.VetoProposal(as("addr3"), &v1.MsgVetoProposal{ProposalId: propID})
+		require.NoError(t, err)
+		require.False(t, res.Vetoed)
+		requireStatus(t, propID, v1.ProposalStatus_PROPOSAL_STATUS_QUEUED)
+
+		_, err = acc.VetoProposal(as("addr3"), &v1.MsgVetoProposal{ProposalId: propID})
+		require.ErrorContains(t, err, "member has already vetoed the proposal")
+
+		res, err = acc.VetoProposal(as("addr4"), &v1.MsgVetoProposal{ProposalId: propID})
+		require.NoError(t, err)
+		require.True(t, res.Vetoed)
+		requireStatus(t, propID, v1.ProposalStatus_PROPOSAL_STATUS_VETOED)
+
+		currentTime = currentTime.Add(100 * time.Second)
+		_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propID})
+		require.ErrorContains(t, err, "proposal cannot be executed, status: PROPOSAL_STATUS_VETOED")
+	})
+
+	t.Run("proposal in voting period cannot be vetoed", func(t *testing.T) {
+		propID := createProposal(t)
+		_, err := acc.VetoProposal(as("addr3"), &v1.MsgVetoProposal{ProposalId: propID})
+		require.ErrorContains(t, err, "only queued proposals can be vetoed")
+	})
+
+	t.Run("proposal is cancelled by its proposer", func(t *testing.T) {
+		propID := queueProposal(t)
+
+		_, err := acc.CancelProposal(as("addr2"), &v1.MsgCancelProposal{ProposalId: propID})
+		require.ErrorContains(t, err, "only the proposer or the account itself can cancel a proposal")
+
+		_, err = acc.CancelProposal(as("addr1"), &v1.MsgCancelProposal{ProposalId: propID})
+		require.NoError(t, err)
+		requireStatus(t, propID, v1.ProposalStatus_PROPOSAL_STATUS_CANCELLED)
+
+		_, err = acc.CancelProposal(as("addr1"), &v1.MsgCancelProposal{ProposalId: propID})
+		require.ErrorContains(t, err, "proposal cannot be cancelled, status: PROPOSAL_STATUS_CANCELLED")
+	})
+
+	t.Run("proposal expires if not executed", func(t *testing.T) {
+		propID := queueProposal(t)
+		executionsBefore := executions
+
+		currentTime = currentTime.Add(151 * time.Second)
+		requireStatus(t, propID, v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED)
+
+		_, err := acc.CancelProposal(as("addr1"), &v1.MsgCancelProposal{ProposalId: propID})
+		require.ErrorContains(t, err, "proposal cannot be cancelled, status: PROPOSAL_STATUS_EXPIRED")
+
+		// executing an expired proposal marks it as expired, without executing it
+		_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propID})
+		require.NoError(t, err)
+		require.Equal(t, executionsBefore, executions)
+		prop, err := acc.Proposals.Get(ctx, propID)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED, prop.Status)
+	})
+
+	t.Run("query proposals by status", func(t *testing.T) {
+		res, err := acc.QueryProposals(ctx, &v1.QueryProposals{})
+		require.NoError(t, err)
+		require.Len(t, res.Proposals, 5)
+		require.Zero(t, res.NextId)
+
+		for status, ids := range map[v1.ProposalStatus][]uint64{
+			v1.ProposalStatus_PROPOSAL_STATUS_PASSED:    {0},
+			v1.ProposalStatus_PROPOSAL_STATUS_VETOED:    {1},
+			v1.ProposalStatus_PROPOSAL_STATUS_CANCELLED: {3},
+			// the proposal left in voting period expired without being marked as expired
+			v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED: {2, 4},
+		} {
+			res, err := acc.QueryProposals(ctx, &v1.QueryProposals{Status: status})
+			require.NoError(t, err)
+			require.Len(t, res.Proposals, len(ids))
+			for i, id := range ids {
+				require.Equal(t, id, res.Proposals[i].ProposalId)
+				require.Equal(t, status, res.Proposals[i].Proposal.Status)
+			}
+		}
+
+		// pagination
+		res, err = acc.QueryProposals(ctx, &v1.QueryProposals{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, res.Proposals, 2)
+		require.Equal(t, uint64(2), res.NextId)
+		res, err = acc.QueryProposals(ctx, &v1.QueryProposals{StartId: res.NextId, Limit: 2})
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), res.Proposals[0].ProposalId)
+		require.Equal(t, uint64(4), res.NextId)
+	})
+}
+
+func TestTimelockConfigValidation(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setup(t, ctx, ss, nil)
+
+	members := []*v1.Member{{Address: "addr1", Weight: 100}}
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config:  &v1.Config{Threshold: 100, Quorum: 100, VotingPeriod: 60, ExecutionDelay: -1},
+		Members: members,
+	})
+	require.ErrorContains(t, err, "execution delay, execution period and veto threshold must not be negative")
+
+	_, err = acc.Init(ctx, &v1.MsgInit{
+		Config:  &v1.Config{Threshold: 100, Quorum: 100, VotingPeriod: 60, VetoThreshold: 101},
+		Members: members,
+	})
+	require.ErrorContains(t, err, "veto threshold must be less than or equal to the total weight")
+}
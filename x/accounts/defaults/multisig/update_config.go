@@ -84,5 +84,15 @@ func validateConfig(cfg v1.Config, totalWeight uint64) error {
 		return errors.New("quorum must be less than or equal to the total weight")
 	}
 
+	// check for negative values, zero disables the timelock, the expiry and the vetoes
+	if cfg.ExecutionDelay < 0 || cfg.ExecutionPeriod < 0 || cfg.VetoThreshold < 0 {
+		return errors.New("execution delay, execution period and veto threshold must not be negative")
+	}
+
+	// veto threshold must be less than or equal to the total weight
+	if totalWeight < uint64(cfg.VetoThreshold) {
+		return errors.New("veto threshold must be less than or equal to the total weight")
+	}
+
 	return nil
 }
@@ -36,6 +36,18 @@ const (
 	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 2
 	// PROPOSAL_STATUS_REJECTED defines the proposal status when the proposal was rejected.
 	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 3
+	// PROPOSAL_STATUS_QUEUED defines the proposal status when the proposal passed
+	// and waits for the execution delay to end.
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 4
+	// PROPOSAL_STATUS_CANCELLED defines the proposal status when the proposal was
+	// cancelled by its proposer.
+	ProposalStatus_PROPOSAL_STATUS_CANCELLED ProposalStatus = 5
+	// PROPOSAL_STATUS_VETOED defines the proposal status when the proposal was
+	// vetoed by the members while queued.
+	ProposalStatus_PROPOSAL_STATUS_VETOED ProposalStatus = 6
+	// PROPOSAL_STATUS_EXPIRED defines the proposal status when the proposal was
+	// not executed before its expiry.
+	ProposalStatus_PROPOSAL_STATUS_EXPIRED ProposalStatus = 7
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -43,6 +55,10 @@ var ProposalStatus_name = map[int32]string{
 	1: "PROPOSAL_STATUS_VOTING_PERIOD",
 	2: "PROPOSAL_STATUS_PASSED",
 	3: "PROPOSAL_STATUS_REJECTED",
+	4: "PROPOSAL_STATUS_QUEUED",
+	5: "PROPOSAL_STATUS_CANCELLED",
+	6: "PROPOSAL_STATUS_VETOED",
+	7: "PROPOSAL_STATUS_EXPIRED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -50,6 +66,10 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_VOTING_PERIOD": 1,
 	"PROPOSAL_STATUS_PASSED":        2,
 	"PROPOSAL_STATUS_REJECTED":      3,
+	"PROPOSAL_STATUS_QUEUED":        4,
+	"PROPOSAL_STATUS_CANCELLED":     5,
+	"PROPOSAL_STATUS_VETOED":        6,
+	"PROPOSAL_STATUS_EXPIRED":       7,
 }
 
 func (x ProposalStatus) String() string {
@@ -456,6 +476,180 @@ func (m *MsgExecuteProposalResponse) GetResponses() []*any.Any {
 	return nil
 }
 
+// MsgCancelProposal is used by the proposer to cancel a proposal which was not
+// executed yet.
+type MsgCancelProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{8}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgCancelProposalResponse is the response returned after cancelling a proposal.
+type MsgCancelProposalResponse struct {
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{9}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
+// MsgVetoProposal is used by a member to veto a queued proposal.
+type MsgVetoProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{10}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgVetoProposalResponse is the response returned after vetoing a proposal.
+type MsgVetoProposalResponse struct {
+	// vetoed is true when the veto threshold was reached and the proposal vetoed.
+	Vetoed bool `protobuf:"varint,1,opt,name=vetoed,proto3" json:"vetoed,omitempty"`
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{11}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
+func (m *MsgVetoProposalResponse) GetVetoed() bool {
+	if m != nil {
+		return m.Vetoed
+	}
+	return false
+}
+
 // MsgUpdateConfig is used to change the config or members.
 type MsgUpdateConfig struct {
 	// only the members that are changing are required, if their weight is 0, they are removed.
@@ -468,7 +662,7 @@ func (m *MsgUpdateConfig) Reset()         { *m = MsgUpdateConfig{} }
 func (m *MsgUpdateConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfig) ProtoMessage()    {}
 func (*MsgUpdateConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{8}
+	return fileDescriptor_e6da8796717704d7, []int{12}
 }
 func (m *MsgUpdateConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +713,7 @@ func (m *MsgUpdateConfigResponse) Reset()         { *m = MsgUpdateConfigResponse
 func (m *MsgUpdateConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{9}
+	return fileDescriptor_e6da8796717704d7, []int{13}
 }
 func (m *MsgUpdateConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +752,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{10}
+	return fileDescriptor_e6da8796717704d7, []int{14}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,13 +805,24 @@ type Config struct {
 	Revote bool `protobuf:"varint,4,opt,name=revote,proto3" json:"revote,omitempty"`
 	// early_execution defines if the multisig can be executed before the voting period ends.
 	EarlyExecution bool `protobuf:"varint,5,opt,name=early_execution,json=earlyExecution,proto3" json:"early_execution,omitempty"`
+	// execution_delay is the duration in seconds a passed proposal is queued for
+	// before it can be executed, during which it can be cancelled or vetoed.
+	// Passed proposals are executed immediately if zero.
+	ExecutionDelay int64 `protobuf:"varint,6,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// execution_period is the duration in seconds during which a proposal can be
+	// executed once the voting period or the execution delay ends, after which it
+	// expires. Proposals never expire if zero.
+	ExecutionPeriod int64 `protobuf:"varint,7,opt,name=execution_period,json=executionPeriod,proto3" json:"execution_period,omitempty"`
+	// veto_threshold is the weight of the members vetoing a queued proposal
+	// required to veto it. Queued proposals cannot be vetoed if zero.
+	VetoThreshold int64 `protobuf:"varint,8,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{11}
+	return fileDescriptor_e6da8796717704d7, []int{15}
 }
 func (m *Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -681,6 +886,27 @@ func (m *Config) GetEarlyExecution() bool {
 	return false
 }
 
+func (m *Config) GetExecutionDelay() int64 {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *Config) GetExecutionPeriod() int64 {
+	if m != nil {
+		return m.ExecutionPeriod
+	}
+	return 0
+}
+
+func (m *Config) GetVetoThreshold() int64 {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return 0
+}
+
 // Proposal defines the structure of a proposal.
 type Proposal struct {
 	Title    string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -689,13 +915,21 @@ type Proposal struct {
 	// voting_period_end will be set by the account when the proposal is created.
 	VotingPeriodEnd int64          `protobuf:"varint,4,opt,name=voting_period_end,json=votingPeriodEnd,proto3" json:"voting_period_end,omitempty"`
 	Status          ProposalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.accounts.defaults.multisig.v1.ProposalStatus" json:"status,omitempty"`
+	// proposer will be set by the account when the proposal is created.
+	Proposer string `protobuf:"bytes,6,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// executable_after will be set by the account when the proposal is queued,
+	// it is the time from which the proposal can be executed.
+	ExecutableAfter int64 `protobuf:"varint,7,opt,name=executable_after,json=executableAfter,proto3" json:"executable_after,omitempty"`
+	// expires_at will be set by the account, it is the time after which the
+	// proposal cannot be executed anymore, or zero if it never expires.
+	ExpiresAt int64 `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{12}
+	return fileDescriptor_e6da8796717704d7, []int{16}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,6 +993,27 @@ func (m *Proposal) GetStatus() ProposalStatus {
 	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Proposal) GetExecutableAfter() int64 {
+	if m != nil {
+		return m.ExecutableAfter
+	}
+	return 0
+}
+
+func (m *Proposal) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 // QuerySequence is the request for the account sequence.
 type QuerySequence struct {
 }
@@ -767,7 +1022,7 @@ func (m *QuerySequence) Reset()         { *m = QuerySequence{} }
 func (m *QuerySequence) String() string { return proto.CompactTextString(m) }
 func (*QuerySequence) ProtoMessage()    {}
 func (*QuerySequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{13}
+	return fileDescriptor_e6da8796717704d7, []int{17}
 }
 func (m *QuerySequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +1061,7 @@ func (m *QuerySequenceResponse) Reset()         { *m = QuerySequenceResponse{} }
 func (m *QuerySequenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySequenceResponse) ProtoMessage()    {}
 func (*QuerySequenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{14}
+	return fileDescriptor_e6da8796717704d7, []int{18}
 }
 func (m *QuerySequenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,7 +1105,7 @@ func (m *QueryConfig) Reset()         { *m = QueryConfig{} }
 func (m *QueryConfig) String() string { return proto.CompactTextString(m) }
 func (*QueryConfig) ProtoMessage()    {}
 func (*QueryConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{15}
+	return fileDescriptor_e6da8796717704d7, []int{19}
 }
 func (m *QueryConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +1144,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{16}
+	return fileDescriptor_e6da8796717704d7, []int{20}
 }
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1196,7 @@ func (m *QueryProposal) Reset()         { *m = QueryProposal{} }
 func (m *QueryProposal) String() string { return proto.CompactTextString(m) }
 func (*QueryProposal) ProtoMessage()    {}
 func (*QueryProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{17}
+	return fileDescriptor_e6da8796717704d7, []int{21}
 }
 func (m *QueryProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -986,7 +1241,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{18}
+	return fileDescriptor_e6da8796717704d7, []int{22}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1022,6 +1277,180 @@ func (m *QueryProposalResponse) GetProposal() *Proposal {
 	return nil
 }
 
+// QueryProposals is the request for the proposals of the account, from the
+// oldest to the newest.
+type QueryProposals struct {
+	// status filters the proposals by status, all the proposals are returned if
+	// unspecified.
+	Status ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cosmos.accounts.defaults.multisig.v1.ProposalStatus" json:"status,omitempty"`
+	// start_id is the ID of the first proposal to return.
+	StartId uint64 `protobuf:"varint,2,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	// limit is the maximum number of proposals to return, 100 if zero.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryProposals) Reset()         { *m = QueryProposals{} }
+func (m *QueryProposals) String() string { return proto.CompactTextString(m) }
+func (*QueryProposals) ProtoMessage()    {}
+func (*QueryProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{23}
+}
+func (m *QueryProposals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposals.Merge(m, src)
+}
+func (m *QueryProposals) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposals proto.InternalMessageInfo
+
+func (m *QueryProposals) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *QueryProposals) GetStartId() uint64 {
+	if m != nil {
+		return m.StartId
+	}
+	return 0
+}
+
+func (m *QueryProposals) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryProposalsResponse returns the proposals.
+type QueryProposalsResponse struct {
+	Proposals []*ProposalEntry `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// next_id is the ID of the proposal to start the next query from, or zero if
+	// there are no more proposals.
+	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+}
+
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{24}
+}
+func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+}
+func (m *QueryProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsResponse) GetProposals() []*ProposalEntry {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsResponse) GetNextId() uint64 {
+	if m != nil {
+		return m.NextId
+	}
+	return 0
+}
+
+// ProposalEntry defines a proposal and its ID.
+type ProposalEntry struct {
+	ProposalId uint64    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Proposal   *Proposal `protobuf:"bytes,2,opt,name=proposal,proto3" json:"proposal,omitempty"`
+}
+
+func (m *ProposalEntry) Reset()         { *m = ProposalEntry{} }
+func (m *ProposalEntry) String() string { return proto.CompactTextString(m) }
+func (*ProposalEntry) ProtoMessage()    {}
+func (*ProposalEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{25}
+}
+func (m *ProposalEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalEntry.Merge(m, src)
+}
+func (m *ProposalEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalEntry proto.InternalMessageInfo
+
+func (m *ProposalEntry) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalEntry) GetProposal() *Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1033,6 +1462,10 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExecuteProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposal")
 	proto.RegisterType((*MsgExecuteProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposalResponse")
+	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgCancelProposalResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse")
 	proto.RegisterType((*MsgUpdateConfig)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig")
 	proto.RegisterType((*MsgUpdateConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse")
 	proto.RegisterType((*Member)(nil), "cosmos.accounts.defaults.multisig.v1.Member")
@@ -1044,6 +1477,9 @@ func init() {
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse")
 	proto.RegisterType((*QueryProposal)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposal")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse")
+	proto.RegisterType((*QueryProposals)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposals")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposalsResponse")
+	proto.RegisterType((*ProposalEntry)(nil), "cosmos.accounts.defaults.multisig.v1.ProposalEntry")
 }
 
 func init() {
@@ -1051,61 +1487,78 @@ func init() {
 }
 
 var fileDescriptor_e6da8796717704d7 = []byte{
-	// 859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0x6e, 0x9a, 0xbe, 0xd2, 0x24, 0x9d, 0x96, 0xad, 0x9b, 0x5d, 0xb2, 0xc5, 0x20,
-	0xb1, 0xaa, 0x16, 0xa7, 0x9b, 0xc2, 0x8d, 0x4b, 0xda, 0xb8, 0x28, 0xab, 0x4d, 0x6c, 0xc6, 0x69,
-	0x25, 0xb8, 0x58, 0x6e, 0x3c, 0x75, 0xad, 0x8d, 0x3d, 0x5e, 0xcf, 0xb8, 0x6c, 0xfe, 0x04, 0xe2,
-	0xc6, 0x0f, 0x80, 0x23, 0x47, 0x7e, 0x04, 0xc7, 0x15, 0x27, 0x8e, 0xa8, 0x95, 0xf8, 0x1d, 0xa8,
-	0xe3, 0xb1, 0x9b, 0xa6, 0xd2, 0x6e, 0x10, 0x3d, 0x70, 0xf3, 0x7b, 0xf3, 0xbe, 0xef, 0x7d, 0xf3,
-	0xcd, 0x9b, 0x91, 0x61, 0x7f, 0x44, 0x59, 0x48, 0x59, 0xcb, 0x1d, 0x8d, 0x68, 0x1a, 0x71, 0xd6,
-	0xf2, 0xc8, 0x99, 0x9b, 0x8e, 0x39, 0x6b, 0x85, 0xe9, 0x98, 0x07, 0x2c, 0xf0, 0x5b, 0x17, 0xcf,
-	0x8b, 0x6f, 0x3d, 0x4e, 0x28, 0xa7, 0xe8, 0xd3, 0x0c, 0xa4, 0xe7, 0x20, 0x3d, 0x07, 0xe9, 0x45,
-	0xe1, 0xc5, 0xf3, 0xc6, 0xb6, 0x4f, 0xa9, 0x3f, 0x26, 0x2d, 0x81, 0x39, 0x4d, 0xcf, 0x5a, 0x6e,
-	0x34, 0xc9, 0x08, 0x1a, 0xdb, 0x19, 0x81, 0x23, 0xa2, 0x96, 0x64, 0x13, 0x81, 0xf6, 0x93, 0x02,
-	0xcb, 0x7d, 0xe6, 0xf7, 0xa2, 0x80, 0xa3, 0x23, 0x58, 0x0e, 0x49, 0x78, 0x4a, 0x12, 0xa6, 0x2a,
-	0x3b, 0xa5, 0xa7, 0xab, 0xed, 0x67, 0xfa, 0x3c, 0x9d, 0xf5, 0xbe, 0x00, 0xe1, 0x1c, 0x8c, 0xba,
-	0x50, 0x1e, 0xd1, 0xe8, 0x2c, 0xf0, 0xd5, 0xc5, 0x1d, 0x65, 0x7e, 0x9a, 0x43, 0x81, 0xc1, 0x12,
-	0xab, 0xad, 0x43, 0x4d, 0x0a, 0xc3, 0x84, 0xc5, 0x34, 0x62, 0x44, 0x73, 0x60, 0xbd, 0xcf, 0xfc,
-	0xc3, 0x84, 0xb8, 0x9c, 0x58, 0x09, 0x8d, 0x29, 0x73, 0xc7, 0xe8, 0x05, 0x54, 0x62, 0xf9, 0xad,
-	0x2a, 0xa2, 0x9f, 0x3e, 0x5f, 0xbf, 0x9c, 0x01, 0x17, 0x78, 0xed, 0x2b, 0xd8, 0xbe, 0xd3, 0x20,
-	0xef, 0x8e, 0x9e, 0xc0, 0x6a, 0x5e, 0xe8, 0x04, 0x9e, 0xe8, 0xb5, 0x84, 0x21, 0x4f, 0xf5, 0x3c,
-	0x2d, 0x16, 0x56, 0x9e, 0x50, 0xfe, 0xfe, 0x5a, 0xd4, 0x85, 0xa5, 0x0b, 0xca, 0x89, 0x70, 0xa8,
-	0xda, 0xde, 0x9b, 0x4f, 0xf1, 0x35, 0xb5, 0x19, 0xf3, 0x80, 0x46, 0x58, 0xa0, 0xa5, 0x47, 0xd7,
-	0xe9, 0xc2, 0xa3, 0x2f, 0x01, 0xf5, 0x99, 0x6f, 0xbc, 0x21, 0xa3, 0x74, 0xca, 0xa4, 0xf7, 0x6a,
-	0xb7, 0xa0, 0x71, 0x17, 0x56, 0x6c, 0xbd, 0x0d, 0x2b, 0x89, 0xfc, 0xce, 0x67, 0x63, 0x53, 0xcf,
-	0xe6, 0x4d, 0xcf, 0xe7, 0x4d, 0xef, 0x44, 0x13, 0x7c, 0x53, 0xa6, 0xfd, 0xaa, 0x08, 0x71, 0xc7,
-	0xb1, 0xe7, 0x72, 0x92, 0x9d, 0x2d, 0xb2, 0xa1, 0x9a, 0x8a, 0xd8, 0xf9, 0x2f, 0x83, 0xb6, 0x96,
-	0x71, 0xf4, 0xef, 0x75, 0xdc, 0xb6, 0x61, 0x6b, 0x46, 0x6d, 0x61, 0xe9, 0x10, 0xca, 0x59, 0x2f,
-	0xd4, 0x86, 0x65, 0xd7, 0xf3, 0x12, 0xc2, 0x98, 0xb0, 0x70, 0xe5, 0x40, 0xfd, 0xe3, 0xb7, 0xcf,
-	0x37, 0x65, 0xbb, 0x4e, 0xb6, 0x62, 0xf3, 0x24, 0x88, 0x7c, 0x9c, 0x17, 0xa2, 0x87, 0x50, 0xfe,
-	0x9e, 0x04, 0xfe, 0x39, 0x17, 0xf2, 0x96, 0xb0, 0x8c, 0xb4, 0x5f, 0x14, 0x28, 0x4b, 0x5b, 0x1e,
-	0xc3, 0x0a, 0x3f, 0x4f, 0x08, 0x3b, 0xa7, 0xe3, 0xec, 0x6c, 0x4a, 0xf8, 0x26, 0x71, 0x4d, 0xf0,
-	0x3a, 0xa5, 0x49, 0x1a, 0x0a, 0x82, 0x12, 0x96, 0x11, 0xfa, 0x04, 0xd6, 0x2e, 0x28, 0x0f, 0x22,
-	0xdf, 0x89, 0x49, 0x12, 0x50, 0x4f, 0x2d, 0x89, 0xe5, 0x0f, 0xb2, 0xa4, 0x25, 0x72, 0xd7, 0xe0,
-	0x84, 0x88, 0x49, 0x5b, 0xda, 0x51, 0x9e, 0x56, 0xb0, 0x8c, 0xd0, 0x67, 0x50, 0x23, 0x6e, 0x32,
-	0x9e, 0x38, 0x44, 0x1c, 0x79, 0x40, 0x23, 0xf5, 0x81, 0x28, 0xa8, 0x8a, 0xb4, 0x91, 0x67, 0xb5,
-	0xbf, 0x15, 0xa8, 0x14, 0x63, 0xb4, 0x09, 0x0f, 0x78, 0xc0, 0xc7, 0x24, 0xdb, 0x3d, 0xce, 0x02,
-	0xa4, 0xc2, 0x32, 0x4b, 0xc3, 0xd0, 0x4d, 0x26, 0x42, 0xe1, 0x0a, 0xce, 0x43, 0xb4, 0x07, 0x95,
-	0x90, 0x30, 0xe6, 0xfa, 0x84, 0xa9, 0xa5, 0x77, 0x8c, 0x4d, 0x51, 0x85, 0x76, 0x61, 0xfd, 0xd6,
-	0xa6, 0x1c, 0x12, 0x79, 0x42, 0x7a, 0x09, 0xd7, 0xa6, 0x37, 0x66, 0x44, 0x1e, 0x7a, 0x09, 0x65,
-	0xc6, 0x5d, 0x9e, 0x32, 0x21, 0xbd, 0xda, 0xfe, 0xe2, 0xdf, 0xdd, 0x7b, 0x5b, 0x60, 0xb1, 0xe4,
-	0xd0, 0x6a, 0xb0, 0xf6, 0x4d, 0x4a, 0x92, 0x89, 0x4d, 0x5e, 0xa7, 0x24, 0x1a, 0x11, 0x6d, 0x1f,
-	0x3e, 0xbc, 0x95, 0x28, 0x6e, 0x43, 0x03, 0x2a, 0x4c, 0xe6, 0xe4, 0x4d, 0x2a, 0x62, 0x6d, 0x0d,
-	0x56, 0x05, 0x28, 0x3b, 0x59, 0xed, 0x67, 0x05, 0x36, 0xa6, 0xe2, 0x82, 0xe2, 0xff, 0xf5, 0xd4,
-	0xee, 0xc9, 0xad, 0xcf, 0xff, 0x5c, 0x8c, 0xa4, 0x37, 0x77, 0x5e, 0x8a, 0x7b, 0x7c, 0x8d, 0x77,
-	0x7f, 0x50, 0xa0, 0x7a, 0xfb, 0xb0, 0xd0, 0x13, 0x78, 0x64, 0x61, 0xd3, 0x32, 0xed, 0xce, 0x4b,
-	0xc7, 0x1e, 0x76, 0x86, 0xc7, 0xb6, 0x73, 0x3c, 0xb0, 0x2d, 0xe3, 0xb0, 0x77, 0xd4, 0x33, 0xba,
-	0xf5, 0x05, 0xf4, 0x31, 0x7c, 0x34, 0x5b, 0x70, 0x62, 0x0e, 0x7b, 0x83, 0xaf, 0x1d, 0xcb, 0xc0,
-	0x3d, 0xb3, 0x5b, 0x57, 0x50, 0x03, 0x1e, 0xce, 0x96, 0x58, 0x1d, 0xdb, 0x36, 0xba, 0xf5, 0x45,
-	0xf4, 0x18, 0xd4, 0xd9, 0x35, 0x6c, 0xbc, 0x30, 0x0e, 0x87, 0x46, 0xb7, 0x5e, 0xda, 0x7d, 0x05,
-	0x70, 0xf3, 0x04, 0xa3, 0x47, 0xb0, 0x75, 0x62, 0x0e, 0x0d, 0xc7, 0xb4, 0x86, 0x3d, 0x73, 0x30,
-	0xa3, 0x63, 0x03, 0x6a, 0xd3, 0x8b, 0xdf, 0x1a, 0x76, 0x5d, 0x41, 0x5b, 0xb0, 0x31, 0x9d, 0xec,
-	0x1c, 0xd8, 0xc3, 0x4e, 0x6f, 0x50, 0x5f, 0x44, 0x08, 0xaa, 0xd3, 0x0b, 0x03, 0xb3, 0x5e, 0x3a,
-	0x38, 0xfa, 0xfd, 0xb2, 0xa9, 0xbc, 0xbd, 0x6c, 0x2a, 0x7f, 0x5d, 0x36, 0x95, 0x1f, 0xaf, 0x9a,
-	0x0b, 0x6f, 0xaf, 0x9a, 0x0b, 0x7f, 0x5e, 0x35, 0x17, 0xbe, 0x7b, 0x96, 0x19, 0xca, 0xbc, 0x57,
-	0x7a, 0x40, 0x5b, 0x6f, 0xde, 0xfd, 0x33, 0x71, 0x5a, 0x16, 0x37, 0x6d, 0xff, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x6b, 0x50, 0x07, 0xb0, 0x7b, 0x08, 0x00, 0x00,
+	// 1125 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x4f, 0x23, 0x47,
+	0x17, 0xa5, 0x6d, 0x8f, 0x1f, 0x97, 0xcf, 0xa6, 0x29, 0x18, 0xb0, 0x61, 0xf0, 0xcc, 0xd7, 0x49,
+	0x94, 0x04, 0x4d, 0x6c, 0x30, 0x64, 0x97, 0x8d, 0xb1, 0x9b, 0xc8, 0x23, 0xb0, 0x4d, 0xb5, 0x41,
+	0x49, 0x36, 0xad, 0xc6, 0x5d, 0x98, 0xd6, 0xd8, 0x5d, 0x9e, 0xae, 0x6a, 0x82, 0xa5, 0xac, 0xa2,
+	0xec, 0x93, 0x5d, 0x96, 0x59, 0x64, 0x9b, 0x65, 0x7e, 0x44, 0x96, 0xa3, 0xac, 0xb2, 0x8c, 0x40,
+	0xca, 0xef, 0x88, 0xba, 0xfa, 0xe1, 0x07, 0xa3, 0xc1, 0xa3, 0x61, 0x91, 0x5d, 0xdd, 0x53, 0xf7,
+	0xdc, 0xc7, 0xa9, 0x5b, 0xe5, 0x36, 0xec, 0x75, 0x29, 0x1b, 0x50, 0x56, 0x36, 0xba, 0x5d, 0xea,
+	0xda, 0x9c, 0x95, 0x4d, 0x72, 0x61, 0xb8, 0x7d, 0xce, 0xca, 0x03, 0xb7, 0xcf, 0x2d, 0x66, 0xf5,
+	0xca, 0x57, 0xbb, 0xd1, 0xba, 0x34, 0x74, 0x28, 0xa7, 0xe8, 0x43, 0x9f, 0x54, 0x0a, 0x49, 0xa5,
+	0x90, 0x54, 0x8a, 0x1c, 0xaf, 0x76, 0x37, 0x0a, 0x3d, 0x4a, 0x7b, 0x7d, 0x52, 0x16, 0x9c, 0x73,
+	0xf7, 0xa2, 0x6c, 0xd8, 0x23, 0x3f, 0xc0, 0x46, 0xc1, 0x0f, 0xa0, 0x0b, 0xab, 0x1c, 0x44, 0x13,
+	0x86, 0xf2, 0xb3, 0x04, 0xa9, 0x63, 0xd6, 0x6b, 0xd8, 0x16, 0x47, 0x87, 0x90, 0x1a, 0x90, 0xc1,
+	0x39, 0x71, 0x58, 0x5e, 0x7a, 0x16, 0xff, 0x64, 0xb1, 0xf2, 0xbc, 0x34, 0x4f, 0xe6, 0xd2, 0xb1,
+	0x20, 0xe1, 0x90, 0x8c, 0xea, 0x90, 0xec, 0x52, 0xfb, 0xc2, 0xea, 0xe5, 0x63, 0xcf, 0xa4, 0xf9,
+	0xc3, 0xd4, 0x04, 0x07, 0x07, 0x5c, 0x65, 0x19, 0x96, 0x82, 0xc2, 0x30, 0x61, 0x43, 0x6a, 0x33,
+	0xa2, 0xe8, 0xb0, 0x7c, 0xcc, 0x7a, 0x35, 0x87, 0x18, 0x9c, 0xb4, 0x1d, 0x3a, 0xa4, 0xcc, 0xe8,
+	0xa3, 0x17, 0x90, 0x1e, 0x06, 0xeb, 0xbc, 0x24, 0xf2, 0x95, 0xe6, 0xcb, 0x17, 0x46, 0xc0, 0x11,
+	0x5f, 0xf9, 0x02, 0x0a, 0x77, 0x12, 0x84, 0xd9, 0xd1, 0x53, 0x58, 0x0c, 0x1d, 0x75, 0xcb, 0x14,
+	0xb9, 0x12, 0x18, 0x42, 0xa8, 0x61, 0x2a, 0x43, 0x21, 0xe5, 0x19, 0xe5, 0xf7, 0xfb, 0xa2, 0x3a,
+	0x24, 0xae, 0x28, 0x27, 0x42, 0xa1, 0x5c, 0x65, 0x67, 0xbe, 0x8a, 0xbd, 0xd0, 0xad, 0x21, 0xb7,
+	0xa8, 0x8d, 0x05, 0x3b, 0xd0, 0xc8, 0x83, 0x23, 0x8d, 0x3e, 0x07, 0x74, 0xcc, 0x7a, 0xea, 0x35,
+	0xe9, 0xba, 0x13, 0x22, 0xdd, 0x5b, 0x7b, 0x1b, 0x36, 0xee, 0xd2, 0xa2, 0xd6, 0x2b, 0x90, 0x71,
+	0x82, 0x75, 0x38, 0x1b, 0xab, 0x25, 0x7f, 0xde, 0x4a, 0xe1, 0xbc, 0x95, 0xaa, 0xf6, 0x08, 0x8f,
+	0xdd, 0x94, 0x7d, 0xff, 0xb0, 0x0c, 0xbb, 0x4b, 0xfa, 0xf3, 0xd7, 0xb1, 0x09, 0x85, 0x3b, 0xac,
+	0xa8, 0xb7, 0x8a, 0xdf, 0x2e, 0xe1, 0x74, 0xfe, 0x80, 0xbb, 0xb0, 0x3e, 0xc3, 0x89, 0xba, 0x5a,
+	0x83, 0xe4, 0x15, 0xe1, 0x94, 0xf8, 0xb4, 0x34, 0x0e, 0x2c, 0xe5, 0x37, 0x49, 0xe4, 0x39, 0x1d,
+	0x9a, 0x06, 0x27, 0xfe, 0x54, 0x22, 0x0d, 0x72, 0xae, 0xb0, 0xf5, 0xf7, 0xb9, 0x22, 0x59, 0x3f,
+	0xc6, 0xf1, 0x83, 0x5e, 0x94, 0x02, 0xac, 0xcf, 0x54, 0x1b, 0x09, 0xd6, 0x81, 0xa4, 0x9f, 0x0b,
+	0x55, 0x20, 0x65, 0x98, 0xa6, 0x43, 0x18, 0x13, 0xcd, 0x66, 0x0e, 0xf2, 0x7f, 0xfe, 0xfe, 0xd9,
+	0x6a, 0x90, 0xae, 0xea, 0xef, 0x68, 0xdc, 0xb1, 0xec, 0x1e, 0x0e, 0x1d, 0x3d, 0x7d, 0xbe, 0x25,
+	0x56, 0xef, 0x92, 0x8b, 0xf2, 0x12, 0x38, 0xb0, 0x94, 0x5f, 0x62, 0x90, 0x0c, 0x64, 0x79, 0x02,
+	0x19, 0x7e, 0xe9, 0x10, 0x76, 0x49, 0xfb, 0xbe, 0x8a, 0x71, 0x3c, 0x06, 0xbc, 0x00, 0xaf, 0x5c,
+	0xea, 0xb8, 0x03, 0x11, 0x20, 0x8e, 0x03, 0x0b, 0x7d, 0x00, 0xd9, 0x2b, 0xca, 0x2d, 0xbb, 0xa7,
+	0x0f, 0x89, 0x63, 0x51, 0x33, 0x1f, 0x17, 0xdb, 0xff, 0xf3, 0xc1, 0xb6, 0xc0, 0x3c, 0xb2, 0x43,
+	0xc4, 0x1d, 0x49, 0xf8, 0xa7, 0xe3, 0x5b, 0xe8, 0x63, 0x58, 0x22, 0x86, 0xd3, 0x1f, 0xe9, 0x44,
+	0x0c, 0xab, 0x45, 0xed, 0xfc, 0x23, 0xe1, 0x90, 0x13, 0xb0, 0x1a, 0xa2, 0xc2, 0x31, 0x34, 0x74,
+	0x93, 0xf4, 0x8d, 0x51, 0x3e, 0x29, 0xf2, 0xe4, 0x22, 0xb8, 0xee, 0xa1, 0xe8, 0x53, 0x90, 0xc7,
+	0x8e, 0x41, 0x45, 0x29, 0xe1, 0x39, 0x0e, 0x10, 0x14, 0xf5, 0x11, 0xe4, 0xbc, 0x21, 0xd1, 0xc7,
+	0x4d, 0xa7, 0x85, 0x63, 0xd6, 0x43, 0x3b, 0x21, 0xa8, 0xfc, 0x13, 0x83, 0x74, 0x34, 0xa2, 0xab,
+	0xf0, 0x88, 0x5b, 0xbc, 0x4f, 0x7c, 0xe1, 0xb1, 0x6f, 0xa0, 0x3c, 0xa4, 0x98, 0x3b, 0x18, 0x18,
+	0xce, 0x48, 0x88, 0x93, 0xc1, 0xa1, 0x89, 0x76, 0x20, 0x3d, 0x20, 0x8c, 0x19, 0x3d, 0xc2, 0xf2,
+	0xf1, 0xb7, 0xdc, 0xb5, 0xc8, 0x0b, 0x6d, 0xc3, 0xf2, 0x94, 0x9e, 0x3a, 0xb1, 0x4d, 0xa1, 0x5a,
+	0x1c, 0x2f, 0x4d, 0x6a, 0xaa, 0xda, 0x26, 0x3a, 0x82, 0x24, 0xe3, 0x06, 0x77, 0x99, 0x50, 0x2d,
+	0x57, 0xd9, 0x7f, 0xb7, 0xc7, 0x52, 0x13, 0x5c, 0x1c, 0xc4, 0x40, 0xfb, 0xe1, 0xe3, 0x4b, 0x9c,
+	0x7c, 0xf2, 0x9e, 0xb9, 0x8a, 0x3c, 0xc7, 0x82, 0x1b, 0xe7, 0x7d, 0xa2, 0x1b, 0x17, 0x9c, 0x38,
+	0xd3, 0x82, 0x7b, 0x78, 0xd5, 0x83, 0xd1, 0x16, 0x00, 0xb9, 0x1e, 0x5a, 0x0e, 0x61, 0xba, 0xc1,
+	0x03, 0xb1, 0x33, 0x01, 0x52, 0xe5, 0xca, 0x12, 0x64, 0x4f, 0x5c, 0xe2, 0x8c, 0x34, 0xf2, 0xca,
+	0x25, 0x76, 0x97, 0x28, 0x7b, 0xf0, 0x78, 0x0a, 0x88, 0x2e, 0xfb, 0x06, 0xa4, 0x59, 0x80, 0x05,
+	0xaf, 0x44, 0x64, 0x2b, 0x59, 0x58, 0x14, 0x24, 0x7f, 0xa8, 0x95, 0x5f, 0x25, 0x58, 0x99, 0xb0,
+	0xa3, 0x10, 0xff, 0xad, 0xdf, 0xc7, 0x9d, 0xa0, 0xf5, 0xf9, 0x9f, 0xc2, 0x2e, 0x3c, 0x9e, 0x62,
+	0x44, 0x8d, 0x3d, 0xe4, 0x4f, 0xe8, 0x8f, 0x12, 0xe4, 0xa6, 0xb2, 0xb0, 0x89, 0x91, 0x93, 0x1e,
+	0x60, 0xe4, 0x0a, 0x90, 0x66, 0xdc, 0x70, 0xb8, 0xd7, 0xa3, 0xff, 0x2e, 0xa5, 0x84, 0xdd, 0x30,
+	0xbd, 0x9b, 0xd6, 0xb7, 0x06, 0x16, 0x17, 0xef, 0x49, 0x02, 0xfb, 0x86, 0xf2, 0x83, 0x04, 0x6b,
+	0xd3, 0x15, 0x45, 0x8d, 0x9f, 0x40, 0x26, 0x2c, 0x3c, 0x3c, 0xd3, 0xbd, 0x77, 0x2b, 0x4e, 0xb5,
+	0xb9, 0x33, 0xc2, 0xe3, 0x28, 0x68, 0x1d, 0x52, 0x36, 0xb9, 0x9e, 0xa8, 0x2e, 0xe9, 0x99, 0x0d,
+	0x53, 0xf9, 0x0e, 0xb2, 0x53, 0xa4, 0xfb, 0xbf, 0x11, 0x26, 0x8f, 0x25, 0xf6, 0x7e, 0xc7, 0xb2,
+	0xfd, 0x7d, 0x0c, 0x72, 0xd3, 0x82, 0xa2, 0xa7, 0xb0, 0xd9, 0xc6, 0xad, 0x76, 0x4b, 0xab, 0x1e,
+	0xe9, 0x5a, 0xa7, 0xda, 0x39, 0xd5, 0xf4, 0xd3, 0xa6, 0xd6, 0x56, 0x6b, 0x8d, 0xc3, 0x86, 0x5a,
+	0x97, 0x17, 0xd0, 0xff, 0x61, 0x6b, 0xd6, 0xe1, 0xac, 0xd5, 0x69, 0x34, 0xbf, 0xd4, 0xdb, 0x2a,
+	0x6e, 0xb4, 0xea, 0xb2, 0x84, 0x36, 0x60, 0x6d, 0xd6, 0xa5, 0x5d, 0xd5, 0x34, 0xb5, 0x2e, 0xc7,
+	0xd0, 0x13, 0xc8, 0xcf, 0xee, 0x61, 0xf5, 0x85, 0x5a, 0xeb, 0xa8, 0x75, 0x39, 0xfe, 0x26, 0xe6,
+	0xc9, 0xa9, 0x7a, 0xaa, 0xd6, 0xe5, 0x04, 0xda, 0x82, 0xc2, 0xec, 0x5e, 0xad, 0xda, 0xac, 0xa9,
+	0x47, 0x47, 0x6a, 0x5d, 0x7e, 0xf4, 0x26, 0xea, 0x99, 0xda, 0x69, 0xa9, 0x75, 0x39, 0x89, 0x36,
+	0x61, 0x7d, 0x76, 0x4f, 0xfd, 0xaa, 0xdd, 0xc0, 0x6a, 0x5d, 0x4e, 0x6d, 0xbf, 0x04, 0x18, 0x7f,
+	0x42, 0x79, 0xae, 0x67, 0xad, 0x8e, 0xaa, 0xb7, 0xda, 0x9d, 0x46, 0xab, 0x39, 0xd3, 0xfb, 0x0a,
+	0x2c, 0x4d, 0x6e, 0x7e, 0xad, 0x6a, 0xb2, 0x84, 0xd6, 0x61, 0x65, 0x12, 0xac, 0x1e, 0x68, 0x9d,
+	0x6a, 0xa3, 0x29, 0xc7, 0x10, 0x82, 0xdc, 0xe4, 0x46, 0xb3, 0x25, 0xc7, 0x0f, 0x0e, 0xff, 0xb8,
+	0x29, 0x4a, 0xaf, 0x6f, 0x8a, 0xd2, 0xdf, 0x37, 0x45, 0xe9, 0xa7, 0xdb, 0xe2, 0xc2, 0xeb, 0xdb,
+	0xe2, 0xc2, 0x5f, 0xb7, 0xc5, 0x85, 0x6f, 0x9e, 0xfb, 0x87, 0xc8, 0xcc, 0x97, 0x25, 0x8b, 0x96,
+	0xaf, 0xdf, 0xfe, 0x67, 0xe0, 0x3c, 0x29, 0x1e, 0xfd, 0xbd, 0x7f, 0x07, 0x00, 0x6d, 0x62, 0xb0,
+	0x7a, 0x3b, 0x0c, 0x00, 0x00,
 }
 
 func (m *MsgInit) Marshal() (dAtA []byte, err error) {
@@ -1364,6 +1817,118 @@ func (m *MsgExecuteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vetoed {
+		i--
+		if m.Vetoed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1491,6 +2056,21 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VetoThreshold != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.VetoThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExecutionPeriod != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutionPeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecutionDelay != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutionDelay))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EarlyExecution {
 		i--
 		if m.EarlyExecution {
@@ -1549,6 +2129,23 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExecutableAfter != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutableAfter))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Status != 0 {
 		i = encodeVarintMultisig(dAtA, i, uint64(m.Status))
 		i--
@@ -1776,6 +2373,126 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.StartId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.NextId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMultisig(v)
 	base := offset
@@ -1891,6 +2608,51 @@ func (m *MsgExecuteProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vetoed {
+		n += 2
+	}
+	return n
+}
+
 func (m *MsgUpdateConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1956,6 +2718,15 @@ func (m *Config) Size() (n int) {
 	if m.EarlyExecution {
 		n += 2
 	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutionDelay))
+	}
+	if m.ExecutionPeriod != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutionPeriod))
+	}
+	if m.VetoThreshold != 0 {
+		n += 1 + sovMultisig(uint64(m.VetoThreshold))
+	}
 	return n
 }
 
@@ -1985,7 +2756,17 @@ func (m *Proposal) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovMultisig(uint64(m.Status))
 	}
-	return n
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.ExecutableAfter != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutableAfter))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovMultisig(uint64(m.ExpiresAt))
+	}
+	return n
 }
 
 func (m *QuerySequence) Size() (n int) {
@@ -2062,6 +2843,58 @@ func (m *QueryProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovMultisig(uint64(m.Status))
+	}
+	if m.StartId != 0 {
+		n += 1 + sovMultisig(uint64(m.StartId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMultisig(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	if m.NextId != 0 {
+		n += 1 + sovMultisig(uint64(m.NextId))
+	}
+	return n
+}
+
+func (m *ProposalEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalId))
+	}
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	return n
+}
+
 func sovMultisig(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2684,7 +3517,7 @@ func (m *MsgExecuteProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2707,51 +3540,17 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMembers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UpdateMembers = append(m.UpdateMembers, &Member{})
-			if err := m.UpdateMembers[len(m.UpdateMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -2761,28 +3560,11 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Config == nil {
-				m.Config = &Config{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -2804,7 +3586,7 @@ func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,10 +3609,10 @@ func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2854,7 +3636,7 @@ func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2877,49 +3659,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.Weight = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -2929,7 +3679,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2955,7 +3705,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Config) Unmarshal(dAtA []byte) error {
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2978,92 +3728,15 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-			}
-			m.Threshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Threshold |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
-			}
-			m.Quorum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Quorum |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
-			}
-			m.VotingPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotingPeriod |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revote", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Revote = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarlyExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3080,7 +3753,7 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.EarlyExecution = bool(v != 0)
+			m.Vetoed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3102,7 +3775,7 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3125,17 +3798,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMembers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -3145,29 +3818,897 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMultisig
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultisig
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
+			m.UpdateMembers = append(m.UpdateMembers, &Member{})
+			if err := m.UpdateMembers[len(m.UpdateMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &Config{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			m.Quorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quorum |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			m.VotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revote = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EarlyExecution = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPeriod", wireType)
+			}
+			m.ExecutionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			m.VetoThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodEnd", wireType)
+			}
+			m.VotingPeriodEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriodEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAfter", wireType)
+			}
+			m.ExecutableAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -3177,27 +4718,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMultisig
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultisig
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3224,49 +4767,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &any.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &Config{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodEnd", wireType)
-			}
-			m.VotingPeriodEnd = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotingPeriodEnd |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultisig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3288,7 +4795,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySequence) Unmarshal(dAtA []byte) error {
+func (m *QueryProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3311,12 +4818,31 @@ func (m *QuerySequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3338,7 +4864,7 @@ func (m *QuerySequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3361,17 +4887,17 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -3381,11 +4907,28 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3407,7 +4950,7 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfig) Unmarshal(dAtA []byte) error {
+func (m *QueryProposals) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3430,12 +4973,69 @@ func (m *QueryConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposals: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposals: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
+			}
+			m.StartId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3457,7 +5057,7 @@ func (m *QueryConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3480,15 +5080,15 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3515,16 +5115,16 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, &ProposalEntry{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
 			}
-			var msglen int
+			m.NextId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultisig
@@ -3534,28 +5134,11 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Config == nil {
-				m.Config = &Config{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3577,7 +5160,7 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposal) Unmarshal(dAtA []byte) error {
+func (m *ProposalEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3600,10 +5183,10 @@ func (m *QueryProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3625,57 +5208,7 @@ func (m *QueryProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMultisig(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMultisig
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMultisig
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
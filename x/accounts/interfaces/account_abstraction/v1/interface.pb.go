@@ -5,7 +5,10 @@ package v1
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -140,6 +143,121 @@ func (m *MsgAuthenticateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAuthenticateResponse proto.InternalMessageInfo
 
+// MsgSponsorFees is a message that an x/account paymaster implementer must handle
+// to sponsor the fees of a transaction designating it as fee granter, after running
+// its own validation logic. The fees are then deducted from the paymaster.
+// Always ensure the caller is the Accounts module.
+type MsgSponsorFees struct {
+	// fee_payer defines the address of the fee payer of the tx.
+	FeePayer string `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fee defines the fees the paymaster is requested to pay.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// tx defines the decoded version of the tx.
+	Tx *tx.Tx `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *MsgSponsorFees) Reset()         { *m = MsgSponsorFees{} }
+func (m *MsgSponsorFees) String() string { return proto.CompactTextString(m) }
+func (*MsgSponsorFees) ProtoMessage()    {}
+func (*MsgSponsorFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56b360422260e9d1, []int{2}
+}
+func (m *MsgSponsorFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSponsorFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSponsorFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSponsorFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSponsorFees.Merge(m, src)
+}
+func (m *MsgSponsorFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSponsorFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSponsorFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSponsorFees proto.InternalMessageInfo
+
+func (m *MsgSponsorFees) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *MsgSponsorFees) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *MsgSponsorFees) GetTx() *tx.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// MsgSponsorFeesResponse is the response to MsgSponsorFees.
+type MsgSponsorFeesResponse struct {
+	// payment defines the coins the fee payer pays to the paymaster in exchange for
+	// the sponsored fees. They are transferred by the accounts module along with the
+	// fees, the tx is rejected if the fee payer cannot pay them.
+	Payment github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=payment,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payment"`
+}
+
+func (m *MsgSponsorFeesResponse) Reset()         { *m = MsgSponsorFeesResponse{} }
+func (m *MsgSponsorFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSponsorFeesResponse) ProtoMessage()    {}
+func (*MsgSponsorFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56b360422260e9d1, []int{3}
+}
+func (m *MsgSponsorFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSponsorFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSponsorFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSponsorFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSponsorFeesResponse.Merge(m, src)
+}
+func (m *MsgSponsorFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSponsorFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSponsorFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSponsorFeesResponse proto.InternalMessageInfo
+
+func (m *MsgSponsorFeesResponse) GetPayment() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
 // QueryAuthenticationMethods is a query that an x/account account abstraction implementer
 // must handle to return the authentication methods that the account supports.
 type QueryAuthenticationMethods struct {
@@ -149,7 +267,7 @@ func (m *QueryAuthenticationMethods) Reset()         { *m = QueryAuthenticationM
 func (m *QueryAuthenticationMethods) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthenticationMethods) ProtoMessage()    {}
 func (*QueryAuthenticationMethods) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56b360422260e9d1, []int{2}
+	return fileDescriptor_56b360422260e9d1, []int{4}
 }
 func (m *QueryAuthenticationMethods) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +306,7 @@ func (m *QueryAuthenticationMethodsResponse) Reset()         { *m = QueryAuthent
 func (m *QueryAuthenticationMethodsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthenticationMethodsResponse) ProtoMessage()    {}
 func (*QueryAuthenticationMethodsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56b360422260e9d1, []int{3}
+	return fileDescriptor_56b360422260e9d1, []int{5}
 }
 func (m *QueryAuthenticationMethodsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +345,8 @@ func (m *QueryAuthenticationMethodsResponse) GetAuthenticationMethods() []string
 func init() {
 	proto.RegisterType((*MsgAuthenticate)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgAuthenticate")
 	proto.RegisterType((*MsgAuthenticateResponse)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgAuthenticateResponse")
+	proto.RegisterType((*MsgSponsorFees)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgSponsorFees")
+	proto.RegisterType((*MsgSponsorFeesResponse)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.MsgSponsorFeesResponse")
 	proto.RegisterType((*QueryAuthenticationMethods)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.QueryAuthenticationMethods")
 	proto.RegisterType((*QueryAuthenticationMethodsResponse)(nil), "cosmos.accounts.interfaces.account_abstraction.v1.QueryAuthenticationMethodsResponse")
 }
@@ -236,29 +356,38 @@ func init() {
 }
 
 var fileDescriptor_56b360422260e9d1 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xeb, 0xf6, 0xde, 0xa2, 0xba, 0x20, 0xa4, 0x48, 0x85, 0x50, 0xa1, 0x28, 0x44, 0x42,
-	0xca, 0x64, 0x2b, 0x20, 0x06, 0xc6, 0xb2, 0x31, 0x74, 0x20, 0x74, 0x82, 0x21, 0x72, 0x12, 0xd3,
-	0x5a, 0x50, 0xbb, 0xb2, 0x4f, 0x5a, 0xf3, 0x16, 0x3c, 0x05, 0xcf, 0xc2, 0xd8, 0x91, 0x11, 0xb5,
-	0x2f, 0x82, 0x68, 0x5a, 0x0a, 0xa8, 0x0c, 0x8c, 0xfe, 0xcf, 0xe7, 0xcf, 0xc7, 0xfa, 0x71, 0x27,
-	0x53, 0x66, 0xa8, 0x0c, 0x65, 0x59, 0xa6, 0x0a, 0x09, 0x86, 0x0a, 0x09, 0x5c, 0xdf, 0xb1, 0x8c,
-	0x7f, 0x66, 0x09, 0x4b, 0x0d, 0x68, 0x96, 0x81, 0x50, 0x92, 0x8e, 0xa3, 0x35, 0x41, 0x46, 0x5a,
-	0x81, 0x72, 0xa2, 0x52, 0x41, 0x56, 0x0a, 0xb2, 0x56, 0x90, 0x0d, 0x0a, 0x32, 0x8e, 0xda, 0xed,
-	0xe5, 0xab, 0x60, 0xe9, 0x38, 0x4a, 0x39, 0xb0, 0x88, 0x82, 0x2d, 0x75, 0xc1, 0x33, 0xc2, 0xbb,
-	0x5d, 0xd3, 0xef, 0x14, 0x30, 0xe0, 0x12, 0x44, 0xc6, 0x80, 0x3b, 0x2e, 0xde, 0x4a, 0x0b, 0x99,
-	0x3f, 0x70, 0xed, 0x22, 0x1f, 0x85, 0x8d, 0x78, 0x75, 0x74, 0x28, 0xae, 0x6b, 0x36, 0x49, 0xc0,
-	0xba, 0x55, 0x1f, 0x85, 0xcd, 0x13, 0x97, 0x2c, 0xb7, 0x01, 0x4b, 0x96, 0x6a, 0xd2, 0xb3, 0x31,
-	0x9b, 0xc4, 0xff, 0x35, 0x9b, 0xf4, 0xac, 0x73, 0x8c, 0xab, 0x60, 0xdd, 0xda, 0x02, 0x6e, 0x6d,
-	0x86, 0xab, 0x60, 0x9d, 0x23, 0xbc, 0x6d, 0x44, 0x5f, 0x72, 0x9d, 0x08, 0x99, 0x73, 0xeb, 0xfe,
-	0xf3, 0x51, 0xb8, 0x13, 0x37, 0xcb, 0xec, 0xf2, 0x23, 0x0a, 0x0e, 0xf0, 0xfe, 0x8f, 0x3d, 0x63,
-	0x6e, 0x46, 0x4a, 0x1a, 0x1e, 0x1c, 0xe2, 0xf6, 0x55, 0xc1, 0xf5, 0xe3, 0x97, 0xa1, 0x50, 0xb2,
-	0xcb, 0x61, 0xa0, 0x72, 0x13, 0xdc, 0xe2, 0xe0, 0xf7, 0xe9, 0xca, 0xe1, 0x9c, 0xe1, 0x3d, 0xf6,
-	0x0d, 0x48, 0x86, 0x25, 0xe1, 0x22, 0xbf, 0x16, 0x36, 0xe2, 0x16, 0xdb, 0x74, 0xfd, 0xe2, 0xfa,
-	0x65, 0xe6, 0xa1, 0xe9, 0xcc, 0x43, 0x6f, 0x33, 0x0f, 0x3d, 0xcd, 0xbd, 0xca, 0x74, 0xee, 0x55,
-	0x5e, 0xe7, 0x5e, 0xe5, 0xe6, 0xbc, 0xfc, 0xac, 0xc9, 0xef, 0x89, 0x50, 0xd4, 0xfe, 0xa1, 0xf2,
-	0xb4, 0xbe, 0xa8, 0xe6, 0xf4, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x1c, 0x3d, 0x0e, 0x2e, 0x02,
-	0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0x09, 0xb4, 0x64, 0xc3, 0x87, 0x64, 0xd1, 0xe2, 0x06, 0xe4, 0x06, 0x4b, 0x48,
+	0xb9, 0xb0, 0x4b, 0x8a, 0x38, 0x70, 0x6c, 0x91, 0x90, 0x38, 0x44, 0x02, 0xb7, 0x27, 0x10, 0xb2,
+	0xd6, 0xf6, 0xc4, 0x59, 0x95, 0xec, 0x5a, 0xde, 0x75, 0xb2, 0x39, 0xf1, 0x0a, 0x3c, 0x05, 0x07,
+	0xae, 0xbc, 0x44, 0x8f, 0x3d, 0x72, 0x02, 0x94, 0xbc, 0x08, 0xf2, 0x67, 0x29, 0x0a, 0x12, 0x48,
+	0x3d, 0x79, 0x67, 0xe6, 0xbf, 0xff, 0xf9, 0xcd, 0xc8, 0x8b, 0x0f, 0x43, 0xa9, 0x66, 0x52, 0x51,
+	0x16, 0x86, 0x32, 0x13, 0x5a, 0x51, 0x2e, 0x34, 0xa4, 0x13, 0x16, 0x42, 0x93, 0xf3, 0x59, 0xa0,
+	0x74, 0xca, 0x42, 0xcd, 0xa5, 0xa0, 0xf3, 0xd1, 0x85, 0x82, 0x24, 0xa9, 0xd4, 0xd2, 0x1a, 0x95,
+	0x16, 0xa4, 0xb6, 0x20, 0x17, 0x16, 0x64, 0x83, 0x05, 0x99, 0x8f, 0xfa, 0x4e, 0xd5, 0x35, 0x60,
+	0x0a, 0xe8, 0x7c, 0x14, 0x80, 0x66, 0x23, 0x1a, 0x4a, 0x2e, 0x4a, 0xcb, 0x7e, 0xbf, 0xaa, 0x6b,
+	0xd3, 0x54, 0xb5, 0xa9, 0x6a, 0x77, 0x63, 0x19, 0xcb, 0xe2, 0x48, 0xf3, 0x53, 0x99, 0x75, 0x3f,
+	0x23, 0x7c, 0x67, 0xac, 0xe2, 0xc3, 0x4c, 0x4f, 0x41, 0x68, 0x1e, 0x32, 0x0d, 0x96, 0x8d, 0xb7,
+	0x83, 0x4c, 0x44, 0x1f, 0x20, 0xb5, 0xd1, 0x00, 0x0d, 0xbb, 0x5e, 0x1d, 0x5a, 0x14, 0x6f, 0xa5,
+	0x6c, 0xe1, 0x6b, 0x63, 0xb7, 0x07, 0x68, 0xd8, 0x3b, 0xb0, 0x49, 0x35, 0x83, 0x36, 0xa4, 0x6a,
+	0x48, 0x4e, 0x8c, 0xc7, 0x16, 0xde, 0xf5, 0x94, 0x2d, 0x4e, 0x8c, 0xf5, 0x08, 0xb7, 0xb5, 0xb1,
+	0x3b, 0x85, 0x78, 0x67, 0xb3, 0xb8, 0xad, 0x8d, 0xf5, 0x10, 0xdf, 0x54, 0x3c, 0x16, 0x90, 0xfa,
+	0x5c, 0x44, 0x60, 0xec, 0x6b, 0x03, 0x34, 0xbc, 0xe5, 0xf5, 0xca, 0xdc, 0xab, 0x3c, 0xe5, 0xee,
+	0xe1, 0x7b, 0x7f, 0x70, 0x7a, 0xa0, 0x12, 0x29, 0x14, 0xb8, 0x5f, 0x11, 0xbe, 0x3d, 0x56, 0xf1,
+	0x71, 0x1e, 0xc9, 0xf4, 0x25, 0x80, 0xb2, 0xee, 0xe3, 0xee, 0x04, 0xc0, 0x4f, 0xd8, 0xb2, 0x19,
+	0xe2, 0xc6, 0x04, 0xe0, 0x75, 0x1e, 0x5b, 0xef, 0x71, 0x67, 0x02, 0x60, 0xb7, 0x07, 0x9d, 0x61,
+	0xef, 0x60, 0xaf, 0xa6, 0xca, 0x77, 0xda, 0x70, 0xbd, 0x90, 0x5c, 0x1c, 0x3d, 0x39, 0xfb, 0xbe,
+	0xdf, 0xfa, 0xf2, 0x63, 0x7f, 0x18, 0x73, 0x3d, 0xcd, 0x02, 0x12, 0xca, 0x19, 0xad, 0x16, 0x5c,
+	0x7e, 0x1e, 0xab, 0xe8, 0x94, 0xea, 0x65, 0x02, 0xaa, 0xb8, 0xa0, 0xbc, 0xdc, 0xf7, 0x1f, 0x67,
+	0x76, 0x3f, 0xe2, 0xdd, 0xcb, 0xd0, 0xf5, 0x3c, 0x16, 0xe0, 0xed, 0x84, 0x2d, 0x67, 0x20, 0xb4,
+	0x8d, 0xae, 0x9e, 0xb1, 0xf6, 0x76, 0x1f, 0xe0, 0xfe, 0x9b, 0x0c, 0xd2, 0xe5, 0x6f, 0x3b, 0xe5,
+	0x52, 0x8c, 0x41, 0x4f, 0x65, 0xa4, 0xdc, 0x77, 0xd8, 0xfd, 0x7b, 0xb5, 0x41, 0x7d, 0x86, 0x77,
+	0xd9, 0x25, 0x81, 0x3f, 0x2b, 0x15, 0x05, 0x79, 0xd7, 0xdb, 0x61, 0x9b, 0xae, 0x1f, 0x1d, 0x9f,
+	0xad, 0x1c, 0x74, 0xbe, 0x72, 0xd0, 0xcf, 0x95, 0x83, 0x3e, 0xad, 0x9d, 0xd6, 0xf9, 0xda, 0x69,
+	0x7d, 0x5b, 0x3b, 0xad, 0xb7, 0xcf, 0x4b, 0x6a, 0x15, 0x9d, 0x12, 0x2e, 0xa9, 0xf9, 0x8f, 0xf7,
+	0x15, 0x6c, 0x15, 0x7f, 0xf4, 0xd3, 0x5f, 0x03, 0x00, 0xa5, 0x05, 0xe3, 0x8d, 0x9b, 0x03, 0x00,
+	0x00,
 }
 
 func (m *MsgAuthenticate) Marshal() (dAtA []byte, err error) {
@@ -343,6 +472,99 @@ func (m *MsgAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSponsorFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSponsorFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSponsorFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterface(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterface(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintInterface(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSponsorFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSponsorFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSponsorFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payment) > 0 {
+		for iNdEx := len(m.Payment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterface(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAuthenticationMethods) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -442,6 +664,44 @@ func (m *MsgAuthenticateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSponsorFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovInterface(uint64(l))
+		}
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSponsorFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payment) > 0 {
+		for _, e := range m.Payment {
+			l = e.Size()
+			n += 1 + l + sovInterface(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryAuthenticationMethods) Size() (n int) {
 	if m == nil {
 		return 0
@@ -695,6 +955,242 @@ func (m *MsgAuthenticateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSponsorFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSponsorFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSponsorFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &tx.Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSponsorFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSponsorFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSponsorFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payment = append(m.Payment, types.Coin{})
+			if err := m.Payment[len(m.Payment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAuthenticationMethods) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
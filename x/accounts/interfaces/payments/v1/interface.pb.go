@@ -0,0 +1,583 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accounts/interfaces/payments/v1/interface.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgClaimPayments is a message that an x/accounts account paying merchants
+// must handle to authorize and record a payment claimed by a merchant. The
+// x/accounts module then transfers the returned amount from the account to the
+// merchant. Always ensure the caller is the Accounts module.
+type MsgClaimPayments struct {
+	// merchant defines the address of the merchant claiming the payment.
+	Merchant string `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	// amount defines the coins claimed by the merchant, all the coins due to the
+	// merchant if empty.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimPayments) Reset()         { *m = MsgClaimPayments{} }
+func (m *MsgClaimPayments) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPayments) ProtoMessage()    {}
+func (*MsgClaimPayments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300644ccba3119db, []int{0}
+}
+func (m *MsgClaimPayments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPayments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPayments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimPayments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPayments.Merge(m, src)
+}
+func (m *MsgClaimPayments) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPayments) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPayments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPayments proto.InternalMessageInfo
+
+func (m *MsgClaimPayments) GetMerchant() string {
+	if m != nil {
+		return m.Merchant
+	}
+	return ""
+}
+
+func (m *MsgClaimPayments) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgClaimPaymentsResponse is the response to MsgClaimPayments.
+type MsgClaimPaymentsResponse struct {
+	// amount defines the coins paid to the merchant, which are transferred to it.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimPaymentsResponse) Reset()         { *m = MsgClaimPaymentsResponse{} }
+func (m *MsgClaimPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPaymentsResponse) ProtoMessage()    {}
+func (*MsgClaimPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_300644ccba3119db, []int{1}
+}
+func (m *MsgClaimPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPaymentsResponse.Merge(m, src)
+}
+func (m *MsgClaimPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPaymentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPaymentsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimPaymentsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgClaimPayments)(nil), "cosmos.accounts.interfaces.payments.v1.MsgClaimPayments")
+	proto.RegisterType((*MsgClaimPaymentsResponse)(nil), "cosmos.accounts.interfaces.payments.v1.MsgClaimPaymentsResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accounts/interfaces/payments/v1/interface.proto", fileDescriptor_300644ccba3119db)
+}
+
+var fileDescriptor_300644ccba3119db = []byte{
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x4e, 0xc2, 0x40,
+	0x18, 0xc7, 0x7b, 0x9a, 0x10, 0xad, 0x8b, 0x21, 0x0c, 0x85, 0xe1, 0x20, 0x0c, 0x86, 0x85, 0x3b,
+	0xab, 0xc6, 0x5d, 0x58, 0x5c, 0x4c, 0x0c, 0x6e, 0x2e, 0xe6, 0x7a, 0x3d, 0xcb, 0x05, 0x7b, 0x5f,
+	0xd3, 0xef, 0x20, 0x32, 0xf9, 0x0a, 0x3e, 0x87, 0x89, 0x9b, 0x0f, 0xc1, 0x48, 0x9c, 0x9c, 0xd4,
+	0xc0, 0x8b, 0x98, 0xd2, 0x43, 0x8c, 0x8b, 0x93, 0x53, 0xdb, 0xff, 0xd7, 0xdf, 0xff, 0xfb, 0x25,
+	0x77, 0xfe, 0xa9, 0x04, 0x4c, 0x01, 0xb9, 0x90, 0x12, 0xc6, 0xc6, 0x22, 0xd7, 0xc6, 0xaa, 0xfc,
+	0x56, 0x48, 0x85, 0x3c, 0x13, 0xd3, 0x54, 0x15, 0xd9, 0x24, 0xdc, 0xc4, 0x2c, 0xcb, 0xc1, 0x42,
+	0xf5, 0xa0, 0xe4, 0xd8, 0x9a, 0x63, 0x1b, 0x8e, 0xad, 0x39, 0x36, 0x09, 0x1b, 0xd4, 0xf5, 0x47,
+	0x02, 0x15, 0x9f, 0x84, 0x91, 0xb2, 0x22, 0xe4, 0x12, 0xb4, 0x29, 0x7b, 0x1a, 0xf5, 0x72, 0x7e,
+	0xb3, 0xfa, 0xe2, 0xae, 0xb4, 0x1c, 0xd5, 0x12, 0x48, 0xa0, 0xcc, 0x8b, 0xb7, 0x32, 0x6d, 0x3f,
+	0x13, 0x7f, 0xff, 0x02, 0x93, 0xfe, 0x9d, 0xd0, 0xe9, 0xa5, 0x5b, 0x54, 0x3d, 0xf1, 0x77, 0x52,
+	0x95, 0xcb, 0xa1, 0x30, 0x36, 0x20, 0x2d, 0xd2, 0xd9, 0xed, 0x05, 0xaf, 0x2f, 0xdd, 0x9a, 0xab,
+	0x3b, 0x8b, 0xe3, 0x5c, 0x21, 0x5e, 0xd9, 0x5c, 0x9b, 0x64, 0xf0, 0xfd, 0x67, 0x55, 0xfa, 0x15,
+	0x91, 0x16, 0xf6, 0xc1, 0x56, 0x6b, 0xbb, 0xb3, 0x77, 0x54, 0x67, 0x0e, 0x28, 0x64, 0x99, 0x93,
+	0x65, 0x7d, 0xd0, 0xa6, 0x77, 0x38, 0x7b, 0x6f, 0x7a, 0x4f, 0x1f, 0xcd, 0x4e, 0xa2, 0xed, 0x70,
+	0x1c, 0x31, 0x09, 0xa9, 0x93, 0x75, 0x8f, 0x2e, 0xc6, 0x23, 0x6e, 0xa7, 0x99, 0xc2, 0x15, 0x80,
+	0x03, 0x57, 0xdd, 0x7e, 0xf0, 0x83, 0xdf, 0xba, 0x03, 0x85, 0x19, 0x18, 0x54, 0x3f, 0x04, 0xc8,
+	0xbf, 0x09, 0xf4, 0xce, 0x67, 0x0b, 0x4a, 0xe6, 0x0b, 0x4a, 0x3e, 0x17, 0x94, 0x3c, 0x2e, 0xa9,
+	0x37, 0x5f, 0x52, 0xef, 0x6d, 0x49, 0xbd, 0x6b, 0xb7, 0x0d, 0xe3, 0x11, 0xd3, 0xc0, 0xef, 0xff,
+	0xba, 0x03, 0x51, 0x65, 0x75, 0x02, 0xc7, 0x5f, 0x03, 0x00, 0xfb, 0x67, 0x2b, 0x56, 0x34, 0x02,
+	0x00, 0x00,
+}
+
+func (m *MsgClaimPayments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPayments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPayments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterface(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Merchant) > 0 {
+		i -= len(m.Merchant)
+		copy(dAtA[i:], m.Merchant)
+		i = encodeVarintInterface(dAtA, i, uint64(len(m.Merchant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterface(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterface(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterface(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgClaimPayments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Merchant)
+	if l > 0 {
+		n += 1 + l + sovInterface(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovInterface(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovInterface(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovInterface(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterface(x uint64) (n int) {
+	return sovInterface(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgClaimPayments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimPayments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimPayments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merchant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Merchant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimPaymentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimPaymentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterface
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterface(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterface
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInterface(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInterface
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterface
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInterface
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInterface
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInterface
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInterface        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInterface          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInterface = fmt.Errorf("proto: unexpected end of group")
+)
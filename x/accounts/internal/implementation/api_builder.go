@@ -9,9 +9,10 @@ import (
 )
 
 var (
-	errNoInitHandler    = errors.New("no init handler")
-	errNoExecuteHandler = errors.New("account does not accept messages")
-	errInvalidMessage   = errors.New("invalid message")
+	errNoInitHandler      = errors.New("no init handler")
+	errNoExecuteHandler   = errors.New("account does not accept messages")
+	errInvalidMessage     = errors.New("invalid message")
+	errNoMigrationHandler = errors.New("no migration handler")
 )
 
 // NewInitBuilder creates a new InitBuilder instance.
@@ -102,6 +103,42 @@ func (r *QueryBuilder) makeHandler() (func(ctx context.Context, queryRequest tra
 	return r.er.makeHandler()
 }
 
+// NewMigrationBuilder creates a new MigrationBuilder instance.
+func NewMigrationBuilder() *MigrationBuilder {
+	return &MigrationBuilder{
+		handlers:       make(map[string]func(ctx context.Context, migrateRequest transaction.Msg) (migrateResponse transaction.Msg, err error)),
+		handlersSchema: make(map[string]HandlerSchema),
+	}
+}
+
+// MigrationBuilder defines a smart account's migration router, it maps the
+// account type an account is migrated from to the handler migrating its state.
+type MigrationBuilder struct {
+	// handlers is a map of handler functions, keyed by the account type migrated from.
+	handlers map[string]func(ctx context.Context, migrateRequest transaction.Msg) (migrateResponse transaction.Msg, err error)
+
+	// handlersSchema is a map of schemas for the messages that will be passed to the handler functions
+	// and the messages that will be returned by the handler functions, keyed by the account type migrated from.
+	handlersSchema map[string]HandlerSchema
+
+	// err is the error that occurred before building the handler function.
+	err error
+}
+
+func (m *MigrationBuilder) makeHandler() (func(ctx context.Context, fromAccountType string, migrateRequest transaction.Msg) (migrateResponse transaction.Msg, err error), error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return func(ctx context.Context, fromAccountType string, migrateRequest transaction.Msg) (migrateResponse transaction.Msg, err error) {
+		handler, ok := m.handlers[fromAccountType]
+		if !ok {
+			return nil, fmt.Errorf("%w: from account type %s", errNoMigrationHandler, fromAccountType)
+		}
+		return handler(ctx, migrateRequest)
+	}, nil
+}
+
 // IsRoutingError returns true if the error is a routing error,
 // which typically occurs when a message cannot be matched to a handler.
 func IsRoutingError(err error) bool {
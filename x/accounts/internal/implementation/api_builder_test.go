@@ -37,3 +37,30 @@ func TestEmptyQueryExecuteHandler(t *testing.T) {
 	_, err = eh(ctx, &types.StringValue{})
 	require.ErrorIs(t, err, errNoExecuteHandler)
 }
+
+func TestMigrationBuilder(t *testing.T) {
+	mr := NewMigrationBuilder()
+	RegisterMigrationHandler(mr, "v1", func(_ context.Context, req *types.StringValue) (*types.StringValue, error) {
+		return req, nil
+	})
+
+	mh, err := mr.makeHandler()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	resp, err := mh(ctx, "v1", &types.StringValue{Value: "migrated"})
+	require.NoError(t, err)
+	require.Equal(t, &types.StringValue{Value: "migrated"}, resp)
+	_, err = mh(ctx, "v1", &types.Empty{})
+	require.ErrorIs(t, err, errInvalidMessage)
+	_, err = mh(ctx, "unknown", &types.StringValue{})
+	require.ErrorIs(t, err, errNoMigrationHandler)
+
+	// double registration
+	RegisterMigrationHandler(mr, "v1", func(_ context.Context, req *types.StringValue) (*types.StringValue, error) {
+		return nil, nil
+	})
+	_, err = mr.makeHandler()
+	require.ErrorContains(t, err, "already registered")
+}
@@ -88,19 +88,31 @@ func newImplementation(schemaBuilder *collections.SchemaBuilder, account Account
 		return Implementation{}, err
 	}
 
+	// make migration handler, accounts which cannot be migrated to have no migration handlers
+	mr := NewMigrationBuilder()
+	if migratable, ok := account.(MigratableAccount); ok {
+		migratable.RegisterMigrationHandlers(mr)
+	}
+	migrateHandler, err := mr.makeHandler()
+	if err != nil {
+		return Implementation{}, err
+	}
+
 	// build schema
 	schema, err := schemaBuilder.Build()
 	if err != nil {
 		return Implementation{}, err
 	}
 	return Implementation{
-		Init:                  initHandler,
-		Execute:               executeHandler,
-		Query:                 queryHandler,
-		CollectionsSchema:     schema,
-		InitHandlerSchema:     ir.schema,
-		QueryHandlersSchema:   qr.er.handlersSchema,
-		ExecuteHandlersSchema: er.handlersSchema,
+		Init:                    initHandler,
+		Execute:                 executeHandler,
+		Query:                   queryHandler,
+		Migrate:                 migrateHandler,
+		CollectionsSchema:       schema,
+		InitHandlerSchema:       ir.schema,
+		QueryHandlersSchema:     qr.er.handlersSchema,
+		ExecuteHandlersSchema:   er.handlersSchema,
+		MigrationHandlersSchema: mr.handlersSchema,
 	}, nil
 }
 
@@ -113,6 +125,8 @@ type Implementation struct {
 	Execute func(ctx context.Context, msg transaction.Msg) (resp transaction.Msg, err error)
 	// Query defines the query handler for the smart account.
 	Query func(ctx context.Context, msg transaction.Msg) (resp transaction.Msg, err error)
+	// Migrate defines the handler migrating an account of the given account type to the smart account.
+	Migrate func(ctx context.Context, fromAccountType string, msg transaction.Msg) (resp transaction.Msg, err error)
 	// CollectionsSchema represents the state schema.
 	CollectionsSchema collections.Schema
 	// InitHandlerSchema represents the init handler schema.
@@ -121,6 +135,8 @@ type Implementation struct {
 	QueryHandlersSchema map[string]HandlerSchema
 	// ExecuteHandlersSchema is the schema of the execute handlers.
 	ExecuteHandlersSchema map[string]HandlerSchema
+	// MigrationHandlersSchema is the schema of the migration handlers, keyed by the account type migrated from.
+	MigrationHandlersSchema map[string]HandlerSchema
 }
 
 // HasExec returns true if the account can execute the given msg.
@@ -140,6 +156,13 @@ func (i Implementation) HasInit(m transaction.Msg) bool {
 	return i.InitHandlerSchema.RequestSchema.Name == MessageName(m)
 }
 
+// HasMigration returns true if an account of the given account type can be migrated
+// to the smart account.
+func (i Implementation) HasMigration(fromAccountType string) bool {
+	_, ok := i.MigrationHandlersSchema[fromAccountType]
+	return ok
+}
+
 // MessageSchema defines the schema of a message.
 // A message can also define a state schema.
 type MessageSchema struct {
@@ -15,3 +15,14 @@ type Account interface {
 	// might also decide to not register any query handler.
 	RegisterQueryHandlers(builder *QueryBuilder)
 }
+
+// MigratableAccount defines a smart account which existing accounts of other
+// account types, such as previous versions of itself, can be migrated to.
+type MigratableAccount interface {
+	Account
+
+	// RegisterMigrationHandlers allows the smart account to register the handlers
+	// migrating an account of another account type to this account type, using
+	// the provided MigrationBuilder.
+	RegisterMigrationHandlers(builder *MigrationBuilder)
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/transaction"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
@@ -19,10 +21,24 @@ var (
 	ErrBundlerPayment = errors.New("bundler payment failed")
 	// ErrExecution is returned when the execution fails.
 	ErrExecution = errors.New("execution failed")
+	// ErrPaymaster is returned when the paymaster refuses to sponsor the fees.
+	ErrPaymaster = errors.New("paymaster refused to sponsor the fees")
 )
 
 // IsAbstractedAccount returns if the provided address is an abstracted account or not.
 func (k Keeper) IsAbstractedAccount(ctx context.Context, addr []byte) (bool, error) {
+	return k.hasExecHandler(ctx, addr, &aa_interface_v1.MsgAuthenticate{})
+}
+
+// IsPaymasterAccount returns if the provided address is an account which can
+// sponsor the fees of transactions or not.
+func (k Keeper) IsPaymasterAccount(ctx context.Context, addr []byte) (bool, error) {
+	return k.hasExecHandler(ctx, addr, &aa_interface_v1.MsgSponsorFees{})
+}
+
+// hasExecHandler returns if the provided address is an account handling the
+// provided execute message or not.
+func (k Keeper) hasExecHandler(ctx context.Context, addr []byte, msg transaction.Msg) (bool, error) {
 	accType, err := k.AccountsByType.Get(ctx, addr)
 	switch {
 	case errors.Is(err, collections.ErrNotFound):
@@ -35,7 +51,7 @@ func (k Keeper) IsAbstractedAccount(ctx context.Context, addr []byte) (bool, err
 	if !ok {
 		return false, fmt.Errorf("%w: %s", errAccountTypeNotFound, accType)
 	}
-	return impl.HasExec(&aa_interface_v1.MsgAuthenticate{}), nil
+	return impl.HasExec(msg), nil
 }
 
 func (k Keeper) AuthenticateAccount(ctx context.Context, signer []byte, bundler string, rawTx *tx.TxRaw, protoTx *tx.Tx, signIndex uint32) error {
@@ -51,3 +67,32 @@ func (k Keeper) AuthenticateAccount(ctx context.Context, signer []byte, bundler
 	}
 	return nil
 }
+
+// SponsorFees asks the paymaster account to sponsor the fees of the tx paid by
+// the fee payer. The paymaster runs its own validation logic, and the fees are
+// deducted from it by the caller once it accepts. The payment requested by the
+// paymaster is transferred from the fee payer right away, so that it is taken
+// along with the fees even if the execution of the tx fails.
+func (k Keeper) SponsorFees(ctx context.Context, paymaster, feePayer []byte, fee sdk.Coins, protoTx *tx.Tx) error {
+	feePayerAddr, err := k.addressCodec.BytesToString(feePayer)
+	if err != nil {
+		return err
+	}
+	msg := &aa_interface_v1.MsgSponsorFees{
+		FeePayer: feePayerAddr,
+		Fee:      fee,
+		Tx:       protoTx,
+	}
+	resp, err := k.Execute(ctx, paymaster, address.Module("accounts"), msg, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPaymaster, err)
+	}
+	sponsorResp, ok := resp.(*aa_interface_v1.MsgSponsorFeesResponse)
+	if !ok {
+		return fmt.Errorf("%w: unexpected response type %T", ErrPaymaster, resp)
+	}
+	if err := k.maybeSendFunds(ctx, feePayer, paymaster, sponsorResp.Payment); err != nil {
+		return fmt.Errorf("%w: unable to pay the paymaster: %w", ErrPaymaster, err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/accounts/accountstd"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestKeeper_SponsorFees(t *testing.T) {
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("paymaster", NewTestPaymasterAccount))
+
+	_, testAddr, err := k.Init(ctx, "test", []byte("owner"), &types.Empty{}, nil)
+	require.NoError(t, err)
+	_, paymasterAddr, err := k.Init(ctx, "paymaster", []byte("owner"), &types.Empty{}, nil)
+	require.NoError(t, err)
+
+	t.Run("is paymaster account", func(t *testing.T) {
+		isPaymaster, err := k.IsPaymasterAccount(ctx, paymasterAddr)
+		require.NoError(t, err)
+		require.True(t, isPaymaster)
+
+		isPaymaster, err = k.IsPaymasterAccount(ctx, testAddr)
+		require.NoError(t, err)
+		require.False(t, isPaymaster)
+
+		isPaymaster, err = k.IsPaymasterAccount(ctx, []byte("unknown"))
+		require.NoError(t, err)
+		require.False(t, isPaymaster)
+	})
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	protoTx := &tx.Tx{Body: &tx.TxBody{}, AuthInfo: &tx.AuthInfo{}}
+
+	t.Run("paymaster sponsors the fees", func(t *testing.T) {
+		require.NoError(t, k.SponsorFees(ctx, paymasterAddr, []byte("sponsored"), fee, protoTx))
+	})
+
+	t.Run("paymaster refuses to sponsor the fees", func(t *testing.T) {
+		err := k.SponsorFees(ctx, paymasterAddr, []byte("other"), fee, protoTx)
+		require.ErrorIs(t, err, ErrPaymaster)
+		require.ErrorContains(t, err, "fee payer other is not sponsored")
+
+		err = k.SponsorFees(ctx, paymasterAddr, []byte("sponsored"), sdk.NewCoins(sdk.NewInt64Coin("atom", 101)), protoTx)
+		require.ErrorIs(t, err, ErrPaymaster)
+	})
+
+	t.Run("fee payer cannot pay the paymaster", func(t *testing.T) {
+		err := k.SponsorFees(ctx, paymasterAddr, []byte("insolvent"), fee, protoTx)
+		require.ErrorIs(t, err, ErrPaymaster)
+		require.ErrorContains(t, err, "unable to pay the paymaster: insufficient funds")
+	})
+
+	t.Run("account is not a paymaster", func(t *testing.T) {
+		err := k.SponsorFees(ctx, testAddr, []byte("sponsored"), fee, protoTx)
+		require.ErrorIs(t, err, ErrPaymaster)
+	})
+}
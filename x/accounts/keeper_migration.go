@@ -0,0 +1,156 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/transaction"
+)
+
+// ErrIncompatibleSchema is returned when the state of an account cannot be read
+// by the account type it is migrated to.
+var ErrIncompatibleSchema = errors.New("incompatible account state schema")
+
+// MigrateAccount migrates the account to the toAccountType account type, also
+// called an account code upgrade. The migration handler registered by the new
+// account type for the current account type of the account is run with the
+// provided sender and message, and it is up to the handler to check the sender
+// is allowed to migrate the account, for example that it is the account owner.
+// The address, the account number and the state of the account are kept, the
+// collections shared by both account types must be compatible.
+func (k Keeper) MigrateAccount(
+	ctx context.Context,
+	accountAddr []byte,
+	sender []byte,
+	toAccountType string,
+	migrateRequest transaction.Msg,
+) (transaction.Msg, error) {
+	fromAccountType, err := k.AccountsByType.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+	fromImpl, ok := k.accounts[fromAccountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errAccountTypeNotFound, fromAccountType)
+	}
+	toImpl, ok := k.accounts[toAccountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: not found %s", errAccountTypeNotFound, toAccountType)
+	}
+	if !toImpl.HasMigration(fromAccountType) {
+		return nil, fmt.Errorf("account type %s cannot be migrated to %s", fromAccountType, toAccountType)
+	}
+	if err := checkSchemaCompatibility(fromImpl.CollectionsSchema, toImpl.CollectionsSchema); err != nil {
+		return nil, fmt.Errorf("cannot migrate account type %s to %s: %w", fromAccountType, toAccountType, err)
+	}
+
+	// get account number
+	accountNum, err := k.AccountByNumber.Get(ctx, accountAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// make the context and migrate the account state
+	accCtx := k.makeAccountContext(ctx, accountNum, accountAddr, sender, nil, false)
+	resp, err := toImpl.Migrate(accCtx, fromAccountType, migrateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// map account address to the new account type
+	if err := k.AccountsByType.Set(ctx, accountAddr, toAccountType); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// MigrateAccounts migrates at most limit accounts of the fromAccountType
+// account type to the toAccountType account type, starting from the account at
+// the from address. The migration handler is run with the x/accounts module as
+// sender and the provided message. It returns the address to start the next
+// batch from, or nil once all the accounts were iterated.
+//
+// Should only be used in an upgrade handler, as a batch migration approved by
+// governance, spreading the migration of the accounts across blocks if needed.
+func MigrateAccounts(
+	ctx context.Context,
+	k Keeper,
+	fromAccountType, toAccountType string,
+	migrateRequest transaction.Msg,
+	from []byte,
+	limit int,
+) ([]byte, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %d", limit)
+	}
+
+	var ranger collections.Ranger[[]byte]
+	if from != nil {
+		ranger = new(collections.Range[[]byte]).StartInclusive(from)
+	}
+
+	// accounts are collected first, as their account type is updated once migrated
+	var addrs [][]byte
+	var next []byte
+	err := k.AccountsByType.Walk(ctx, ranger, func(addr []byte, accountType string) (stop bool, err error) {
+		if accountType != fromAccountType {
+			return false, nil
+		}
+		if len(addrs) == limit {
+			next = addr
+			return true, nil
+		}
+		addrs = append(addrs, addr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range addrs {
+		if _, err := k.MigrateAccount(ctx, addr, ModuleAccountAddress, toAccountType, migrateRequest); err != nil {
+			return nil, fmt.Errorf("failed to migrate account %x: %w", addr, err)
+		}
+	}
+
+	return next, nil
+}
+
+// checkSchemaCompatibility checks the state written with the from collections
+// schema can be read with the to collections schema: the collections sharing a
+// prefix must have the same name, key type and value type, and a collection cannot be
+// moved to another prefix.
+func checkSchemaCompatibility(from, to collections.Schema) error {
+	toByPrefix := make(map[string]collections.Collection)
+	toByName := make(map[string]collections.Collection)
+	for _, coll := range to.ListCollections() {
+		toByPrefix[string(coll.GetPrefix())] = coll
+		toByName[coll.GetName()] = coll
+	}
+
+	for _, fromColl := range from.ListCollections() {
+		if toColl, ok := toByName[fromColl.GetName()]; ok && !bytes.Equal(toColl.GetPrefix(), fromColl.GetPrefix()) {
+			return fmt.Errorf("%w: collection %s moved from prefix %x to %x", ErrIncompatibleSchema, fromColl.GetName(), fromColl.GetPrefix(), toColl.GetPrefix())
+		}
+		toColl, ok := toByPrefix[string(fromColl.GetPrefix())]
+		if !ok {
+			// the collection was dropped, its state is left untouched
+			continue
+		}
+		if toColl.GetName() != fromColl.GetName() {
+			return fmt.Errorf("%w: collection %s at prefix %x renamed to %s", ErrIncompatibleSchema, fromColl.GetName(), fromColl.GetPrefix(), toColl.GetName())
+		}
+		fromKeyType, toKeyType := fromColl.KeyType(), toColl.KeyType()
+		if fromKeyType != toKeyType {
+			return fmt.Errorf("%w: collection %s key type changed from %s to %s", ErrIncompatibleSchema, fromColl.GetName(), fromKeyType, toKeyType)
+		}
+		fromValueType, toValueType := fromColl.ValueCodec().ValueType(), toColl.ValueCodec().ValueType()
+		if fromValueType != toValueType {
+			return fmt.Errorf("%w: collection %s value type changed from %s to %s", ErrIncompatibleSchema, fromColl.GetName(), fromValueType, toValueType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+)
+
+func TestKeeper_MigrateAccount(t *testing.T) {
+	m, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("test-v2", NewTestAccountV2),
+		accountstd.AddAccount("incompatible", NewTestIncompatibleAccount),
+		accountstd.AddAccount("incompatible-key", NewTestIncompatibleKeyAccount),
+	)
+
+	sender := []byte("sender")
+	_, accAddr, err := m.Init(ctx, "test", sender, &types.Empty{}, nil)
+	require.NoError(t, err)
+	_, err = m.Execute(ctx, accAddr, sender, &types.UInt64Value{Value: 10}, nil)
+	require.NoError(t, err)
+
+	t.Run("unknown account type", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, sender, "unknown", &types.UInt64Value{Value: 5})
+		require.ErrorIs(t, err, errAccountTypeNotFound)
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, []byte("unknown"), sender, "test-v2", &types.UInt64Value{Value: 5})
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+
+	t.Run("no migration handler", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, sender, "test", &types.UInt64Value{Value: 5})
+		require.ErrorContains(t, err, "account type test cannot be migrated to test")
+	})
+
+	t.Run("incompatible schema", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, sender, "incompatible", &types.Empty{})
+		require.ErrorIs(t, err, ErrIncompatibleSchema)
+	})
+
+	t.Run("incompatible key schema", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, sender, "incompatible-key", &types.Empty{})
+		require.ErrorIs(t, err, ErrIncompatibleSchema)
+		require.ErrorContains(t, err, "key type changed")
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		_, err := m.MigrateAccount(ctx, accAddr, []byte("attacker"), "test-v2", &types.UInt64Value{Value: 5})
+		require.ErrorContains(t, err, "unauthorized migration")
+		accType, err := m.AccountsByType.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "test", accType)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		resp, err := m.MigrateAccount(ctx, accAddr, sender, "test-v2", &types.UInt64Value{Value: 5})
+		require.NoError(t, err)
+		require.Equal(t, &types.UInt64Value{Value: 1}, resp)
+
+		accType, err := m.AccountsByType.Get(ctx, accAddr)
+		require.NoError(t, err)
+		require.Equal(t, "test-v2", accType)
+
+		// the state of the account was kept and migrated
+		counter, err := m.Query(ctx, accAddr, &types.DoubleValue{})
+		require.NoError(t, err)
+		require.Equal(t, &types.UInt64Value{Value: 15}, counter)
+	})
+}
+
+func TestMigrateAccounts(t *testing.T) {
+	m, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("test-v2", NewTestAccountV2),
+	)
+
+	sender := []byte("sender")
+	for i := 0; i < 5; i++ {
+		_, _, err := m.Init(ctx, "test", sender, &types.Empty{}, nil)
+		require.NoError(t, err)
+	}
+
+	_, err := MigrateAccounts(ctx, m, "test", "test-v2", &types.UInt64Value{Value: 1}, nil, 0)
+	require.ErrorContains(t, err, "invalid limit 0")
+
+	var (
+		next       []byte
+		iterations int
+	)
+	for {
+		next, err = MigrateAccounts(ctx, m, "test", "test-v2", &types.UInt64Value{Value: 1}, next, 2)
+		require.NoError(t, err)
+		iterations++
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, 3, iterations)
+
+	err = m.AccountsByType.Walk(ctx, nil, func(_ []byte, accountType string) (bool, error) {
+		require.Equal(t, "test-v2", accountType)
+		return false, nil
+	})
+	require.NoError(t, err)
+}
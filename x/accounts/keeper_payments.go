@@ -0,0 +1,50 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	payments_v1 "cosmossdk.io/x/accounts/interfaces/payments/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrPaymentClaim is returned when the account refuses the payment claimed by a merchant.
+var ErrPaymentClaim = errors.New("payment claim failed")
+
+// IsPaymentsAccount returns if the provided address is an account which merchants
+// can claim payments from.
+func (k Keeper) IsPaymentsAccount(ctx context.Context, addr []byte) (bool, error) {
+	return k.hasExecHandler(ctx, addr, &payments_v1.MsgClaimPayments{})
+}
+
+// ClaimPayments claims the provided amount, or all the coins due if amount is
+// empty, from the account on behalf of the merchant. The account authorizes the
+// claim and records it, then the coins are transferred from the account to the
+// merchant. It returns the transferred coins.
+func (k Keeper) ClaimPayments(ctx context.Context, accountAddr, merchant []byte, amount sdk.Coins) (sdk.Coins, error) {
+	merchantAddr, err := k.addressCodec.BytesToString(merchant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := k.Execute(ctx, accountAddr, ModuleAccountAddress, &payments_v1.MsgClaimPayments{
+		Merchant: merchantAddr,
+		Amount:   amount,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPaymentClaim, err)
+	}
+	claimResp, ok := resp.(*payments_v1.MsgClaimPaymentsResponse)
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected response type %T", ErrPaymentClaim, resp)
+	}
+	if !amount.IsZero() && !amount.IsAllGTE(claimResp.Amount) {
+		return nil, fmt.Errorf("%w: account paid %s, exceeding the claimed %s", ErrPaymentClaim, claimResp.Amount, amount)
+	}
+
+	if err := k.maybeSendFunds(ctx, accountAddr, merchant, claimResp.Amount); err != nil {
+		return nil, fmt.Errorf("unable to transfer coins to merchant: %w", err)
+	}
+	return claimResp.Amount, nil
+}
@@ -0,0 +1,78 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/router"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/x/accounts/accountstd"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKeeper_ClaimPayments(t *testing.T) {
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("payments", NewTestPaymentsAccount))
+
+	_, testAddr, err := k.Init(ctx, "test", []byte("owner"), &types.Empty{}, nil)
+	require.NoError(t, err)
+	_, paymentsAddr, err := k.Init(ctx, "payments", []byte("owner"), &types.Empty{}, nil)
+	require.NoError(t, err)
+
+	t.Run("is payments account", func(t *testing.T) {
+		isPayments, err := k.IsPaymentsAccount(ctx, paymentsAddr)
+		require.NoError(t, err)
+		require.True(t, isPayments)
+
+		isPayments, err = k.IsPaymentsAccount(ctx, testAddr)
+		require.NoError(t, err)
+		require.False(t, isPayments)
+
+		isPayments, err = k.IsPaymentsAccount(ctx, []byte("unknown"))
+		require.NoError(t, err)
+		require.False(t, isPayments)
+	})
+
+	t.Run("claim transfers the paid coins to the merchant", func(t *testing.T) {
+		router := &recordingMsgRouter{Service: k.MsgRouterService}
+		k.MsgRouterService = router
+
+		amount, err := k.ClaimPayments(ctx, paymentsAddr, []byte("merchant"), nil)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), amount)
+
+		amount, err = k.ClaimPayments(ctx, paymentsAddr, []byte("merchant"), sdk.NewCoins(sdk.NewInt64Coin("atom", 5)))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5)), amount)
+
+		require.Equal(t, []transaction.Msg{
+			&banktypes.MsgSend{FromAddress: string(paymentsAddr), ToAddress: "merchant", Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))},
+			&banktypes.MsgSend{FromAddress: string(paymentsAddr), ToAddress: "merchant", Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 5))},
+		}, router.sent)
+	})
+
+	t.Run("account paying more than claimed", func(t *testing.T) {
+		_, err := k.ClaimPayments(ctx, paymentsAddr, []byte("greedy"), sdk.NewCoins(sdk.NewInt64Coin("atom", 5)))
+		require.ErrorIs(t, err, ErrPaymentClaim)
+	})
+
+	t.Run("account not handling payments", func(t *testing.T) {
+		_, err := k.ClaimPayments(ctx, testAddr, []byte("merchant"), nil)
+		require.ErrorIs(t, err, ErrPaymentClaim)
+	})
+}
+
+// recordingMsgRouter records the messages invoked through the wrapped router.
+type recordingMsgRouter struct {
+	router.Service
+	sent []transaction.Msg
+}
+
+func (r *recordingMsgRouter) Invoke(ctx context.Context, msg transaction.Msg) (transaction.Msg, error) {
+	r.sent = append(r.sent, msg)
+	return r.Service.Invoke(ctx, msg)
+}
@@ -84,6 +84,82 @@ func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.Msg
 	}, nil
 }
 
+func (m msgServer) Migrate(ctx context.Context, request *v1.MsgMigrate) (*v1.MsgMigrateResponse, error) {
+	// decode sender address
+	senderAddr, err := m.k.addressCodec.StringToBytes(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+	// decode the target address
+	targetAddr, err := m.k.addressCodec.StringToBytes(request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode message bytes into the concrete boxed message type
+	req, err := implementation.UnpackAnyRaw(request.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	// run account migration logic
+	resp, err := m.k.MigrateAccount(ctx, targetAddr, senderAddr, request.AccountType, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate account: %w", err)
+	}
+
+	eventManager := m.k.EventService.EventManager(ctx)
+	err = eventManager.EmitKV(
+		"account_migration",
+		event.NewAttribute("address", request.Target),
+		event.NewAttribute("account_type", request.AccountType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// encode the response
+	respAny, err := implementation.PackAny(resp)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgMigrateResponse{
+		Response: respAny,
+	}, nil
+}
+
+func (m msgServer) ClaimPayments(ctx context.Context, request *v1.MsgClaimPayments) (*v1.MsgClaimPaymentsResponse, error) {
+	// decode merchant address
+	merchantAddr, err := m.k.addressCodec.StringToBytes(request.Sender)
+	if err != nil {
+		return nil, err
+	}
+	// decode the target address
+	targetAddr, err := m.k.addressCodec.StringToBytes(request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	// claim the payment and transfer it to the merchant
+	amount, err := m.k.ClaimPayments(ctx, targetAddr, merchantAddr, request.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	eventManager := m.k.EventService.EventManager(ctx)
+	err = eventManager.EmitKV(
+		"payment_claim",
+		event.NewAttribute("address", request.Target),
+		event.NewAttribute("merchant", request.Sender),
+		event.NewAttribute("amount", amount.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgClaimPaymentsResponse{Amount: amount}, nil
+}
+
 func (m msgServer) ExecuteBundle(ctx context.Context, req *v1.MsgExecuteBundle) (*v1.MsgExecuteBundleResponse, error) {
 	panic("impl")
 }
@@ -10,6 +10,8 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
 	v1 "cosmossdk.io/x/accounts/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestMsgServer(t *testing.T) {
@@ -43,3 +45,53 @@ func TestMsgServer(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, execResp)
 }
+
+func TestMsgServer_Migrate(t *testing.T) {
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("test-v2", NewTestAccountV2))
+	s := NewMsgServer(k)
+
+	initMsg, err := implementation.PackAny(&emptypb.Empty{})
+	require.NoError(t, err)
+	initResp, err := s.Init(ctx, &v1.MsgInit{
+		Sender:      "sender",
+		AccountType: "test",
+		Message:     initMsg,
+	})
+	require.NoError(t, err)
+
+	migrateMsgAny, err := implementation.PackAny(&wrapperspb.UInt64Value{Value: 1})
+	require.NoError(t, err)
+	migrateResp, err := s.Migrate(ctx, &v1.MsgMigrate{
+		Sender:      "sender",
+		Target:      initResp.AccountAddress,
+		AccountType: "test-v2",
+		Message:     migrateMsgAny,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, migrateResp.Response)
+
+	accType, err := k.AccountsByType.Get(ctx, []byte(initResp.AccountAddress))
+	require.NoError(t, err)
+	require.Equal(t, "test-v2", accType)
+}
+
+func TestMsgServer_ClaimPayments(t *testing.T) {
+	k, ctx := newKeeper(t, accountstd.AddAccount("payments", NewTestPaymentsAccount))
+	s := NewMsgServer(k)
+
+	initMsg, err := implementation.PackAny(&emptypb.Empty{})
+	require.NoError(t, err)
+	initResp, err := s.Init(ctx, &v1.MsgInit{
+		Sender:      "sender",
+		AccountType: "payments",
+		Message:     initMsg,
+	})
+	require.NoError(t, err)
+
+	claimResp, err := s.ClaimPayments(ctx, &v1.MsgClaimPayments{
+		Sender: "merchant",
+		Target: initResp.AccountAddress,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), claimResp.Amount)
+}
@@ -14,7 +14,7 @@ import (
 )
 
 func TestQueryServer(t *testing.T) {
-	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount))
+	k, ctx := newKeeper(t, accountstd.AddAccount("test", NewTestAccount), accountstd.AddAccount("test-v2", NewTestAccountV2))
 
 	ms := NewMsgServer(k)
 	qs := NewQueryServer(k)
@@ -74,4 +74,16 @@ func TestQueryServer(t *testing.T) {
 		// Check if both responses are the same (cached)
 		require.Equal(t, schemaResp1, schemaResp2)
 	})
+
+	t.Run("schema migration handlers", func(t *testing.T) {
+		schemaResp, err := qs.Schema(ctx, &v1.SchemaRequest{AccountType: "test-v2"})
+		require.NoError(t, err)
+		require.Len(t, schemaResp.MigrationHandlers, 1)
+		require.Equal(t, "test", schemaResp.MigrationHandlers[0].FromAccountType)
+		require.Equal(t, "google.protobuf.UInt64Value", schemaResp.MigrationHandlers[0].Handler.Request)
+
+		schemaResp, err = qs.Schema(ctx, &v1.SchemaRequest{AccountType: "test"})
+		require.NoError(t, err)
+		require.Empty(t, schemaResp.MigrationHandlers)
+	})
 }
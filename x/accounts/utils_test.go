@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
@@ -95,6 +96,10 @@ func (b bankQueryServer) Balance(context.Context, *bankv1beta1.QueryBalanceReque
 	}}, nil
 }
 
-func (b bankMsgServer) Send(context.Context, *bankv1beta1.MsgSend) (*bankv1beta1.MsgSendResponse, error) {
+// Send fails for the sender "insolvent", which has no funds.
+func (b bankMsgServer) Send(_ context.Context, msg *bankv1beta1.MsgSend) (*bankv1beta1.MsgSendResponse, error) {
+	if msg.FromAddress == "insolvent" {
+		return nil, errors.New("insufficient funds")
+	}
 	return &bankv1beta1.MsgSendResponse{}, nil
 }
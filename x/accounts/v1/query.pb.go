@@ -183,6 +183,9 @@ type SchemaResponse struct {
 	ExecuteHandlers []*SchemaResponse_Handler `protobuf:"bytes,2,rep,name=execute_handlers,json=executeHandlers,proto3" json:"execute_handlers,omitempty"`
 	// query_handlers defines the schema descriptor for the Query account method.
 	QueryHandlers []*SchemaResponse_Handler `protobuf:"bytes,3,rep,name=query_handlers,json=queryHandlers,proto3" json:"query_handlers,omitempty"`
+	// migration_handlers defines the schema descriptor for the account types
+	// which can be migrated to the account type.
+	MigrationHandlers []*SchemaResponse_MigrationHandler `protobuf:"bytes,4,rep,name=migration_handlers,json=migrationHandlers,proto3" json:"migration_handlers,omitempty"`
 }
 
 func (m *SchemaResponse) Reset()         { *m = SchemaResponse{} }
@@ -239,6 +242,13 @@ func (m *SchemaResponse) GetQueryHandlers() []*SchemaResponse_Handler {
 	return nil
 }
 
+func (m *SchemaResponse) GetMigrationHandlers() []*SchemaResponse_MigrationHandler {
+	if m != nil {
+		return m.MigrationHandlers
+	}
+	return nil
+}
+
 // Handler defines a schema descriptor for a handler.
 // Where request and response are names that can be used to lookup the
 // reflection descriptor.
@@ -296,6 +306,61 @@ func (m *SchemaResponse_Handler) GetResponse() string {
 	return ""
 }
 
+// MigrationHandler defines a schema descriptor for a migration handler.
+type SchemaResponse_MigrationHandler struct {
+	// from_account_type is the account type migrated from.
+	FromAccountType string `protobuf:"bytes,1,opt,name=from_account_type,json=fromAccountType,proto3" json:"from_account_type,omitempty"`
+	// handler is the schema descriptor of the migration handler.
+	Handler *SchemaResponse_Handler `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"`
+}
+
+func (m *SchemaResponse_MigrationHandler) Reset()         { *m = SchemaResponse_MigrationHandler{} }
+func (m *SchemaResponse_MigrationHandler) String() string { return proto.CompactTextString(m) }
+func (*SchemaResponse_MigrationHandler) ProtoMessage()    {}
+func (*SchemaResponse_MigrationHandler) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16ad14c22e3080d2, []int{3, 1}
+}
+func (m *SchemaResponse_MigrationHandler) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaResponse_MigrationHandler) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaResponse_MigrationHandler.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchemaResponse_MigrationHandler) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaResponse_MigrationHandler.Merge(m, src)
+}
+func (m *SchemaResponse_MigrationHandler) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaResponse_MigrationHandler) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaResponse_MigrationHandler.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaResponse_MigrationHandler proto.InternalMessageInfo
+
+func (m *SchemaResponse_MigrationHandler) GetFromAccountType() string {
+	if m != nil {
+		return m.FromAccountType
+	}
+	return ""
+}
+
+func (m *SchemaResponse_MigrationHandler) GetHandler() *SchemaResponse_Handler {
+	if m != nil {
+		return m.Handler
+	}
+	return nil
+}
+
 // AccountTypeRequest is the request type for the Query/AccountType RPC method.
 type AccountTypeRequest struct {
 	// address defines the address to query the account type for.
@@ -487,6 +552,7 @@ func init() {
 	proto.RegisterType((*SchemaRequest)(nil), "cosmos.accounts.v1.SchemaRequest")
 	proto.RegisterType((*SchemaResponse)(nil), "cosmos.accounts.v1.SchemaResponse")
 	proto.RegisterType((*SchemaResponse_Handler)(nil), "cosmos.accounts.v1.SchemaResponse.Handler")
+	proto.RegisterType((*SchemaResponse_MigrationHandler)(nil), "cosmos.accounts.v1.SchemaResponse.MigrationHandler")
 	proto.RegisterType((*AccountTypeRequest)(nil), "cosmos.accounts.v1.AccountTypeRequest")
 	proto.RegisterType((*AccountTypeResponse)(nil), "cosmos.accounts.v1.AccountTypeResponse")
 	proto.RegisterType((*AccountNumberRequest)(nil), "cosmos.accounts.v1.AccountNumberRequest")
@@ -496,39 +562,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/query.proto", fileDescriptor_16ad14c22e3080d2) }
 
 var fileDescriptor_16ad14c22e3080d2 = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0x48, 0x60, 0xd2, 0x14, 0x34, 0x94, 0xca, 0xf8, 0x60, 0xa5, 0x3e, 0xd0, 0xc0,
-	0x61, 0xdd, 0x06, 0x0e, 0xdc, 0x50, 0x38, 0x55, 0x42, 0x42, 0x8a, 0x81, 0x0b, 0x12, 0x0a, 0x8e,
-	0xb3, 0x4d, 0x23, 0x1a, 0x6f, 0xea, 0xb5, 0xab, 0xe6, 0x13, 0xb8, 0xf1, 0x59, 0x3d, 0xf6, 0xc8,
-	0x11, 0x25, 0x3f, 0x82, 0xba, 0x3b, 0x9b, 0x38, 0x50, 0xe2, 0xf6, 0xe6, 0xd9, 0x79, 0xf3, 0xde,
-	0xfa, 0xbd, 0xb1, 0xc1, 0x8b, 0x85, 0x9c, 0x08, 0x19, 0x44, 0x71, 0x2c, 0xf2, 0x24, 0x93, 0xc1,
-	0xf9, 0x61, 0x70, 0x96, 0xf3, 0x74, 0xc6, 0xa6, 0xa9, 0xc8, 0x04, 0xa2, 0xee, 0x33, 0xd3, 0x67,
-	0xe7, 0x87, 0xee, 0xb3, 0x91, 0x10, 0xa3, 0x53, 0x1e, 0x28, 0xc4, 0x20, 0x3f, 0x0e, 0xa2, 0x84,
-	0xe0, 0xfe, 0x57, 0x78, 0xd2, 0xd5, 0xc8, 0xde, 0x35, 0x49, 0xc8, 0xcf, 0x72, 0x2e, 0x33, 0xdc,
-	0x85, 0x5a, 0x16, 0xa5, 0x23, 0x9e, 0x39, 0x76, 0xcb, 0x6e, 0x3f, 0x0c, 0xa9, 0x42, 0x06, 0xf5,
-	0x54, 0x43, 0x9c, 0x4a, 0xcb, 0x6e, 0x37, 0x3a, 0x3b, 0x4c, 0x73, 0x33, 0xc3, 0xcd, 0xba, 0xc9,
-	0x2c, 0x34, 0x20, 0xff, 0x08, 0x76, 0xd6, 0xe9, 0xe5, 0x54, 0x24, 0x92, 0xe3, 0x01, 0x3c, 0x48,
-	0xe9, 0x59, 0x29, 0xfc, 0x8f, 0x68, 0x89, 0xf2, 0x3b, 0xd0, 0xfc, 0x18, 0x9f, 0xf0, 0x49, 0x64,
-	0xae, 0xb8, 0x07, 0x5b, 0xf4, 0x8e, 0xfd, 0x6c, 0x36, 0xe5, 0x74, 0xd1, 0x06, 0x9d, 0x7d, 0x9a,
-	0x4d, 0xb9, 0x7f, 0x59, 0x81, 0x6d, 0x33, 0x44, 0xc2, 0xef, 0xa1, 0x31, 0x4e, 0xc6, 0x59, 0x5f,
-	0xaa, 0x63, 0xd2, 0x7e, 0xc9, 0xfe, 0x35, 0x8d, 0xad, 0x0f, 0xb2, 0xa3, 0x28, 0x19, 0x9e, 0xf2,
-	0x34, 0x84, 0xeb, 0x71, 0xdd, 0xc3, 0xcf, 0xf0, 0x98, 0x5f, 0xf0, 0x38, 0xcf, 0x78, 0xff, 0x44,
-	0xb7, 0xa5, 0x53, 0x69, 0x55, 0xef, 0xc8, 0xf8, 0x88, 0x38, 0xa8, 0x96, 0xd8, 0x83, 0x6d, 0x95,
-	0xe8, 0x8a, 0xb4, 0x7a, 0x67, 0xd2, 0xa6, 0x62, 0x30, 0x94, 0xee, 0x5b, 0xa8, 0xd3, 0x33, 0x3a,
-	0xab, 0x08, 0xb5, 0x65, 0xa6, 0x44, 0xb7, 0x10, 0x4a, 0x45, 0xb5, 0x56, 0xf6, 0x33, 0xc0, 0xee,
-	0xca, 0x59, 0x93, 0x81, 0x03, 0xf5, 0x68, 0x38, 0x4c, 0xb9, 0x94, 0x86, 0x8b, 0x4a, 0xff, 0xcd,
-	0x72, 0xaf, 0x34, 0x9e, 0xec, 0xbf, 0x45, 0x68, 0x07, 0xcb, 0x95, 0xf9, 0x90, 0x4f, 0x06, 0x3c,
-	0x2d, 0xd7, 0x0a, 0xe0, 0xe9, 0x5f, 0x13, 0xa4, 0xb6, 0x0b, 0xb5, 0x44, 0x9d, 0xa8, 0x89, 0x7b,
-	0x21, 0x55, 0x9d, 0x1f, 0x55, 0xb8, 0xaf, 0xf6, 0x11, 0x63, 0xd8, 0x2a, 0xee, 0x27, 0xee, 0xdf,
-	0x64, 0xf1, 0x0d, 0x1f, 0x88, 0xdb, 0x2e, 0x07, 0x92, 0x73, 0x16, 0xf6, 0xa0, 0x46, 0x0b, 0xb3,
-	0xb7, 0x29, 0x41, 0x4d, 0xec, 0x97, 0x87, 0xec, 0x5b, 0xf8, 0x0d, 0x1a, 0x05, 0x7b, 0xf1, 0xf9,
-	0x86, 0xdb, 0x14, 0xf2, 0x72, 0xf7, 0x4b, 0x71, 0x4b, 0x85, 0x63, 0x68, 0xae, 0x99, 0x8a, 0x9b,
-	0xde, 0x78, 0x2d, 0x29, 0xf7, 0xc5, 0x2d, 0x90, 0x46, 0xe7, 0xdd, 0xeb, 0xcb, 0xb9, 0x67, 0x5f,
-	0xcd, 0x3d, 0xfb, 0xf7, 0xdc, 0xb3, 0x7f, 0x2e, 0x3c, 0xeb, 0x6a, 0xe1, 0x59, 0xbf, 0x16, 0x9e,
-	0xf5, 0xc5, 0xd5, 0x2c, 0x72, 0xf8, 0x9d, 0x8d, 0x45, 0x70, 0x51, 0xfc, 0xe3, 0x0d, 0x6a, 0xea,
-	0x2f, 0xf1, 0xea, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0x75, 0x93, 0xfa, 0x0e, 0x05, 0x00,
-	0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0x24, 0x4f, 0xf2, 0x30, 0x69, 0xfa, 0xb2, 0x2d, 0x95, 0xf1, 0xc1, 0x4a, 0x7d,
+	0xa0, 0xa1, 0x87, 0x75, 0x9b, 0x72, 0xe0, 0x86, 0x82, 0x38, 0x54, 0x42, 0x20, 0xc5, 0xc0, 0x05,
+	0x09, 0x05, 0xc7, 0xd9, 0xa4, 0x16, 0xb5, 0x37, 0xdd, 0xb5, 0xab, 0xe6, 0xce, 0x85, 0x1b, 0xdf,
+	0x85, 0x2f, 0xc1, 0xb1, 0x47, 0x8e, 0x28, 0xf9, 0x22, 0x28, 0xeb, 0x5d, 0xc7, 0x0e, 0x51, 0x5e,
+	0x6e, 0x9e, 0x9d, 0xff, 0xfc, 0xc6, 0x3b, 0x2f, 0x0b, 0xa6, 0x47, 0x79, 0x40, 0xb9, 0xed, 0x7a,
+	0x1e, 0x8d, 0xc3, 0x88, 0xdb, 0x77, 0x17, 0xf6, 0x6d, 0x4c, 0xd8, 0x18, 0x8f, 0x18, 0x8d, 0x28,
+	0x42, 0x89, 0x1f, 0x2b, 0x3f, 0xbe, 0xbb, 0x30, 0x9e, 0x0c, 0x29, 0x1d, 0xde, 0x10, 0x5b, 0x28,
+	0x7a, 0xf1, 0xc0, 0x76, 0x43, 0x29, 0xb7, 0x3e, 0xc3, 0x61, 0x3b, 0x51, 0x76, 0x66, 0x10, 0x87,
+	0xdc, 0xc6, 0x84, 0x47, 0xe8, 0x18, 0x2a, 0x91, 0xcb, 0x86, 0x24, 0xd2, 0xb5, 0x86, 0xd6, 0x7c,
+	0xe4, 0x48, 0x0b, 0x61, 0xa8, 0xb2, 0x44, 0xa2, 0x17, 0x1b, 0x5a, 0xb3, 0xd6, 0x3a, 0xc2, 0x09,
+	0x1b, 0x2b, 0x36, 0x6e, 0x87, 0x63, 0x47, 0x89, 0xac, 0x2b, 0x38, 0xca, 0xe3, 0xf9, 0x88, 0x86,
+	0x9c, 0xa0, 0x73, 0xf8, 0x9f, 0xc9, 0x6f, 0x5d, 0x5b, 0x01, 0x4a, 0x55, 0x56, 0x0b, 0xea, 0xef,
+	0xbd, 0x6b, 0x12, 0xb8, 0xea, 0x17, 0x4f, 0x60, 0x47, 0xde, 0xb1, 0x1b, 0x8d, 0x47, 0x44, 0xfe,
+	0x68, 0x4d, 0x9e, 0x7d, 0x18, 0x8f, 0x88, 0xf5, 0xb3, 0x0c, 0xbb, 0x2a, 0x48, 0x26, 0x7e, 0x03,
+	0x35, 0x3f, 0xf4, 0xa3, 0x2e, 0x17, 0xc7, 0x32, 0xf7, 0x19, 0xfe, 0xb7, 0x68, 0x38, 0x1f, 0x88,
+	0xaf, 0xdc, 0xb0, 0x7f, 0x43, 0x98, 0x03, 0xb3, 0xf0, 0xc4, 0x87, 0x3e, 0xc2, 0x3e, 0xb9, 0x27,
+	0x5e, 0x1c, 0x91, 0xee, 0x75, 0xe2, 0xe6, 0x7a, 0xb1, 0x51, 0xda, 0x92, 0xb8, 0x27, 0x19, 0xd2,
+	0xe6, 0xa8, 0x03, 0xbb, 0xa2, 0xa3, 0x73, 0x68, 0x69, 0x6b, 0x68, 0x5d, 0x10, 0x52, 0x64, 0x0f,
+	0x50, 0xe0, 0x0f, 0x99, 0x1b, 0xf9, 0x34, 0x9c, 0x63, 0xcb, 0x02, 0x7b, 0xb9, 0x01, 0xf6, 0xad,
+	0x0a, 0x56, 0xfc, 0x83, 0x60, 0xe1, 0x84, 0x1b, 0x2f, 0xa1, 0x2a, 0xbf, 0x91, 0x3e, 0x1f, 0x93,
+	0xa4, 0x2d, 0xca, 0x44, 0x46, 0xa6, 0xf1, 0x45, 0xe1, 0x4a, 0x6d, 0xe3, 0x9b, 0x06, 0xfb, 0x8b,
+	0x89, 0xd0, 0x19, 0x1c, 0x0c, 0x18, 0x0d, 0xba, 0x4b, 0x7a, 0xbd, 0x37, 0x73, 0xb4, 0xe7, 0xfd,
+	0x46, 0xaf, 0xa1, 0x2a, 0xef, 0x26, 0xa7, 0x73, 0x9b, 0x8a, 0xa9, 0x50, 0x0b, 0x03, 0xca, 0x40,
+	0xd5, 0xb8, 0xe9, 0x50, 0x75, 0xfb, 0x7d, 0x46, 0x38, 0x57, 0x57, 0x92, 0xa6, 0xf5, 0x02, 0x0e,
+	0x73, 0x7a, 0x39, 0x69, 0x1b, 0xcc, 0xe7, 0x79, 0xba, 0x1d, 0xef, 0xe2, 0xa0, 0x47, 0xd8, 0xfa,
+	0x5c, 0x36, 0x3c, 0x5e, 0x88, 0x90, 0xd9, 0x8e, 0xa1, 0x12, 0x8a, 0x13, 0x11, 0x51, 0x76, 0xa4,
+	0xd5, 0xfa, 0x5e, 0x82, 0xff, 0xc4, 0xea, 0x21, 0x0f, 0x76, 0xb2, 0xab, 0x88, 0x4e, 0x97, 0xd5,
+	0x66, 0xc9, 0x5b, 0x60, 0x34, 0xd7, 0x0b, 0xe5, 0x8e, 0x16, 0x50, 0x07, 0x2a, 0x72, 0x37, 0x4e,
+	0x56, 0x95, 0x3e, 0x01, 0x5b, 0xeb, 0xbb, 0x63, 0x15, 0xd0, 0x17, 0xa8, 0x65, 0x7b, 0xfc, 0x74,
+	0xc5, 0xdf, 0x64, 0xfa, 0x65, 0x9c, 0xae, 0xd5, 0xa5, 0x19, 0x06, 0x50, 0xcf, 0x15, 0x15, 0xad,
+	0xba, 0x71, 0xae, 0x53, 0xc6, 0xb3, 0x0d, 0x94, 0x2a, 0xcf, 0xab, 0xe7, 0xbf, 0x26, 0xa6, 0xf6,
+	0x30, 0x31, 0xb5, 0x3f, 0x13, 0x53, 0xfb, 0x31, 0x35, 0x0b, 0x0f, 0x53, 0xb3, 0xf0, 0x7b, 0x6a,
+	0x16, 0x3e, 0x19, 0x09, 0x85, 0xf7, 0xbf, 0x62, 0x9f, 0xda, 0xf7, 0xd9, 0xc7, 0xbd, 0x57, 0x11,
+	0x0f, 0xe2, 0xe5, 0xdf, 0x01, 0x00, 0x3c, 0xc0, 0x5c, 0x8b, 0xf9, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -855,6 +924,20 @@ func (m *SchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MigrationHandlers) > 0 {
+		for iNdEx := len(m.MigrationHandlers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigrationHandlers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.QueryHandlers) > 0 {
 		for iNdEx := len(m.QueryHandlers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -935,6 +1018,48 @@ func (m *SchemaResponse_Handler) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *SchemaResponse_MigrationHandler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaResponse_MigrationHandler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaResponse_MigrationHandler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Handler != nil {
+		{
+			size, err := m.Handler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAccountType) > 0 {
+		i -= len(m.FromAccountType)
+		copy(dAtA[i:], m.FromAccountType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromAccountType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccountTypeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1129,6 +1254,12 @@ func (m *SchemaResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.MigrationHandlers) > 0 {
+		for _, e := range m.MigrationHandlers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1149,6 +1280,23 @@ func (m *SchemaResponse_Handler) Size() (n int) {
 	return n
 }
 
+func (m *SchemaResponse_MigrationHandler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAccountType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Handler != nil {
+		l = m.Handler.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *AccountTypeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1625,6 +1773,40 @@ func (m *SchemaResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationHandlers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationHandlers = append(m.MigrationHandlers, &SchemaResponse_MigrationHandler{})
+			if err := m.MigrationHandlers[len(m.MigrationHandlers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1760,6 +1942,124 @@ func (m *SchemaResponse_Handler) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SchemaResponse_MigrationHandler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationHandler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationHandler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Handler == nil {
+				m.Handler = &SchemaResponse_Handler{}
+			}
+			if err := m.Handler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AccountTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
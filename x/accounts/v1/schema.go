@@ -23,8 +23,9 @@ func makeAccountSchema(impl implementation.Implementation) *SchemaResponse {
 			Request:  impl.InitHandlerSchema.RequestSchema.Name,
 			Response: impl.InitHandlerSchema.ResponseSchema.Name,
 		},
-		ExecuteHandlers: makeHandlersSchema(impl.ExecuteHandlersSchema),
-		QueryHandlers:   makeHandlersSchema(impl.QueryHandlersSchema),
+		ExecuteHandlers:   makeHandlersSchema(impl.ExecuteHandlersSchema),
+		QueryHandlers:     makeHandlersSchema(impl.QueryHandlersSchema),
+		MigrationHandlers: makeMigrationHandlersSchema(impl.MigrationHandlersSchema),
 	}
 }
 
@@ -42,6 +43,23 @@ func makeHandlersSchema(handlers map[string]implementation.HandlerSchema) []*Sch
 	return schemas
 }
 
+func makeMigrationHandlersSchema(handlers map[string]implementation.HandlerSchema) []*SchemaResponse_MigrationHandler {
+	schemas := make([]*SchemaResponse_MigrationHandler, 0, len(handlers))
+	for fromAccountType, handler := range handlers {
+		schemas = append(schemas, &SchemaResponse_MigrationHandler{
+			FromAccountType: fromAccountType,
+			Handler: &SchemaResponse_Handler{
+				Request:  handler.RequestSchema.Name,
+				Response: handler.ResponseSchema.Name,
+			},
+		})
+	}
+	slices.SortFunc(schemas, func(a, b *SchemaResponse_MigrationHandler) int {
+		return strings.Compare(a.FromAccountType, b.FromAccountType)
+	})
+	return schemas
+}
+
 func MsgServiceDesc() *grpc.ServiceDesc {
 	return &_Msg_serviceDesc
 }
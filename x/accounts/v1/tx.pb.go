@@ -282,6 +282,237 @@ func (m *MsgExecuteResponse) GetResponse() *any.Any {
 	return nil
 }
 
+// MsgMigrate defines the Migrate request type for the Msg/Migrate RPC method.
+type MsgMigrate struct {
+	// sender is the address of the sender of this message.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// target is the address of the account to be migrated.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// account_type is the type the account is migrated to.
+	AccountType string `protobuf:"bytes,3,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	// message is the message to be sent to the migration handler of the
+	// account type.
+	Message *any.Any `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *MsgMigrate) Reset()         { *m = MsgMigrate{} }
+func (m *MsgMigrate) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrate) ProtoMessage()    {}
+func (*MsgMigrate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{4}
+}
+func (m *MsgMigrate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrate.Merge(m, src)
+}
+func (m *MsgMigrate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrate proto.InternalMessageInfo
+
+func (m *MsgMigrate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrate) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *MsgMigrate) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+func (m *MsgMigrate) GetMessage() *any.Any {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+// MsgMigrateResponse defines the Migrate response type for the Msg/Migrate RPC method.
+type MsgMigrateResponse struct {
+	// response is the response returned by the account implementation.
+	Response *any.Any `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *MsgMigrateResponse) Reset()         { *m = MsgMigrateResponse{} }
+func (m *MsgMigrateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateResponse) ProtoMessage()    {}
+func (*MsgMigrateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{5}
+}
+func (m *MsgMigrateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateResponse.Merge(m, src)
+}
+func (m *MsgMigrateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateResponse) GetResponse() *any.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+// MsgClaimPayments defines the ClaimPayments request type for the Msg/ClaimPayments RPC method.
+type MsgClaimPayments struct {
+	// sender is the address of the merchant claiming the payment.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// target is the address of the account paying the merchant.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// amount contains the coins claimed by the merchant, all the coins
+	// due to the merchant if empty.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimPayments) Reset()         { *m = MsgClaimPayments{} }
+func (m *MsgClaimPayments) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPayments) ProtoMessage()    {}
+func (*MsgClaimPayments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{6}
+}
+func (m *MsgClaimPayments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPayments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPayments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimPayments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPayments.Merge(m, src)
+}
+func (m *MsgClaimPayments) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPayments) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPayments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPayments proto.InternalMessageInfo
+
+func (m *MsgClaimPayments) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgClaimPayments) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *MsgClaimPayments) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgClaimPaymentsResponse defines the ClaimPayments response type for the Msg/ClaimPayments RPC method.
+type MsgClaimPaymentsResponse struct {
+	// amount contains the coins transferred to the merchant.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimPaymentsResponse) Reset()         { *m = MsgClaimPaymentsResponse{} }
+func (m *MsgClaimPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimPaymentsResponse) ProtoMessage()    {}
+func (*MsgClaimPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29c2b6d8a13d4189, []int{7}
+}
+func (m *MsgClaimPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimPaymentsResponse.Merge(m, src)
+}
+func (m *MsgClaimPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimPaymentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimPaymentsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimPaymentsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // MsgExecuteBundle defines the ExecuteBundle request type for the Msg/ExecuteBundle RPC method.
 type MsgExecuteBundle struct {
 	// bundler defines the entity going through the standard TX flow
@@ -295,7 +526,7 @@ func (m *MsgExecuteBundle) Reset()         { *m = MsgExecuteBundle{} }
 func (m *MsgExecuteBundle) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteBundle) ProtoMessage()    {}
 func (*MsgExecuteBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{4}
+	return fileDescriptor_29c2b6d8a13d4189, []int{8}
 }
 func (m *MsgExecuteBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +579,7 @@ func (m *BundledTxResponse) Reset()         { *m = BundledTxResponse{} }
 func (m *BundledTxResponse) String() string { return proto.CompactTextString(m) }
 func (*BundledTxResponse) ProtoMessage()    {}
 func (*BundledTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{5}
+	return fileDescriptor_29c2b6d8a13d4189, []int{9}
 }
 func (m *BundledTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +632,7 @@ func (m *MsgExecuteBundleResponse) Reset()         { *m = MsgExecuteBundleRespon
 func (m *MsgExecuteBundleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteBundleResponse) ProtoMessage()    {}
 func (*MsgExecuteBundleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_29c2b6d8a13d4189, []int{6}
+	return fileDescriptor_29c2b6d8a13d4189, []int{10}
 }
 func (m *MsgExecuteBundleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,6 +673,10 @@ func init() {
 	proto.RegisterType((*MsgInitResponse)(nil), "cosmos.accounts.v1.MsgInitResponse")
 	proto.RegisterType((*MsgExecute)(nil), "cosmos.accounts.v1.MsgExecute")
 	proto.RegisterType((*MsgExecuteResponse)(nil), "cosmos.accounts.v1.MsgExecuteResponse")
+	proto.RegisterType((*MsgMigrate)(nil), "cosmos.accounts.v1.MsgMigrate")
+	proto.RegisterType((*MsgMigrateResponse)(nil), "cosmos.accounts.v1.MsgMigrateResponse")
+	proto.RegisterType((*MsgClaimPayments)(nil), "cosmos.accounts.v1.MsgClaimPayments")
+	proto.RegisterType((*MsgClaimPaymentsResponse)(nil), "cosmos.accounts.v1.MsgClaimPaymentsResponse")
 	proto.RegisterType((*MsgExecuteBundle)(nil), "cosmos.accounts.v1.MsgExecuteBundle")
 	proto.RegisterType((*BundledTxResponse)(nil), "cosmos.accounts.v1.BundledTxResponse")
 	proto.RegisterType((*MsgExecuteBundleResponse)(nil), "cosmos.accounts.v1.MsgExecuteBundleResponse")
@@ -450,46 +685,52 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/tx.proto", fileDescriptor_29c2b6d8a13d4189) }
 
 var fileDescriptor_29c2b6d8a13d4189 = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0xb6, 0xf9, 0x7a, 0xd3, 0x9f, 0x8f, 0x51, 0x55, 0x5c, 0x57, 0x72, 0x4b, 0xf8,
-	0x8b, 0x2a, 0x18, 0x37, 0x85, 0x55, 0x59, 0xb5, 0x15, 0x08, 0x16, 0x5d, 0x60, 0x75, 0xc5, 0xa6,
-	0xf2, 0xcf, 0x64, 0x88, 0xda, 0x78, 0x22, 0xdf, 0x71, 0x71, 0x76, 0x88, 0x07, 0x40, 0x3c, 0x07,
-	0xab, 0x3e, 0x46, 0x97, 0x5d, 0xb2, 0x40, 0x80, 0x1a, 0xa4, 0xbe, 0x06, 0xb2, 0x3d, 0xe3, 0x04,
-	0x4a, 0xa2, 0x2e, 0x59, 0x65, 0xe6, 0x9e, 0x73, 0xef, 0x9c, 0x73, 0xc6, 0x19, 0x58, 0x0b, 0x04,
-	0x76, 0x05, 0x3a, 0x5e, 0x10, 0x88, 0x24, 0x92, 0xe8, 0x9c, 0xb6, 0x1c, 0x99, 0xd2, 0x5e, 0x2c,
-	0xa4, 0x20, 0xa4, 0x00, 0xa9, 0x06, 0xe9, 0x69, 0xcb, 0x5a, 0xe5, 0x42, 0xf0, 0x13, 0xe6, 0xe4,
-	0x0c, 0x3f, 0x69, 0x3b, 0x5e, 0xd4, 0x2f, 0xe8, 0xd6, 0x6d, 0x35, 0xab, 0x8b, 0x3c, 0x1b, 0xd3,
-	0x45, 0xae, 0x00, 0x5b, 0x01, 0xbe, 0x87, 0xcc, 0x39, 0x6d, 0xf9, 0x4c, 0x7a, 0x2d, 0x27, 0x10,
-	0x9d, 0x48, 0xe1, 0x96, 0xc2, 0x65, 0x5a, 0xa2, 0x5a, 0x83, 0xb5, 0xcc, 0x05, 0x17, 0xf9, 0xd2,
-	0xc9, 0x56, 0x45, 0xb5, 0xf1, 0xd3, 0x80, 0xda, 0x01, 0xf2, 0x57, 0x51, 0x47, 0x92, 0x15, 0x98,
-	0x45, 0x16, 0x85, 0x2c, 0x36, 0x8d, 0x0d, 0xa3, 0x39, 0xe7, 0xaa, 0x1d, 0xb9, 0x03, 0xf3, 0x4a,
-	0xf8, 0x91, 0xec, 0xf7, 0x98, 0x39, 0x95, 0xa3, 0x75, 0x55, 0x3b, 0xec, 0xf7, 0x18, 0xa1, 0x50,
-	0xeb, 0x32, 0x44, 0x8f, 0x33, 0xb3, 0xba, 0x61, 0x34, 0xeb, 0xdb, 0xcb, 0xb4, 0xb0, 0x47, 0xb5,
-	0x3d, 0xba, 0x1b, 0xf5, 0x5d, 0x4d, 0x22, 0x1e, 0xcc, 0xb4, 0x93, 0x28, 0x44, 0x73, 0x7a, 0xa3,
-	0xda, 0xac, 0x6f, 0xaf, 0x52, 0x15, 0x50, 0x66, 0x8c, 0x2a, 0xe9, 0x74, 0x5f, 0x74, 0xa2, 0xbd,
-	0xad, 0xf3, 0x6f, 0xeb, 0x95, 0xcf, 0xdf, 0xd7, 0x9b, 0xbc, 0x23, 0xdf, 0x26, 0x3e, 0x0d, 0x44,
-	0xd7, 0x51, 0x2e, 0x8b, 0x9f, 0xc7, 0x18, 0x1e, 0x3b, 0x99, 0x2e, 0xcc, 0x1b, 0xd0, 0x2d, 0x26,
-	0xef, 0xd4, 0x3f, 0x5c, 0x9d, 0x6d, 0x2a, 0x0b, 0x8d, 0x13, 0x58, 0x52, 0x2e, 0x5d, 0x86, 0x3d,
-	0x11, 0x21, 0x23, 0x0f, 0x61, 0x49, 0xbb, 0xf2, 0xc2, 0x30, 0x66, 0x88, 0xca, 0xf6, 0xa2, 0x2a,
-	0xef, 0x16, 0x55, 0xb2, 0x05, 0xff, 0xc5, 0xaa, 0x29, 0xb7, 0x3e, 0xce, 0x5c, 0xc9, 0x6a, 0x7c,
-	0x35, 0x00, 0x0e, 0x90, 0x3f, 0x4f, 0x59, 0x90, 0x48, 0x36, 0x36, 0xd7, 0x15, 0x98, 0x95, 0x5e,
-	0xcc, 0x99, 0x54, 0x89, 0xaa, 0xdd, 0x3f, 0x1f, 0xe6, 0x0b, 0x20, 0x43, 0x77, 0x65, 0x9e, 0xa3,
-	0x31, 0x19, 0x37, 0x8a, 0xa9, 0x0d, 0xff, 0x0f, 0xe7, 0xec, 0x25, 0x51, 0x78, 0xc2, 0x88, 0x09,
-	0x35, 0x3f, 0x5f, 0xe9, 0xb0, 0xf4, 0x96, 0x6c, 0x42, 0x55, 0xa6, 0x68, 0x4e, 0xe5, 0x1e, 0x4d,
-	0xed, 0x51, 0xa6, 0xa5, 0xc3, 0xc3, 0xd4, 0xf5, 0xde, 0xb9, 0x19, 0x69, 0x67, 0x3e, 0x93, 0xab,
-	0x3b, 0x1b, 0x6d, 0xb8, 0x55, 0x4c, 0x0f, 0x0f, 0xd3, 0x52, 0xee, 0x33, 0x58, 0x64, 0x29, 0x0b,
-	0x8e, 0xb4, 0x1a, 0x9c, 0x28, 0x7a, 0x21, 0xe3, 0xea, 0x5e, 0x24, 0xcb, 0x30, 0xc3, 0xe2, 0x58,
-	0xc4, 0xea, 0xe2, 0x8a, 0x4d, 0xe3, 0x08, 0xcc, 0x3f, 0xfd, 0x94, 0xc7, 0xed, 0xc3, 0xdc, 0xe8,
-	0x49, 0x99, 0x87, 0xfb, 0xf4, 0xfa, 0xab, 0x40, 0xaf, 0x09, 0x75, 0x87, 0x7d, 0xdb, 0x1f, 0xa7,
-	0xa0, 0x7a, 0x80, 0x9c, 0xbc, 0x84, 0xe9, 0xfc, 0x0f, 0xbb, 0xf6, 0xb7, 0x09, 0xea, 0x3b, 0xb7,
-	0xee, 0x4e, 0x00, 0x4b, 0x59, 0xaf, 0xa1, 0xa6, 0xbf, 0x52, 0x7b, 0x0c, 0x5f, 0xe1, 0xd6, 0x83,
-	0xc9, 0x78, 0x39, 0x32, 0x80, 0x85, 0xdf, 0xaf, 0xf4, 0xde, 0xe4, 0xc6, 0x82, 0x65, 0x3d, 0xba,
-	0x09, 0x4b, 0x1f, 0x62, 0xcd, 0xbc, 0xbf, 0x3a, 0xdb, 0x34, 0xf6, 0x9e, 0x9e, 0x5f, 0xda, 0xc6,
-	0xc5, 0xa5, 0x6d, 0xfc, 0xb8, 0xb4, 0x8d, 0x4f, 0x03, 0xbb, 0x72, 0x31, 0xb0, 0x2b, 0x5f, 0x06,
-	0x76, 0xe5, 0x8d, 0x7a, 0x09, 0x31, 0x3c, 0xa6, 0x1d, 0xe1, 0xa4, 0xa3, 0xcf, 0xb2, 0x3f, 0x9b,
-	0x5f, 0xed, 0x93, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x6a, 0x98, 0xb0, 0xb3, 0x05, 0x00,
-	0x00,
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0x36, 0xf9, 0x7a, 0xd3, 0xbf, 0xcf, 0xaa, 0x8a, 0xeb, 0x4a, 0x6e, 0x09, 0xa5,
+	0x44, 0x55, 0x19, 0x37, 0x85, 0x55, 0x59, 0xb5, 0x15, 0x08, 0x16, 0x91, 0x20, 0xea, 0x8a, 0x4d,
+	0xe5, 0xd8, 0x93, 0xc1, 0x6a, 0xec, 0x89, 0x3c, 0x93, 0xe2, 0xac, 0x40, 0x3c, 0x01, 0x0f, 0xc0,
+	0x13, 0xb0, 0xea, 0x82, 0x67, 0x40, 0x5d, 0x76, 0xc9, 0x02, 0x01, 0x6a, 0x91, 0xfa, 0x1a, 0xc8,
+	0xf6, 0x8c, 0x93, 0xb4, 0x8d, 0x15, 0xfe, 0x24, 0x56, 0xf1, 0xcc, 0x39, 0x73, 0xe7, 0x9c, 0x33,
+	0xd7, 0xe3, 0xc0, 0x92, 0x4d, 0x99, 0x47, 0x99, 0x69, 0xd9, 0x36, 0xed, 0xf8, 0x9c, 0x99, 0x47,
+	0x55, 0x93, 0x87, 0xa8, 0x1d, 0x50, 0x4e, 0x55, 0x35, 0x01, 0x91, 0x04, 0xd1, 0x51, 0x55, 0x5f,
+	0x24, 0x94, 0x92, 0x16, 0x36, 0x63, 0x46, 0xa3, 0xd3, 0x34, 0x2d, 0xbf, 0x9b, 0xd0, 0xf5, 0x1b,
+	0xa2, 0x96, 0xc7, 0x48, 0x54, 0xc6, 0x63, 0x44, 0x00, 0x86, 0x00, 0x1a, 0x16, 0xc3, 0xe6, 0x51,
+	0xb5, 0x81, 0xb9, 0x55, 0x35, 0x6d, 0xea, 0xfa, 0x02, 0xd7, 0x05, 0xce, 0xc3, 0x14, 0x95, 0x1a,
+	0xf4, 0x79, 0x42, 0x09, 0x8d, 0x1f, 0xcd, 0xe8, 0x29, 0x99, 0x2d, 0x7f, 0x57, 0xa0, 0x58, 0x63,
+	0xe4, 0x89, 0xef, 0x72, 0x75, 0x01, 0x0a, 0x0c, 0xfb, 0x0e, 0x0e, 0x34, 0x65, 0x45, 0xa9, 0x4c,
+	0xd6, 0xc5, 0x48, 0xbd, 0x09, 0x53, 0x42, 0xf8, 0x01, 0xef, 0xb6, 0xb1, 0x36, 0x16, 0xa3, 0x25,
+	0x31, 0xb7, 0xdf, 0x6d, 0x63, 0x15, 0x41, 0xd1, 0xc3, 0x8c, 0x59, 0x04, 0x6b, 0xf9, 0x15, 0xa5,
+	0x52, 0xda, 0x9a, 0x47, 0x89, 0x3d, 0x24, 0xed, 0xa1, 0x1d, 0xbf, 0x5b, 0x97, 0x24, 0xd5, 0x82,
+	0x89, 0x66, 0xc7, 0x77, 0x98, 0x36, 0xbe, 0x92, 0xaf, 0x94, 0xb6, 0x16, 0x91, 0x08, 0x28, 0x32,
+	0x86, 0x84, 0x74, 0xb4, 0x47, 0x5d, 0x7f, 0x77, 0xf3, 0xe4, 0xcb, 0x72, 0xee, 0xfd, 0xd7, 0xe5,
+	0x0a, 0x71, 0xf9, 0x8b, 0x4e, 0x03, 0xd9, 0xd4, 0x33, 0x85, 0xcb, 0xe4, 0xe7, 0x2e, 0x73, 0x0e,
+	0xcd, 0x48, 0x17, 0x8b, 0x17, 0xb0, 0x7a, 0x52, 0x79, 0xbb, 0xf4, 0xe6, 0xe2, 0x78, 0x5d, 0x58,
+	0x28, 0xb7, 0x60, 0x56, 0xb8, 0xac, 0x63, 0xd6, 0xa6, 0x3e, 0xc3, 0xea, 0x1d, 0x98, 0x95, 0xae,
+	0x2c, 0xc7, 0x09, 0x30, 0x63, 0xc2, 0xf6, 0x8c, 0x98, 0xde, 0x49, 0x66, 0xd5, 0x4d, 0xf8, 0x2f,
+	0x10, 0x8b, 0xb4, 0xb1, 0x0c, 0x73, 0x29, 0xab, 0xfc, 0x59, 0x01, 0xa8, 0x31, 0xf2, 0x30, 0xc4,
+	0x76, 0x87, 0xe3, 0xa1, 0xb9, 0x2e, 0x40, 0x81, 0x5b, 0x01, 0xc1, 0x5c, 0x24, 0x2a, 0x46, 0xff,
+	0x7c, 0x98, 0x8f, 0x40, 0xed, 0xb9, 0x4b, 0xf3, 0xec, 0x8f, 0x49, 0x19, 0x29, 0xa6, 0x77, 0x49,
+	0x4c, 0x35, 0x97, 0x04, 0xd6, 0x2f, 0xc4, 0x74, 0xb9, 0x2d, 0xf3, 0x99, 0x6d, 0x39, 0x3e, 0x42,
+	0x92, 0xd7, 0xd9, 0x14, 0xea, 0x7e, 0xc3, 0xe6, 0x07, 0x05, 0xe6, 0x6a, 0x8c, 0xec, 0xb5, 0x2c,
+	0xd7, 0x7b, 0x6a, 0x75, 0x3d, 0xec, 0x73, 0xf6, 0xd3, 0x66, 0x6d, 0x28, 0x58, 0x5e, 0xe4, 0x4b,
+	0xcb, 0xff, 0xf9, 0x43, 0x16, 0xa5, 0x07, 0xed, 0xbf, 0x02, 0xed, 0xb2, 0xea, 0x34, 0x84, 0x9e,
+	0x1a, 0xe5, 0xaf, 0xa9, 0x29, 0x37, 0x61, 0xae, 0xd7, 0x66, 0xbb, 0x1d, 0xdf, 0x69, 0x61, 0x55,
+	0x83, 0x62, 0x23, 0x7e, 0x92, 0xb9, 0xc9, 0xa1, 0xba, 0x0e, 0x79, 0x1e, 0x32, 0x6d, 0x2c, 0xd6,
+	0xa3, 0x49, 0x3d, 0x3c, 0x4c, 0xd5, 0xec, 0x87, 0x75, 0xeb, 0x65, 0x3d, 0x22, 0x6d, 0x4f, 0x45,
+	0x3e, 0xe5, 0xca, 0x72, 0x13, 0xfe, 0x4f, 0xaa, 0x3b, 0xfb, 0x61, 0xea, 0xf0, 0x01, 0xcc, 0xe0,
+	0x10, 0xdb, 0x07, 0xf2, 0x14, 0x59, 0xe6, 0x61, 0x4f, 0x47, 0x5c, 0xb9, 0x96, 0xa9, 0xf3, 0x30,
+	0x81, 0x83, 0x80, 0x06, 0xe2, 0x0c, 0x93, 0x41, 0xf9, 0x00, 0xb4, 0xcb, 0x7e, 0xd2, 0xed, 0xf6,
+	0x60, 0xb2, 0x7f, 0xa7, 0xc8, 0xc3, 0x6d, 0x74, 0xf5, 0xa3, 0x81, 0xae, 0x08, 0xad, 0xf7, 0xd6,
+	0x6d, 0x7d, 0xcc, 0x43, 0xbe, 0xc6, 0x88, 0xfa, 0x18, 0xc6, 0xe3, 0xfb, 0x7c, 0xe9, 0xba, 0x0a,
+	0xe2, 0x1a, 0xd4, 0x6f, 0x65, 0x80, 0xa9, 0xac, 0x67, 0x50, 0x94, 0x97, 0x98, 0x31, 0x84, 0x2f,
+	0x70, 0x7d, 0x2d, 0x1b, 0xef, 0x2f, 0x29, 0x5f, 0xf8, 0x61, 0x25, 0x05, 0xae, 0xaf, 0x65, 0xe3,
+	0x7d, 0xdd, 0x38, 0x3d, 0xf8, 0x72, 0xad, 0x0e, 0x59, 0x38, 0xc0, 0xd2, 0x37, 0x46, 0x61, 0xf5,
+	0x6f, 0x32, 0xd8, 0x8a, 0xab, 0xd9, 0x86, 0x13, 0x96, 0xbe, 0x31, 0x0a, 0x4b, 0x6e, 0xa2, 0x4f,
+	0xbc, 0xbe, 0x38, 0x5e, 0x57, 0x76, 0xef, 0x9f, 0x9c, 0x19, 0xca, 0xe9, 0x99, 0xa1, 0x7c, 0x3b,
+	0x33, 0x94, 0xb7, 0xe7, 0x46, 0xee, 0xf4, 0xdc, 0xc8, 0x7d, 0x3a, 0x37, 0x72, 0xcf, 0xc5, 0x07,
+	0x9e, 0x39, 0x87, 0xc8, 0xa5, 0x66, 0xd8, 0xff, 0x6f, 0xa3, 0x51, 0x88, 0x5b, 0xf2, 0xde, 0x8f,
+	0x01, 0x00, 0xae, 0x6a, 0x1c, 0x82, 0x8a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -508,6 +749,12 @@ type MsgClient interface {
 	Init(ctx context.Context, in *MsgInit, opts ...grpc.CallOption) (*MsgInitResponse, error)
 	// Execute executes a message to the target account.
 	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
+	// Migrate migrates the target account to another account type, keeping its
+	// address, account number and state.
+	Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error)
+	// ClaimPayments claims a payment authorized by the target account for the
+	// sender merchant.
+	ClaimPayments(ctx context.Context, in *MsgClaimPayments, opts ...grpc.CallOption) (*MsgClaimPaymentsResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error)
@@ -539,6 +786,24 @@ func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) Migrate(ctx context.Context, in *MsgMigrate, opts ...grpc.CallOption) (*MsgMigrateResponse, error) {
+	out := new(MsgMigrateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/Migrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimPayments(ctx context.Context, in *MsgClaimPayments, opts ...grpc.CallOption) (*MsgClaimPaymentsResponse, error) {
+	out := new(MsgClaimPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/ClaimPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ExecuteBundle(ctx context.Context, in *MsgExecuteBundle, opts ...grpc.CallOption) (*MsgExecuteBundleResponse, error) {
 	out := new(MsgExecuteBundleResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.accounts.v1.Msg/ExecuteBundle", in, out, opts...)
@@ -554,6 +819,12 @@ type MsgServer interface {
 	Init(context.Context, *MsgInit) (*MsgInitResponse, error)
 	// Execute executes a message to the target account.
 	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
+	// Migrate migrates the target account to another account type, keeping its
+	// address, account number and state.
+	Migrate(context.Context, *MsgMigrate) (*MsgMigrateResponse, error)
+	// ClaimPayments claims a payment authorized by the target account for the
+	// sender merchant.
+	ClaimPayments(context.Context, *MsgClaimPayments) (*MsgClaimPaymentsResponse, error)
 	// ExecuteBundle pertains account abstraction, it is used by the bundler
 	// to execute multiple UserOperations in a single transaction message.
 	ExecuteBundle(context.Context, *MsgExecuteBundle) (*MsgExecuteBundleResponse, error)
@@ -569,6 +840,12 @@ func (*UnimplementedMsgServer) Init(ctx context.Context, req *MsgInit) (*MsgInit
 func (*UnimplementedMsgServer) Execute(ctx context.Context, req *MsgExecute) (*MsgExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
+func (*UnimplementedMsgServer) Migrate(ctx context.Context, req *MsgMigrate) (*MsgMigrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Migrate not implemented")
+}
+func (*UnimplementedMsgServer) ClaimPayments(ctx context.Context, req *MsgClaimPayments) (*MsgClaimPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimPayments not implemented")
+}
 func (*UnimplementedMsgServer) ExecuteBundle(ctx context.Context, req *MsgExecuteBundle) (*MsgExecuteBundleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteBundle not implemented")
 }
@@ -613,6 +890,42 @@ func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Msg/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Migrate(ctx, req.(*MsgMigrate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimPayments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accounts.v1.Msg/ClaimPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimPayments(ctx, req.(*MsgClaimPayments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ExecuteBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExecuteBundle)
 	if err := dec(in); err != nil {
@@ -644,6 +957,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Execute",
 			Handler:    _Msg_Execute_Handler,
 		},
+		{
+			MethodName: "Migrate",
+			Handler:    _Msg_Migrate_Handler,
+		},
+		{
+			MethodName: "ClaimPayments",
+			Handler:    _Msg_ClaimPayments_Handler,
+		},
 		{
 			MethodName: "ExecuteBundle",
 			Handler:    _Msg_ExecuteBundle_Handler,
@@ -856,7 +1177,7 @@ func (m *MsgExecuteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteBundle) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -866,41 +1187,53 @@ func (m *MsgExecuteBundle) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteBundle) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Bundler) > 0 {
-		i -= len(m.Bundler)
-		copy(dAtA[i:], m.Bundler)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bundler)))
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BundledTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgMigrateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -910,26 +1243,19 @@ func (m *BundledTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BundledTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMigrateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BundledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMigrateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ExecResponses != nil {
+	if m.Response != nil {
 		{
-			size, err := m.ExecResponses.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -942,7 +1268,7 @@ func (m *BundledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteBundleResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimPayments) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -952,18 +1278,192 @@ func (m *MsgExecuteBundleResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecuteBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimPayments) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecuteBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimPayments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Responses) > 0 {
-		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteBundle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteBundle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bundler) > 0 {
+		i -= len(m.Bundler)
+		copy(dAtA[i:], m.Bundler)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bundler)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundledTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundledTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExecResponses != nil {
+		{
+			size, err := m.ExecResponses.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteBundleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteBundleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -1074,6 +1574,82 @@ func (m *MsgExecuteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimPayments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgExecuteBundle) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1703,6 +2279,506 @@ func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &any.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &any.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimPayments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimPayments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimPayments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimPaymentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimPaymentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgExecuteBundle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
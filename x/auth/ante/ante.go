@@ -22,6 +22,7 @@ type HandlerOptions struct {
 	ConsensusKeeper          ConsensusKeeper
 	ExtensionOptionChecker   ExtensionOptionChecker
 	FeegrantKeeper           FeegrantKeeper
+	PaymasterKeeper          PaymasterKeeper
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter gas.Meter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker             TxFeeChecker
@@ -44,6 +45,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	deductFeeDecorator := NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker)
+	deductFeeDecorator.SetPaymasterKeeper(options.PaymasterKeeper)
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(options.Environment, options.ConsensusKeeper), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
@@ -51,7 +55,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(options.Environment),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		deductFeeDecorator,
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
 	}
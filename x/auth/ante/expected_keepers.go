@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -27,6 +28,13 @@ type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
+// PaymasterKeeper defines the expected keeper of the paymaster accounts, which can
+// sponsor the fees of the txs designating them as fee granter.
+type PaymasterKeeper interface {
+	IsPaymasterAccount(ctx context.Context, addr []byte) (bool, error)
+	SponsorFees(ctx context.Context, paymaster, feePayer []byte, fee sdk.Coins, protoTx *tx.Tx) error
+}
+
 type ConsensusKeeper interface {
 	BlockParams(context.Context) (uint64, uint64, error)
 }
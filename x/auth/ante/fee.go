@@ -11,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -19,6 +20,7 @@ import (
 type TxFeeChecker func(ctx context.Context, tx transaction.Tx) (sdk.Coins, int64, error)
 
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
+// The fee granter is either a paymaster account (if a PaymasterKeeper is set) sponsoring the fees, or a feegrant granter.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
 // Call next AnteHandler if fees are successfully deducted.
 // CONTRACT: The Tx must implement the FeeTx interface to use DeductFeeDecorator.
@@ -28,6 +30,8 @@ type DeductFeeDecorator struct {
 	feegrantKeeper FeegrantKeeper
 	txFeeChecker   TxFeeChecker
 	minGasPrices   sdk.DecCoins
+
+	paymasterKeeper PaymasterKeeper
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) *DeductFeeDecorator {
@@ -51,6 +55,12 @@ func (dfd *DeductFeeDecorator) SetMinGasPrices(minGasPrices sdk.DecCoins) {
 	dfd.minGasPrices = minGasPrices
 }
 
+// SetPaymasterKeeper sets the keeper of the paymaster accounts, letting the fee granter of a tx be a paymaster account
+// which sponsors the fees after running its own validation logic.
+func (dfd *DeductFeeDecorator) SetPaymasterKeeper(pk PaymasterKeeper) {
+	dfd.paymasterKeeper = pk
+}
+
 // AnteHandle implements an AnteHandler decorator for the DeductFeeDecorator
 func (dfd *DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ bool, next sdk.AnteHandler) (sdk.Context, error) {
 	dfd.minGasPrices = ctx.MinGasPrices()
@@ -109,9 +119,18 @@ func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.Fee
 	deductFeesFrom := feePayer
 
 	// if feegranter set, deduct fee from feegranter account.
-	// this works only when feegrant is enabled.
+	// this works only when feegrant is enabled, or when the feegranter is a paymaster.
 	if feeGranter != nil {
-		if dfd.feegrantKeeper == nil {
+		isPaymaster, err := dfd.isPaymaster(ctx, feeGranter, feePayer)
+		if err != nil {
+			return err
+		}
+
+		if isPaymaster {
+			if err := dfd.sponsorFees(ctx, feeTx, feeGranter, feePayer, fee); err != nil {
+				return err
+			}
+		} else if dfd.feegrantKeeper == nil {
 			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranter, feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, feeTx.GetMsgs())
@@ -148,6 +167,41 @@ func (dfd *DeductFeeDecorator) checkDeductFee(ctx context.Context, feeTx sdk.Fee
 	return nil
 }
 
+// isPaymaster returns true if the fee granter is a paymaster account sponsoring the fees of another fee payer.
+func (dfd *DeductFeeDecorator) isPaymaster(ctx context.Context, feeGranter, feePayer []byte) (bool, error) {
+	if dfd.paymasterKeeper == nil || bytes.Equal(feeGranter, feePayer) {
+		return false, nil
+	}
+	return dfd.paymasterKeeper.IsPaymasterAccount(ctx, feeGranter)
+}
+
+// sponsorFees asks the paymaster to sponsor the fees of the tx, the paymaster runs its own validation logic.
+func (dfd *DeductFeeDecorator) sponsorFees(ctx context.Context, feeTx sdk.FeeTx, paymaster, feePayer []byte, fee sdk.Coins) error {
+	infoTx, ok := feeTx.(interface {
+		AsTx() (*tx.Tx, error)
+	})
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "paymasters require a proto tx")
+	}
+	protoTx, err := infoTx.AsTx()
+	if err != nil {
+		return fmt.Errorf("unable to get proto tx: %w", err)
+	}
+
+	if err := dfd.paymasterKeeper.SponsorFees(ctx, paymaster, feePayer, fee, protoTx); err != nil {
+		paymasterAddr, acErr := dfd.accountKeeper.AddressCodec().BytesToString(paymaster)
+		if acErr != nil {
+			return errorsmod.Wrapf(err, "%s, paymaster does not sponsor the fees", acErr.Error())
+		}
+		payerAddr, acErr := dfd.accountKeeper.AddressCodec().BytesToString(feePayer)
+		if acErr != nil {
+			return errorsmod.Wrapf(err, "%s, paymaster does not sponsor the fees", acErr.Error())
+		}
+		return errorsmod.Wrapf(err, "%s does not sponsor the fees of %s", paymasterAddr, payerAddr)
+	}
+	return nil
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx context.Context, acc []byte, fees sdk.Coins) error {
 	if !fees.IsValid() {
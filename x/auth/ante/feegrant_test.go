@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	antetestutil "github.com/cosmos/cosmos-sdk/x/auth/ante/testutil"
 	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -253,3 +254,78 @@ func genTxWithFeeGranter(gen client.TxConfig, msgs []sdk.Msg, feeAmt sdk.Coins,
 
 	return tx.GetTx(), nil
 }
+
+func TestDeductFeesPaymaster(t *testing.T) {
+	cases := map[string]struct {
+		valid    bool
+		errMsg   string
+		malleate func(*AnteTestSuite, *antetestutil.MockPaymasterKeeper) (signer TestAccount, feeAcc sdk.AccAddress)
+	}{
+		"paymaster sponsors the fees": {
+			valid: true,
+			malleate: func(suite *AnteTestSuite, pk *antetestutil.MockPaymasterKeeper) (TestAccount, sdk.AccAddress) {
+				accs := suite.CreateTestAccounts(2)
+				pk.EXPECT().IsPaymasterAccount(gomock.Any(), []byte(accs[1].acc.GetAddress())).Return(true, nil)
+				pk.EXPECT().SponsorFees(gomock.Any(), []byte(accs[1].acc.GetAddress()), []byte(accs[0].acc.GetAddress()), gomock.Any(), gomock.Not(gomock.Nil())).Return(nil)
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+				return accs[0], accs[1].acc.GetAddress()
+			},
+		},
+		"paymaster refuses to sponsor the fees": {
+			valid:  false,
+			errMsg: "fee payer is not allowed",
+			malleate: func(suite *AnteTestSuite, pk *antetestutil.MockPaymasterKeeper) (TestAccount, sdk.AccAddress) {
+				accs := suite.CreateTestAccounts(2)
+				pk.EXPECT().IsPaymasterAccount(gomock.Any(), []byte(accs[1].acc.GetAddress())).Return(true, nil)
+				pk.EXPECT().SponsorFees(gomock.Any(), []byte(accs[1].acc.GetAddress()), []byte(accs[0].acc.GetAddress()), gomock.Any(), gomock.Any()).Return(errors.New("fee payer is not allowed"))
+				return accs[0], accs[1].acc.GetAddress()
+			},
+		},
+		"fee granter is not a paymaster": {
+			valid: true,
+			malleate: func(suite *AnteTestSuite, pk *antetestutil.MockPaymasterKeeper) (TestAccount, sdk.AccAddress) {
+				accs := suite.CreateTestAccounts(2)
+				pk.EXPECT().IsPaymasterAccount(gomock.Any(), []byte(accs[1].acc.GetAddress())).Return(false, nil)
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(nil)
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+				return accs[0], accs[1].acc.GetAddress()
+			},
+		},
+		"no fee granter": {
+			valid: true,
+			malleate: func(suite *AnteTestSuite, pk *antetestutil.MockPaymasterKeeper) (TestAccount, sdk.AccAddress) {
+				accs := suite.CreateTestAccounts(1)
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+				return accs[0], nil
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+			cdc := codec.NewProtoCodec(suite.encCfg.InterfaceRegistry)
+			signingCtx := suite.encCfg.InterfaceRegistry.SigningContext()
+			protoTxCfg := tx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), tx.DefaultSignModes)
+			paymasterKeeper := antetestutil.NewMockPaymasterKeeper(gomock.NewController(t))
+			dfd := ante.NewDeductFeeDecorator(suite.accountKeeper, suite.bankKeeper, suite.feeGrantKeeper, nil)
+			dfd.SetPaymasterKeeper(paymasterKeeper)
+			feeAnteHandler := sdk.ChainAnteDecorators(dfd)
+
+			signer, feeAcc := tc.malleate(suite, paymasterKeeper)
+
+			fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 50))
+			msgs := []sdk.Msg{testdata.NewTestMsg(signer.acc.GetAddress())}
+			acc := suite.accountKeeper.GetAccount(suite.ctx, signer.acc.GetAddress())
+			tx, err := genTxWithFeeGranter(protoTxCfg, msgs, fee, 10000000, suite.ctx.ChainID(), []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, feeAcc, signer.priv)
+			require.NoError(t, err)
+
+			_, err = feeAnteHandler(suite.ctx, tx, false)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
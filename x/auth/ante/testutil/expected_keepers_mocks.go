@@ -11,6 +11,7 @@ import (
 	address "cosmossdk.io/core/address"
 	appmodule "cosmossdk.io/core/appmodule"
 	types "github.com/cosmos/cosmos-sdk/types"
+	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -171,6 +172,58 @@ func (mr *MockFeegrantKeeperMockRecorder) UseGrantedFees(ctx, granter, grantee,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseGrantedFees", reflect.TypeOf((*MockFeegrantKeeper)(nil).UseGrantedFees), ctx, granter, grantee, fee, msgs)
 }
 
+// MockPaymasterKeeper is a mock of PaymasterKeeper interface.
+type MockPaymasterKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymasterKeeperMockRecorder
+}
+
+// MockPaymasterKeeperMockRecorder is the mock recorder for MockPaymasterKeeper.
+type MockPaymasterKeeperMockRecorder struct {
+	mock *MockPaymasterKeeper
+}
+
+// NewMockPaymasterKeeper creates a new mock instance.
+func NewMockPaymasterKeeper(ctrl *gomock.Controller) *MockPaymasterKeeper {
+	mock := &MockPaymasterKeeper{ctrl: ctrl}
+	mock.recorder = &MockPaymasterKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymasterKeeper) EXPECT() *MockPaymasterKeeperMockRecorder {
+	return m.recorder
+}
+
+// IsPaymasterAccount mocks base method.
+func (m *MockPaymasterKeeper) IsPaymasterAccount(ctx context.Context, addr []byte) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaymasterAccount", ctx, addr)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPaymasterAccount indicates an expected call of IsPaymasterAccount.
+func (mr *MockPaymasterKeeperMockRecorder) IsPaymasterAccount(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaymasterAccount", reflect.TypeOf((*MockPaymasterKeeper)(nil).IsPaymasterAccount), ctx, addr)
+}
+
+// SponsorFees mocks base method.
+func (m *MockPaymasterKeeper) SponsorFees(ctx context.Context, paymaster, feePayer []byte, fee types.Coins, protoTx *tx.Tx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SponsorFees", ctx, paymaster, feePayer, fee, protoTx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SponsorFees indicates an expected call of SponsorFees.
+func (mr *MockPaymasterKeeperMockRecorder) SponsorFees(ctx, paymaster, feePayer, fee, protoTx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SponsorFees", reflect.TypeOf((*MockPaymasterKeeper)(nil).SponsorFees), ctx, paymaster, feePayer, fee, protoTx)
+}
+
 // MockConsensusKeeper is a mock of ConsensusKeeper interface.
 type MockConsensusKeeper struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/transaction"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// LegacyAccountMigrator returns the x/accounts account type and init message an
+// x/auth account is migrated to. An empty account type means the account is
+// not migrated.
+type LegacyAccountMigrator func(ctx context.Context, acc sdk.AccountI) (accountType string, initMsg transaction.Msg, err error)
+
+// MigrateLegacyAccount migrates a BaseAccount or a vesting account to the
+// x/accounts account type initialized with initMsg, and removes it from x/auth.
+// The address and the account number are kept, and the migration fails when
+// the legacy representation of the new account does not preserve the sequence
+// of a BaseAccount or the locked coins of a vesting account.
+func (ak AccountKeeper) MigrateLegacyAccount(ctx context.Context, acc sdk.AccountI, accountType string, initMsg transaction.Msg) (transaction.Msg, error) {
+	if err := checkLegacyAccountType(acc); err != nil {
+		return nil, err
+	}
+
+	initResp, err := ak.AccountsModKeeper.MigrateLegacyAccount(ctx, acc.GetAddress(), acc.GetAccountNumber(), accountType, initMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isVestingAccount := acc.(vestingexported.VestingAccount); isVestingAccount {
+		err = ak.verifyMigratedVestingAccount(ctx, acc)
+	} else {
+		err = ak.verifyMigratedBaseAccount(ctx, acc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// account is then removed from state
+	ak.RemoveAccount(ctx, acc)
+
+	return initResp, nil
+}
+
+// checkLegacyAccountType checks the account is a BaseAccount or a vesting account.
+func checkLegacyAccountType(acc sdk.AccountI) error {
+	switch acc.(type) {
+	case *types.BaseAccount, vestingexported.VestingAccount:
+		return nil
+	default:
+		return status.Error(codes.InvalidArgument, "only BaseAccount and vesting accounts can be migrated")
+	}
+}
+
+// verifyMigratedBaseAccount checks the sequence of a migrated BaseAccount was
+// not decreased, which would allow to replay its txs. Accounts without a legacy
+// representation are not checked.
+func (ak AccountKeeper) verifyMigratedBaseAccount(ctx context.Context, acc sdk.AccountI) error {
+	resp, err := ak.AccountsModKeeper.Query(ctx, acc.GetAddress(), &types.QueryLegacyAccount{})
+	if err == nil {
+		base := resp.(*types.QueryLegacyAccountResponse).Base
+		if base != nil && base.Sequence < acc.GetSequence() {
+			return fmt.Errorf("migrated account sequence %d is lower than the account sequence %d", base.Sequence, acc.GetSequence())
+		}
+	}
+	return nil
+}
+
+// verifyMigratedVestingAccount checks the legacy representation of a migrated
+// vesting account matches the account. The pubkey and the sequence are not
+// compared, as the lockup accounts do not sign txs.
+func (ak AccountKeeper) verifyMigratedVestingAccount(ctx context.Context, acc sdk.AccountI) error {
+	resp, err := ak.AccountsModKeeper.Query(ctx, acc.GetAddress(), &types.QueryLegacyAccount{})
+	if err != nil {
+		return fmt.Errorf("failed to query the migrated vesting account: %w", err)
+	}
+	var migrated sdk.AccountI
+	if err := ak.cdc.UnpackAny(resp.(*types.QueryLegacyAccountResponse).Account, &migrated); err != nil {
+		return fmt.Errorf("failed to unpack the migrated vesting account: %w", err)
+	}
+	if err := migrated.SetPubKey(acc.GetPubKey()); err != nil {
+		return err
+	}
+	if err := migrated.SetSequence(acc.GetSequence()); err != nil {
+		return err
+	}
+
+	legacyBz, err := ak.cdc.Marshal(acc)
+	if err != nil {
+		return err
+	}
+	migratedBz, err := ak.cdc.Marshal(migrated)
+	if err != nil {
+		return err
+	}
+	if sdk.MsgTypeURL(migrated) != sdk.MsgTypeURL(acc) || !bytes.Equal(migratedBz, legacyBz) {
+		return fmt.Errorf("migrated account does not match the vesting account %s", acc.GetAddress())
+	}
+	return nil
+}
+
+// MigrateLegacyAccounts migrates at most limit x/auth accounts to x/accounts,
+// starting from the account at the from address, the accounts for which the
+// migrator returns an empty account type are skipped. It returns the address
+// to start the next batch from, or nil once all the accounts were iterated.
+//
+// Should only be used in an upgrade handler, spreading the migration of the
+// accounts across blocks if needed.
+func MigrateLegacyAccounts(ctx context.Context, ak *AccountKeeper, migrator LegacyAccountMigrator, from sdk.AccAddress, limit int) (sdk.AccAddress, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid limit %d", limit)
+	}
+
+	var ranger collections.Ranger[sdk.AccAddress]
+	if from != nil {
+		ranger = new(collections.Range[sdk.AccAddress]).StartInclusive(from)
+	}
+
+	// accounts are collected first, as they are removed from state once migrated
+	var accs []sdk.AccountI
+	err := ak.Accounts.Walk(ctx, ranger, func(_ sdk.AccAddress, acc sdk.AccountI) (stop bool, err error) {
+		accs = append(accs, acc)
+		return len(accs) > limit, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var next sdk.AccAddress
+	if len(accs) > limit {
+		next = accs[limit].GetAddress()
+		accs = accs[:limit]
+	}
+
+	for _, acc := range accs {
+		accountType, initMsg, err := migrator(ctx, acc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate account %s: %w", acc.GetAddress(), err)
+		}
+		if accountType == "" {
+			continue
+		}
+		if _, err := ak.MigrateLegacyAccount(ctx, acc, accountType, initMsg); err != nil {
+			return nil, fmt.Errorf("failed to migrate account %s: %w", acc.GetAddress(), err)
+		}
+	}
+
+	return next, nil
+}
@@ -8,8 +8,6 @@ import (
 	"strings"
 
 	gogoproto "github.com/cosmos/gogoproto/proto"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -88,9 +86,8 @@ func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAcc
 	}
 
 	// check if account type is valid or not
-	_, isBaseAccount := (acc).(*types.BaseAccount)
-	if !isBaseAccount {
-		return nil, status.Error(codes.InvalidArgument, "only BaseAccount can be migrated")
+	if err := checkLegacyAccountType(acc); err != nil {
+		return nil, err
 	}
 
 	// unwrap any msg
@@ -99,14 +96,11 @@ func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAcc
 		return nil, err
 	}
 
-	initResp, err := ms.ak.AccountsModKeeper.MigrateLegacyAccount(ctx, signer, acc.GetAccountNumber(), msg.AccountType, initMsg)
+	initResp, err := ms.ak.MigrateLegacyAccount(ctx, acc, msg.AccountType, initMsg)
 	if err != nil {
 		return nil, err
 	}
 
-	// account is then removed from state
-	ms.ak.RemoveAccount(ctx, acc)
-
 	initRespAny, err := codectypes.NewAnyWithValue(initResp)
 	if err != nil {
 		return nil, err
@@ -10,6 +10,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	corestore "cosmossdk.io/core/store"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -23,20 +25,45 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateWithOverridesFn is the signature of the Baseapp#SimulateWithOverrides function.
+type baseAppSimulateWithOverridesFn func(txBytes []byte, height int64, overrides []corestore.StateChanges) (sdk.GasInfo, *sdk.Result, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx         client.Context
-	simulate          baseAppSimulateFn
-	interfaceRegistry codectypes.InterfaceRegistry
+	clientCtx             client.Context
+	simulate              baseAppSimulateFn
+	simulateWithOverrides baseAppSimulateWithOverridesFn
+	interfaceRegistry     codectypes.InterfaceRegistry
+}
+
+// TxServerOption is an option of the Tx service server.
+type TxServerOption func(*txServer)
+
+// WithSimulateWithOverrides enables the simulations against a past height or
+// with state overrides, run by the given function.
+func WithSimulateWithOverrides(simulateWithOverrides baseAppSimulateWithOverridesFn) TxServerOption {
+	return func(s *txServer) {
+		s.simulateWithOverrides = simulateWithOverrides
+	}
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
-	return txServer{
+func NewTxServer(
+	clientCtx client.Context,
+	simulate baseAppSimulateFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
+) txtypes.ServiceServer {
+	s := txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
 }
 
 var _ txtypes.ServiceServer = txServer{}
@@ -94,7 +121,24 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
-	gasInfo, result, err := s.simulate(txBytes)
+	var (
+		gasInfo sdk.GasInfo
+		result  *sdk.Result
+		err     error
+	)
+	switch {
+	case req.Height < 0:
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+
+	case req.Height == 0 && len(req.StateOverrides) == 0:
+		gasInfo, result, err = s.simulate(txBytes)
+
+	case s.simulateWithOverrides == nil:
+		return nil, status.Error(codes.Unimplemented, "simulations against a height or with state overrides are not supported")
+
+	default:
+		gasInfo, result, err = s.simulateWithOverrides(txBytes, req.Height, txtypes.StateOverridesToStateChanges(req.StateOverrides))
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasInfo.GasUsed)
 	}
@@ -318,10 +362,11 @@ func RegisterTxService(
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, opts...),
 	)
 }
 
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// NewAccountStateOverride returns the state override setting an account, e.g.
+// with another sequence or public key, to simulate a transaction with.
+func NewAccountStateOverride(cdc codec.BinaryCodec, acc sdk.AccountI) (*txtypes.StateOverride, error) {
+	return txtypes.NewStateOverride(
+		StoreKey,
+		AddressStoreKeyPrefix,
+		sdk.AccAddressKey,
+		codec.CollInterfaceValue[sdk.AccountI](cdc),
+		acc.GetAddress(),
+		acc,
+	)
+}
@@ -280,7 +280,7 @@ func (m *MsgNonAtomicExecResponse) GetResults() []*NonAtomicExecResult {
 }
 
 // MsgMigrateAccount defines a message which allows users to migrate from BaseAccount
-// to other x/accounts types.
+// or vesting accounts to other x/accounts types.
 type MsgMigrateAccount struct {
 	Signer         string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	AccountType    string   `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// NewBalanceStateOverride returns the state override setting the balance of an
+// account to simulate a transaction with. The total supply and the balances by
+// denom index are not overridden.
+func NewBalanceStateOverride(addr sdk.AccAddress, balance sdk.Coin) (*txtypes.StateOverride, error) {
+	return txtypes.NewStateOverride(
+		StoreKey,
+		BalancesPrefix,
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+		BalanceValueCodec,
+		collections.Join(addr, balance.Denom),
+		balance.Amount,
+	)
+}
@@ -42,6 +42,7 @@ type ModuleInputs struct {
 	ConsensusKeeper          ante.ConsensusKeeper
 	FeeGrantKeeper           ante.FeegrantKeeper                       `optional:"true"`
 	AccountAbstractionKeeper ante.AccountAbstractionKeeper             `optional:"true"`
+	PaymasterKeeper          ante.PaymasterKeeper                      `optional:"true"`
 	ExtraTxValidators        []appmodulev2.TxValidator[transaction.Tx] `optional:"true"`
 	UnorderedTxManager       *unorderedtx.Manager                      `optional:"true"`
 	TxFeeChecker             ante.TxFeeChecker                         `optional:"true"`
@@ -77,7 +78,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		}
 
 		feeTxValidator = ante.NewDeductFeeDecorator(in.AccountKeeper, in.BankKeeper, in.FeeGrantKeeper, in.TxFeeChecker)
-		feeTxValidator.SetMinGasPrices(minGasPrices) // set min gas price in deduct fee decorator
+		feeTxValidator.SetMinGasPrices(minGasPrices)          // set min gas price in deduct fee decorator
+		feeTxValidator.SetPaymasterKeeper(in.PaymasterKeeper) // can be nil
 	}
 
 	if in.UnorderedTxManager != nil {
@@ -133,6 +135,7 @@ func newAnteHandler(in ModuleInputs) (sdk.AnteHandler, error) {
 			BankKeeper:         in.BankKeeper,
 			SignModeHandler:    in.TxConfig.SignModeHandler(),
 			FeegrantKeeper:     in.FeeGrantKeeper,
+			PaymasterKeeper:    in.PaymasterKeeper,
 			SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
 			UnorderedTxManager: in.UnorderedTxManager,
 		},